@@ -0,0 +1,148 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"errors"
+	"internal/extract"
+	"io"
+	"io/fs"
+)
+
+// ExtractOptions control how [Reader.Extract] extracts files.
+// The zero value extracts regular files, directories, and hard links,
+// without limits, and fails on the other kinds of file.
+type ExtractOptions struct {
+	// Symlinks is how symbolic links are handled.
+	Symlinks SymlinkPolicy
+
+	// SkipSpecial skips device files and named pipes,
+	// which are never created. Without it, they are an error.
+	SkipSpecial bool
+
+	// PreserveOwner sets the owner and group of the files to the
+	// Uid and Gid of their headers, which usually needs privileges.
+	// Otherwise the files belong to the user running the program.
+	PreserveOwner bool
+
+	// PreservePermissions gives files and directories exactly the
+	// permissions of their headers, including the setuid, setgid,
+	// and sticky bits. Otherwise they are created with the permission
+	// bits of their headers, less the umask of the process, and
+	// directories can always be written by their owner.
+	PreservePermissions bool
+
+	// Overwrite replaces existing files and symbolic links.
+	// Otherwise an existing file is an error. Existing directories
+	// are used as they are, and never replaced.
+	Overwrite bool
+
+	// MaxFiles limits the number of files, MaxFileSize the size of
+	// each regular file, and MaxTotalSize the total size of the regular
+	// files. The size of a sparse file is its size with the holes.
+	// Zero means no limit.
+	MaxFiles     int64
+	MaxFileSize  int64
+	MaxTotalSize int64
+}
+
+// A SymlinkPolicy is how [Reader.Extract] handles symbolic links.
+type SymlinkPolicy int
+
+const (
+	// SymlinkError makes a symbolic link an error.
+	SymlinkError SymlinkPolicy = iota
+
+	// SymlinkSkip skips symbolic links.
+	SymlinkSkip
+
+	// SymlinkLocal creates the symbolic links that lead to files within
+	// the destination directory, and makes the others an error. The
+	// target of such a link is a relative path that may start with ".."
+	// elements, leading up from the directory of the link but not out of
+	// the destination, and has no ".." elements after that.
+	SymlinkLocal
+)
+
+func (opts *ExtractOptions) internal() *extract.Options {
+	if opts == nil {
+		return nil
+	}
+	return &extract.Options{
+		Symlinks:            int(opts.Symlinks),
+		SkipSpecial:         opts.SkipSpecial,
+		PreserveOwner:       opts.PreserveOwner,
+		PreservePermissions: opts.PreservePermissions,
+		Overwrite:           opts.Overwrite,
+		MaxFiles:            opts.MaxFiles,
+		MaxFileSize:         opts.MaxFileSize,
+		MaxTotalSize:        opts.MaxTotalSize,
+	}
+}
+
+var errUnsupportedType = errors.New("archive/tar: unsupported file type")
+
+// Extract extracts the rest of the files in the archive into the
+// directory dst, creating it if needed. A nil opts is the same as
+// the zero [ExtractOptions]. The modification times of the files are
+// set from their headers. The content of sparse files is written
+// with holes, if the file system supports them.
+//
+// All files are kept within dst: the extraction fails on the first
+// file with a name that is not local, as defined by [path/filepath.IsLocal],
+// and files are never created or written through symbolic links. Hard
+// links must refer to regular files extracted earlier. This holds as
+// long as no other program modifies dst during the extraction.
+//
+// The errors for particular files are [*fs.PathError] values. When
+// Extract fails, the files that it has already extracted remain.
+func (tr *Reader) Extract(dst string, opts *ExtractOptions) error {
+	x, err := extract.New(dst, opts.internal(), ErrInsecurePath)
+	if err != nil {
+		return err
+	}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != ErrInsecurePath {
+			return err
+		}
+		e := &extract.Entry{
+			Name:     hdr.Name,
+			Linkname: hdr.Linkname,
+			Mode:     hdr.FileInfo().Mode(),
+			ModTime:  hdr.ModTime,
+			Uid:      hdr.Uid,
+			Gid:      hdr.Gid,
+			Size:     hdr.Size,
+		}
+		switch hdr.Typeflag {
+		case TypeReg, TypeCont, TypeGNUSparse:
+			e.Kind = extract.Regular
+		case TypeDir:
+			e.Kind = extract.Dir
+		case TypeSymlink:
+			e.Kind = extract.Symlink
+		case TypeLink:
+			e.Kind = extract.Hardlink
+		case TypeChar, TypeBlock, TypeFifo:
+			e.Kind = extract.Special
+		case TypeXGlobalHeader:
+			continue
+		default:
+			return &fs.PathError{Op: "extract", Path: hdr.Name, Err: errUnsupportedType}
+		}
+		err = x.Extract(e, func(w io.Writer) error {
+			_, err := tr.writeTo(w)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return x.Finish()
+}
@@ -0,0 +1,376 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"bytes"
+	"errors"
+	"internal/testenv"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// extractFile is a file in an archive built by makeExtractTar.
+type extractFile struct {
+	name     string
+	typeflag byte
+	linkname string
+	mode     int64
+	data     string
+}
+
+func makeExtractTar(t *testing.T, files []extractFile) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := NewWriter(&buf)
+	for _, f := range files {
+		hdr := &Header{
+			Name:     f.name,
+			Typeflag: f.typeflag,
+			Linkname: f.linkname,
+			Mode:     f.mode,
+			Size:     int64(len(f.data)),
+			ModTime:  time.Unix(1e9, 0),
+		}
+		if f.typeflag == 0 {
+			hdr.Typeflag = TypeReg
+		}
+		if hdr.Typeflag != TypeReg {
+			hdr.Size = 0
+		}
+		if hdr.Mode == 0 {
+			hdr.Mode = 0644
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(tw, f.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestExtract(t *testing.T) {
+	testenv.MustHaveSymlink(t)
+	dst := filepath.Join(t.TempDir(), "dst")
+	tr := NewReader(makeExtractTar(t, []extractFile{
+		{name: "dir/", typeflag: TypeDir, mode: 0755},
+		{name: "dir/file", data: "hello"},
+		{name: "dir/sub/deep", data: "deep", mode: 0600},
+		{name: "hard", typeflag: TypeLink, linkname: "dir/file"},
+		{name: "dir/link", typeflag: TypeSymlink, linkname: "sub/deep"},
+		{name: "dir/sub/up", typeflag: TypeSymlink, linkname: "../file"},
+		{name: "fifo", typeflag: TypeFifo},
+	}))
+	err := tr.Extract(dst, &ExtractOptions{Symlinks: SymlinkLocal, SkipSpecial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"dir/file":     "hello",
+		"dir/sub/deep": "deep",
+		"hard":         "hello",
+		"dir/link":     "deep",
+		"dir/sub/up":   "hello",
+	} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Error(err)
+		} else if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if _, err := os.Lstat(filepath.Join(dst, "fifo")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("special file created: %v", err)
+	}
+	fi, err := os.Lstat(filepath.Join(dst, "dir"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fi.ModTime().Equal(time.Unix(1e9, 0)) {
+		t.Errorf("dir modification time = %v, want %v", fi.ModTime(), time.Unix(1e9, 0))
+	}
+	if fi, err := os.Lstat(filepath.Join(dst, "dir/link")); err != nil || fi.Mode()&fs.ModeSymlink == 0 {
+		t.Errorf("dir/link is not a symbolic link: %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		fi, err := os.Stat(filepath.Join(dst, "dir/sub/deep"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode().Perm() != 0600 {
+			t.Errorf("dir/sub/deep has mode %v, want 0600", fi.Mode().Perm())
+		}
+	}
+}
+
+func TestExtractErrors(t *testing.T) {
+	testenv.MustHaveSymlink(t)
+	tests := []struct {
+		name  string
+		files []extractFile
+		opts  *ExtractOptions
+		err   error // if nil, any error
+	}{{
+		name:  "parent",
+		files: []extractFile{{name: "../evil", data: "x"}},
+		err:   ErrInsecurePath,
+	}, {
+		name:  "absolute",
+		files: []extractFile{{name: "/evil", data: "x"}},
+		err:   ErrInsecurePath,
+	}, {
+		name:  "dotdot inside",
+		files: []extractFile{{name: "a/../../evil", data: "x"}},
+		err:   ErrInsecurePath,
+	}, {
+		name:  "symlink default",
+		files: []extractFile{{name: "link", typeflag: TypeSymlink, linkname: "file"}},
+	}, {
+		name:  "special default",
+		files: []extractFile{{name: "fifo", typeflag: TypeFifo}},
+	}, {
+		name:  "symlink out",
+		files: []extractFile{{name: "link", typeflag: TypeSymlink, linkname: "../evil"}},
+		opts:  &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name:  "symlink absolute",
+		files: []extractFile{{name: "link", typeflag: TypeSymlink, linkname: "/etc"}},
+		opts:  &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name:  "symlink too far up",
+		files: []extractFile{{name: "a/link", typeflag: TypeSymlink, linkname: "../../evil"}},
+		opts:  &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		// link2 resolves to the destination, so link2/../.. leads out of it.
+		name: "symlink chain",
+		files: []extractFile{
+			{name: "sub/link2", typeflag: TypeSymlink, linkname: ".."},
+			{name: "sub/link3", typeflag: TypeSymlink, linkname: "link2/../.."},
+		},
+		opts: &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name: "through symlink",
+		files: []extractFile{
+			{name: "dir/", typeflag: TypeDir},
+			{name: "link", typeflag: TypeSymlink, linkname: "dir"},
+			{name: "link/file", data: "x"},
+		},
+		opts: &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name: "through file",
+		files: []extractFile{
+			{name: "file", data: "x"},
+			{name: "file/file", data: "x"},
+		},
+	}, {
+		name:  "hard link out",
+		files: []extractFile{{name: "hard", typeflag: TypeLink, linkname: "../evil"}},
+		err:   ErrInsecurePath,
+	}, {
+		name: "hard link to symlink",
+		files: []extractFile{
+			{name: "link", typeflag: TypeSymlink, linkname: "file"},
+			{name: "file", data: "x"},
+			{name: "hard", typeflag: TypeLink, linkname: "link"},
+		},
+		opts: &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name:  "hard link missing",
+		files: []extractFile{{name: "hard", typeflag: TypeLink, linkname: "missing"}},
+		err:   fs.ErrNotExist,
+	}, {
+		name: "exists",
+		files: []extractFile{
+			{name: "file", data: "x"},
+			{name: "file", data: "y"},
+		},
+		err: fs.ErrExist,
+	}, {
+		name: "replace dir",
+		files: []extractFile{
+			{name: "dir/", typeflag: TypeDir},
+			{name: "dir", data: "y"},
+		},
+		opts: &ExtractOptions{Overwrite: true},
+		err:  fs.ErrExist,
+	}, {
+		name: "max files",
+		files: []extractFile{
+			{name: "a", data: "x"},
+			{name: "b", data: "x"},
+			{name: "c", data: "x"},
+		},
+		opts: &ExtractOptions{MaxFiles: 2},
+	}, {
+		name:  "max file size",
+		files: []extractFile{{name: "a", data: "12345"}},
+		opts:  &ExtractOptions{MaxFileSize: 4},
+	}, {
+		name: "max total size",
+		files: []extractFile{
+			{name: "a", data: "123"},
+			{name: "b", data: "456"},
+		},
+		opts: &ExtractOptions{MaxTotalSize: 5},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dst := filepath.Join(dir, "dst")
+			err := NewReader(makeExtractTar(t, tt.files)).Extract(dst, tt.opts)
+			if err == nil {
+				t.Fatal("Extract succeeded, want error")
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Extract error = %v, want %v", err, tt.err)
+			}
+			if _, err := os.Lstat(filepath.Join(dir, "evil")); err == nil {
+				t.Fatal("file created outside the destination")
+			}
+		})
+	}
+}
+
+func TestExtractSymlinkSkip(t *testing.T) {
+	dst := t.TempDir()
+	tr := NewReader(makeExtractTar(t, []extractFile{
+		{name: "link", typeflag: TypeSymlink, linkname: "/etc/passwd"},
+		{name: "file", data: "x"},
+	}))
+	if err := tr.Extract(dst, &ExtractOptions{Symlinks: SymlinkSkip}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Lstat(filepath.Join(dst, "link")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("symbolic link created: %v", err)
+	}
+	if _, err := os.Lstat(filepath.Join(dst, "file")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExtractOverwrite(t *testing.T) {
+	testenv.MustHaveSymlink(t)
+	dir := t.TempDir()
+	dst := filepath.Join(dir, "dst")
+	outside := filepath.Join(dir, "outside")
+	if err := os.WriteFile(outside, []byte("keep"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(dst, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(dst, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dst, "file"), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files := []extractFile{
+		{name: "link", data: "new link"},
+		{name: "file", data: "new file"},
+	}
+	if err := NewReader(makeExtractTar(t, files)).Extract(dst, nil); !errors.Is(err, fs.ErrExist) {
+		t.Fatalf("Extract without Overwrite: got %v, want %v", err, fs.ErrExist)
+	}
+	err := NewReader(makeExtractTar(t, files)).Extract(dst, &ExtractOptions{Overwrite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"dst/link": "new link",
+		"dst/file": "new file",
+		"outside":  "keep",
+	} {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Error(err)
+		} else if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestExtractPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skipf("no Unix permissions on %s", runtime.GOOS)
+	}
+	dst := t.TempDir()
+	tr := NewReader(makeExtractTar(t, []extractFile{
+		{name: "ro/", typeflag: TypeDir, mode: 0555},
+		{name: "ro/file", data: "x", mode: 0777},
+		{name: "sticky/", typeflag: TypeDir, mode: 01777},
+	}))
+	if err := tr.Extract(dst, &ExtractOptions{PreservePermissions: true}); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chmod(filepath.Join(dst, "ro"), 0755)
+	for name, want := range map[string]fs.FileMode{
+		"ro":      fs.ModeDir | 0555,
+		"ro/file": 0777,
+		"sticky":  fs.ModeDir | fs.ModeSticky | 0777,
+	} {
+		fi, err := os.Lstat(filepath.Join(dst, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode() != want {
+			t.Errorf("%s has mode %v, want %v", name, fi.Mode(), want)
+		}
+	}
+}
+
+func TestExtractSparse(t *testing.T) {
+	f, err := os.Open("testdata/sparse-formats.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	want := make(map[string][]byte)
+	tr := NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want[hdr.Name], err = io.ReadAll(tr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	dst := t.TempDir()
+	if err := NewReader(f).Extract(dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range want {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: got %q, want %q", name, got, data)
+		}
+		if strings.HasPrefix(name, "sparse-") && len(got) != 200 {
+			t.Errorf("%s has size %d, want 200", name, len(got))
+		}
+	}
+}
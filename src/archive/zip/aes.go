@@ -0,0 +1,280 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+)
+
+// This file implements the WinZip AES encryption format, AE-1 and AE-2.
+// See https://www.winzip.com/en/support/aes-encryption/.
+//
+// An encrypted file has the method aesMethod and an extra field that
+// records the AES key size and the actual compression method. Its data
+// is a random salt, a password verifier, the compressed data encrypted
+// with AES in counter mode, and an HMAC-SHA1 of the encrypted data.
+// The keys and the verifier are derived from the password and the salt
+// with PBKDF2.
+
+const (
+	aesMethod     = 99     // the method of AES encrypted files
+	aesExtraID    = 0x9901 // WinZip AES extra field
+	aesExtraLen   = 7      // size of the data of the AES extra field
+	aesVendorID   = 0x4541 // "AE"
+	aesIterations = 1000   // PBKDF2 iteration count
+	aesVerifyLen  = 2      // size of the password verifier
+	aesMACLen     = 10     // size of the truncated HMAC-SHA1
+
+	// The vendor versions. AE-1 files record the CRC-32 of their
+	// content; AE-2 files record zero instead, as the CRC-32 of a
+	// small file can give away its content. The MAC authenticates
+	// both kinds.
+	aesVersion1 = 1
+	aesVersion2 = 2
+
+	// The key strength written by Writer: AES-256.
+	aesStrength256 = 3
+)
+
+// ErrPassword is returned by [File.Open] for a file encrypted with
+// a password when no password is available or the password is wrong.
+var ErrPassword = errors.New("zip: invalid password")
+
+// aesExtra is the content of the WinZip AES extra field.
+type aesExtra struct {
+	version  uint16
+	strength uint8 // 1, 2, and 3 mean AES-128, AES-192, and AES-256
+	method   uint16
+}
+
+// findAESExtra returns the AES extra field in extra.
+func findAESExtra(extra []byte) (ae aesExtra, ok bool) {
+	for b := readBuf(extra); len(b) >= 4; {
+		tag := b.uint16()
+		size := int(b.uint16())
+		if len(b) < size {
+			break
+		}
+		field := b.sub(size)
+		if tag != aesExtraID || size < aesExtraLen {
+			continue
+		}
+		ae.version = field.uint16()
+		if field.uint16() != aesVendorID {
+			break
+		}
+		ae.strength = field.uint8()
+		ae.method = field.uint16()
+		if ae.version != aesVersion1 && ae.version != aesVersion2 || ae.strength < 1 || ae.strength > 3 {
+			break
+		}
+		return ae, true
+	}
+	return aesExtra{}, false
+}
+
+// appendAESExtra appends the AES extra field for ae to extra.
+func appendAESExtra(extra []byte, ae aesExtra) []byte {
+	var buf [4 + aesExtraLen]byte
+	b := writeBuf(buf[:])
+	b.uint16(aesExtraID)
+	b.uint16(aesExtraLen)
+	b.uint16(ae.version)
+	b.uint16(aesVendorID)
+	b.uint8(ae.strength)
+	b.uint16(ae.method)
+	return append(extra, buf[:]...)
+}
+
+// aesKeys derives the encryption key, the authentication key, and the
+// password verifier from the password and the salt. The size of the
+// salt is half the size of the keys.
+func aesKeys(password string, salt []byte) (encKey, macKey, verifier []byte, err error) {
+	n := 2 * len(salt)
+	k, err := pbkdf2.Key(sha1.New, password, salt, aesIterations, 2*n+aesVerifyLen)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return k[:n], k[n : 2*n], k[2*n:], nil
+}
+
+// aesCTR is the counter mode used by the WinZip format. It differs from
+// [cipher.NewCTR] in that the counter is a little-endian number that
+// fills the block and starts at one.
+type aesCTR struct {
+	b      cipher.Block
+	ctr    [aes.BlockSize]byte
+	stream [aes.BlockSize]byte
+	used   int // bytes of stream used
+}
+
+func newAESCTR(key []byte) (*aesCTR, error) {
+	b, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &aesCTR{b: b, used: aes.BlockSize}, nil
+}
+
+func (x *aesCTR) XORKeyStream(dst, src []byte) {
+	for len(src) > 0 {
+		if x.used == len(x.stream) {
+			for i := range x.ctr {
+				x.ctr[i]++
+				if x.ctr[i] != 0 {
+					break
+				}
+			}
+			x.b.Encrypt(x.stream[:], x.ctr[:])
+			x.used = 0
+		}
+		n := subtle.XORBytes(dst, src, x.stream[x.used:])
+		dst, src = dst[n:], src[n:]
+		x.used += n
+	}
+}
+
+// An aesReader decrypts the data of an encrypted file, and checks
+// its MAC at the end.
+type aesReader struct {
+	r   io.Reader // the encrypted data
+	mac io.Reader // the MAC that follows it
+	ctr *aesCTR
+	h   hash.Hash
+	err error // sticky error
+}
+
+// newAESReader returns an aesReader for the data r of a file
+// with the given key strength. It checks the password first,
+// and returns ErrPassword if it is wrong.
+func newAESReader(r *io.SectionReader, strength uint8, password string) (*aesReader, error) {
+	saltLen := 4 + 4*int64(strength)
+	size := r.Size() - saltLen - aesVerifyLen - aesMACLen
+	if size < 0 {
+		return nil, ErrFormat
+	}
+	buf := make([]byte, saltLen+aesVerifyLen)
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	encKey, macKey, verifier, err := aesKeys(password, buf[:saltLen])
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(verifier, buf[saltLen:]) != 1 {
+		return nil, ErrPassword
+	}
+	ctr, err := newAESCTR(encKey)
+	if err != nil {
+		return nil, err
+	}
+	off := saltLen + aesVerifyLen
+	return &aesReader{
+		r:   io.NewSectionReader(r, off, size),
+		mac: io.NewSectionReader(r, off+size, aesMACLen),
+		ctr: ctr,
+		h:   hmac.New(sha1.New, macKey),
+	}, nil
+}
+
+func (r *aesReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.r.Read(p)
+	r.h.Write(p[:n])
+	r.ctr.XORKeyStream(p[:n], p[:n])
+	if err == io.EOF {
+		var mac [aesMACLen]byte
+		if _, err1 := io.ReadFull(r.mac, mac[:]); err1 != nil {
+			err = io.ErrUnexpectedEOF
+		} else if !hmac.Equal(mac[:], r.h.Sum(nil)[:aesMACLen]) {
+			err = ErrChecksum
+		}
+	}
+	r.err = err
+	return n, err
+}
+
+// verify reads any data that the decompressor has left and
+// reports whether the MAC is correct.
+func (r *aesReader) verify() error {
+	_, err := io.Copy(io.Discard, r)
+	return err
+}
+
+// An aesWriter encrypts the data of a file.
+type aesWriter struct {
+	w      io.Writer
+	header []byte // the salt and verifier, until written
+	ctr    *aesCTR
+	h      hash.Hash
+	buf    []byte
+}
+
+// newAESWriter returns an aesWriter that encrypts with AES-256.
+func newAESWriter(w io.Writer, password string) (*aesWriter, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	encKey, macKey, verifier, err := aesKeys(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	ctr, err := newAESCTR(encKey)
+	if err != nil {
+		return nil, err
+	}
+	return &aesWriter{
+		w:      w,
+		header: append(salt, verifier...),
+		ctr:    ctr,
+		h:      hmac.New(sha1.New, macKey),
+	}, nil
+}
+
+func (w *aesWriter) writeHeader() error {
+	if w.header == nil {
+		return nil
+	}
+	_, err := w.w.Write(w.header)
+	w.header = nil
+	return err
+}
+
+func (w *aesWriter) Write(p []byte) (int, error) {
+	if err := w.writeHeader(); err != nil {
+		return 0, err
+	}
+	if cap(w.buf) < len(p) {
+		w.buf = make([]byte, len(p))
+	}
+	buf := w.buf[:len(p)]
+	w.ctr.XORKeyStream(buf, p)
+	w.h.Write(buf)
+	return w.w.Write(buf)
+}
+
+// Close writes the MAC. It does not close the underlying writer.
+func (w *aesWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	_, err := w.w.Write(w.h.Sum(nil)[:aesMACLen])
+	return err
+}
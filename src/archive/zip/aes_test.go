@@ -0,0 +1,318 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// The testdata/aes*-bsdtar.zip archives were written by bsdtar 3.7.7
+// (libarchive 3.7.7) from files dated 2024-05-01 12:00 UTC, with
+//
+//	bsdtar --format zip --options zip:encryption=aes256 --passphrase golang \
+//		-cf aes256-bsdtar.zip hello.txt short.txt dir
+//	bsdtar --format zip --options zip:encryption=aes128,zip:compression=store \
+//		--passphrase golang -cf aes128-bsdtar.zip hello.txt short.txt
+//
+// where hello.txt holds "Hello, gophers!\n" 20 times, and short.txt and
+// dir/plain.txt hold "short\n". libarchive encrypts files shorter than
+// 20 bytes in the AE-2 format and the others in the AE-1 format, and
+// doesn't encrypt directories.
+func TestReaderAES(t *testing.T) {
+	for _, tt := range []struct {
+		file      string
+		encrypted []string
+	}{
+		{"aes256-bsdtar.zip", []string{"hello.txt", "short.txt", "dir/plain.txt"}},
+		{"aes128-bsdtar.zip", []string{"hello.txt", "short.txt"}},
+	} {
+		t.Run(tt.file, func(t *testing.T) {
+			testReaderAES(t, "testdata/"+tt.file, tt.encrypted)
+		})
+	}
+}
+
+// The testdata/aes256*-7zip.zip archives were written by 7-Zip on
+// Windows with the password "golang", which uses the AE-2 format for
+// all files. They come from the testdata of github.com/alexmullins/zip
+// (MIT license) as hello-aes.zip and macbeth-act1.zip. The first stores
+// its file, and the second deflates it before encrypting it.
+func TestReaderAES7Zip(t *testing.T) {
+	for _, tt := range []struct {
+		file   string
+		name   string
+		method uint16
+		size   int
+		sum    string // SHA-256 of the content
+	}{
+		{"aes256-7zip.zip", "hello.txt", Store, 13, "b08022d315cf1eb12d2665bded0e6af40653c0a0be975232fb49bcbd021cfc36"},
+		{"aes256-deflate-7zip.zip", "macbeth-act1.txt", Deflate, 23124, "b2b28da226cd9d0992162d136fdd6a4089593e311ddfdce960ae4af83ac0e7ef"},
+	} {
+		t.Run(tt.file, func(t *testing.T) {
+			r, err := OpenReader("testdata/" + tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			if len(r.File) != 1 || r.File[0].Name != tt.name {
+				t.Fatalf("got %d files, want only %s", len(r.File), tt.name)
+			}
+			f := r.File[0]
+			ae, ok := findAESExtra(f.Extra)
+			if f.Method != aesMethod || !ok || ae != (aesExtra{aesVersion2, aesStrength256, tt.method}) {
+				t.Errorf("got method %d, AES extra %+v; want %d, %+v", f.Method, ae, aesMethod, aesExtra{aesVersion2, aesStrength256, tt.method})
+			}
+			if _, err := f.Open(); err != ErrPassword {
+				t.Errorf("Open without password: got error %v, want %v", err, ErrPassword)
+			}
+			r.SetPasswordFunc(func(*File) (string, error) { return "golang", nil })
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rc.Close()
+			got, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sum := sha256.Sum256(got); len(got) != tt.size || hex.EncodeToString(sum[:]) != tt.sum {
+				t.Errorf("got %d bytes with SHA-256 %x, want %d bytes with SHA-256 %s", len(got), sum, tt.size, tt.sum)
+			}
+		})
+	}
+}
+
+// The testdata/zipcrypto-infozip.zip archive was written by Info-ZIP
+// Zip 3.0, which only supports the traditional PKWARE encryption, with
+//
+//	zip -X -P golang zipcrypto-infozip.zip hello.txt short.txt
+//
+// from the files of the bsdtar archives.
+func TestReaderZipCrypto(t *testing.T) {
+	r, err := OpenReader("testdata/zipcrypto-infozip.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	called := false
+	r.SetPasswordFunc(func(*File) (string, error) {
+		called = true
+		return "golang", nil
+	})
+	for _, f := range r.File {
+		if f.Flags&0x1 == 0 {
+			t.Errorf("%s: not encrypted", f.Name)
+		}
+		if _, err := f.Open(); err != ErrAlgorithm {
+			t.Errorf("%s: got error %v, want %v", f.Name, err, ErrAlgorithm)
+		}
+	}
+	if called {
+		t.Errorf("password requested for traditional encryption")
+	}
+}
+
+func testReaderAES(t *testing.T, file string, encrypted []string) {
+	r, err := OpenReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	want := map[string]string{
+		"hello.txt":     strings.Repeat("Hello, gophers!\n", 20),
+		"short.txt":     "short\n",
+		"dir/":          "",
+		"dir/plain.txt": "short\n",
+	}
+	read := func(f *File) (string, error) {
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		return string(b), err
+	}
+
+	// Without a password, only the files that are not encrypted can be read.
+	for _, f := range r.File {
+		got, err := read(f)
+		if f.Flags&0x1 != 0 {
+			if err != ErrPassword {
+				t.Errorf("%s: got error %v, want %v", f.Name, err, ErrPassword)
+			}
+		} else if err != nil || got != want[f.Name] {
+			t.Errorf("%s: got %q, %v; want %q", f.Name, got, err, want[f.Name])
+		}
+	}
+
+	r.SetPasswordFunc(func(f *File) (string, error) { return "wrong", nil })
+	for _, f := range r.File {
+		if _, err := read(f); f.Flags&0x1 != 0 && err != ErrPassword {
+			t.Errorf("%s: wrong password: got error %v, want %v", f.Name, err, ErrPassword)
+		}
+	}
+
+	errNoPassword := errors.New("no password")
+	r.SetPasswordFunc(func(f *File) (string, error) { return "", errNoPassword })
+	if _, err := read(r.File[0]); err != errNoPassword {
+		t.Errorf("got error %v, want %v", err, errNoPassword)
+	}
+
+	var names []string
+	r.SetPasswordFunc(func(f *File) (string, error) {
+		names = append(names, f.Name)
+		return "golang", nil
+	})
+	for _, f := range r.File {
+		got, err := read(f)
+		if err != nil || got != want[f.Name] {
+			t.Errorf("%s: got %q, %v; want %q", f.Name, got, err, want[f.Name])
+		}
+	}
+	if !slices.Equal(names, encrypted) {
+		t.Errorf("password requested for %q, want %q", names, encrypted)
+	}
+}
+
+func TestReaderAESCorrupt(t *testing.T) {
+	data, err := os.ReadFile("testdata/aes256-bsdtar.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	off, err := r.File[0].DataOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := int64(r.File[0].CompressedSize64)
+	for _, i := range []int64{16 + 2, size/2 + 9, size - 1} {
+		bad := bytes.Clone(data)
+		bad[off+i] ^= 0x80
+		r, err := NewReader(bytes.NewReader(bad), int64(len(bad)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.SetPasswordFunc(func(*File) (string, error) { return "golang", nil })
+		rc, err := r.File[0].Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Changes to the encrypted data may also break the
+		// compressed data, but must not go unnoticed.
+		if _, err := io.ReadAll(rc); err == nil {
+			t.Errorf("corrupting byte %d of the data went unnoticed", i)
+		}
+	}
+}
+
+func TestWriterAES(t *testing.T) {
+	large := bytes.Repeat([]byte("Hello, gophers! "), 10000)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SetPassword("secret")
+	for _, f := range []struct {
+		name   string
+		method uint16
+		data   []byte
+	}{
+		{"store.txt", Store, []byte("stored\n")},
+		{"deflate.txt", Deflate, large},
+		{"zstd.txt", Zstd, large},
+		{"empty.txt", Deflate, nil},
+		{"dir/", Deflate, nil},
+	} {
+		fw, err := w.CreateHeader(&FileHeader{Name: f.name, Method: f.method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.SetPassword("")
+	fw, err := w.Create("plain.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write([]byte("plain\n"))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("stored\n")) {
+		t.Errorf("stored file is not encrypted")
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetPasswordFunc(func(*File) (string, error) { return "secret", nil })
+	for _, f := range r.File {
+		encrypted := f.Name != "dir/" && f.Name != "plain.txt"
+		if got := f.Method == aesMethod && f.Flags&0x1 != 0; got != encrypted {
+			t.Errorf("%s: encrypted = %v, want %v", f.Name, got, encrypted)
+		}
+		if encrypted && (f.CRC32 != 0 || f.ReaderVersion != zipVersion51) {
+			t.Errorf("%s: CRC32 = %#x, ReaderVersion = %d; want 0, %d", f.Name, f.CRC32, f.ReaderVersion, zipVersion51)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		got, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		if len(got) != int(f.UncompressedSize64) {
+			t.Errorf("%s: read %d bytes, want %d", f.Name, len(got), f.UncompressedSize64)
+		}
+		if f.Name == "deflate.txt" || f.Name == "zstd.txt" {
+			if !bytes.Equal(got, large) {
+				t.Errorf("%s: content mismatch", f.Name)
+			}
+			if f.CompressedSize64 > 5000 {
+				t.Errorf("%s: compressed to %d bytes", f.Name, f.CompressedSize64)
+			}
+		}
+	}
+}
+
+func TestWriterAESHeaderUnchanged(t *testing.T) {
+	w := NewWriter(io.Discard)
+	w.SetPassword("secret")
+	extra := make([]byte, 4, 64)
+	extra[0] = 0xfe // unknown extra field with no data
+	fh := &FileHeader{Name: "a.txt", Method: Deflate, Extra: extra}
+	want := *fh
+	fw, err := w.CreateHeader(fh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fw.Write([]byte("hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if fh.Method != want.Method || fh.Flags != want.Flags || fh.CreatorVersion != want.CreatorVersion ||
+		fh.ReaderVersion != want.ReaderVersion || !bytes.Equal(fh.Extra, want.Extra) {
+		t.Errorf("CreateHeader changed the header to %+v, want %+v", *fh, want)
+	}
+	if spare := extra[len(extra):cap(extra)]; !bytes.Equal(spare, make([]byte, len(spare))) {
+		t.Errorf("CreateHeader wrote %x past the end of the extra field", spare)
+	}
+}
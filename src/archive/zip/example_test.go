@@ -91,3 +91,36 @@ func ExampleWriter_RegisterCompressor() {
 
 	// Proceed to add files to w.
 }
+
+func ExampleUpdater() {
+	f, err := os.OpenFile("archive.zip", os.O_RDWR, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		log.Fatal(err)
+	}
+	u, err := zip.NewUpdater(f, fi.Size())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Replace one file, and delete another.
+	w, err := u.Create("todo.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(w, "Write more examples."); err != nil {
+		log.Fatal(err)
+	}
+	if err := u.Delete("gopher.txt"); err != nil {
+		log.Fatal(err)
+	}
+
+	// Make sure to check the error on Close.
+	if err := u.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
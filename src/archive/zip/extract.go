@@ -0,0 +1,171 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"errors"
+	"internal/extract"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// ExtractOptions control how [Reader.Extract] extracts files.
+// The zero value extracts regular files and directories, without
+// limits, and fails on the other kinds of file.
+type ExtractOptions struct {
+	// Symlinks is how symbolic links are handled.
+	Symlinks SymlinkPolicy
+
+	// SkipSpecial skips device files, named pipes, and sockets,
+	// which are never created. Without it, they are an error.
+	SkipSpecial bool
+
+	// PreservePermissions gives files and directories exactly the
+	// permissions of their headers, including the setuid, setgid,
+	// and sticky bits. Otherwise they are created with the permission
+	// bits of their headers, less the umask of the process, and
+	// directories can always be written by their owner.
+	PreservePermissions bool
+
+	// Overwrite replaces existing files and symbolic links.
+	// Otherwise an existing file is an error. Existing directories
+	// are used as they are, and never replaced.
+	Overwrite bool
+
+	// MaxFiles limits the number of files, MaxFileSize the size of
+	// each regular file, and MaxTotalSize the total size of the regular
+	// files. The sizes are the uncompressed sizes, and the extraction
+	// fails if a file holds more data than its header says.
+	// Zero means no limit.
+	MaxFiles     int64
+	MaxFileSize  int64
+	MaxTotalSize int64
+}
+
+// A SymlinkPolicy is how [Reader.Extract] handles symbolic links.
+type SymlinkPolicy int
+
+const (
+	// SymlinkError makes a symbolic link an error.
+	SymlinkError SymlinkPolicy = iota
+
+	// SymlinkSkip skips symbolic links.
+	SymlinkSkip
+
+	// SymlinkLocal creates the symbolic links that lead to files within
+	// the destination directory, and makes the others an error. The
+	// target of such a link is a relative path that may start with ".."
+	// elements, leading up from the directory of the link but not out of
+	// the destination, and has no ".." elements after that.
+	SymlinkLocal
+)
+
+func (opts *ExtractOptions) internal() *extract.Options {
+	if opts == nil {
+		return nil
+	}
+	return &extract.Options{
+		Symlinks:            int(opts.Symlinks),
+		SkipSpecial:         opts.SkipSpecial,
+		PreservePermissions: opts.PreservePermissions,
+		Overwrite:           opts.Overwrite,
+		MaxFiles:            opts.MaxFiles,
+		MaxFileSize:         opts.MaxFileSize,
+		MaxTotalSize:        opts.MaxTotalSize,
+	}
+}
+
+// maxLinkname is the largest symbolic link target that Extract reads.
+const maxLinkname = 4096
+
+var errLinkname = errors.New("zip: symbolic link target too long")
+
+// Extract extracts the files in the archive into the directory dst,
+// creating it if needed. A nil opts is the same as the zero
+// [ExtractOptions]. The modification times of the files are set from
+// their headers. Files without Unix permissions are created with the
+// permissions 0666, and directories with 0777, less the umask.
+//
+// All files are kept within dst: the extraction fails on the first
+// file with a name that is not local, as defined by [path/filepath.IsLocal],
+// or that contains a backslash, and files are never created or written
+// through symbolic links. This holds as long as no other program
+// modifies dst during the extraction.
+//
+// The errors for particular files are [*fs.PathError] values. When
+// Extract fails, the files that it has already extracted remain.
+func (r *Reader) Extract(dst string, opts *ExtractOptions) error {
+	x, err := extract.New(dst, opts.internal(), ErrInsecurePath)
+	if err != nil {
+		return err
+	}
+	for _, f := range r.File {
+		if strings.Contains(f.Name, `\`) {
+			return &fs.PathError{Op: "extract", Path: f.Name, Err: ErrInsecurePath}
+		}
+		mode := f.Mode()
+		e := &extract.Entry{
+			Name:    f.Name,
+			Mode:    mode,
+			ModTime: f.Modified,
+			Size:    int64(f.UncompressedSize64),
+		}
+		switch {
+		case mode.IsDir():
+			e.Kind = extract.Dir
+			if mode.Perm() == 0 {
+				e.Mode |= 0777
+			}
+		case mode&fs.ModeSymlink != 0:
+			e.Kind = extract.Symlink
+			if opts != nil && opts.Symlinks == SymlinkLocal {
+				if e.Linkname, err = f.readLinkname(); err != nil {
+					return err
+				}
+			}
+		case mode&fs.ModeType != 0:
+			e.Kind = extract.Special
+		default:
+			e.Kind = extract.Regular
+			if mode.Perm() == 0 {
+				e.Mode |= 0666
+			}
+		}
+		err = x.Extract(e, func(w io.Writer) error {
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			defer rc.Close()
+			// The checksum reader fails if the file holds
+			// more data than its size.
+			_, err = io.Copy(w, rc)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return x.Finish()
+}
+
+// readLinkname returns the target of a symbolic link,
+// which is the content of the file.
+func (f *File) readLinkname() (string, error) {
+	if f.UncompressedSize64 > maxLinkname {
+		return "", &fs.PathError{Op: "extract", Path: f.Name, Err: errLinkname}
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"internal/testenv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// extractFile is a file in an archive built by makeExtractZip.
+// A zero mode means no Unix mode.
+type extractFile struct {
+	name string
+	mode fs.FileMode
+	data string
+}
+
+func makeExtractZip(t *testing.T, files []extractFile) *Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := NewWriter(&buf)
+	for _, f := range files {
+		fh := &FileHeader{Name: f.name, Method: Deflate, Modified: time.Unix(1e9, 0)}
+		if f.mode != 0 {
+			fh.SetMode(f.mode)
+		}
+		w, err := zw.CreateHeader(fh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(f.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return zr
+}
+
+func TestExtract(t *testing.T) {
+	testenv.MustHaveSymlink(t)
+	dst := filepath.Join(t.TempDir(), "dst")
+	zr := makeExtractZip(t, []extractFile{
+		{name: "dir/"},
+		{name: "dir/file", data: "hello"},
+		{name: "dir/sub/deep", mode: 0600, data: "deep"},
+		{name: "dir/link", mode: fs.ModeSymlink | 0777, data: "sub/deep"},
+		{name: "dir/sub/up", mode: fs.ModeSymlink | 0777, data: "../file"},
+		{name: "fifo", mode: fs.ModeNamedPipe | 0644},
+	})
+	err := zr.Extract(dst, &ExtractOptions{Symlinks: SymlinkLocal, SkipSpecial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"dir/file":     "hello",
+		"dir/sub/deep": "deep",
+		"dir/link":     "deep",
+		"dir/sub/up":   "hello",
+	} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Error(err)
+		} else if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if _, err := os.Lstat(filepath.Join(dst, "fifo")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("special file created: %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		for name, want := range map[string]fs.FileMode{
+			"dir/file":     0644, // no Unix mode, less the usual umask
+			"dir/sub/deep": 0600,
+		} {
+			fi, err := os.Stat(filepath.Join(dst, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fi.Mode().Perm()&^want != 0 {
+				t.Errorf("%s has mode %v, want at most %v", name, fi.Mode().Perm(), want)
+			}
+		}
+	}
+	fi, err := os.Stat(filepath.Join(dst, "dir/file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fi.ModTime().Equal(time.Unix(1e9, 0)) {
+		t.Errorf("modification time = %v, want %v", fi.ModTime(), time.Unix(1e9, 0))
+	}
+}
+
+func TestExtractErrors(t *testing.T) {
+	testenv.MustHaveSymlink(t)
+	tests := []struct {
+		name  string
+		files []extractFile
+		opts  *ExtractOptions
+		err   error // if nil, any error
+	}{{
+		name:  "parent",
+		files: []extractFile{{name: "../evil", data: "x"}},
+		err:   ErrInsecurePath,
+	}, {
+		name:  "backslash",
+		files: []extractFile{{name: `..\evil`, data: "x"}},
+		err:   ErrInsecurePath,
+	}, {
+		name:  "symlink default",
+		files: []extractFile{{name: "link", mode: fs.ModeSymlink | 0777, data: "file"}},
+	}, {
+		name:  "symlink out",
+		files: []extractFile{{name: "link", mode: fs.ModeSymlink | 0777, data: "../evil"}},
+		opts:  &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name: "symlink chain",
+		files: []extractFile{
+			{name: "sub/link2", mode: fs.ModeSymlink | 0777, data: ".."},
+			{name: "sub/link3", mode: fs.ModeSymlink | 0777, data: "link2/../.."},
+		},
+		opts: &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name: "through symlink",
+		files: []extractFile{
+			{name: "dir/"},
+			{name: "link", mode: fs.ModeSymlink | 0777, data: "dir"},
+			{name: "link/file", data: "x"},
+		},
+		opts: &ExtractOptions{Symlinks: SymlinkLocal},
+	}, {
+		name: "exists",
+		files: []extractFile{
+			{name: "file", data: "x"},
+			{name: "file", data: "y"},
+		},
+		err: fs.ErrExist,
+	}, {
+		name: "max files",
+		files: []extractFile{
+			{name: "a", data: "x"},
+			{name: "b", data: "x"},
+		},
+		opts: &ExtractOptions{MaxFiles: 1},
+	}, {
+		name:  "max file size",
+		files: []extractFile{{name: "a", data: "12345"}},
+		opts:  &ExtractOptions{MaxFileSize: 4},
+	}, {
+		name: "max total size",
+		files: []extractFile{
+			{name: "a", data: "123"},
+			{name: "b", data: "456"},
+		},
+		opts: &ExtractOptions{MaxTotalSize: 5},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dst := filepath.Join(dir, "dst")
+			err := makeExtractZip(t, tt.files).Extract(dst, tt.opts)
+			if err == nil {
+				t.Fatal("Extract succeeded, want error")
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Extract error = %v, want %v", err, tt.err)
+			}
+			if _, err := os.Lstat(filepath.Join(dir, "evil")); err == nil {
+				t.Fatal("file created outside the destination")
+			}
+		})
+	}
+}
+
+func TestExtractSizeMismatch(t *testing.T) {
+	// The header understates the size of the file, to get
+	// past the limits, but the data is checked against it.
+	zr := makeExtractZip(t, []extractFile{{name: "bomb", data: "0123456789"}})
+	zr.File[0].UncompressedSize64 = 4
+	err := zr.Extract(t.TempDir(), &ExtractOptions{MaxFileSize: 5})
+	if err != ErrFormat {
+		t.Fatalf("Extract error = %v, want %v", err, ErrFormat)
+	}
+}
@@ -37,6 +37,7 @@ type Reader struct {
 	File          []*File
 	Comment       string
 	decompressors map[uint16]Decompressor
+	password      func(*File) (string, error)
 
 	// Some JAR files are zip files with a prefix that is a bash script.
 	// The baseOffset field is the start of the zip file proper.
@@ -186,6 +187,19 @@ func (r *Reader) RegisterDecompressor(method uint16, dcomp Decompressor) {
 	r.decompressors[method] = dcomp
 }
 
+// SetPasswordFunc sets the function that supplies the password of
+// each encrypted file opened by [File.Open]. Files encrypted with
+// WinZip AES, AE-1 and AE-2, can be read; for files encrypted with
+// the other methods, Open returns [ErrAlgorithm]. If fn returns an
+// error, Open returns it.
+//
+// Without a password function, or with a wrong password, Open returns
+// [ErrPassword]. The data of an encrypted file is authenticated: if it
+// has been modified, reading it returns [ErrChecksum] at the end.
+func (r *Reader) SetPasswordFunc(fn func(f *File) (string, error)) {
+	r.password = fn
+}
+
 func (r *Reader) decompressor(method uint16) Decompressor {
 	dcomp := r.decompressors[method]
 	if dcomp == nil {
@@ -237,24 +251,59 @@ func (f *File) Open() (io.ReadCloser, error) {
 	}
 	size := int64(f.CompressedSize64)
 	r := io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset, size)
-	dcomp := f.zip.decompressor(f.Method)
+	var (
+		body   io.Reader = r
+		method           = f.Method
+		ar     *aesReader
+		noCRC  bool
+	)
+	if f.Method == aesMethod {
+		ae, ok := findAESExtra(f.Extra)
+		if !ok {
+			return nil, ErrFormat
+		}
+		if ar, err = f.openAES(r, ae); err != nil {
+			return nil, err
+		}
+		body, method = ar, ae.method
+		noCRC = ae.version == aesVersion2
+	} else if f.Flags&0x1 != 0 {
+		// Traditional PKWARE encryption, or strong encryption.
+		return nil, ErrAlgorithm
+	}
+	dcomp := f.zip.decompressor(method)
 	if dcomp == nil {
 		return nil, ErrAlgorithm
 	}
-	var rc io.ReadCloser = dcomp(r)
+	var rc io.ReadCloser = dcomp(body)
 	var desr io.Reader
 	if f.hasDataDescriptor() {
 		desr = io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset+size, dataDescriptorLen)
 	}
 	rc = &checksumReader{
-		rc:   rc,
-		hash: crc32.NewIEEE(),
-		f:    f,
-		desr: desr,
+		rc:    rc,
+		hash:  crc32.NewIEEE(),
+		f:     f,
+		desr:  desr,
+		aes:   ar,
+		noCRC: noCRC,
 	}
 	return rc, nil
 }
 
+// openAES checks the password of a file encrypted with WinZip AES
+// and returns a reader for its decrypted data.
+func (f *File) openAES(r *io.SectionReader, ae aesExtra) (*aesReader, error) {
+	if f.zip.password == nil {
+		return nil, ErrPassword
+	}
+	password, err := f.zip.password(f)
+	if err != nil {
+		return nil, err
+	}
+	return newAESReader(r, ae.strength, password)
+}
+
 // OpenRaw returns a [Reader] that provides access to the [File]'s contents without
 // decompression.
 func (f *File) OpenRaw() (io.Reader, error) {
@@ -283,8 +332,10 @@ type checksumReader struct {
 	hash  hash.Hash32
 	nread uint64 // number of bytes read so far
 	f     *File
-	desr  io.Reader // if non-nil, where to read the data descriptor
-	err   error     // sticky error
+	desr  io.Reader  // if non-nil, where to read the data descriptor
+	aes   *aesReader // if non-nil, the decrypted data to authenticate
+	noCRC bool       // the file records no CRC-32 (WinZip AE-2)
+	err   error      // sticky error
 }
 
 func (r *checksumReader) Stat() (fs.FileInfo, error) {
@@ -308,6 +359,12 @@ func (r *checksumReader) Read(b []byte) (n int, err error) {
 		if r.nread != r.f.UncompressedSize64 {
 			return 0, io.ErrUnexpectedEOF
 		}
+		if r.aes != nil {
+			if err1 := r.aes.verify(); err1 != nil {
+				r.err = err1
+				return n, r.err
+			}
+		}
 		if r.desr != nil {
 			if err1 := readDataDescriptor(r.desr, r.f); err1 != nil {
 				if err1 == io.EOF {
@@ -315,14 +372,14 @@ func (r *checksumReader) Read(b []byte) (n int, err error) {
 				} else {
 					err = err1
 				}
-			} else if r.hash.Sum32() != r.f.CRC32 {
+			} else if !r.noCRC && r.hash.Sum32() != r.f.CRC32 {
 				err = ErrChecksum
 			}
 		} else {
 			// If there's not a data descriptor, we still compare
 			// the CRC32 of what we've read against the file header
 			// or TOC's CRC32, if it seems like it was set.
-			if r.f.CRC32 != 0 && r.hash.Sum32() != r.f.CRC32 {
+			if !r.noCRC && r.f.CRC32 != 0 && r.hash.Sum32() != r.f.CRC32 {
 				err = ErrChecksum
 			}
 		}
@@ -457,6 +457,28 @@ var tests = []ZipTest{
 			},
 		},
 	},
+	{
+		Name: "zstd.zip",
+		// The entries were compressed by the zstd 1.5.6 command, with
+		// "zstd -19 -c gophercolor16x16.png" and "zstd -3 -c short.txt",
+		// and written with Writer.CreateRaw, as none of the zip tools at
+		// hand writes method 93. bsdtar 3.7.7 (libarchive 3.7.7) extracts
+		// the archive.
+		File: []ZipTestFile{
+			{
+				Name:     "gophercolor16x16.png",
+				File:     "gophercolor16x16.png",
+				Modified: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				Mode:     0644,
+			},
+			{
+				Name:     "short.txt",
+				Content:  []byte("short\n"),
+				Modified: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				Mode:     0644,
+			},
+		},
+	},
 	{
 		Name: "time-infozip.zip",
 		File: []ZipTestFile{
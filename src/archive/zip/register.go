@@ -5,7 +5,9 @@
 package zip
 
 import (
+	"bufio"
 	"compress/flate"
+	"compress/zstd"
 	"errors"
 	"io"
 	"sync"
@@ -101,6 +103,15 @@ func (r *pooledFlateReader) Close() error {
 	return err
 }
 
+func newZstdWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w), nil
+}
+
+func newZstdReader(r io.Reader) io.ReadCloser {
+	// The zstd reader issues many small reads.
+	return io.NopCloser(zstd.NewReader(bufio.NewReader(r)))
+}
+
 var (
 	compressors   sync.Map // map[uint16]Compressor
 	decompressors sync.Map // map[uint16]Decompressor
@@ -109,13 +120,15 @@ var (
 func init() {
 	compressors.Store(Store, Compressor(func(w io.Writer) (io.WriteCloser, error) { return &nopCloser{w}, nil }))
 	compressors.Store(Deflate, Compressor(func(w io.Writer) (io.WriteCloser, error) { return newFlateWriter(w), nil }))
+	compressors.Store(Zstd, Compressor(newZstdWriter))
 
 	decompressors.Store(Store, Decompressor(io.NopCloser))
 	decompressors.Store(Deflate, Decompressor(newFlateReader))
+	decompressors.Store(Zstd, Decompressor(newZstdReader))
 }
 
 // RegisterDecompressor allows custom decompressors for a specified method ID.
-// The common methods [Store] and [Deflate] are built in.
+// The common methods [Store], [Deflate], and [Zstd] are built in.
 func RegisterDecompressor(method uint16, dcomp Decompressor) {
 	if _, dup := decompressors.LoadOrStore(method, dcomp); dup {
 		panic("decompressor already registered")
@@ -123,7 +136,7 @@ func RegisterDecompressor(method uint16, dcomp Decompressor) {
 }
 
 // RegisterCompressor registers custom compressors for a specified method ID.
-// The common methods [Store] and [Deflate] are built in.
+// The common methods [Store], [Deflate], and [Zstd] are built in.
 func RegisterCompressor(method uint16, comp Compressor) {
 	if _, dup := compressors.LoadOrStore(method, comp); dup {
 		panic("compressor already registered")
@@ -29,8 +29,9 @@ import (
 
 // Compression methods.
 const (
-	Store   uint16 = 0 // no compression
-	Deflate uint16 = 8 // DEFLATE compressed
+	Store   uint16 = 0  // no compression
+	Deflate uint16 = 8  // DEFLATE compressed
+	Zstd    uint16 = 93 // Zstandard compressed
 )
 
 const (
@@ -58,6 +59,7 @@ const (
 	// Version numbers.
 	zipVersion20 = 20 // 2.0
 	zipVersion45 = 45 // 4.5 (reads and writes zip64 archives)
+	zipVersion51 = 51 // 5.1 (reads and writes AES encrypted files)
 
 	// Limits for non zip64 files.
 	uint16max = (1 << 16) - 1
@@ -0,0 +1,132 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"io"
+	"io/fs"
+	"slices"
+)
+
+// An UpdateFile is a file holding a zip archive that an [Updater]
+// modifies in place. It is implemented by [*os.File].
+type UpdateFile interface {
+	io.ReaderAt
+	io.WriterAt
+	Truncate(size int64) error
+}
+
+// An Updater adds, replaces, and deletes files in an existing zip
+// archive without rewriting the files that it keeps. It writes the
+// new files where the central directory of the archive started,
+// after the last file, and then writes a new central directory that
+// lists the kept files and the new ones. The data of deleted and
+// replaced files stays in the archive, unreferenced.
+//
+// An Updater is a [Writer], and files are added to it in the same way.
+// Adding a file with the same name as a file in the archive, or one
+// added earlier, replaces that file.
+//
+// The archive is invalid from the first write until [Updater.Close]
+// returns successfully, so programs that cannot afford to lose it
+// should update a copy.
+type Updater struct {
+	*Writer
+	f    UpdateFile
+	ow   *io.OffsetWriter
+	base int64 // offset of the new data in f
+}
+
+// NewUpdater returns an [Updater] for the zip archive in f,
+// which is size bytes long. The archive is read, but not modified,
+// until files are added or deleted.
+//
+// As with [NewReader], if any file inside the archive has an insecure
+// name and the GODEBUG environment variable contains `zipinsecurepath=0`,
+// NewUpdater returns the updater with an [ErrInsecurePath] error.
+func NewUpdater(f UpdateFile, size int64) (*Updater, error) {
+	r, err := NewReader(f, size)
+	if err != nil && err != ErrInsecurePath {
+		return nil, err
+	}
+	end, baseOffset, err1 := readDirectoryEnd(f, size)
+	if err1 != nil {
+		return nil, err1
+	}
+	start := baseOffset + int64(end.directoryOffset)
+	ow := io.NewOffsetWriter(f, start)
+	u := &Updater{
+		Writer: NewWriter(ow),
+		f:      f,
+		ow:     ow,
+		base:   start,
+	}
+	u.cw.count = start - baseOffset
+	u.comment = r.Comment
+	u.replace = true
+	for _, zf := range r.File {
+		fh := zf.FileHeader
+		// The zip64 extra field, if any, is rewritten by Close.
+		fh.Extra = deleteExtra(fh.Extra, zip64ExtraID)
+		fh.CompressedSize = uint32(min(fh.CompressedSize64, uint32max))
+		fh.UncompressedSize = uint32(min(fh.UncompressedSize64, uint32max))
+		u.dir = append(u.dir, &header{
+			FileHeader: &fh,
+			offset:     uint64(zf.headerOffset - baseOffset),
+			raw:        true,
+		})
+	}
+	return u, err
+}
+
+// Delete removes the files with the given name from the archive.
+// It returns an error wrapping [fs.ErrNotExist] if there is none.
+func (u *Updater) Delete(name string) error {
+	if u.last != nil && !u.last.closed {
+		if err := u.last.close(); err != nil {
+			return err
+		}
+	}
+	n := len(u.dir)
+	u.dir = slices.DeleteFunc(u.dir, func(h *header) bool { return h.Name == name })
+	if len(u.dir) == n {
+		return &fs.PathError{Op: "delete", Path: name, Err: fs.ErrNotExist}
+	}
+	return nil
+}
+
+// Close finishes updating the archive by writing the central directory,
+// and truncates the file after it.
+func (u *Updater) Close() error {
+	if err := u.Writer.Close(); err != nil {
+		return err
+	}
+	n, err := u.ow.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	return u.f.Truncate(u.base + n)
+}
+
+// deleteExtra returns a copy of the extra fields in extra
+// without the fields with the given tag.
+func deleteExtra(extra []byte, tag uint16) []byte {
+	var out []byte
+	b := readBuf(extra)
+	for len(b) >= 4 {
+		field := b
+		t := b.uint16()
+		size := int(b.uint16())
+		if len(b) < size {
+			// Keep what cannot be parsed as it is.
+			return append(out, field...)
+		}
+		b.sub(size)
+		if t != tag {
+			out = append(out, field[:4+size]...)
+		}
+	}
+	return append(out, b...)
+}
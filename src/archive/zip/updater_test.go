@@ -0,0 +1,238 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestArchive writes an archive with the given files to a new file,
+// after prefix, and returns its name.
+func writeTestArchive(t *testing.T, prefix string, files map[string]string) string {
+	name := filepath.Join(t.TempDir(), "test.zip")
+	var buf bytes.Buffer
+	buf.WriteString(prefix)
+	w := NewWriter(&buf)
+	w.SetComment("comment")
+	for _, n := range []string{"a.txt", "b.txt", "c.txt", "dir/", "dir/d.txt"} {
+		if content, ok := files[n]; ok {
+			fw, err := w.Create(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			io.WriteString(fw, content)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, buf.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func openUpdater(t *testing.T, name string) (*Updater, *os.File) {
+	f, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := NewUpdater(f, fi.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u, f
+}
+
+func checkArchive(t *testing.T, name string, want []string, contents map[string]string) {
+	t.Helper()
+	r, err := OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	var got []string
+	for _, f := range r.File {
+		got = append(got, f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		if string(b) != contents[f.Name] {
+			t.Errorf("%s: got %q, want %q", f.Name, b, contents[f.Name])
+		}
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("files are %q, want %q", got, want)
+	}
+	if r.Comment != "comment" {
+		t.Errorf("comment is %q, want %q", r.Comment, "comment")
+	}
+}
+
+func TestUpdater(t *testing.T) {
+	for _, prefix := range []string{"", "#!/bin/sh\nexit 0\n"} {
+		files := map[string]string{
+			"a.txt":     "file a",
+			"b.txt":     "file b",
+			"c.txt":     strings.Repeat("file c ", 1000),
+			"dir/":      "",
+			"dir/d.txt": "file d",
+		}
+		name := writeTestArchive(t, prefix, files)
+		before, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := NewReader(bytes.NewReader(before), int64(len(before)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dataEnd, err := r.File[len(r.File)-1].DataOffset()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u, _ := openUpdater(t, name)
+		if err := u.Delete("b.txt"); err != nil {
+			t.Fatal(err)
+		}
+		if err := u.Delete("b.txt"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("deleting a deleted file: got %v, want %v", err, fs.ErrNotExist)
+		}
+		fw, err := u.Create("a.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(fw, "new file a")
+		fw, err = u.CreateHeader(&FileHeader{Name: "e.txt", Method: Store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(fw, "file e")
+		if err := u.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		after, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(after[:dataEnd], before[:dataEnd]) {
+			t.Errorf("the files that were kept have been rewritten")
+		}
+		files["a.txt"] = "new file a"
+		files["e.txt"] = "file e"
+		checkArchive(t, name, []string{"c.txt", "dir/", "dir/d.txt", "a.txt", "e.txt"}, files)
+
+		// Deleting files makes the archive shorter.
+		u, _ = openUpdater(t, name)
+		for _, n := range []string{"a.txt", "c.txt", "dir/", "dir/d.txt"} {
+			if err := u.Delete(n); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := u.Close(); err != nil {
+			t.Fatal(err)
+		}
+		checkArchive(t, name, []string{"e.txt"}, files)
+		fi, err := os.Stat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Size() >= int64(len(after)) {
+			t.Errorf("archive grew from %d to %d bytes after deleting files", len(after), fi.Size())
+		}
+	}
+}
+
+func TestUpdaterCopy(t *testing.T) {
+	files := map[string]string{"a.txt": "file a", "b.txt": "file b"}
+	name := writeTestArchive(t, "", files)
+	r, err := OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	// Copy a file within the archive under a new name,
+	// and replace a file with a copy of another.
+	u, _ := openUpdater(t, name)
+	f := *r.File[0]
+	f.Name = "copy.txt"
+	if err := u.Copy(&f); err != nil {
+		t.Fatal(err)
+	}
+	f.Name = "b.txt"
+	if err := u.Copy(&f); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+	checkArchive(t, name, []string{"a.txt", "copy.txt", "b.txt"}, map[string]string{
+		"a.txt":    "file a",
+		"b.txt":    "file a",
+		"copy.txt": "file a",
+	})
+}
+
+func TestUpdaterZip64(t *testing.T) {
+	// An archive with a zip64 extra field for a small file
+	// keeps a single, correct zip64 extra field.
+	name := filepath.Join(t.TempDir(), "test.zip")
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	fw, err := w.CreateRaw(&FileHeader{
+		Name:               "a.txt",
+		CRC32:              0x6b906cc4, // "file a"
+		CompressedSize64:   6,
+		UncompressedSize64: 6,
+		Extra:              []byte{0x01, 0x00, 0x10, 0x00, 6, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(fw, "file a")
+	w.SetComment("comment")
+	w.Close()
+	os.WriteFile(name, buf.Bytes(), 0666)
+
+	u, _ := openUpdater(t, name)
+	fw, err = u.Create("b.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(fw, "file b")
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+	checkArchive(t, name, []string{"a.txt", "b.txt"}, map[string]string{"a.txt": "file a", "b.txt": "file b"})
+	r, err := OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if extra := r.File[0].Extra; len(extra) != 0 {
+		t.Errorf("extra fields are %x, want none", extra)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"hash/crc32"
 	"io"
 	"io/fs"
+	"slices"
 	"strings"
 	"unicode/utf8"
 )
@@ -29,6 +30,8 @@ type Writer struct {
 	closed      bool
 	compressors map[uint16]Compressor
 	comment     string
+	password    string
+	replace     bool // a new file replaces earlier files with its name
 
 	// testHookCloseSizeOffset if non-nil is called with the size
 	// of offset of the central directory at Close.
@@ -73,6 +76,19 @@ func (w *Writer) SetComment(comment string) error {
 	return nil
 }
 
+// SetPassword sets the password with which to encrypt the files
+// added afterwards by [Writer.Create], [Writer.CreateHeader], and
+// [Writer.AddFS]. The files are encrypted with AES-256 in the WinZip
+// AE-2 format, which most zip tools can read, and their data is
+// authenticated. The names and other metadata of the files are not
+// encrypted. An empty password turns encryption off again.
+//
+// Directories and files added by [Writer.CreateRaw] and [Writer.Copy]
+// are not encrypted.
+func (w *Writer) SetPassword(password string) {
+	w.password = password
+}
+
 // Close finishes writing the zip file by writing the central directory.
 // It does not close the underlying writer.
 func (w *Writer) Close() error {
@@ -260,12 +276,17 @@ func (w *Writer) prepare(fh *FileHeader) error {
 		// See https://golang.org/issue/11144 confusion.
 		return errors.New("archive/zip: invalid duplicate FileHeader")
 	}
+	if w.replace {
+		w.dir = slices.DeleteFunc(w.dir, func(h *header) bool { return h.Name == fh.Name })
+	}
 	return nil
 }
 
 // CreateHeader adds a file to the zip archive using the provided [FileHeader]
 // for the file metadata. [Writer] takes ownership of fh and may mutate
 // its fields. The caller must not modify fh after calling [Writer.CreateHeader].
+// If a password is set with [Writer.SetPassword], the Writer uses a copy of fh
+// and does not change it.
 //
 // This returns a [Writer] to which the file contents should be written.
 // The file's contents must be written to the io.Writer before the next
@@ -274,6 +295,14 @@ func (w *Writer) CreateHeader(fh *FileHeader) (io.Writer, error) {
 	if err := w.prepare(fh); err != nil {
 		return nil, err
 	}
+	if w.password != "" {
+		// Encryption changes the method, flags and extra field that the
+		// file is written with, which the caller may not expect, so leave
+		// the caller's header alone.
+		fhCopy := *fh
+		fhCopy.Extra = slices.Clip(fh.Extra)
+		fh = &fhCopy
+	}
 
 	// The ZIP format has a sad state of affairs regarding character encoding.
 	// Officially, the name and comment fields are supposed to be encoded
@@ -367,8 +396,25 @@ func (w *Writer) CreateHeader(fh *FileHeader) (io.Writer, error) {
 		if comp == nil {
 			return nil, ErrAlgorithm
 		}
+		var cw io.Writer = fw.compCount
+		if w.password != "" {
+			aw, err := newAESWriter(fw.compCount, w.password)
+			if err != nil {
+				return nil, err
+			}
+			fh.Flags |= 0x1 // encrypted
+			fh.Extra = appendAESExtra(fh.Extra, aesExtra{
+				version:  aesVersion2,
+				strength: aesStrength256,
+				method:   fh.Method,
+			})
+			fh.Method = aesMethod
+			fh.ReaderVersion = zipVersion51
+			fw.aes = aw
+			cw = aw
+		}
 		var err error
-		fw.comp, err = comp(fw.compCount)
+		fw.comp, err = comp(cw)
 		if err != nil {
 			return nil, err
 		}
@@ -559,6 +605,7 @@ type fileWriter struct {
 	comp      io.WriteCloser
 	compCount *countWriter
 	crc32     hash.Hash32
+	aes       *aesWriter // if non-nil, encrypts the compressed data
 	closed    bool
 }
 
@@ -584,17 +631,27 @@ func (w *fileWriter) close() error {
 	if err := w.comp.Close(); err != nil {
 		return err
 	}
+	if w.aes != nil {
+		if err := w.aes.Close(); err != nil {
+			return err
+		}
+	}
 
 	// update FileHeader
 	fh := w.header.FileHeader
 	fh.CRC32 = w.crc32.Sum32()
+	if w.aes != nil {
+		fh.CRC32 = 0 // AE-2 records no CRC-32
+	}
 	fh.CompressedSize64 = uint64(w.compCount.count)
 	fh.UncompressedSize64 = uint64(w.rawCount.count)
 
 	if fh.isZip64() {
 		fh.CompressedSize = uint32max
 		fh.UncompressedSize = uint32max
-		fh.ReaderVersion = zipVersion45 // requires 4.5 - File uses ZIP64 format extensions
+		if fh.ReaderVersion < zipVersion45 {
+			fh.ReaderVersion = zipVersion45 // requires 4.5 - File uses ZIP64 format extensions
+		}
 	} else {
 		fh.CompressedSize = uint32(fh.CompressedSize64)
 		fh.UncompressedSize = uint32(fh.UncompressedSize64)
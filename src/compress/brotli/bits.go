@@ -0,0 +1,101 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bufio"
+	"io"
+)
+
+// A bitReader reads the bits of a brotli stream, least significant
+// bit of each byte first. It reads no more bytes from the underlying
+// reader than it needs, so that a stream can be followed by other data.
+type bitReader struct {
+	r      io.ByteReader
+	bits   uint64 // unread bits, in the low nbits bits
+	nbits  uint
+	offset int64 // number of bytes read from r
+	err    error // sticky; after an error, reads return zero bits
+}
+
+func (br *bitReader) reset(r io.Reader) {
+	rb, ok := r.(io.ByteReader)
+	if !ok {
+		rb = bufio.NewReader(r)
+	}
+	*br = bitReader{r: rb}
+}
+
+// more reads another byte into the buffer.
+// It reports whether it did, setting br.err if not.
+func (br *bitReader) more() bool {
+	if br.err != nil {
+		return false
+	}
+	c, err := br.r.ReadByte()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		br.err = err
+		return false
+	}
+	br.bits |= uint64(c) << br.nbits
+	br.nbits += 8
+	br.offset++
+	return true
+}
+
+// readBits reads n bits, for n up to 32.
+func (br *bitReader) readBits(n uint) uint32 {
+	for br.nbits < n {
+		if !br.more() {
+			return 0
+		}
+	}
+	v := uint32(br.bits & (1<<n - 1))
+	br.bits >>= n
+	br.nbits -= n
+	return v
+}
+
+// readBool reads a single bit.
+func (br *bitReader) readBool() bool {
+	return br.readBits(1) == 1
+}
+
+// align skips to the next byte boundary, and reports whether the
+// bits skipped were zero, as the format requires.
+func (br *bitReader) align() bool {
+	return br.readBits(br.nbits%8) == 0
+}
+
+// A bitWriter accumulates the bits of a brotli stream, least
+// significant bit of each byte first.
+type bitWriter struct {
+	out   []byte // complete bytes
+	bits  uint64 // pending bits, in the low nbits bits
+	nbits uint
+}
+
+// writeBits writes the n low bits of v, for n up to 32.
+// The other bits of v must be zero.
+func (bw *bitWriter) writeBits(v uint32, n uint) {
+	bw.bits |= uint64(v) << bw.nbits
+	bw.nbits += n
+	for bw.nbits >= 8 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits >>= 8
+		bw.nbits -= 8
+	}
+}
+
+// align pads the stream with zero bits to the next byte boundary.
+func (bw *bitWriter) align() {
+	if bw.nbits > 0 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits, bw.nbits = 0, 0
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package brotli implements reading and writing of brotli format compressed data,
+// as specified in RFC 7932.
+//
+// Brotli is mostly used as a content coding in HTTP, where it is
+// known as "br".
+package brotli
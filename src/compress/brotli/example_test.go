@@ -0,0 +1,41 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli_test
+
+import (
+	"bytes"
+	"compress/brotli"
+	"io"
+	"log"
+	"os"
+)
+
+func ExampleNewReader() {
+	// "abababababababababab", compressed with brotli.
+	data := []byte{0x1b, 0x13, 0x00, 0xf8, 0xa5, 0xc2, 0xc4, 0x42, 0x8c, 0x00, 0x40, 0x05}
+
+	r := brotli.NewReader(bytes.NewReader(data))
+	if _, err := io.Copy(os.Stdout, r); err != nil {
+		log.Fatal(err)
+	}
+	// Output: abababababababababab
+}
+
+func ExampleNewWriter() {
+	var buf bytes.Buffer
+	w := brotli.NewWriter(&buf)
+	if _, err := io.WriteString(w, "hello, world\n"); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	r := brotli.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, r); err != nil {
+		log.Fatal(err)
+	}
+	// Output: hello, world
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// This program generates dictionary.go from dictionary.bin, the static
+// dictionary of RFC 7932, Appendix A, as distributed with the reference
+// implementation at https://github.com/google/brotli (c/common).
+//
+//	go run gen_dictionary.go -input /path/to/dictionary.bin
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// dictionarySHA256 is the SHA-256 of the dictionary, as listed in RFC 7932.
+const dictionarySHA256 = "20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70"
+
+var (
+	input  = flag.String("input", "dictionary.bin", "dictionary file to read")
+	output = flag.String("output", "dictionary.go", "Go file to write")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen_dictionary: ")
+	flag.Parse()
+
+	data, err := os.ReadFile(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != dictionarySHA256 {
+		log.Fatalf("%s is not the brotli dictionary", *input)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by "go run gen_dictionary.go"; DO NOT EDIT.
+
+package brotli
+
+// dictionary is the static dictionary of RFC 7932, Appendix A.
+// The words of each length are stored together, in order of
+// increasing length; see dictOffset.
+const dictionary = "" +
+`)
+	const lineLen = 64
+	for len(data) > 0 {
+		n := min(lineLen, len(data))
+		b.WriteString("\t\"")
+		for _, c := range data[:n] {
+			switch {
+			case c == '"' || c == '\\':
+				b.WriteByte('\\')
+				b.WriteByte(c)
+			case c >= ' ' && c <= '~':
+				b.WriteByte(c)
+			default:
+				fmt.Fprintf(&b, `\x%02x`, c)
+			}
+		}
+		b.WriteString("\"")
+		if data = data[n:]; len(data) > 0 {
+			b.WriteString(" +")
+		}
+		b.WriteString("\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
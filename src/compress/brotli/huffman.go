@@ -0,0 +1,171 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import "math/bits"
+
+// Prefix codes, RFC 7932, Section 3.
+
+const (
+	maxCodeLen = 15 // the longest prefix code
+	rootBits   = 8  // bits decoded by the first level of a decoding table
+
+	// Decoding table entries. A symbol entry holds the symbol and the
+	// length of its code. A link entry holds the offset of a second level
+	// table, for the codes that start with its index, and the number of
+	// bits that index the second level table.
+	entryLink    = 0x80
+	entryLenMask = 0x1f
+	entryShift   = 8
+)
+
+// A huffman is a prefix code decoding table.
+type huffman struct {
+	table []uint32
+}
+
+// codeLengthOrder is the order in which the code lengths of the code
+// length code are stored.
+var codeLengthOrder = [18]uint8{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// codeLengthCodeLengths is the fixed code for the code lengths of the
+// code length code, whose lengths are the values 0 to 5.
+var codeLengthCodeLengths huffman
+
+// fixedLens are the code lengths of codeLengthCodeLengths.
+var fixedLens = [6]uint8{2, 4, 3, 2, 2, 4}
+
+func init() {
+	if !codeLengthCodeLengths.build(fixedLens[:]) {
+		panic("brotli: bad fixed code")
+	}
+}
+
+// build builds the decoding table for the code with the given code
+// lengths, which must form a complete prefix code, or have exactly
+// one nonzero length, for a code of a single symbol, which uses no
+// bits. It reports whether they do.
+func (h *huffman) build(lens []uint8) bool {
+	var count [maxCodeLen + 1]int
+	nsym, single := 0, 0
+	for s, n := range lens {
+		if n != 0 {
+			count[n]++
+			nsym++
+			single = s
+		}
+	}
+	size := 1 << rootBits
+	if nsym == 0 {
+		return false
+	}
+	if nsym == 1 {
+		h.table = h.alloc(size)
+		for i := range h.table {
+			h.table[i] = uint32(single) << entryShift
+		}
+		return true
+	}
+
+	// Check that the code is complete, and compute the first code
+	// of each length, as in RFC 1951, Section 3.2.2.
+	var next [maxCodeLen + 1]uint32
+	left := 1
+	code := uint32(0)
+	for n := 1; n <= maxCodeLen; n++ {
+		left = left<<1 - count[n]
+		if left < 0 {
+			return false
+		}
+		next[n] = code
+		code = (code + uint32(count[n])) << 1
+	}
+	if left != 0 {
+		return false
+	}
+
+	// Size the second level tables: each holds the codes that share
+	// its first rootBits bits, and is indexed by the remaining bits
+	// of the longest of them.
+	var subBits [1 << rootBits]uint8
+	codes := next
+	for _, n := range lens {
+		if n > rootBits {
+			c := codes[n]
+			codes[n]++
+			i := reverse(c>>(n-rootBits), rootBits)
+			subBits[i] = max(subBits[i], n-rootBits)
+		}
+	}
+	var offset [1 << rootBits]uint32
+	for i, b := range subBits {
+		if b > 0 {
+			offset[i] = uint32(size)
+			size += 1 << b
+		}
+	}
+
+	h.table = h.alloc(size)
+	for i, b := range subBits {
+		if b > 0 {
+			h.table[i] = offset[i]<<entryShift | entryLink | uint32(b)
+		}
+	}
+	for s, n := range lens {
+		if n == 0 {
+			continue
+		}
+		c := reverse(next[n], uint(n))
+		next[n]++
+		e := uint32(s)<<entryShift | uint32(n)
+		if n <= rootBits {
+			for i := c; i < 1<<rootBits; i += 1 << n {
+				h.table[i] = e
+			}
+			continue
+		}
+		root := c & (1<<rootBits - 1)
+		b := subBits[root]
+		for i := c >> rootBits; i < 1<<b; i += 1 << (n - rootBits) {
+			h.table[offset[root]+i] = e
+		}
+	}
+	return true
+}
+
+// alloc returns a table of the given size, reusing h.table if it can.
+func (h *huffman) alloc(size int) []uint32 {
+	if cap(h.table) >= size {
+		t := h.table[:size]
+		clear(t)
+		return t
+	}
+	return make([]uint32, size)
+}
+
+// reverse reverses the n low bits of c. Codes are stored most
+// significant bit first, and bits are read least significant first.
+func reverse(c uint32, n uint) uint32 {
+	return bits.Reverse32(c) >> (32 - n)
+}
+
+// decode reads a symbol coded with h.
+func (br *bitReader) decode(h *huffman) uint32 {
+	for {
+		e := h.table[br.bits&(1<<rootBits-1)]
+		if e&entryLink != 0 {
+			sub := br.bits >> rootBits & (1<<(e&entryLenMask) - 1)
+			e = h.table[e>>entryShift+uint32(sub)]
+		}
+		if n := uint(e & entryLenMask); n <= br.nbits {
+			br.bits >>= n
+			br.nbits -= n
+			return e >> entryShift
+		}
+		if !br.more() {
+			return 0
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	// windowBits is the log of the window size of a Writer,
+	// the default of the reference implementation.
+	windowBits = 22
+
+	// maxDistance is the largest distance of a copy, RFC 7932, Section 9.1.
+	maxDistance = 1<<windowBits - 16
+
+	// minMatch is the shortest match that we look for.
+	minMatch = 4
+
+	// niceMatch is the length of a match that ends the search.
+	niceMatch = 128
+
+	// maxDepth is the number of candidates to check for a match.
+	maxDepth = 32
+
+	hashLog  = 15 // log of the hash table size
+	chainLog = 16 // log of the hash chain size
+
+	// initialBase is the absolute position of the start of the
+	// history when the tables are clear. It is large enough that
+	// an empty table entry is never mistaken for a position.
+	initialBase = 1 << 24
+
+	// maxBase bounds the absolute positions stored in the tables
+	// before they are cleared.
+	maxBase = 1 << 30
+)
+
+// A command inserts literals and then copies earlier output,
+// RFC 7932, Section 2.
+type command struct {
+	insert  int // number of literals
+	copyLen int // length of the copy, or 0 if there is none
+	dist    int // distance of the copy
+}
+
+// matcher finds matches in the history of a stream and turns each
+// meta-block into commands and literals.
+type matcher struct {
+	// hist holds up to maxDistance bytes of previous data,
+	// followed by the meta-block being compressed.
+	hist []byte
+
+	// base is the absolute position of hist[0].
+	// The tables hold absolute positions.
+	base int32
+
+	// table maps a hash to the most recent position with that hash.
+	table []int32
+
+	// chain maps a position to the previous one with the same hash.
+	chain []int32
+
+	// next is the first position in hist not yet in the tables.
+	next int
+
+	// The output of the last call to commands.
+	cmds []command
+	lits []byte
+}
+
+func (m *matcher) init() {
+	m.table = make([]int32, 1<<hashLog)
+	m.chain = make([]int32, 1<<chainLog)
+	m.base = initialBase
+}
+
+// reset prepares m for a new stream.
+func (m *matcher) reset() {
+	// Rather than clearing the tables, move the base past
+	// all the positions they may contain.
+	m.base += int32(len(m.hist)) + maxDistance + 1
+	if m.base > maxBase {
+		clear(m.table)
+		clear(m.chain)
+		m.base = initialBase
+	}
+	m.hist = m.hist[:0]
+	m.next = 0
+}
+
+// slide discards history that is no longer needed, to make room for
+// a new meta-block. It returns the number of bytes discarded.
+func (m *matcher) slide() int {
+	n := len(m.hist) - maxDistance
+	if n <= 0 {
+		return 0
+	}
+	copy(m.hist, m.hist[n:])
+	m.hist = m.hist[:maxDistance]
+	m.base += int32(n)
+	m.next = max(m.next-n, 0)
+	if m.base > maxBase {
+		// Rebase the positions in the tables.
+		delta := m.base - initialBase
+		for _, t := range [][]int32{m.table, m.chain} {
+			for i, p := range t {
+				t[i] = max(p-delta, 0)
+			}
+		}
+		m.base = initialBase
+	}
+	return n
+}
+
+// hash returns the hash of the 4 bytes at hist[i].
+func (m *matcher) hash(i int) uint32 {
+	return (binary.LittleEndian.Uint32(m.hist[i:]) * 0x9e3779b1) >> (32 - hashLog)
+}
+
+// insert adds the positions up to end to the tables.
+func (m *matcher) insert(end int) {
+	end = min(end, len(m.hist)-minMatch+1)
+	for i := m.next; i < end; i++ {
+		h := m.hash(i)
+		m.chain[(int32(i)+m.base)&(1<<chainLog-1)] = m.table[h]
+		m.table[h] = int32(i) + m.base
+	}
+	m.next = max(m.next, end)
+}
+
+// matchLen returns the length of the common prefix of a and b.
+func matchLen(a, b []byte) int {
+	n := 0
+	for len(a) >= 8 && len(b) >= 8 {
+		if x := binary.LittleEndian.Uint64(a) ^ binary.LittleEndian.Uint64(b); x != 0 {
+			return n + bits.TrailingZeros64(x)>>3
+		}
+		a, b, n = a[8:], b[8:], n+8
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// find returns the distance and the length of the longest match
+// at hist[i] that ends before end, or a zero length if there is none.
+// The positions before i must be in the tables.
+func (m *matcher) find(i, end int) (dist, length int) {
+	if i+minMatch > end {
+		return 0, 0
+	}
+	cur := m.hist[i:end]
+	p := m.table[m.hash(i)]
+	for range maxDepth {
+		j := int(p - m.base)
+		if j < 0 || j >= i || i-j > maxDistance {
+			break
+		}
+		if n := matchLen(m.hist[j:], cur); n > length {
+			dist, length = i-j, n
+			if n >= niceMatch {
+				break
+			}
+		}
+		next := m.chain[p&(1<<chainLog-1)]
+		if next >= p {
+			// The entry was overwritten by a later position.
+			break
+		}
+		p = next
+	}
+	if length < minMatch {
+		return 0, 0
+	}
+	return dist, length
+}
+
+// commands turns hist[start:] into commands and literals,
+// with one step of lazy matching.
+func (m *matcher) commands(start int) {
+	m.cmds, m.lits = m.cmds[:0], m.lits[:0]
+	end := len(m.hist)
+	litStart := start
+	for i := start; i < end; {
+		m.insert(i)
+		dist, n := m.find(i, end)
+		if n == 0 {
+			i++
+			continue
+		}
+		m.insert(i + 1)
+		if d, n1 := m.find(i+1, end); n1 > n {
+			i, dist, n = i+1, d, n1
+		}
+		m.lits = append(m.lits, m.hist[litStart:i]...)
+		m.cmds = append(m.cmds, command{insert: i - litStart, copyLen: n, dist: dist})
+		i += n
+		litStart = i
+	}
+	if litStart < end {
+		m.lits = append(m.lits, m.hist[litStart:]...)
+		m.cmds = append(m.cmds, command{insert: end - litStart})
+	}
+	m.insert(end)
+}
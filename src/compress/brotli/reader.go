@@ -0,0 +1,795 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"io"
+	"math/bits"
+	"strconv"
+)
+
+// A CorruptInputError reports the presence of corrupt input
+// at a given offset.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "brotli: corrupt input before offset " + strconv.FormatInt(int64(e), 10)
+}
+
+// Decoder states.
+const (
+	stateStream  = iota // at the stream header
+	stateMeta           // at a meta-block header
+	stateRaw            // in an uncompressed meta-block
+	stateCommand        // at a command of a compressed meta-block
+	stateInsert         // inserting the literals of a command
+	stateCopy           // copying earlier output
+	stateWord           // copying a dictionary word
+	stateDone           // after the last meta-block
+)
+
+// Block categories.
+const (
+	catLiteral = iota
+	catCommand
+	catDistance
+)
+
+const (
+	numLiterals    = 256
+	numCommands    = 704
+	numBlockCounts = 26
+	numDistShort   = 16
+)
+
+// A Reader is an [io.Reader] that decompresses a brotli stream.
+//
+// A Reader is not safe for concurrent use. To decompress several
+// streams, reuse a Reader with [Reader.Reset].
+type Reader struct {
+	br    bitReader
+	err   error // sticky
+	state int
+
+	// The output is decoded into a ring buffer of a power of two
+	// bytes, which holds the window of past output that copies can
+	// refer to and the output that Read has not returned yet.
+	// It starts small and grows up to winSize as the output does,
+	// so that short streams don't allocate the whole window.
+	win     []byte
+	winSize int   // size of the window declared by the stream
+	maxDist int   // window size
+	pos     int64 // number of bytes decoded
+	rpos    int64 // number of bytes returned by Read
+
+	last   bool // the current meta-block is the last
+	remain int  // bytes left in the current meta-block
+
+	blocks    [3]blockState
+	npostfix  uint
+	ndirect   uint32
+	modes     []uint8 // context mode of each literal block type
+	litMap    []uint8 // literal context map
+	distMap   []uint8 // distance context map
+	litCodes  []huffman
+	cmdCodes  []huffman
+	distCodes []huffman
+	dists     [4]int // last distances, most recent first
+
+	insert  int    // literals left to insert
+	copyLen int    // length of the copy that follows them
+	implied bool   // the copy uses the last distance
+	dist    int    // distance of the current copy
+	word    []byte // rest of the current dictionary word
+	wordBuf []byte
+}
+
+// A blockState tracks the block types of a category.
+type blockState struct {
+	ntypes int
+	types  huffman // block type code
+	counts huffman // block count code
+	left   int     // number of symbols left in the current block
+	typ    int     // current block type
+	prev   int     // previous block type
+}
+
+// NewReader creates a new Reader that decompresses data from r.
+// If r does not also implement [io.ByteReader], the decompressor
+// may read more data than necessary from r.
+func NewReader(r io.Reader) *Reader {
+	z := new(Reader)
+	z.Reset(r)
+	return z
+}
+
+// Reset discards the Reader z's state and makes it equivalent to the
+// result of its original state from [NewReader], but reading from r
+// instead. This permits reusing a Reader rather than allocating a new one.
+func (z *Reader) Reset(r io.Reader) {
+	z.br.reset(r)
+	z.err = nil
+	z.state = stateStream
+	z.pos, z.rpos = 0, 0
+	z.dists = [4]int{4, 11, 15, 16}
+}
+
+// Read implements [io.Reader], reading uncompressed bytes
+// from its underlying reader.
+func (z *Reader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for z.rpos == z.pos {
+		if z.err != nil {
+			return 0, z.err
+		}
+		z.decode()
+	}
+	mask := int64(len(z.win) - 1)
+	start := int(z.rpos & mask)
+	end := start + int(min(z.pos-z.rpos, int64(len(z.win)-start)))
+	n := copy(p, z.win[start:end])
+	if n < len(p) && end == len(z.win) {
+		n += copy(p[n:], z.win[:z.pos-z.rpos-int64(n)])
+	}
+	z.rpos += int64(n)
+	return n, nil
+}
+
+// decode decodes until there is no space for more output,
+// a meta-block ends, or there is an error.
+func (z *Reader) decode() {
+	for z.err == nil {
+		z.grow()
+		if z.state != stateStream && z.space() == 0 {
+			return
+		}
+		switch z.state {
+		case stateStream:
+			z.readStreamHeader()
+		case stateMeta:
+			if z.pos > z.rpos {
+				// Return the output of the meta-block before reading more
+				// input, which may not be available yet if the stream is
+				// being flushed.
+				return
+			}
+			z.readMetaHeader()
+		case stateRaw:
+			z.copyRaw()
+		case stateCommand:
+			z.readCommand()
+		case stateInsert:
+			z.insertLiterals()
+		case stateCopy:
+			z.copyMatch()
+		case stateWord:
+			z.copyWord()
+		case stateDone:
+			if !z.br.align() {
+				z.corrupt()
+				break
+			}
+			z.err = io.EOF
+		}
+		if z.br.err != nil {
+			z.err = z.br.err
+		}
+	}
+}
+
+func (z *Reader) corrupt() {
+	if z.err == nil {
+		z.err = CorruptInputError(z.br.offset)
+	}
+}
+
+// maxUnread limits the output that is decoded before Read returns it.
+const maxUnread = 64 << 10
+
+// space returns the number of bytes that can be decoded before Read
+// must return some, because the ring buffer is full or holds maxUnread
+// bytes.
+func (z *Reader) space() int {
+	return min(len(z.win), maxUnread) - int(z.pos-z.rpos)
+}
+
+// initialWindow is the size of the ring buffer when a stream starts,
+// or the size of the window if it is smaller.
+const initialWindow = 1 << 16
+
+// grow doubles the ring buffer until it can hold the output that
+// can be decoded before Read returns some, or it reaches the size of
+// the window. The output has not wrapped around before that, so the
+// bytes keep their positions.
+func (z *Reader) grow() {
+	for len(z.win) < z.winSize && z.rpos+maxUnread > int64(len(z.win)) {
+		n := 2 * len(z.win)
+		if cap(z.win) >= n {
+			z.win = z.win[:n]
+		} else {
+			win := make([]byte, n)
+			copy(win, z.win)
+			z.win = win
+		}
+	}
+}
+
+// put appends b to the output.
+func (z *Reader) put(b byte) {
+	z.win[int(z.pos)&(len(z.win)-1)] = b
+	z.pos++
+}
+
+// readStreamHeader reads the size of the window, RFC 7932, Section 9.1.
+func (z *Reader) readStreamHeader() {
+	br := &z.br
+	wbits := uint(16)
+	if br.readBool() {
+		if n := br.readBits(3); n != 0 {
+			wbits = 17 + uint(n)
+		} else if n := br.readBits(3); n == 1 {
+			// Reserved, used by the large window extension.
+			z.corrupt()
+			return
+		} else if n != 0 {
+			wbits = 8 + uint(n)
+		} else {
+			wbits = 17
+		}
+	}
+	z.winSize = 1 << wbits
+	if n := min(z.winSize, initialWindow); cap(z.win) >= n {
+		z.win = z.win[:n]
+	} else {
+		z.win = make([]byte, n)
+	}
+	z.maxDist = 1<<wbits - 16
+	z.state = stateMeta
+}
+
+// readMetaHeader reads the header of a meta-block, RFC 7932, Section 9.2.
+func (z *Reader) readMetaHeader() {
+	br := &z.br
+	z.last = br.readBool()
+	if z.last && br.readBool() {
+		// ISLASTEMPTY
+		z.state = stateDone
+		return
+	}
+	nibbles := br.readBits(2) + 4
+	if nibbles == 7 {
+		// A metadata block, which is skipped.
+		if br.readBool() {
+			z.corrupt()
+			return
+		}
+		nbytes := br.readBits(2)
+		n := 0
+		if nbytes > 0 {
+			for i := range nbytes {
+				b := br.readBits(8)
+				if i > 0 && i == nbytes-1 && b == 0 {
+					z.corrupt()
+					return
+				}
+				n |= int(b) << (8 * i)
+			}
+			n++
+		}
+		if !br.align() {
+			z.corrupt()
+			return
+		}
+		for ; n > 0 && br.err == nil; n-- {
+			br.readBits(8)
+		}
+		if z.last {
+			z.state = stateDone
+		}
+		return
+	}
+	n := 0
+	for i := range nibbles {
+		nib := br.readBits(4)
+		if i > 3 && i == nibbles-1 && nib == 0 {
+			z.corrupt()
+			return
+		}
+		n |= int(nib) << (4 * i)
+	}
+	z.remain = n + 1
+	if !z.last && br.readBool() {
+		// ISUNCOMPRESSED
+		if !br.align() {
+			z.corrupt()
+			return
+		}
+		z.state = stateRaw
+		return
+	}
+	z.readCompressedHeader()
+	z.state = stateCommand
+}
+
+// copyRaw copies the data of an uncompressed meta-block.
+func (z *Reader) copyRaw() {
+	for n := min(z.remain, z.space()); n > 0 && z.br.err == nil; n-- {
+		z.put(byte(z.br.readBits(8)))
+		z.remain--
+	}
+	if z.remain == 0 {
+		z.state = stateMeta
+	}
+}
+
+// readCompressedHeader reads the rest of the header
+// of a compressed meta-block.
+func (z *Reader) readCompressedHeader() {
+	br := &z.br
+	for i := range z.blocks {
+		b := &z.blocks[i]
+		b.ntypes = z.readCount()
+		b.typ, b.prev = 0, 1
+		b.left = 1 << 24 // more than any meta-block
+		if b.ntypes >= 2 {
+			if !z.readPrefixCode(&b.types, b.ntypes+2) || !z.readPrefixCode(&b.counts, numBlockCounts) {
+				return
+			}
+			b.left = z.readBlockCount(b)
+		}
+	}
+	z.npostfix = uint(br.readBits(2))
+	z.ndirect = br.readBits(4) << z.npostfix
+	z.modes = resize(z.modes, z.blocks[catLiteral].ntypes)
+	for i := range z.modes {
+		z.modes[i] = uint8(br.readBits(2))
+	}
+
+	var nlit, ndist int
+	if z.litMap, nlit = z.readContextMap(z.litMap, 64*z.blocks[catLiteral].ntypes); z.err != nil {
+		return
+	}
+	if z.distMap, ndist = z.readContextMap(z.distMap, 4*z.blocks[catDistance].ntypes); z.err != nil {
+		return
+	}
+	var ok bool
+	if z.litCodes, ok = z.readPrefixCodes(z.litCodes, nlit, numLiterals); !ok {
+		return
+	}
+	if z.cmdCodes, ok = z.readPrefixCodes(z.cmdCodes, z.blocks[catCommand].ntypes, numCommands); !ok {
+		return
+	}
+	distAlphabet := numDistShort + int(z.ndirect) + 48<<z.npostfix
+	z.distCodes, _ = z.readPrefixCodes(z.distCodes, ndist, distAlphabet)
+}
+
+// resize returns a slice of length n, reusing s if it can.
+func resize[T any](s []T, n int) []T {
+	if cap(s) >= n {
+		return s[:n]
+	}
+	return make([]T, n)
+}
+
+// readCount reads a number of block types or of prefix codes,
+// from 1 to 256.
+func (z *Reader) readCount() int {
+	if !z.br.readBool() {
+		return 1
+	}
+	n := uint(z.br.readBits(3))
+	return 1 + 1<<n + int(z.br.readBits(n))
+}
+
+// blockCounts gives the base and the number of extra bits of each
+// block count code.
+var blockCounts = [numBlockCounts]struct {
+	base  uint32
+	extra uint8
+}{
+	{1, 2}, {5, 2}, {9, 2}, {13, 2}, {17, 3}, {25, 3}, {33, 3}, {41, 3},
+	{49, 4}, {65, 4}, {81, 4}, {97, 4}, {113, 5}, {145, 5}, {177, 5}, {209, 5},
+	{241, 6}, {305, 6}, {369, 7}, {497, 8}, {753, 9}, {1265, 10}, {2289, 11}, {4337, 12},
+	{8433, 13}, {16625, 24},
+}
+
+func (z *Reader) readBlockCount(b *blockState) int {
+	c := blockCounts[z.br.decode(&b.counts)]
+	return int(c.base + z.br.readBits(uint(c.extra)))
+}
+
+// switchBlock reads a block switch command, RFC 7932, Section 6.
+func (z *Reader) switchBlock(b *blockState) {
+	t := int(z.br.decode(&b.types))
+	switch t {
+	case 0:
+		t = b.prev
+	case 1:
+		t = b.typ + 1
+	default:
+		t -= 2
+	}
+	if t >= b.ntypes {
+		t -= b.ntypes
+	}
+	b.prev, b.typ = b.typ, t
+	b.left = z.readBlockCount(b)
+}
+
+// readContextMap reads a context map of the given size, RFC 7932,
+// Section 7.3, and returns it with the number of prefix codes it uses.
+func (z *Reader) readContextMap(cmap []uint8, size int) ([]uint8, int) {
+	br := &z.br
+	cmap = resize(cmap, size)
+	clear(cmap)
+	ntrees := z.readCount()
+	if ntrees == 1 {
+		return cmap, 1
+	}
+	rleMax := uint32(0)
+	if br.readBool() {
+		rleMax = br.readBits(4) + 1
+	}
+	var h huffman
+	if !z.readPrefixCode(&h, ntrees+int(rleMax)) {
+		return cmap, 0
+	}
+	for i := 0; i < size && br.err == nil; {
+		switch sym := br.decode(&h); {
+		case sym == 0:
+			i++
+		case sym <= rleMax:
+			n := 1<<sym + int(br.readBits(uint(sym)))
+			if i+n > size {
+				z.corrupt()
+				return cmap, 0
+			}
+			i += n
+		default:
+			cmap[i] = uint8(sym - rleMax)
+			i++
+		}
+	}
+	if br.readBool() {
+		// Inverse move-to-front transform.
+		var mtf [256]uint8
+		for i := range mtf {
+			mtf[i] = uint8(i)
+		}
+		for i, v := range cmap {
+			t := mtf[v]
+			cmap[i] = t
+			copy(mtf[1:v+1], mtf[:v])
+			mtf[0] = t
+		}
+	}
+	return cmap, ntrees
+}
+
+// readPrefixCodes reads n prefix codes of the given alphabet size.
+func (z *Reader) readPrefixCodes(codes []huffman, n, alphabet int) ([]huffman, bool) {
+	codes = resize(codes, n)
+	for i := range codes {
+		if !z.readPrefixCode(&codes[i], alphabet) {
+			return codes, false
+		}
+	}
+	return codes, true
+}
+
+// readPrefixCode reads a prefix code of the given alphabet size,
+// RFC 7932, Section 3.4 and 3.5, and reports whether it is valid.
+func (z *Reader) readPrefixCode(h *huffman, alphabet int) bool {
+	br := &z.br
+	lens := make([]uint8, alphabet)
+	hskip := br.readBits(2)
+	if hskip == 1 {
+		// A simple prefix code.
+		var syms [4]uint32
+		nsym := int(br.readBits(2)) + 1
+		abits := uint(bits.Len(uint(alphabet - 1)))
+		for i := range nsym {
+			syms[i] = br.readBits(abits)
+			if int(syms[i]) >= alphabet || lens[syms[i]] != 0 {
+				z.corrupt()
+				return false
+			}
+			lens[syms[i]] = 1
+		}
+		switch nsym {
+		case 3:
+			lens[syms[1]], lens[syms[2]] = 2, 2
+		case 4:
+			if br.readBool() {
+				lens[syms[1]], lens[syms[2]], lens[syms[3]] = 2, 3, 3
+			} else {
+				lens[syms[0]], lens[syms[1]], lens[syms[2]], lens[syms[3]] = 2, 2, 2, 2
+			}
+		}
+		if !h.build(lens) {
+			z.corrupt()
+			return false
+		}
+		return true
+	}
+
+	// A complex prefix code, whose code lengths are
+	// themselves coded with a code length code.
+	var clens [len(codeLengthOrder)]uint8
+	space, num := 32, 0
+	for _, i := range codeLengthOrder[hskip:] {
+		n := uint8(br.decode(&codeLengthCodeLengths))
+		clens[i] = n
+		if n != 0 {
+			space -= 32 >> n
+			num++
+			if space <= 0 {
+				break
+			}
+		}
+	}
+	var clcode huffman
+	if num != 1 && space != 0 || !clcode.build(clens[:]) {
+		z.corrupt()
+		return false
+	}
+
+	const (
+		repeatPrevious = 16 // repeat the last nonzero length
+		repeatZero     = 17 // repeat zero
+	)
+	space = 1 << maxCodeLen
+	prev, repeatLen := uint8(8), uint8(0)
+	repeat := 0
+	for sym := 0; sym < alphabet && space > 0; {
+		if br.err != nil {
+			return false
+		}
+		c := uint8(br.decode(&clcode))
+		if c < repeatPrevious {
+			repeat = 0
+			lens[sym] = c
+			sym++
+			if c != 0 {
+				prev = c
+				space -= 1 << maxCodeLen >> c
+			}
+			continue
+		}
+		extra, n := uint(2), prev
+		if c == repeatZero {
+			extra, n = 3, 0
+		}
+		if repeatLen != n {
+			repeat, repeatLen = 0, n
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extra
+		}
+		repeat += int(br.readBits(extra)) + 3
+		delta := repeat - old
+		if sym+delta > alphabet {
+			z.corrupt()
+			return false
+		}
+		for range delta {
+			lens[sym] = n
+			sym++
+		}
+		if n != 0 {
+			space -= delta << (maxCodeLen - n)
+		}
+	}
+	if space != 0 || !h.build(lens) {
+		z.corrupt()
+		return false
+	}
+	return true
+}
+
+// Insert and copy lengths, RFC 7932, Section 5.
+
+type lengthCode struct {
+	base  uint32
+	extra uint8
+}
+
+var insertLengths = [24]lengthCode{
+	{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 1}, {8, 1},
+	{10, 2}, {14, 2}, {18, 3}, {26, 3}, {34, 4}, {50, 4}, {66, 5}, {98, 5},
+	{130, 6}, {194, 7}, {322, 8}, {578, 9}, {1090, 10}, {2114, 12}, {6210, 14}, {22594, 24},
+}
+
+var copyLengths = [24]lengthCode{
+	{2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 0}, {7, 0}, {8, 0}, {9, 0},
+	{10, 1}, {12, 1}, {14, 2}, {18, 2}, {22, 3}, {30, 3}, {38, 4}, {54, 4},
+	{70, 5}, {102, 5}, {134, 6}, {198, 7}, {326, 8}, {582, 9}, {1094, 10}, {2118, 24},
+}
+
+// commandCells gives the first insert and copy length codes of each
+// group of 64 insert-and-copy length codes.
+var commandCells = [numCommands / 64]struct{ insert, copy uint8 }{
+	{0, 0}, {0, 8}, {0, 0}, {0, 8}, {8, 0}, {8, 8}, {0, 16}, {16, 0}, {8, 16}, {16, 8}, {16, 16},
+}
+
+// readCommand reads an insert-and-copy length code and its extra bits.
+func (z *Reader) readCommand() {
+	b := &z.blocks[catCommand]
+	if b.left == 0 {
+		z.switchBlock(b)
+	}
+	b.left--
+	sym := z.br.decode(&z.cmdCodes[b.typ])
+	cell := commandCells[sym>>6]
+	ic := insertLengths[uint32(cell.insert)+sym>>3&7]
+	cc := copyLengths[uint32(cell.copy)+sym&7]
+	z.insert = int(ic.base + z.br.readBits(uint(ic.extra)))
+	z.copyLen = int(cc.base + z.br.readBits(uint(cc.extra)))
+	z.implied = sym < 128
+	if z.insert > z.remain {
+		z.corrupt()
+		return
+	}
+	z.state = stateInsert
+}
+
+// insertLiterals decodes the literals of a command,
+// and then reads the distance of the copy that follows.
+func (z *Reader) insertLiterals() {
+	br := &z.br
+	b := &z.blocks[catLiteral]
+	mask := int64(len(z.win) - 1)
+	for n := min(z.insert, z.space()); n > 0 && br.err == nil; n-- {
+		if b.left == 0 {
+			z.switchBlock(b)
+		}
+		b.left--
+		var p1, p2 byte
+		if z.pos > 0 {
+			p1 = z.win[(z.pos-1)&mask]
+		}
+		if z.pos > 1 {
+			p2 = z.win[(z.pos-2)&mask]
+		}
+		ctx := literalContext(z.modes[b.typ], p1, p2)
+		code := &z.litCodes[z.litMap[b.typ<<6|int(ctx)]]
+		z.put(byte(br.decode(code)))
+		z.insert--
+		z.remain--
+	}
+	if z.insert > 0 {
+		return
+	}
+	if z.remain == 0 {
+		// The meta-block ends without the copy.
+		z.endMetaBlock()
+		return
+	}
+	z.readDistance()
+}
+
+// readDistance reads the distance of a copy, RFC 7932, Section 4,
+// and sets up the copy.
+func (z *Reader) readDistance() {
+	br := &z.br
+	dcode := uint32(0)
+	if !z.implied {
+		b := &z.blocks[catDistance]
+		if b.left == 0 {
+			z.switchBlock(b)
+		}
+		b.left--
+		ctx := min(z.copyLen-2, 3)
+		dcode = br.decode(&z.distCodes[z.distMap[b.typ<<2|ctx]])
+	}
+
+	var dist int
+	switch {
+	case dcode < numDistShort:
+		dist = z.dists[distShortIndex[dcode]] + int(distShortOffset[dcode])
+		if dist <= 0 {
+			z.corrupt()
+			return
+		}
+	case dcode < numDistShort+z.ndirect:
+		dist = int(dcode-numDistShort) + 1
+	default:
+		d := dcode - numDistShort - z.ndirect
+		hcode := d >> z.npostfix
+		lcode := d & (1<<z.npostfix - 1)
+		nbits := uint(1 + hcode>>1)
+		offset := (2+hcode&1)<<nbits - 4
+		dist = int((offset+br.readBits(nbits))<<z.npostfix+lcode+z.ndirect) + 1
+	}
+
+	maxDist := int(min(int64(z.maxDist), z.pos))
+	if dist > maxDist {
+		// A reference to a dictionary word.
+		n := z.copyLen
+		if n < minDictWordLen || n > maxDictWordLen {
+			z.corrupt()
+			return
+		}
+		id := dist - maxDist - 1
+		i := id & (1<<dictBits[n] - 1)
+		t := id >> dictBits[n]
+		if t >= len(transforms) {
+			z.corrupt()
+			return
+		}
+		z.wordBuf = appendWord(z.wordBuf[:0], n, i, &transforms[t])
+		z.word = z.wordBuf
+		if len(z.word) > z.remain {
+			z.corrupt()
+			return
+		}
+		z.state = stateWord
+		return
+	}
+	if dcode != 0 {
+		z.dists = [4]int{dist, z.dists[0], z.dists[1], z.dists[2]}
+	}
+	if z.copyLen > z.remain {
+		z.corrupt()
+		return
+	}
+	z.dist = dist
+	z.state = stateCopy
+}
+
+// The short distance codes refer to the last distances,
+// with small offsets.
+var (
+	distShortIndex  = [numDistShort]uint8{0, 1, 2, 3, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1}
+	distShortOffset = [numDistShort]int8{0, 0, 0, 0, -1, 1, -2, 2, -3, 3, -1, 1, -2, 2, -3, 3}
+)
+
+// copyMatch copies earlier output.
+func (z *Reader) copyMatch() {
+	mask := len(z.win) - 1
+	src := int(z.pos) - z.dist
+	n := min(z.copyLen, z.space())
+	for i := range n {
+		z.win[(int(z.pos)+i)&mask] = z.win[(src+i)&mask]
+	}
+	z.pos += int64(n)
+	z.copyLen -= n
+	z.remain -= n
+	if z.copyLen == 0 {
+		z.endCommand()
+	}
+}
+
+// copyWord copies a dictionary word.
+func (z *Reader) copyWord() {
+	n := min(len(z.word), z.space())
+	for _, c := range z.word[:n] {
+		z.put(c)
+	}
+	z.word = z.word[n:]
+	z.remain -= n
+	if len(z.word) == 0 {
+		z.endCommand()
+	}
+}
+
+func (z *Reader) endCommand() {
+	if z.remain == 0 {
+		z.endMetaBlock()
+		return
+	}
+	z.state = stateCommand
+}
+
+func (z *Reader) endMetaBlock() {
+	if z.last {
+		z.state = stateDone
+		return
+	}
+	z.state = stateMeta
+}
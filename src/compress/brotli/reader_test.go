@@ -0,0 +1,218 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"testing/iotest"
+)
+
+// The testdata files hold the first 16 KiB of the text, compressed by
+// the reference implementation with different quality levels and window
+// sizes. The q11 and q5 files use dictionary words.
+var testFiles = []string{
+	"newton-q11.br",
+	"newton-q5-w10.br",
+	"newton-q1.br",
+}
+
+func newton(t testing.TB) []byte {
+	data, err := os.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data[:16<<10]
+}
+
+// flushed is "hello, world\n" compressed in a stream that is flushed after
+// "hello, ", followed by a metadata block, by the reference implementation.
+const flushed = "0b038068656c6c6f2c20d6016d65746164617461280008776f726c640a03"
+
+// flushedAt is the offset in flushed of the end of the first flush.
+const flushedAt = 10
+
+func decodeHex(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestReader(t *testing.T) {
+	want := newton(t)
+	for _, name := range testFiles {
+		data, err := os.ReadFile("testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range []struct {
+			name string
+			wrap func(io.Reader) io.Reader
+		}{
+			{"", func(r io.Reader) io.Reader { return r }},
+			{"OneByteReader", iotest.OneByteReader},
+			{"DataErrReader", iotest.DataErrReader},
+		} {
+			got, err := io.ReadAll(tt.wrap(NewReader(tt.wrap(bytes.NewReader(data)))))
+			if err != nil {
+				t.Errorf("%s %s: %v", name, tt.name, err)
+			} else if !bytes.Equal(got, want) {
+				t.Errorf("%s %s: output mismatch", name, tt.name)
+			}
+		}
+		if err := iotest.TestReader(NewReader(bytes.NewReader(data)), want); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestReaderStreams(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string // hex
+		want string
+	}{
+		{"empty", "06", ""},
+		{"empty window 22", "3b", ""},
+		{"uncompressed", "0b00806103", "a"},
+		{"uncompressed and metadata", flushed, "hello, world\n"},
+		{"compressed", "1b1300f8a5c2c4428c004005", "abababababababababab"},
+	}
+	for _, tt := range tests {
+		got, err := io.ReadAll(NewReader(bytes.NewReader(decodeHex(t, tt.in))))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if string(got) != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	data, err := os.ReadFile("testdata/newton-q11.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		in   []byte
+		err  error // if nil, a CorruptInputError
+	}{
+		{"no input", nil, io.ErrUnexpectedEOF},
+		{"truncated", data[:len(data)/2], io.ErrUnexpectedEOF},
+		{"no last block", decodeHex(t, flushed)[:flushedAt], io.ErrUnexpectedEOF},
+		{"large window", []byte{0x11}, nil},
+		{"padding", []byte{0x86}, nil},
+		{"metadata reserved bit", []byte{0x1c}, nil},
+	}
+	for _, tt := range tests {
+		_, err := io.ReadAll(NewReader(bytes.NewReader(tt.in)))
+		if tt.err == nil {
+			var e CorruptInputError
+			if !errors.As(err, &e) {
+				t.Errorf("%s: got %v, want CorruptInputError", tt.name, err)
+			}
+		} else if err != tt.err {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+// errStop is returned by a reader that has no more data yet.
+var errStop = errors.New("no more data")
+
+func TestReaderFlush(t *testing.T) {
+	// The data flushed by the compressor is available
+	// without reading the rest of the stream.
+	data := decodeHex(t, flushed)
+	r := NewReader(io.MultiReader(bytes.NewReader(data[:flushedAt]), iotest.ErrReader(errStop)))
+	buf := make([]byte, 100)
+	n, err := r.Read(buf)
+	if err != nil || string(buf[:n]) != "hello, " {
+		t.Fatalf("Read = %q, %v; want %q, nil", buf[:n], err, "hello, ")
+	}
+	if _, err := r.Read(buf); err != errStop {
+		t.Fatalf("Read error = %v, want %v", err, errStop)
+	}
+}
+
+func TestReaderTrailingData(t *testing.T) {
+	// A Reader reads nothing after the end of the stream
+	// from an io.ByteReader.
+	buf := bytes.NewBuffer(decodeHex(t, flushed))
+	buf.WriteString("trailer")
+	if _, err := io.Copy(io.Discard, NewReader(buf)); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "trailer" {
+		t.Errorf("data left after the stream = %q, want %q", buf, "trailer")
+	}
+}
+
+func TestReaderWindow(t *testing.T) {
+	// A flushed stream declares the full window of the Writer,
+	// but the Reader only allocates what the short output needs.
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write([]byte("hello, "))
+	w.Flush()
+	w.Write([]byte("world\n"))
+	w.Close()
+	r := NewReader(&buf)
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello, world\n" {
+		t.Errorf("output = %q, want %q", got, "hello, world\n")
+	}
+	if r.winSize != 1<<windowBits {
+		t.Errorf("declared window = %d, want %d", r.winSize, 1<<windowBits)
+	}
+	if len(r.win) > 2*initialWindow {
+		t.Errorf("window size = %d, want at most %d", len(r.win), 2*initialWindow)
+	}
+}
+
+func TestReaderReset(t *testing.T) {
+	want := newton(t)
+	data, err := os.ReadFile("testdata/newton-q5-w10.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewReader(bytes.NewReader(data[:100]))
+	if _, err := io.Copy(io.Discard, r); err != io.ErrUnexpectedEOF {
+		t.Fatalf("reading truncated stream: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	r.Reset(bytes.NewReader(data))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output mismatch after Reset")
+	}
+}
+
+func FuzzReader(f *testing.F) {
+	for _, name := range testFiles {
+		data, err := os.ReadFile("testdata/" + name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data[:min(len(data), 1000)])
+	}
+	f.Add(decodeHex(f, flushed))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := NewReader(bytes.NewReader(data))
+		io.Copy(io.Discard, io.LimitReader(r, 1<<20))
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+// The static dictionary and its transforms, RFC 7932, Section 8
+// and Appendix B.
+
+const (
+	minDictWordLen = 4
+	maxDictWordLen = 24
+)
+
+// dictBits is the number of bits of the index of a word of each length.
+var dictBits = [maxDictWordLen + 1]uint8{
+	4: 10, 5: 10, 6: 11, 7: 11, 8: 10, 9: 10, 10: 10, 11: 10, 12: 10,
+	13: 9, 14: 9, 15: 8, 16: 7, 17: 7, 18: 8, 19: 7, 20: 7, 21: 6,
+	22: 6, 23: 5, 24: 5,
+}
+
+// dictOffset is the offset in dictionary of the words of each length.
+var dictOffset [maxDictWordLen + 2]int
+
+func init() {
+	for n := minDictWordLen; n <= maxDictWordLen; n++ {
+		dictOffset[n+1] = dictOffset[n] + n<<dictBits[n]
+	}
+	if dictOffset[maxDictWordLen+1] != len(dictionary) {
+		panic("brotli: bad dictionary")
+	}
+}
+
+// Transform types.
+const (
+	identity = iota
+	omitLast1
+	omitLast2
+	omitLast3
+	omitLast4
+	omitLast5
+	omitLast6
+	omitLast7
+	omitLast8
+	omitLast9
+	uppercaseFirst
+	uppercaseAll
+	omitFirst1
+	omitFirst2
+	omitFirst3
+	omitFirst4
+	omitFirst5
+	omitFirst6
+	omitFirst7
+	omitFirst8
+	omitFirst9
+)
+
+// A transform turns a dictionary word into a string.
+type transform struct {
+	prefix string
+	typ    uint8
+	suffix string
+}
+
+var transforms = [...]transform{
+	{"", identity, ""},
+	{"", identity, " "},
+	{" ", identity, " "},
+	{"", omitFirst1, ""},
+	{"", uppercaseFirst, " "},
+	{"", identity, " the "},
+	{" ", identity, ""},
+	{"s ", identity, " "},
+	{"", identity, " of "},
+	{"", uppercaseFirst, ""},
+	{"", identity, " and "},
+	{"", omitFirst2, ""},
+	{"", omitLast1, ""},
+	{", ", identity, " "},
+	{"", identity, ", "},
+	{" ", uppercaseFirst, " "},
+	{"", identity, " in "},
+	{"", identity, " to "},
+	{"e ", identity, " "},
+	{"", identity, "\""},
+	{"", identity, "."},
+	{"", identity, "\">"},
+	{"", identity, "\n"},
+	{"", omitLast3, ""},
+	{"", identity, "]"},
+	{"", identity, " for "},
+	{"", omitFirst3, ""},
+	{"", omitLast2, ""},
+	{"", identity, " a "},
+	{"", identity, " that "},
+	{" ", uppercaseFirst, ""},
+	{"", identity, ". "},
+	{".", identity, ""},
+	{" ", identity, ", "},
+	{"", omitFirst4, ""},
+	{"", identity, " with "},
+	{"", identity, "'"},
+	{"", identity, " from "},
+	{"", identity, " by "},
+	{"", omitFirst5, ""},
+	{"", omitFirst6, ""},
+	{" the ", identity, ""},
+	{"", omitLast4, ""},
+	{"", identity, ". The "},
+	{"", uppercaseAll, ""},
+	{"", identity, " on "},
+	{"", identity, " as "},
+	{"", identity, " is "},
+	{"", omitLast7, ""},
+	{"", omitLast1, "ing "},
+	{"", identity, "\n\t"},
+	{"", identity, ":"},
+	{" ", identity, ". "},
+	{"", identity, "ed "},
+	{"", omitFirst9, ""},
+	{"", omitFirst7, ""},
+	{"", omitLast6, ""},
+	{"", identity, "("},
+	{"", uppercaseFirst, ", "},
+	{"", omitLast8, ""},
+	{"", identity, " at "},
+	{"", identity, "ly "},
+	{" the ", identity, " of "},
+	{"", omitLast5, ""},
+	{"", omitLast9, ""},
+	{" ", uppercaseFirst, ", "},
+	{"", uppercaseFirst, "\""},
+	{".", identity, "("},
+	{"", uppercaseAll, " "},
+	{"", uppercaseFirst, "\">"},
+	{"", identity, "=\""},
+	{" ", identity, "."},
+	{".com/", identity, ""},
+	{" the ", identity, " of the "},
+	{"", uppercaseFirst, "'"},
+	{"", identity, ". This "},
+	{"", identity, ","},
+	{".", identity, " "},
+	{"", uppercaseFirst, "("},
+	{"", uppercaseFirst, "."},
+	{"", identity, " not "},
+	{" ", identity, "=\""},
+	{"", identity, "er "},
+	{" ", uppercaseAll, " "},
+	{"", identity, "al "},
+	{" ", uppercaseAll, ""},
+	{"", identity, "='"},
+	{"", uppercaseAll, "\""},
+	{"", uppercaseFirst, ". "},
+	{" ", identity, "("},
+	{"", identity, "ful "},
+	{" ", uppercaseFirst, ". "},
+	{"", identity, "ive "},
+	{"", identity, "less "},
+	{"", uppercaseAll, "'"},
+	{"", identity, "est "},
+	{" ", uppercaseFirst, "."},
+	{"", uppercaseAll, "\">"},
+	{" ", identity, "='"},
+	{"", uppercaseFirst, ","},
+	{"", identity, "ize "},
+	{"", uppercaseAll, "."},
+	{"\xc2\xa0", identity, ""},
+	{" ", identity, ","},
+	{"", uppercaseFirst, "=\""},
+	{"", uppercaseAll, "=\""},
+	{"", identity, "ous "},
+	{"", uppercaseAll, ", "},
+	{"", uppercaseFirst, "='"},
+	{" ", uppercaseFirst, ","},
+	{" ", uppercaseAll, "=\""},
+	{" ", uppercaseAll, ", "},
+	{"", uppercaseAll, ","},
+	{"", uppercaseAll, "("},
+	{"", uppercaseAll, ". "},
+	{" ", uppercaseAll, "."},
+	{"", uppercaseAll, "='"},
+	{" ", uppercaseAll, ". "},
+	{" ", uppercaseFirst, "=\""},
+	{" ", uppercaseAll, "='"},
+	{" ", uppercaseFirst, "='"},
+}
+
+// appendWord appends the dictionary word of length n and index i,
+// transformed by t, to dst.
+func appendWord(dst []byte, n, i int, t *transform) []byte {
+	off := dictOffset[n] + i*n
+	word := dictionary[off : off+n]
+	switch {
+	case t.typ >= omitLast1 && t.typ <= omitLast9:
+		word = word[:max(len(word)-int(t.typ-omitLast1+1), 0)]
+	case t.typ >= omitFirst1 && t.typ <= omitFirst9:
+		word = word[min(int(t.typ-omitFirst1+1), len(word)):]
+	}
+	dst = append(dst, t.prefix...)
+	start := len(dst)
+	dst = append(dst, word...)
+	switch t.typ {
+	case uppercaseFirst:
+		toUpper(dst[start:])
+	case uppercaseAll:
+		for w := dst[start:]; len(w) > 0; {
+			w = w[min(toUpper(w), len(w)):]
+		}
+	}
+	return append(dst, t.suffix...)
+}
+
+// toUpper makes the character at the start of w upper case, in the
+// simplified way of the format, and returns the length of the character.
+func toUpper(w []byte) int {
+	switch {
+	case w[0] < 0xc0:
+		if 'a' <= w[0] && w[0] <= 'z' {
+			w[0] ^= 0x20
+		}
+		return 1
+	case w[0] < 0xe0:
+		if len(w) > 1 {
+			w[1] ^= 0x20
+		}
+		return 2
+	default:
+		if len(w) > 2 {
+			w[2] ^= 0x05
+		}
+		return 3
+	}
+}
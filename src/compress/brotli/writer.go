@@ -0,0 +1,548 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"cmp"
+	"errors"
+	"io"
+	"math/bits"
+	"slices"
+)
+
+// maxMetaBlockSize is the uncompressed size of the meta-blocks
+// that a Writer produces.
+const maxMetaBlockSize = 256 << 10
+
+// numDistances is the size of the distance alphabet with
+// no postfix bits and no direct distance codes.
+const numDistances = numDistShort + 48
+
+var errWriterClosed = errors.New("brotli: write to closed Writer")
+
+// A Writer takes data written to it and writes the compressed
+// form of that data to an underlying writer (see [NewWriter]).
+//
+// A Writer compresses each meta-block with a single prefix code for
+// each category of symbols, and doesn't use the static dictionary.
+//
+// A Writer is not safe for concurrent use. To compress several
+// streams, possibly from different goroutines, reuse Writers
+// with [Writer.Reset], for example by keeping them in a [sync.Pool].
+type Writer struct {
+	w  io.Writer
+	bw bitWriter
+	m  matcher
+
+	// start is the offset in m.hist of the current meta-block.
+	start int
+
+	wroteHeader bool
+	closed      bool
+	err         error
+
+	// The commands of the current meta-block, and its prefix codes.
+	enc       []encodedCommand
+	litLens   [numLiterals]uint8
+	litCodes  [numLiterals]uint16
+	cmdLens   [numCommands]uint8
+	cmdCodes  [numCommands]uint16
+	distLens  [numDistances]uint8
+	distCodes [numDistances]uint16
+}
+
+// NewWriter returns a new Writer.
+// Writes to the returned Writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the Writer when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z := new(Writer)
+	z.m.init()
+	z.Reset(w)
+	return z
+}
+
+// Reset discards the Writer z's state and makes it equivalent to the
+// result of its original state from NewWriter, but writing to w instead.
+// This permits reusing a Writer rather than allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.bw = bitWriter{out: z.bw.out[:0]}
+	z.m.reset()
+	z.start = 0
+	z.wroteHeader = false
+	z.closed = false
+	z.err = nil
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer].
+// The compressed bytes are not necessarily flushed until the Writer
+// is closed or explicitly flushed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	n := 0
+	for len(p) > 0 {
+		if len(z.m.hist)-z.start == maxMetaBlockSize {
+			z.writeHeader(false)
+			z.writeMetaBlock(false)
+			if z.err = z.emit(); z.err != nil {
+				return n, z.err
+			}
+		}
+		if len(z.m.hist) == z.start {
+			z.start -= z.m.slide()
+		}
+		chunk := p[:min(len(p), maxMetaBlockSize-(len(z.m.hist)-z.start))]
+		z.m.hist = append(z.m.hist, chunk...)
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// Flush writes any pending data to the underlying writer,
+// so that a reader can decompress everything written so far.
+// Flush does not end the stream, so more data may be written.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	if len(z.m.hist) == z.start && z.wroteHeader && z.bw.nbits == 0 {
+		return nil
+	}
+	z.writeHeader(false)
+	if len(z.m.hist) > z.start {
+		z.writeMetaBlock(false)
+	}
+	// An empty metadata block: ISLAST, MNIBBLES = 0 coded as 3,
+	// the reserved bit and MSKIPBYTES, followed by padding to the
+	// next byte boundary. RFC 7932, Section 9.2.
+	z.bw.writeBits(0b000110, 6)
+	z.bw.align()
+	z.err = z.emit()
+	return z.err
+}
+
+// Close closes the Writer by flushing any unwritten data to the
+// underlying writer and ending the stream.
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	z.writeHeader(true)
+	if len(z.m.hist) > z.start {
+		z.writeMetaBlock(true)
+	} else {
+		z.bw.writeBits(0b11, 2) // ISLAST, ISLASTEMPTY
+	}
+	z.bw.align()
+	z.err = z.emit()
+	return z.err
+}
+
+// emit writes the complete bytes of the stream to the underlying writer.
+func (z *Writer) emit() error {
+	if len(z.bw.out) == 0 {
+		return nil
+	}
+	_, err := z.w.Write(z.bw.out)
+	z.bw.out = z.bw.out[:0]
+	return err
+}
+
+// writeHeader writes the stream header, RFC 7932, Section 9.1, if it
+// has not been written. If the stream is known to consist of the
+// current meta-block, the window is only as large as needed for it,
+// which lets the decoder use less memory.
+func (z *Writer) writeHeader(last bool) {
+	if z.wroteHeader {
+		return
+	}
+	z.wroteHeader = true
+	wbits := uint(windowBits)
+	if last {
+		for wbits > 10 && 1<<(wbits-1)-16 >= len(z.m.hist)-z.start {
+			wbits--
+		}
+	}
+	switch {
+	case wbits == 16:
+		z.bw.writeBits(0, 1)
+	case wbits == 17:
+		z.bw.writeBits(1, 7)
+	case wbits > 17:
+		z.bw.writeBits(uint32(wbits-17)<<1|1, 4)
+	default:
+		z.bw.writeBits(uint32(wbits-8)<<4|1, 7)
+	}
+}
+
+// writeMetaBlock compresses the current meta-block and writes it,
+// RFC 7932, Section 9.2.
+func (z *Writer) writeMetaBlock(last bool) {
+	bw := &z.bw
+	m := &z.m
+	m.commands(z.start)
+
+	mlen := len(m.hist) - z.start
+	nibbles := max(4, (bits.Len(uint(mlen-1))+3)/4)
+	if last {
+		bw.writeBits(0b01, 2) // ISLAST, not ISLASTEMPTY
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(uint32(nibbles-4), 2)
+	bw.writeBits(uint32(mlen-1), uint(4*nibbles))
+	if !last {
+		bw.writeBits(0, 1) // ISUNCOMPRESSED
+	}
+
+	// A single block type of each category, no postfix bits or
+	// direct distance codes, the context mode of the literal
+	// block type, and a single literal and distance prefix code.
+	bw.writeBits(0, 3)
+	bw.writeBits(0, 6)
+	bw.writeBits(0, 2)
+	bw.writeBits(0, 2)
+
+	var litFreq [numLiterals]uint32
+	var cmdFreq [numCommands]uint32
+	var distFreq [numDistances]uint32
+	for _, c := range m.lits {
+		litFreq[c]++
+	}
+	z.enc = resize(z.enc, len(m.cmds))
+	for i, c := range m.cmds {
+		e := &z.enc[i]
+		*e = encodedCommand{}
+		ic := findLengthCode(&insertLengths, c.insert)
+		cc := 0
+		if c.copyLen > 0 {
+			cc = findLengthCode(&copyLengths, c.copyLen)
+			e.copyExtra = uint32(c.copyLen) - copyLengths[cc].base
+			e.copyBits = copyLengths[cc].extra
+			e.distCode, e.distExtra, e.distBits = distanceCode(c.dist)
+			distFreq[e.distCode]++
+		}
+		e.insertExtra = uint32(c.insert) - insertLengths[ic].base
+		e.insertBits = insertLengths[ic].extra
+		e.code = commandCode(ic, cc)
+		cmdFreq[e.code]++
+	}
+
+	bw.writePrefixCode(litFreq[:], z.litLens[:], z.litCodes[:])
+	bw.writePrefixCode(cmdFreq[:], z.cmdLens[:], z.cmdCodes[:])
+	bw.writePrefixCode(distFreq[:], z.distLens[:], z.distCodes[:])
+
+	lits := m.lits
+	for i, c := range m.cmds {
+		e := &z.enc[i]
+		bw.writeBits(uint32(z.cmdCodes[e.code]), uint(z.cmdLens[e.code]))
+		bw.writeBits(e.insertExtra, uint(e.insertBits))
+		bw.writeBits(e.copyExtra, uint(e.copyBits))
+		for _, b := range lits[:c.insert] {
+			bw.writeBits(uint32(z.litCodes[b]), uint(z.litLens[b]))
+		}
+		lits = lits[c.insert:]
+		if c.copyLen > 0 {
+			bw.writeBits(uint32(z.distCodes[e.distCode]), uint(z.distLens[e.distCode]))
+			bw.writeBits(e.distExtra, uint(e.distBits))
+		}
+	}
+
+	z.start = len(m.hist)
+}
+
+// An encodedCommand holds the codes and extra bits of a command.
+type encodedCommand struct {
+	code                   uint16 // insert-and-copy length code
+	insertExtra, copyExtra uint32
+	insertBits, copyBits   uint8
+	distCode               uint8
+	distExtra              uint32
+	distBits               uint8
+}
+
+// findLengthCode returns the code in t for the insert or copy length n.
+func findLengthCode(t *[24]lengthCode, n int) int {
+	i, _ := slices.BinarySearchFunc(t[:], uint32(n)+1, func(c lengthCode, n uint32) int {
+		return cmp.Compare(c.base, n)
+	})
+	return i - 1
+}
+
+// commandCellIndex maps the top bits of an insert and of a copy length
+// code to their group of insert-and-copy length codes with an explicit
+// distance, an index in commandCells.
+var commandCellIndex = [3][3]uint16{{2, 3, 6}, {4, 5, 8}, {7, 9, 10}}
+
+// commandCode returns the insert-and-copy length code for an insert
+// length code and a copy length code, with an explicit distance.
+// RFC 7932, Section 5.
+func commandCode(ic, cc int) uint16 {
+	return commandCellIndex[ic>>3][cc>>3]<<6 | uint16(ic&7)<<3 | uint16(cc&7)
+}
+
+// distanceCode returns the distance code and the extra bits of a
+// distance, with no postfix bits or direct distance codes.
+// RFC 7932, Section 4.
+func distanceCode(dist int) (code uint8, extra uint32, nbits uint8) {
+	x := uint32(dist) + 3
+	h := bits.Len32(x) - 1
+	prefix := x >> (h - 1) & 1
+	return uint8(numDistShort + 2*(h-2) + int(prefix)), x & (1<<(h-1) - 1), uint8(h - 1)
+}
+
+// writePrefixCode builds a prefix code for the symbol frequencies
+// freq, sets lens and codes to its code lengths and its codes, and
+// writes its description, RFC 7932, Sections 3.4 and 3.5.
+func (bw *bitWriter) writePrefixCode(freq []uint32, lens []uint8, codes []uint16) {
+	clear(lens)
+	clear(codes)
+	var syms [4]int
+	nsym := 0
+	for s, f := range freq {
+		if f > 0 {
+			if nsym < len(syms) {
+				syms[nsym] = s
+			}
+			nsym++
+		}
+	}
+	abits := uint(bits.Len(uint(len(freq) - 1)))
+	if nsym <= 1 {
+		// A simple prefix code of a single symbol, which takes no bits.
+		bw.writeBits(1, 2) // HSKIP
+		bw.writeBits(0, 2) // NSYM-1
+		bw.writeBits(uint32(syms[0]), abits)
+		return
+	}
+
+	buildLengths(lens, freq, maxCodeLen)
+	buildCodes(codes, lens)
+	if nsym <= len(syms) {
+		// A simple prefix code, listing the symbols from
+		// the shortest code to the longest.
+		s := syms[:nsym]
+		slices.SortStableFunc(s, func(a, b int) int {
+			return cmp.Compare(lens[a], lens[b])
+		})
+		bw.writeBits(1, 2)
+		bw.writeBits(uint32(nsym-1), 2)
+		for _, sym := range s {
+			bw.writeBits(uint32(sym), abits)
+		}
+		if nsym == 4 {
+			// The tree-select bit.
+			if lens[s[0]] == 1 {
+				bw.writeBits(1, 1)
+			} else {
+				bw.writeBits(0, 1)
+			}
+		}
+		return
+	}
+
+	// A complex prefix code. Runs of zero code lengths are coded with
+	// the repeat code, but consecutive repeat codes would combine, so
+	// long runs are split by a single zero code length.
+	const repeatZero = 17
+	type clSym struct{ sym, extra uint8 }
+	var seq []clSym
+	last := len(lens) - 1
+	for lens[last] == 0 {
+		last--
+	}
+	for i := 0; i <= last; {
+		if lens[i] != 0 {
+			seq = append(seq, clSym{lens[i], 0})
+			i++
+			continue
+		}
+		run := 0
+		for lens[i+run] == 0 {
+			run++
+		}
+		i += run
+		for run > 0 {
+			if run < 3 {
+				for range run {
+					seq = append(seq, clSym{0, 0})
+				}
+				break
+			}
+			n := min(run, 10)
+			seq = append(seq, clSym{repeatZero, uint8(n - 3)})
+			run -= n
+			if run > 0 {
+				seq = append(seq, clSym{0, 0})
+				run--
+			}
+		}
+	}
+
+	var clFreq [len(codeLengthOrder)]uint32
+	for _, c := range seq {
+		clFreq[c.sym]++
+	}
+	var clLens [len(codeLengthOrder)]uint8
+	var clCodes [len(codeLengthOrder)]uint16
+	bw.writeBits(0, 2) // HSKIP
+	if countNonzero(clFreq[:]) == 1 {
+		// A code length code of a single symbol takes no bits,
+		// and all its code lengths are written.
+		for _, i := range codeLengthOrder {
+			if clFreq[i] > 0 {
+				bw.writeFixed(1)
+			} else {
+				bw.writeFixed(0)
+			}
+		}
+	} else {
+		buildLengths(clLens[:], clFreq[:], 5)
+		buildCodes(clCodes[:], clLens[:])
+		space := 32
+		for _, i := range codeLengthOrder {
+			bw.writeFixed(clLens[i])
+			if clLens[i] != 0 {
+				space -= 32 >> clLens[i]
+				if space == 0 {
+					break
+				}
+			}
+		}
+	}
+	for _, c := range seq {
+		bw.writeBits(uint32(clCodes[c.sym]), uint(clLens[c.sym]))
+		if c.sym == repeatZero {
+			bw.writeBits(uint32(c.extra), 3)
+		}
+	}
+}
+
+// fixedCodes are the codes of codeLengthCodeLengths.
+var fixedCodes [len(fixedLens)]uint16
+
+func init() {
+	buildCodes(fixedCodes[:], fixedLens[:])
+}
+
+// writeFixed writes a code length of the code length code.
+func (bw *bitWriter) writeFixed(n uint8) {
+	bw.writeBits(uint32(fixedCodes[n]), uint(fixedLens[n]))
+}
+
+func countNonzero(freq []uint32) int {
+	n := 0
+	for _, f := range freq {
+		if f != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// buildLengths sets lens to the code lengths of a prefix code for the
+// symbol frequencies freq, with codes no longer than maxLen. At least
+// two symbols must have a nonzero frequency.
+//
+// It builds a Huffman code, and if it is too deep, it raises the lowest
+// frequencies until it isn't, as the reference implementation does.
+func buildLengths(lens []uint8, freq []uint32, maxLen uint8) {
+	type node struct {
+		weight      uint32
+		sym         int // the symbol of a leaf, or -1
+		left, right int
+	}
+	var leaves []node
+	for s, f := range freq {
+		if f > 0 {
+			leaves = append(leaves, node{weight: f, sym: s})
+		}
+	}
+	slices.SortStableFunc(leaves, func(a, b node) int {
+		return cmp.Compare(a.weight, b.weight)
+	})
+
+	nodes := make([]node, 0, 2*len(leaves)-1)
+	depth := make([]uint8, 2*len(leaves)-1)
+	for minWeight := uint32(1); ; minWeight *= 2 {
+		// Merge the two lightest of the leaves and of the internal nodes,
+		// which are created in order of weight, until there is one left.
+		nodes = append(nodes[:0], leaves...)
+		for i := range nodes {
+			nodes[i].weight = max(nodes[i].weight, minWeight)
+		}
+		leaf, inner := 0, len(leaves)
+		lightest := func() int {
+			if leaf < len(leaves) && (inner == len(nodes) || nodes[leaf].weight <= nodes[inner].weight) {
+				leaf++
+				return leaf - 1
+			}
+			inner++
+			return inner - 1
+		}
+		for len(nodes) < cap(nodes) {
+			a := lightest()
+			b := lightest()
+			nodes = append(nodes, node{nodes[a].weight + nodes[b].weight, -1, a, b})
+		}
+
+		// Children come before their parent, so walk from the root.
+		ok := true
+		depth[len(nodes)-1] = 0
+		for i := len(nodes) - 1; i >= 0; i-- {
+			n := &nodes[i]
+			if n.sym >= 0 {
+				lens[n.sym] = depth[i]
+				ok = ok && depth[i] <= maxLen
+				continue
+			}
+			depth[n.left] = depth[i] + 1
+			depth[n.right] = depth[i] + 1
+		}
+		if ok {
+			return
+		}
+	}
+}
+
+// buildCodes sets codes to the canonical prefix codes with the
+// code lengths lens, as in RFC 1951, Section 3.2.2, bit reversed
+// so that they can be written least significant bit first.
+func buildCodes(codes []uint16, lens []uint8) {
+	var count [maxCodeLen + 1]int
+	for _, n := range lens {
+		if n != 0 {
+			count[n]++
+		}
+	}
+	var next [maxCodeLen + 1]uint32
+	code := uint32(0)
+	for n := 1; n <= maxCodeLen; n++ {
+		next[n] = code
+		code = (code + uint32(count[n])) << 1
+	}
+	for s, n := range lens {
+		if n != 0 {
+			codes[s] = uint16(reverse(next[n], uint(n)))
+			next[n]++
+		}
+	}
+}
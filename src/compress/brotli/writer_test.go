@@ -0,0 +1,158 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"testing"
+)
+
+func compress(t testing.TB, data []byte) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decompress(t testing.TB, data []byte) []byte {
+	got, err := io.ReadAll(NewReader(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	random := make([]byte, 100<<10)
+	for i := range random {
+		random[i] = byte(r.Uint32())
+	}
+	// Symbols with few distinct values exercise the simple prefix codes.
+	few := make([]byte, 10<<10)
+	for i := range few {
+		few[i] = "abc"[r.IntN(3)]
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"one byte", []byte{'x'}},
+		{"short", []byte("hello, world\n")},
+		{"repeated", bytes.Repeat([]byte("ab"), 1000)},
+		{"zeros", make([]byte, 1<<20)},
+		{"few symbols", few},
+		{"random", random},
+		{"newton", newton(t)},
+		{"long", []byte(strings.Repeat(string(newton(t)), 40))},
+	}
+	if !testing.Short() {
+		// Longer than the window, so that the history slides.
+		tests = append(tests, struct {
+			name string
+			data []byte
+		}{"sliding", append(bytes.Repeat(random, 50), newton(t)...)})
+	}
+	for _, tt := range tests {
+		compressed := compress(t, tt.data)
+		if got := decompress(t, compressed); !bytes.Equal(got, tt.data) {
+			t.Errorf("%s: round trip mismatch", tt.name)
+		}
+	}
+}
+
+func TestWriterRatio(t *testing.T) {
+	// The Writer compresses at least as well as the reference
+	// implementation at quality 1.
+	q1, err := os.ReadFile("testdata/newton-q1.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(compress(t, newton(t))); n > len(q1) {
+		t.Errorf("compressed size = %d, want at most %d", n, len(q1))
+	}
+}
+
+func TestWriterFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	r := NewReader(&buf)
+	data := newton(t)
+	for len(data) > 0 {
+		n := min(len(data), 1000)
+		if _, err := w.Write(data[:n]); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		// The flushed data can be read without the rest of the stream.
+		got := make([]byte, n)
+		if _, err := io.ReadFull(r, got); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data[:n]) {
+			t.Fatal("flushed data mismatch")
+		}
+		data = data[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		t.Errorf("Read at the end = %d, %v; want 0, EOF", n, err)
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	data := newton(t)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(data)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(data)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Error("output differs after Reset")
+	}
+	if _, err := w.Write(data); err == nil {
+		t.Error("Write after Close succeeded")
+	}
+}
+
+func TestWriterSmallWindow(t *testing.T) {
+	// A short stream declares a small window, that the Reader
+	// allocates.
+	compressed := compress(t, []byte("hello, world\n"))
+	r := NewReader(bytes.NewReader(compressed))
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.win) != 1<<10 {
+		t.Errorf("window size = %d, want %d", len(r.win), 1<<10)
+	}
+}
+
+func BenchmarkWriter(b *testing.B) {
+	data := newton(b)
+	w := NewWriter(io.Discard)
+	b.SetBytes(int64(len(data)))
+	for range b.N {
+		w.Reset(io.Discard)
+		w.Write(data)
+		w.Close()
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import "io"
+
+// bitWriter is the counterpart of bitReader. It buffers values, written
+// bit-by-bit with the most-significant bit first, until Flush is called.
+// Like bitReader, its Write* methods don't return errors; any error is
+// kept and can be checked afterwards.
+type bitWriter struct {
+	w    io.Writer
+	n    uint64
+	bits uint
+	out  []byte
+	err  error
+}
+
+// WriteBits64 writes the given number of least-significant bits of n.
+func (bw *bitWriter) WriteBits64(bits uint, n uint64) {
+	if bits > 32 {
+		bw.writeBits(bits-32, n>>32)
+		bits = 32
+	}
+	bw.writeBits(bits, n)
+}
+
+// writeBits writes up to 32 bits.
+func (bw *bitWriter) writeBits(bits uint, n uint64) {
+	bw.n = bw.n<<bits | n&(1<<bits-1)
+	bw.bits += bits
+	for bw.bits >= 8 {
+		bw.bits -= 8
+		bw.out = append(bw.out, byte(bw.n>>bw.bits))
+	}
+}
+
+func (bw *bitWriter) WriteBits(bits uint, n int) {
+	bw.WriteBits64(bits, uint64(n))
+}
+
+func (bw *bitWriter) WriteBit(b bool) {
+	if b {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(1, 0)
+	}
+}
+
+// Align pads the output with zero bits to a byte boundary.
+func (bw *bitWriter) Align() {
+	if bw.bits > 0 {
+		bw.writeBits(8-bw.bits, 0)
+	}
+}
+
+// Flush writes the buffered whole bytes to the underlying writer.
+// Bits that don't make up a whole byte stay buffered.
+func (bw *bitWriter) Flush() error {
+	if bw.err == nil && len(bw.out) > 0 {
+		_, bw.err = bw.w.Write(bw.out)
+	}
+	bw.out = bw.out[:0]
+	return bw.err
+}
+
+func (bw *bitWriter) Err() error {
+	return bw.err
+}
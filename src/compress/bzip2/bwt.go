@@ -0,0 +1,194 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+// bwtSorter computes the Burrows-Wheeler transform of a block.
+// It keeps its scratch space so that it can be reused for each block.
+type bwtSorter struct {
+	sa   []int32
+	rank []int32
+	tmp  []int32
+}
+
+// bwt sets dst to the last column of the sorted matrix of rotations of
+// src, and returns origPtr, the row of the matrix that holds src itself.
+// The reader undoes this with inverseBWT.
+//
+// The rotations are sorted with the prefix doubling algorithm of
+// Larsson and Sadakane, “Faster Suffix Sorting”: after the pass for h,
+// the rotations are sorted by their first 2h bytes, as each pass sorts
+// the rotations that are still tied by the rank of the rotation h bytes
+// further on. Rotations that are equal may end up in any order; they
+// have the same content, so the reader produces the same output for each.
+func (s *bwtSorter) bwt(dst, src []byte) (origPtr int) {
+	n := len(src)
+	if cap(s.sa) < n {
+		s.sa = make([]int32, n)
+		s.rank = make([]int32, n)
+		s.tmp = make([]int32, n)
+	}
+	s.sa, s.rank, s.tmp = s.sa[:n], s.rank[:n], s.tmp[:n]
+
+	// sa holds the rotations in sorted order, except that a run of
+	// sorted rotations may be replaced by its negated length.
+	// rank holds the group of each rotation: the index in sa of the
+	// last rotation tied with it.
+	sa, rank := s.sa, s.rank
+
+	// Sort by the first byte.
+	var c [256]int32
+	for _, b := range src {
+		c[b]++
+	}
+	sum := int32(0)
+	for i := range c {
+		sum += c[i]
+		c[i] = sum - c[i]
+	}
+	for i, b := range src {
+		sa[c[b]] = int32(i)
+		c[b]++
+	}
+	for i, b := range src {
+		rank[i] = c[b] - 1
+	}
+	for i := range c {
+		if i == 0 && c[0] == 1 || i > 0 && c[i]-c[i-1] == 1 {
+			sa[c[i]-1] = -1
+		}
+	}
+
+	for h := 1; h < n && sa[0] != int32(-n); h *= 2 {
+		sorted := int32(0) // length of the current run of sorted rotations
+		for i := int32(0); i < int32(n); {
+			if sa[i] < 0 {
+				sorted -= sa[i]
+				i -= sa[i]
+				continue
+			}
+			if sorted > 0 {
+				sa[i-sorted] = -sorted
+				sorted = 0
+			}
+			end := rank[sa[i]] + 1
+			s.split(i, end, h)
+			i = end
+		}
+		if sorted > 0 {
+			sa[int32(n)-sorted] = -sorted
+		}
+	}
+
+	// Rebuild sa from the ranks. Rotations that are still tied
+	// share a rank, so fill each group from the end.
+	next := s.tmp
+	for i := range next {
+		next[i] = int32(i)
+	}
+	for i, r := range rank {
+		sa[next[r]] = int32(i)
+		next[r]--
+	}
+
+	for x, i := range sa {
+		if i == 0 {
+			origPtr = x
+			dst[x] = src[n-1]
+		} else {
+			dst[x] = src[i-1]
+		}
+	}
+	return origPtr
+}
+
+// split sorts the group of rotations sa[start:end] by the rank of the
+// rotation h bytes further on, splitting it into new groups.
+func (s *bwtSorter) split(start, end int32, h int) {
+	sa, rank := s.sa, s.rank
+	n := len(rank)
+	key := func(i int32) int32 {
+		j := int(i) + h
+		if j >= n {
+			j -= n
+		}
+		return rank[j]
+	}
+
+	if end-start < 16 {
+		// Selection sort, moving the rotations with the smallest
+		// key to the front and making them a group.
+		for k := start; k < end; {
+			j := int32(1)
+			x := key(sa[k])
+			for i := k + 1; i < end; i++ {
+				if v := key(sa[i]); v < x {
+					x = v
+					j = 0
+				}
+				if key(sa[i]) == x {
+					sa[k+j], sa[i] = sa[i], sa[k+j]
+					j++
+				}
+			}
+			for i := k; i < k+j; i++ {
+				rank[sa[i]] = k + j - 1
+			}
+			if j == 1 {
+				sa[k] = -1
+			}
+			k += j
+		}
+		return
+	}
+
+	// Partition around a pivot into rotations with smaller keys,
+	// which are split further, equal keys, which form a new group,
+	// and larger keys, which are split further.
+	x := key(sa[start+(end-start)/2])
+	lt, eq := start, start
+	for i := start; i < end; i++ {
+		switch v := key(sa[i]); {
+		case v < x:
+			lt++
+			eq++
+		case v == x:
+			eq++
+		}
+	}
+	i, j, k := start, lt, eq
+	for i < lt {
+		switch v := key(sa[i]); {
+		case v < x:
+			i++
+		case v == x:
+			sa[i], sa[j] = sa[j], sa[i]
+			j++
+		default:
+			sa[i], sa[k] = sa[k], sa[i]
+			k++
+		}
+	}
+	for j < eq {
+		if key(sa[j]) == x {
+			j++
+		} else {
+			sa[j], sa[k] = sa[k], sa[j]
+			k++
+		}
+	}
+
+	if lt > start {
+		s.split(start, lt, h)
+	}
+	for i := lt; i < eq; i++ {
+		rank[sa[i]] = eq - 1
+	}
+	if eq-lt == 1 {
+		sa[lt] = -1
+	}
+	if end > eq {
+		s.split(eq, end, h)
+	}
+}
@@ -93,8 +93,24 @@ func newHuffmanTree(lengths []uint8) (huffmanTree, error) {
 		panic("newHuffmanTree: too few symbols")
 	}
 
+	codes := assignHuffmanCodes(lengths)
+
+	// Now we can sort by the code so that the left half of each branch are
+	// grouped together, recursively.
+	slices.SortFunc(codes, func(a, b huffmanCode) int {
+		return cmp.Compare(a.code, b.code)
+	})
+
 	var t huffmanTree
+	t.nodes = make([]huffmanNode, len(codes))
+	_, err := buildHuffmanNode(&t, codes, 0)
+	return t, err
+}
 
+// assignHuffmanCodes assigns the canonical code for each symbol, given the
+// code length of each symbol. The codes are packed at the most-significant
+// end of a uint32.
+func assignHuffmanCodes(lengths []uint8) []huffmanCode {
 	// First we sort the code length assignments by ascending code length,
 	// using the symbol value to break ties.
 	pairs := make([]huffmanSymbolLengthPair, len(lengths))
@@ -130,15 +146,7 @@ func newHuffmanTree(lengths []uint8) (huffmanTree, error) {
 		code += 1 << (32 - length)
 	}
 
-	// Now we can sort by the code so that the left half of each branch are
-	// grouped together, recursively.
-	slices.SortFunc(codes, func(a, b huffmanCode) int {
-		return cmp.Compare(a.code, b.code)
-	})
-
-	t.nodes = make([]huffmanNode, len(codes))
-	_, err := buildHuffmanNode(&t, codes, 0)
-	return t, err
+	return codes
 }
 
 // huffmanSymbolLengthPair contains a symbol and its code length.
@@ -232,3 +240,69 @@ func buildHuffmanNode(t *huffmanTree, codes []huffmanCode, level uint32) (nodeIn
 
 	return
 }
+
+// huffmanCodeLengths sets lengths to the code lengths of a Huffman code for
+// symbols with the given frequencies, none longer than maxLen. Every symbol
+// is given a code, even if its frequency is zero, because bzip2 stores a
+// length for every symbol in the alphabet.
+func huffmanCodeLengths(lengths []uint8, freqs []int32, maxLen uint8) {
+	n := len(freqs)
+	weights := make([]int64, 2*n-1)
+	for i, f := range freqs {
+		weights[i] = int64(max(f, 1))
+	}
+	leaves := make([]int, n)
+	parents := make([]int, 2*n-1)
+	depths := make([]int, 2*n-1)
+
+	for {
+		for i := range leaves {
+			leaves[i] = i
+		}
+		slices.SortStableFunc(leaves, func(a, b int) int {
+			return cmp.Compare(weights[a], weights[b])
+		})
+
+		// Build the tree with the two-queue method: the leaves are
+		// sorted by weight, and the internal nodes are created in order
+		// of weight, so the two lightest nodes are always at the heads
+		// of the two queues.
+		nextLeaf, nextNode := 0, n
+		lightest := func(end int) int {
+			if nextLeaf < n && (nextNode >= end || weights[leaves[nextLeaf]] <= weights[nextNode]) {
+				nextLeaf++
+				return leaves[nextLeaf-1]
+			}
+			nextNode++
+			return nextNode - 1
+		}
+		for node := n; node < 2*n-1; node++ {
+			a := lightest(node)
+			b := lightest(node)
+			weights[node] = weights[a] + weights[b]
+			parents[a] = node
+			parents[b] = node
+		}
+
+		root := 2*n - 2
+		depths[root] = 0
+		tooLong := false
+		for node := root - 1; node >= 0; node-- {
+			depths[node] = depths[parents[node]] + 1
+			if node < n && depths[node] > int(maxLen) {
+				tooLong = true
+			}
+		}
+		if !tooLong {
+			for i := range lengths {
+				lengths[i] = uint8(depths[i])
+			}
+			return
+		}
+
+		// Flatten the distribution and try again, as bzip2 does.
+		for i := range freqs {
+			weights[i] = 1 + weights[i]/2
+		}
+	}
+}
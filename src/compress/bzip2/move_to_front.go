@@ -4,6 +4,8 @@
 
 package bzip2
 
+import "bytes"
+
 // moveToFrontDecoder implements a move-to-front list. Such a list is an
 // efficient way to transform a string with repeating elements into one with
 // many small valued numbers, which is suitable for entropy encoding. It works
@@ -51,3 +53,12 @@ func (m moveToFrontDecoder) Decode(n int) (b byte) {
 func (m moveToFrontDecoder) First() byte {
 	return m[0]
 }
+
+// Encode returns the index of b in the list, and moves b to the front.
+// It is the inverse of Decode.
+func (m moveToFrontDecoder) Encode(b byte) int {
+	n := bytes.IndexByte(m, b)
+	copy(m[1:], m[:n])
+	m[0] = b
+	return n
+}
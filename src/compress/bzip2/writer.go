@@ -0,0 +1,395 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The compression level sets the block size: level n compresses the
+// input in blocks of n*100 kB. Larger blocks usually compress better,
+// but take more memory to compress and to decompress.
+const (
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+)
+
+const (
+	maxHuffmanTrees = 6
+	maxCodeLen      = 17 // the bzip2 encoder's limit; the reader allows 20
+	groupSize       = 50 // symbols coded with each selected Huffman tree
+	maxAlphaSize    = 258
+	tableIterations = 4
+)
+
+var errWriterClosed = errors.New("bzip2: write to closed Writer")
+
+// A Writer is an io.WriteCloser.
+// Writes to a Writer are compressed and written to w.
+type Writer struct {
+	bw    bitWriter
+	level int
+
+	wroteHeader bool
+	closed      bool
+
+	// block holds the current block, after the initial run-length
+	// encoding, and blockCRC the CRC of the data that makes it up.
+	block    []byte
+	limit    int
+	blockCRC uint32
+	fileCRC  uint32
+
+	// The pending run of lastByte, not yet added to block.
+	lastByte int
+	runLen   int
+
+	sorter bwtSorter
+	bwt    []byte
+	mtf    []uint16 // the output of the move-to-front and zero-run coding
+}
+
+// NewWriter returns a new [Writer] compressing data at the default level.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the [Writer] when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level instead
+// of assuming [DefaultCompression].
+//
+// The compression level can be [DefaultCompression] or any integer value
+// between [BestSpeed] and [BestCompression] inclusive. [DefaultCompression]
+// is the same as [BestCompression], the default of the bzip2 command.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level == DefaultCompression {
+		level = BestCompression
+	}
+	if level < BestSpeed || level > BestCompression {
+		return nil, fmt.Errorf("bzip2: invalid compression level: %d", level)
+	}
+	z := &Writer{level: level}
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the [Writer] z's state and makes it equivalent to the
+// result of its original state from [NewWriter] or [NewWriterLevel], but
+// writing to w instead. This permits reusing a [Writer] rather than
+// allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.bw = bitWriter{w: w, out: z.bw.out[:0]}
+	z.wroteHeader = false
+	z.closed = false
+	// The reader rejects blocks of blockSize bytes or more; the
+	// bzip2 command leaves a few bytes to spare, and so do we.
+	z.limit = z.level*100*1000 - 19
+	z.block = z.block[:0]
+	z.blockCRC = 0
+	z.fileCRC = 0
+	z.lastByte = -1
+	z.runLen = 0
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer]. The
+// compressed bytes are not necessarily flushed until the [Writer] is closed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if err := z.bw.Err(); err != nil {
+		return 0, err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	z.writeHeader()
+	crc := ^z.blockCRC
+	for _, b := range p {
+		if int(b) == z.lastByte && z.runLen < 255 {
+			z.runLen++
+		} else {
+			if z.runLen > 0 {
+				z.blockCRC = ^crc
+				if z.addRun() {
+					if err := z.writeBlock(); err != nil {
+						return 0, err
+					}
+				}
+				crc = ^z.blockCRC
+			}
+			z.lastByte = int(b)
+			z.runLen = 1
+		}
+		crc = crctab[byte(crc>>24)^b] ^ (crc << 8)
+	}
+	z.blockCRC = ^crc
+	return len(p), nil
+}
+
+// Close closes the [Writer] by flushing any unwritten data to the underlying
+// [io.Writer] and writing the end of stream marker and checksum.
+// It does not close the underlying [io.Writer].
+func (z *Writer) Close() error {
+	if err := z.bw.Err(); err != nil {
+		return err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	z.writeHeader()
+	if z.runLen > 0 {
+		z.addRun()
+	}
+	if len(z.block) > 0 {
+		if err := z.writeBlock(); err != nil {
+			return err
+		}
+	}
+	z.bw.WriteBits64(48, bzip2FinalMagic)
+	z.bw.WriteBits64(32, uint64(z.fileCRC))
+	z.bw.Align()
+	return z.bw.Flush()
+}
+
+func (z *Writer) writeHeader() {
+	if z.wroteHeader {
+		return
+	}
+	z.wroteHeader = true
+	z.bw.WriteBits(16, bzip2FileMagic)
+	z.bw.WriteBits(8, 'h')
+	z.bw.WriteBits(8, '0'+z.level)
+}
+
+// addRun adds the pending run to the block. This is the counterpart of
+// the run-length decoding in readFromBlock: a run of four to 255 bytes
+// is written as four bytes followed by a count of further repeats.
+// addRun reports whether the block is full, meaning that it may not
+// have room for another run.
+func (z *Writer) addRun() bool {
+	b := byte(z.lastByte)
+	n := z.runLen
+	if n < 4 {
+		for i := 0; i < n; i++ {
+			z.block = append(z.block, b)
+		}
+	} else {
+		z.block = append(z.block, b, b, b, b, byte(n-4))
+	}
+	z.lastByte = -1
+	z.runLen = 0
+	return len(z.block)+5 > z.limit
+}
+
+// writeBlock compresses the current block and writes it out.
+// It is the counterpart of readBlock.
+func (z *Writer) writeBlock() error {
+	bw := &z.bw
+	n := len(z.block)
+	if cap(z.bwt) < n {
+		z.bwt = make([]byte, n)
+	}
+	bwt := z.bwt[:n]
+	origPtr := z.sorter.bwt(bwt, z.block)
+
+	bw.WriteBits64(48, bzip2BlockMagic)
+	bw.WriteBits64(32, uint64(z.blockCRC))
+	z.fileCRC = (z.fileCRC<<1 | z.fileCRC>>31) ^ z.blockCRC
+	bw.WriteBit(false) // not randomized
+	bw.WriteBits(24, origPtr)
+
+	// The two-level bitmap of the byte values in the block.
+	var symbolPresent [256]bool
+	for _, b := range z.block {
+		symbolPresent[b] = true
+	}
+	symbolRangeUsedBitmap := 0
+	for symRange := 0; symRange < 16; symRange++ {
+		for _, present := range symbolPresent[16*symRange : 16*symRange+16] {
+			if present {
+				symbolRangeUsedBitmap |= 1 << (15 - symRange)
+				break
+			}
+		}
+	}
+	bw.WriteBits(16, symbolRangeUsedBitmap)
+	var symbols []byte
+	for symRange := 0; symRange < 16; symRange++ {
+		if symbolRangeUsedBitmap&(1<<(15-symRange)) == 0 {
+			continue
+		}
+		bits := 0
+		for symbol := 0; symbol < 16; symbol++ {
+			if b := 16*symRange + symbol; symbolPresent[b] {
+				bits |= 1 << (15 - symbol)
+				symbols = append(symbols, byte(b))
+			}
+		}
+		bw.WriteBits(16, bits)
+	}
+
+	alphaSize := len(symbols) + 2 // RUNA and RUNB
+	eob := uint16(alphaSize - 1)
+	var freqs [maxAlphaSize]int32
+	z.mtf = moveToFrontEncode(z.mtf[:0], bwt, newMTFDecoder(symbols), eob)
+	for _, v := range z.mtf {
+		freqs[v]++
+	}
+
+	// Choose the Huffman trees, and which one to use for each group.
+	numHuffmanTrees := 6
+	switch {
+	case len(z.mtf) < 200:
+		numHuffmanTrees = 2
+	case len(z.mtf) < 600:
+		numHuffmanTrees = 3
+	case len(z.mtf) < 1200:
+		numHuffmanTrees = 4
+	case len(z.mtf) < 2400:
+		numHuffmanTrees = 5
+	}
+	lengths := make([][]uint8, numHuffmanTrees)
+	for i := range lengths {
+		lengths[i] = make([]uint8, alphaSize)
+	}
+	initialLengths(lengths, freqs[:alphaSize], len(z.mtf))
+	numSelectors := (len(z.mtf) + groupSize - 1) / groupSize
+	treeIndexes := make([]uint8, numSelectors)
+	var treeFreqs [maxHuffmanTrees][maxAlphaSize]int32
+	for iter := 0; iter < tableIterations; iter++ {
+		treeFreqs = [maxHuffmanTrees][maxAlphaSize]int32{}
+		for g := range treeIndexes {
+			group := z.mtf[g*groupSize : min((g+1)*groupSize, len(z.mtf))]
+			best, bestCost := 0, -1
+			for t, l := range lengths {
+				cost := 0
+				for _, v := range group {
+					cost += int(l[v])
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = t, cost
+				}
+			}
+			treeIndexes[g] = uint8(best)
+			for _, v := range group {
+				treeFreqs[best][v]++
+			}
+		}
+		for t, l := range lengths {
+			huffmanCodeLengths(l, treeFreqs[t][:alphaSize], maxCodeLen)
+		}
+	}
+
+	bw.WriteBits(3, numHuffmanTrees)
+	bw.WriteBits(15, numSelectors)
+	mtfTreeEncoder := newMTFDecoderWithRange(numHuffmanTrees)
+	for _, t := range treeIndexes {
+		for c := mtfTreeEncoder.Encode(t); c > 0; c-- {
+			bw.WriteBit(true)
+		}
+		bw.WriteBit(false)
+	}
+
+	// The code lengths are delta encoded from a 5-bit base value.
+	codes := make([][]uint32, numHuffmanTrees)
+	for t, l := range lengths {
+		length := l[0]
+		bw.WriteBits(5, int(length))
+		for _, want := range l {
+			for ; length < want; length++ {
+				bw.WriteBits(2, 2)
+			}
+			for ; length > want; length-- {
+				bw.WriteBits(2, 3)
+			}
+			bw.WriteBit(false)
+		}
+
+		// The reader takes a 1 bit to mean the left branch of a node,
+		// which holds the codes with a 0 bit (see buildHuffmanNode),
+		// so the bits we write are the complement of the code.
+		codes[t] = make([]uint32, alphaSize)
+		for _, c := range assignHuffmanCodes(l) {
+			codes[t][c.value] = ^c.code >> (32 - c.codeLen)
+		}
+	}
+
+	for g, t := range treeIndexes {
+		group := z.mtf[g*groupSize : min((g+1)*groupSize, len(z.mtf))]
+		l, c := lengths[t], codes[t]
+		for _, v := range group {
+			bw.WriteBits64(uint(l[v]), uint64(c[v]))
+		}
+	}
+
+	z.block = z.block[:0]
+	z.blockCRC = 0
+	return bw.Flush()
+}
+
+// moveToFrontEncode appends to dst the symbols for block after the
+// move-to-front transform, with runs of zeros written as RUNA and RUNB
+// symbols, followed by eob. See readBlock for the details.
+func moveToFrontEncode(dst []uint16, block []byte, mtf moveToFrontDecoder, eob uint16) []uint16 {
+	// A run of n zeros is written as n in bijective base 2,
+	// with RUNA and RUNB for the digits 1 and 2.
+	zeros := 0
+	flushZeros := func() {
+		for n := zeros; n > 0; n = (n - 1) / 2 {
+			dst = append(dst, uint16(1-n&1))
+		}
+		zeros = 0
+	}
+	for _, b := range block {
+		v := mtf.Encode(b)
+		if v == 0 {
+			zeros++
+			continue
+		}
+		flushZeros()
+		dst = append(dst, uint16(v+1))
+	}
+	flushZeros()
+	return append(dst, eob)
+}
+
+// initialLengths sets up the initial code lengths for the Huffman trees,
+// which are refined by the iterations in writeBlock. As in the bzip2
+// command, each tree starts out covering a range of symbols that makes
+// up about an equal share of the total frequency.
+func initialLengths(lengths [][]uint8, freqs []int32, total int) {
+	numHuffmanTrees := len(lengths)
+	remaining := total
+	start := 0
+	for part := numHuffmanTrees; part > 0; part-- {
+		target := remaining / part
+		end := start - 1
+		sum := 0
+		for sum < target && end < len(freqs)-1 {
+			end++
+			sum += int(freqs[end])
+		}
+		if end > start && part != numHuffmanTrees && part != 1 && (numHuffmanTrees-part)%2 == 1 {
+			sum -= int(freqs[end])
+			end--
+		}
+		for v := range freqs {
+			if v >= start && v <= end {
+				lengths[part-1][v] = 0
+			} else {
+				lengths[part-1][v] = 15
+			}
+		}
+		start = end + 1
+		remaining -= sum
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func mustDecompress(compressed []byte) []byte {
+	b, err := io.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func compress(t testing.TB, data []byte, level int) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriterLevel(&buf, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestWriter(t *testing.T) {
+	// Runs of each length around the limits of the initial
+	// run-length encoding.
+	var runs []byte
+	for n := 1; n < 600; n++ {
+		runs = append(runs, bytes.Repeat([]byte{byte(n)}, n)...)
+	}
+	var vectors = []struct {
+		desc  string
+		input []byte
+	}{
+		{desc: "empty"},
+		{desc: "hello world", input: []byte("hello world\n")},
+		{desc: "single byte", input: []byte{0}},
+		{desc: "all byte values", input: func() []byte {
+			b := make([]byte, 256)
+			for i := range b {
+				b[i] = byte(i)
+			}
+			return b
+		}()},
+		{desc: "runs", input: runs},
+		{desc: "periodic", input: bytes.Repeat([]byte("ab"), 150*1000)},
+		{desc: "zeros", input: make([]byte, 1000*1000)},
+		{desc: "digits", input: mustDecompress(digits)},
+		{desc: "newton", input: mustDecompress(newton)},
+		{desc: "random", input: mustDecompress(random)},
+		{desc: "sawtooth", input: mustDecompress(mustLoadFile("testdata/pass-sawtooth.bz2"))},
+	}
+
+	for _, v := range vectors {
+		for _, level := range []int{BestSpeed, BestCompression} {
+			compressed := compress(t, v.input, level)
+			got, err := io.ReadAll(NewReader(bytes.NewReader(compressed)))
+			if err != nil {
+				t.Errorf("%s, level %d: %v", v.desc, level, err)
+				continue
+			}
+			if !bytes.Equal(got, v.input) {
+				t.Errorf("%s, level %d: output mismatch:\ngot  %s\nwant %s", v.desc, level, trim(got), trim(v.input))
+			}
+		}
+	}
+}
+
+func TestWriterCompression(t *testing.T) {
+	input := mustDecompress(newton)
+	// The bzip2 command compresses this to 132469 bytes.
+	if n := len(compress(t, input, BestCompression)); n > 135000 {
+		t.Errorf("compressed %d bytes to %d", len(input), n)
+	}
+}
+
+// TestWriterSmallWrites checks that the output does not
+// depend on how the input is split into writes.
+func TestWriterSmallWrites(t *testing.T) {
+	input := mustDecompress(digits)
+	want := compress(t, input, BestSpeed)
+
+	var buf bytes.Buffer
+	w, err := NewWriterLevel(&buf, BestSpeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for p := input; len(p) > 0; {
+		n := min(len(p), 1+rand.Intn(1000))
+		if _, err := w.Write(p[:n]); err != nil {
+			t.Fatal(err)
+		}
+		p = p[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("output differs when written in small pieces")
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	input := mustDecompress(digits)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write([]byte("hello world\n"))
+	w.Write(input[:1000])
+	w.Reset(&buf1)
+	w.Write(input)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	w.Reset(&buf2)
+	w.Write(input)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output differs after Reset")
+	}
+	if got := mustDecompress(buf2.Bytes()); !bytes.Equal(got, input) {
+		t.Errorf("output mismatch after Reset")
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	for _, level := range []int{-2, 0, 10} {
+		if _, err := NewWriterLevel(io.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel(%d) succeeded, want error", level)
+		}
+	}
+
+	w := NewWriter(io.Discard)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("hello")); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+}
+
+func TestBWT(t *testing.T) {
+	naiveBWT := func(src []byte) []byte {
+		rotations := make([][]byte, len(src))
+		for i := range rotations {
+			rotations[i] = append(slices.Clone(src[i:]), src[:i]...)
+		}
+		slices.SortFunc(rotations, bytes.Compare)
+		out := make([]byte, len(src))
+		for i, r := range rotations {
+			out[i] = r[len(r)-1]
+		}
+		return out
+	}
+
+	var s bwtSorter
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		// Small alphabets make long common prefixes.
+		src := make([]byte, 1+r.Intn(200))
+		alphabet := 1 + r.Intn(4)
+		for j := range src {
+			src[j] = byte('a' + r.Intn(alphabet))
+		}
+		if r.Intn(4) == 0 {
+			src = bytes.Repeat(src[:1+len(src)/8], 8)
+		}
+		got := make([]byte, len(src))
+		origPtr := s.bwt(got, src)
+		if want := naiveBWT(src); !bytes.Equal(got, want) {
+			t.Fatalf("bwt(%q) = %q, want %q", src, got, want)
+		}
+
+		// Undo the transform as the reader does.
+		tt := make([]uint32, len(got))
+		var c [256]uint
+		for j, b := range got {
+			tt[j] = uint32(b)
+			c[b]++
+		}
+		tPos := inverseBWT(tt, uint(origPtr), c[:])
+		var undone []byte
+		for range tt {
+			tPos = tt[tPos]
+			undone = append(undone, byte(tPos))
+			tPos >>= 8
+		}
+		if !bytes.Equal(undone, src) {
+			t.Fatalf("inverse of bwt(%q) = %q", src, undone)
+		}
+	}
+}
+
+func TestHuffmanCodeLengths(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		freqs := make([]int32, 3+r.Intn(maxAlphaSize-2))
+		for j := range freqs {
+			// Exponential frequencies make long codes.
+			freqs[j] = int32(r.ExpFloat64() * float64(int32(1)<<r.Intn(20)))
+		}
+		lengths := make([]uint8, len(freqs))
+		huffmanCodeLengths(lengths, freqs, maxCodeLen)
+
+		// A complete code uses all of the code space.
+		var kraft uint64
+		for _, l := range lengths {
+			if l < 1 || l > maxCodeLen {
+				t.Fatalf("code length %d out of range", l)
+			}
+			kraft += 1 << (maxCodeLen - l)
+		}
+		if kraft != 1<<maxCodeLen {
+			t.Fatalf("incomplete code: lengths %v", lengths)
+		}
+		if _, err := newHuffmanTree(lengths); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMTFEncode(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	enc := newMTFDecoderWithRange(10)
+	dec := newMTFDecoderWithRange(10)
+	for i := 0; i < 1000; i++ {
+		b := byte(r.Intn(10))
+		if got := dec.Decode(enc.Encode(b)); got != b {
+			t.Fatalf("Decode(Encode(%d)) = %d", b, got)
+		}
+	}
+}
+
+func TestBitWriter(t *testing.T) {
+	var buf bytes.Buffer
+	bw := bitWriter{w: &buf}
+	bw.WriteBits(1, 1)
+	bw.WriteBits(1, 0)
+	bw.WriteBits(1, 1)
+	bw.WriteBits(5, 11)
+	bw.WriteBits(32, 0x12345678)
+	bw.WriteBits64(48, bzip2BlockMagic)
+	bw.WriteBit(true)
+	bw.Align()
+	if err := bw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	br := newBitReader(&buf)
+	for _, v := range []struct {
+		nbits uint
+		value uint64
+	}{
+		{1, 1}, {1, 0}, {1, 1}, {5, 11}, {32, 0x12345678}, {48, bzip2BlockMagic}, {1, 1}, {7, 0},
+	} {
+		if got := br.ReadBits64(v.nbits); got != v.value {
+			t.Errorf("ReadBits64(%d) = %#x, want %#x", v.nbits, got, v.value)
+		}
+	}
+	if br.ReadBits(1); br.Err() != io.ErrUnexpectedEOF {
+		t.Errorf("reading past end: got %v, want %v", br.Err(), io.ErrUnexpectedEOF)
+	}
+}
+
+func benchmarkEncode(b *testing.B, compressed []byte) {
+	input := mustDecompress(compressed)
+	w := NewWriter(io.Discard)
+
+	b.SetBytes(int64(len(input)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		w.Reset(io.Discard)
+		w.Write(input)
+		w.Close()
+	}
+}
+
+func BenchmarkEncodeDigits(b *testing.B) { benchmarkEncode(b, digits) }
+func BenchmarkEncodeNewton(b *testing.B) { benchmarkEncode(b, newton) }
+func BenchmarkEncodeRand(b *testing.B)   { benchmarkEncode(b, random) }
@@ -0,0 +1,83 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import "io"
+
+// A Checkpoint records the start of a block in a DEFLATE stream, together
+// with the state needed to resume decompression there. Checkpoints make
+// random access to compressed data possible: a program can save them while
+// decompressing the stream once with [NewCheckpointReader], and later use
+// [ResumeReader] to decompress from any of them.
+type Checkpoint struct {
+	// In is the offset of the block in the compressed input, in bits.
+	// Bit i of the input is bit i%8 of byte i/8, counting from the
+	// least significant bit.
+	In int64
+
+	// Out is the offset of the block in the decompressed output.
+	Out int64
+
+	// Window holds the decompressed data that precedes the block,
+	// up to the 32 kB that the block can refer back to.
+	Window []byte
+}
+
+// NewCheckpointReader is like [NewReader], but calls fn with a
+// [Checkpoint] at the start of each block in the stream.
+// The Window of the Checkpoint is only valid until fn returns;
+// to keep the Checkpoint, fn must copy it.
+//
+// In and Out are counted from the start of the stream. If r does not
+// implement [io.ByteReader], the decompressor may read more data than
+// necessary from r, but In still counts only the data used.
+//
+// The [io.ReadCloser] returned by NewCheckpointReader also implements
+// [Resetter]; after a Reset, it continues to call fn.
+func NewCheckpointReader(r io.Reader, fn func(*Checkpoint)) io.ReadCloser {
+	f := NewReader(r).(*decompressor)
+	f.checkpoint = fn
+	return f
+}
+
+// ResumeReader returns a new ReadCloser that decompresses a DEFLATE
+// stream starting at the block recorded by c. The reader r must be
+// positioned at byte c.In/8 of the stream; the first c.In%8 bits of
+// that byte are skipped.
+//
+// Errors report offsets in the input counted from the start of the
+// stream, as for the reader that saved c.
+//
+// The [io.ReadCloser] returned by ResumeReader also implements [Resetter].
+// Reset starts decompressing a new stream from its beginning.
+func ResumeReader(r io.Reader, c *Checkpoint) io.ReadCloser {
+	f := NewReaderDict(r, c.Window).(*decompressor)
+	f.roffset = c.In / 8
+	f.dict.total = c.Out
+	if f.skip = uint(c.In % 8); f.skip > 0 {
+		f.step = (*decompressor).skipBits
+	}
+	return f
+}
+
+// makeCheckpoint calls f.checkpoint with the current state.
+// The current state is the start of a block.
+func (f *decompressor) makeCheckpoint() {
+	f.cp.In = f.roffset*8 - int64(f.nb)
+	f.cp.Out = f.dict.total + int64(f.dict.availRead())
+	f.cp.Window = f.dict.appendHistory(f.cp.Window[:0])
+	f.checkpoint(&f.cp)
+}
+
+// skipBits discards the bits before the first block
+// for a reader created by ResumeReader.
+func (f *decompressor) skipBits() {
+	if f.err = f.moreBits(); f.err != nil {
+		return
+	}
+	f.b >>= f.skip
+	f.nb -= f.skip
+	f.step = (*decompressor).nextBlock
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestCheckpoint(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, level := range []int{BestSpeed, DefaultCompression, HuffmanOnly} {
+		var buf bytes.Buffer
+		w, _ := NewWriter(&buf, level)
+		w.Write(data)
+		w.Close()
+		compressed := buf.Bytes()
+
+		var cps []Checkpoint
+		r := NewCheckpointReader(bytes.NewReader(compressed), func(c *Checkpoint) {
+			cps = append(cps, Checkpoint{c.In, c.Out, bytes.Clone(c.Window)})
+		})
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("level %d: output mismatch", level)
+		}
+		if len(cps) < 2 {
+			t.Fatalf("level %d: got %d checkpoints", level, len(cps))
+		}
+
+		for i, c := range cps {
+			if c.In < 0 || c.In >= int64(len(compressed))*8 || c.Out < 0 || c.Out > int64(len(data)) {
+				t.Fatalf("level %d: checkpoint %d out of range: In=%d Out=%d", level, i, c.In, c.Out)
+			}
+			if want := data[max(c.Out-32<<10, 0):c.Out]; !bytes.Equal(c.Window, want) {
+				t.Fatalf("level %d: checkpoint %d has wrong window", level, i)
+			}
+			r := ResumeReader(bytes.NewReader(compressed[c.In/8:]), &c)
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("level %d: resuming at checkpoint %d: %v", level, i, err)
+			}
+			if !bytes.Equal(got, data[c.Out:]) {
+				t.Fatalf("level %d: resuming at checkpoint %d: output mismatch", level, i)
+			}
+		}
+	}
+}
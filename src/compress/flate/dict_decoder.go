@@ -31,6 +31,8 @@ type dictDecoder struct {
 	wrPos int  // Current output position in buffer
 	rdPos int  // Have emitted hist[:rdPos] already
 	full  bool // Has a full window length been written yet?
+
+	total int64 // Total bytes emitted by readFlush
 }
 
 // init initializes dictDecoder to have a sliding window dictionary of the given
@@ -168,12 +170,22 @@ func (dd *dictDecoder) tryWriteCopy(dist, length int) int {
 	return dstPos - dstBase
 }
 
+// appendHistory appends the historical data in the dictionary to b,
+// oldest first, and returns the result.
+func (dd *dictDecoder) appendHistory(b []byte) []byte {
+	if dd.full {
+		b = append(b, dd.hist[dd.wrPos:]...)
+	}
+	return append(b, dd.hist[:dd.wrPos]...)
+}
+
 // readFlush returns a slice of the historical buffer that is ready to be
 // emitted to the user. The data returned by readFlush must be fully consumed
 // before calling any other dictDecoder methods.
 func (dd *dictDecoder) readFlush() []byte {
 	toRead := dd.hist[dd.rdPos:dd.wrPos]
 	dd.rdPos = dd.wrPos
+	dd.total += int64(len(toRead))
 	if dd.wrPos == len(dd.hist) {
 		dd.wrPos, dd.rdPos = 0, 0
 		dd.full = true
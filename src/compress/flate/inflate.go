@@ -297,9 +297,17 @@ type decompressor struct {
 	hl, hd    *huffmanDecoder
 	copyLen   int
 	copyDist  int
+
+	// Checkpoints; see NewCheckpointReader and ResumeReader.
+	checkpoint func(*Checkpoint)
+	cp         Checkpoint
+	skip       uint // bits to skip before the first block
 }
 
 func (f *decompressor) nextBlock() {
+	if f.checkpoint != nil {
+		f.makeCheckpoint()
+	}
 	for f.nb < 1+2 {
 		if f.err = f.moreBits(); f.err != nil {
 			return
@@ -785,11 +793,13 @@ func fixedHuffmanDecoderInit() {
 
 func (f *decompressor) Reset(r io.Reader, dict []byte) error {
 	*f = decompressor{
-		rBuf:     f.rBuf,
-		bits:     f.bits,
-		codebits: f.codebits,
-		dict:     f.dict,
-		step:     (*decompressor).nextBlock,
+		rBuf:       f.rBuf,
+		bits:       f.bits,
+		codebits:   f.codebits,
+		dict:       f.dict,
+		step:       (*decompressor).nextBlock,
+		checkpoint: f.checkpoint,
+		cp:         Checkpoint{Window: f.cp.Window},
 	}
 	f.makeReader(r)
 	f.dict.init(maxMatchOffset, dict)
@@ -36,6 +36,14 @@ type Writer struct {
 	digest      uint32 // CRC-32, IEEE polynomial (section 8)
 	size        uint32 // Uncompressed size (section 2.3.1)
 	err         error
+
+	// Concurrent compression, enabled by SetConcurrency.
+	blockSize int
+	blocks    int
+	cur       *block   // block being filled by Write
+	pending   []*block // blocks being compressed, in order
+	free      []*block
+	dict      []byte // end of the previous block's input
 }
 
 // NewWriter returns a new [Writer].
@@ -78,13 +86,17 @@ func (z *Writer) init(w io.Writer, level int) {
 		w:          w,
 		level:      level,
 		compressor: compressor,
+		blockSize:  z.blockSize,
+		blocks:     z.blocks,
+		free:       z.free,
 	}
 }
 
 // Reset discards the [Writer] z's state and makes it equivalent to the
 // result of its original state from [NewWriter] or [NewWriterLevel], but
 // writing to w instead. This permits reusing a [Writer] rather than
-// allocating a new one.
+// allocating a new one. Any concurrency set by [Writer.SetConcurrency]
+// is kept.
 func (z *Writer) Reset(w io.Writer) {
 	z.init(w, z.level)
 }
@@ -187,12 +199,15 @@ func (z *Writer) Write(p []byte) (int, error) {
 				return 0, z.err
 			}
 		}
-		if z.compressor == nil {
+		if z.compressor == nil && z.blocks == 0 {
 			z.compressor, _ = flate.NewWriter(z.w, z.level)
 		}
 	}
 	z.size += uint32(len(p))
 	z.digest = crc32.Update(z.digest, crc32.IEEETable, p)
+	if z.blocks > 0 {
+		return z.writeBlocks(p)
+	}
 	n, z.err = z.compressor.Write(p)
 	return n, z.err
 }
@@ -218,6 +233,10 @@ func (z *Writer) Flush() error {
 			return z.err
 		}
 	}
+	if z.blocks > 0 {
+		z.err = z.flushBlocks(false)
+		return z.err
+	}
 	z.err = z.compressor.Flush()
 	return z.err
 }
@@ -239,7 +258,11 @@ func (z *Writer) Close() error {
 			return z.err
 		}
 	}
-	z.err = z.compressor.Close()
+	if z.blocks > 0 {
+		z.err = z.flushBlocks(true)
+	} else {
+		z.err = z.compressor.Close()
+	}
 	if z.err != nil {
 		return z.err
 	}
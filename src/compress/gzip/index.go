@@ -0,0 +1,332 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// An Index records points in a gzip file at which decompression can
+// start, so that a [SeekReader] can read the file's uncompressed data
+// starting at any offset without decompressing everything before it.
+//
+// An Index is built by [BuildIndex], which decompresses the whole file
+// once. It can be saved with [Index.MarshalBinary] and loaded again with
+// [Index.UnmarshalBinary], so that it need only be built once per file.
+type Index struct {
+	span   int64
+	size   int64
+	points []indexPoint
+}
+
+// An indexPoint is the start of a DEFLATE block.
+type indexPoint struct {
+	in     int64  // offset of the block in the file, in bits
+	out    int64  // offset of the block in the uncompressed data
+	window []byte // the uncompressed data that precedes the block, up to 32 kB
+}
+
+// BuildIndex reads the gzip file from r and returns an [Index] for it.
+// The file may be a concatenation of gzip members, as read by [Reader]
+// in multistream mode, and the checksums of all of them are verified.
+//
+// The index holds a point about every span bytes of uncompressed data.
+// A [SeekReader] decompresses on average span/2 bytes that it discards
+// for each seek, while each point takes up to 32 kB of memory, so a span
+// of about 1 MB is a reasonable tradeoff. Points can only be placed at
+// the start of the DEFLATE blocks in the file, so their spacing depends
+// on how the file was compressed. Files compressed by
+// [Writer.SetConcurrency] or flushed periodically are well suited.
+func BuildIndex(r io.Reader, span int64) (*Index, error) {
+	if span <= 0 {
+		return nil, errors.New("gzip: index span must be positive")
+	}
+	x := &Index{span: span}
+	cr := &countReader{r: bufio.NewReader(r)}
+	var (
+		z       Reader
+		inBase  int64 // offset of the current member's DEFLATE stream
+		outBase int64 // offset of the current member's uncompressed data
+		last    int64 = -1
+	)
+	z.decompressor = flate.NewCheckpointReader(cr, func(c *flate.Checkpoint) {
+		if c.In == 0 {
+			// The first block of a member. The header has just been
+			// read, and the data of earlier members fully returned.
+			inBase = cr.n
+			outBase = x.size
+		}
+		out := outBase + c.Out
+		if last >= 0 && out-last < span {
+			return
+		}
+		last = out
+		x.points = append(x.points, indexPoint{
+			in:     inBase*8 + c.In,
+			out:    out,
+			window: append([]byte(nil), c.Window...),
+		})
+	})
+	if err := z.Reset(cr); err != nil {
+		if err == io.EOF {
+			// An empty file is a valid gzip file with no members.
+			return x, nil
+		}
+		return nil, err
+	}
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := z.Read(buf)
+		x.size += int64(n)
+		if err == io.EOF {
+			return x, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// countReader is a [flate.Reader] that counts the bytes read from it.
+type countReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (c *countReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
+
+// Size returns the size of the uncompressed data in the file.
+func (x *Index) Size() int64 { return x.size }
+
+const indexMagic = "GZIX\x01"
+
+var errIndex = errors.New("gzip: invalid index")
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+func (x *Index) MarshalBinary() ([]byte, error) {
+	b := []byte(indexMagic)
+	b = binary.AppendUvarint(b, uint64(x.span))
+	b = binary.AppendUvarint(b, uint64(x.size))
+	b = binary.AppendUvarint(b, uint64(len(x.points)))
+	var in, out int64
+	for _, p := range x.points {
+		b = binary.AppendUvarint(b, uint64(p.in-in))
+		b = binary.AppendUvarint(b, uint64(p.out-out))
+		b = binary.AppendUvarint(b, uint64(len(p.window)))
+		b = append(b, p.window...)
+		in, out = p.in, p.out
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It replaces the contents of x with an index saved by [Index.MarshalBinary].
+func (x *Index) UnmarshalBinary(data []byte) error {
+	if len(data) < len(indexMagic) || string(data[:len(indexMagic)]) != indexMagic {
+		return errIndex
+	}
+	b := data[len(indexMagic):]
+	next := func() int64 {
+		v, n := binary.Uvarint(b)
+		if n <= 0 || v > math.MaxInt64 {
+			b = nil
+			return -1
+		}
+		b = b[n:]
+		return int64(v)
+	}
+	span, size, count := next(), next(), next()
+	if span <= 0 || size < 0 || count < 0 || count > int64(len(b)) {
+		return errIndex
+	}
+	points := make([]indexPoint, count)
+	var in, out int64
+	for i := range points {
+		din, dout, wlen := next(), next(), next()
+		if din < 0 || dout < 0 || wlen < 0 || wlen > int64(len(b)) || wlen > dictSize {
+			return errIndex
+		}
+		if (i == 0) != (dout == 0) || in > math.MaxInt64-din || out > size-dout {
+			return errIndex
+		}
+		in, out = in+din, out+dout
+		points[i] = indexPoint{in: in, out: out, window: append([]byte(nil), b[:wlen]...)}
+		b = b[wlen:]
+	}
+	if len(b) != 0 || size > 0 && count == 0 {
+		return errIndex
+	}
+	x.span, x.size, x.points = span, size, points
+	return nil
+}
+
+// A SeekReader reads the uncompressed data of a gzip file,
+// using an [Index] of the file to start decompressing near the
+// offset of each read.
+//
+// A SeekReader does not verify the checksum of the gzip member in which
+// it starts decompressing, only those of the members after it. The index
+// must have been built from the same file; if the file has changed, the
+// output is undefined.
+type SeekReader struct {
+	ra  io.ReaderAt
+	x   *Index
+	c   cursor // for Read and Seek
+	off int64  // the offset for the next Read
+}
+
+// NewSeekReader returns a [SeekReader] that reads the gzip file r
+// with the help of its index x.
+func NewSeekReader(r io.ReaderAt, x *Index) *SeekReader {
+	return &SeekReader{ra: r, x: x, c: cursor{ra: r, x: x}}
+}
+
+// Size returns the size of the uncompressed data in the file.
+func (s *SeekReader) Size() int64 { return s.x.size }
+
+// Read implements [io.Reader].
+func (s *SeekReader) Read(p []byte) (int, error) {
+	if s.off >= s.x.size {
+		return 0, io.EOF
+	}
+	if err := s.c.seek(s.off); err != nil {
+		return 0, err
+	}
+	n, err := s.c.Read(p)
+	s.off += int64(n)
+	return n, err
+}
+
+// Seek implements [io.Seeker].
+func (s *SeekReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.off
+	case io.SeekEnd:
+		offset += s.x.size
+	default:
+		return 0, errors.New("gzip.SeekReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("gzip.SeekReader.Seek: negative position")
+	}
+	s.off = offset
+	return offset, nil
+}
+
+// ReadAt implements [io.ReaderAt]. It does not affect the offset
+// used by Read and Seek, and it may be called concurrently with
+// other calls to ReadAt.
+func (s *SeekReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("gzip.SeekReader.ReadAt: negative offset")
+	}
+	if off >= s.x.size {
+		return 0, io.EOF
+	}
+	c := cursor{ra: s.ra, x: s.x}
+	if err := c.seek(off); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(&c, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// A cursor decompresses a gzip file starting at a point of its index.
+type cursor struct {
+	ra  io.ReaderAt
+	x   *Index
+	pos int64 // the offset of the next byte from Read
+	br  *bufio.Reader
+	fr  io.ReadCloser // the member being decompressed, until its end
+	z   *Reader       // the members after it
+	err error
+}
+
+// seek positions c to read from offset off. If c is already positioned
+// before off and no index point lies in between, it decompresses and
+// discards the data up to off; otherwise it starts again from the last
+// index point at or before off.
+func (c *cursor) seek(off int64) error {
+	points := c.x.points
+	i := 0
+	for i < len(points) && points[i].out <= off {
+		i++
+	}
+	// points[i-1] is the last point at or before off.
+	if i == 0 {
+		return errIndex
+	}
+	if c.br == nil || c.err != nil || c.pos > off || points[i-1].out > c.pos {
+		p := &points[i-1]
+		sr := io.NewSectionReader(c.ra, p.in/8, math.MaxInt64-p.in/8)
+		if c.br == nil {
+			c.br = bufio.NewReader(sr)
+		} else {
+			c.br.Reset(sr)
+		}
+		c.fr = flate.ResumeReader(c.br, &flate.Checkpoint{In: p.in, Out: p.out, Window: p.window})
+		c.pos = p.out
+		c.err = nil
+	}
+	if _, err := io.CopyN(io.Discard, c, off-c.pos); err != nil {
+		return noEOF(err)
+	}
+	return nil
+}
+
+// Read implements [io.Reader].
+func (c *cursor) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	var n int
+	for n == 0 && c.err == nil {
+		if c.fr == nil {
+			n, c.err = c.z.Read(p)
+			break
+		}
+		n, c.err = c.fr.Read(p)
+		if c.err == io.EOF {
+			// Skip the checksum and size at the end of the member,
+			// then carry on with the members after it, if any.
+			c.fr = nil
+			c.err = nil
+			if _, err := c.br.Discard(8); err != nil {
+				c.err = noEOF(err)
+				break
+			}
+			if c.z == nil {
+				c.z = new(Reader)
+			}
+			if err := c.z.Reset(c.br); err != nil && err != io.EOF {
+				c.err = err
+			}
+		}
+	}
+	c.pos += int64(n)
+	return n, c.err
+}
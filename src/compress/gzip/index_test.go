@@ -0,0 +1,202 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+// seekableFile returns the newton text twice over, compressed into two
+// gzip members by a concurrent Writer, so that there are many blocks.
+func seekableFile(t testing.TB) (data, file []byte) {
+	newton := readNewton(t)
+	var buf bytes.Buffer
+	for i := 0; i < 2; i++ {
+		w := NewWriter(&buf)
+		if err := w.SetConcurrency(16<<10, 2); err != nil {
+			t.Fatal(err)
+		}
+		w.Write(newton)
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, newton...)
+	}
+	return data, buf.Bytes()
+}
+
+func TestSeekReader(t *testing.T) {
+	data, file := seekableFile(t)
+	x, err := BuildIndex(bytes.NewReader(file), 50<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Size() != int64(len(data)) {
+		t.Fatalf("Size() = %d, want %d", x.Size(), len(data))
+	}
+	if n := len(x.points); n < len(data)/(100<<10) {
+		t.Errorf("index has %d points", n)
+	}
+
+	// Round trip the index, and use the copy.
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x = new(Index)
+	if err := x.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewSeekReader(bytes.NewReader(file), x)
+	got, err := io.ReadAll(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("ReadAll mismatch")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		off := r.Int63n(int64(len(data)))
+		n := r.Intn(100 << 10)
+		want := data[off:min(int(off)+n, len(data))]
+
+		p := make([]byte, n)
+		m, err := s.ReadAt(p, off)
+		if m < n && err != io.EOF || m == n && err != nil && err != io.EOF {
+			t.Fatalf("ReadAt(%d bytes, %d) error: %v", n, off, err)
+		}
+		if !bytes.Equal(p[:m], want) {
+			t.Fatalf("ReadAt(%d bytes, %d) mismatch", n, off)
+		}
+
+		if _, err := s.Seek(off, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		m, err = io.ReadFull(s, p)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			t.Fatalf("Read(%d bytes) at %d: %v", n, off, err)
+		}
+		if !bytes.Equal(p[:m], want) {
+			t.Fatalf("Read(%d bytes) at %d mismatch", n, off)
+		}
+	}
+
+	if off, err := s.Seek(-10, io.SeekEnd); err != nil || off != int64(len(data)-10) {
+		t.Fatalf("Seek(-10, io.SeekEnd) = %d, %v", off, err)
+	}
+	got, err = io.ReadAll(s)
+	if err != nil || !bytes.Equal(got, data[len(data)-10:]) {
+		t.Fatalf("reading the last 10 bytes: got %q, %v", got, err)
+	}
+	if _, err := s.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("Seek(-1, io.SeekStart) succeeded")
+	}
+}
+
+func TestBuildIndexSmall(t *testing.T) {
+	for _, data := range []string{"", "hello world\n"} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.Write([]byte(data))
+		w.Close()
+		x, err := BuildIndex(&buf, 1<<20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(x.points) != 1 || x.Size() != int64(len(data)) {
+			t.Errorf("index for %q: %d points, size %d", data, len(x.points), x.Size())
+		}
+	}
+
+	x, err := BuildIndex(bytes.NewReader(nil), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Size() != 0 {
+		t.Errorf("index of empty file has size %d", x.Size())
+	}
+	got, err := io.ReadAll(NewSeekReader(bytes.NewReader(nil), x))
+	if err != nil || len(got) != 0 {
+		t.Errorf("reading empty file: got %q, %v", got, err)
+	}
+}
+
+func TestBuildIndexErrors(t *testing.T) {
+	if _, err := BuildIndex(bytes.NewReader(nil), 0); err == nil {
+		t.Errorf("BuildIndex with span 0 succeeded")
+	}
+
+	_, file := seekableFile(t)
+	bad := bytes.Clone(file)
+	bad[len(bad)-5]++ // the size of the last member
+	if _, err := BuildIndex(bytes.NewReader(bad), 1<<20); err != ErrChecksum {
+		t.Errorf("BuildIndex with bad checksum: got %v, want %v", err, ErrChecksum)
+	}
+	if _, err := BuildIndex(bytes.NewReader(file[:len(file)/3]), 1<<20); err != io.ErrUnexpectedEOF {
+		t.Errorf("BuildIndex of truncated file: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestIndexUnmarshalErrors(t *testing.T) {
+	_, file := seekableFile(t)
+	x, err := BuildIndex(bytes.NewReader(file), 100<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var y Index
+	for i := 0; i < len(b); i += 1 + i/8 {
+		if err := y.UnmarshalBinary(b[:i]); err == nil {
+			t.Errorf("UnmarshalBinary of %d of %d bytes succeeded", i, len(b))
+		}
+	}
+	if err := y.UnmarshalBinary(append(b, 0)); err == nil {
+		t.Errorf("UnmarshalBinary with trailing data succeeded")
+	}
+}
+
+// TestSeekReaderUnaligned checks seeking to blocks that
+// do not start on a byte boundary.
+func TestSeekReaderUnaligned(t *testing.T) {
+	data := readNewton(t)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write(data)
+	w.Close()
+	x, err := BuildIndex(bytes.NewReader(buf.Bytes()), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unaligned := 0
+	for _, p := range x.points {
+		if p.in%8 != 0 {
+			unaligned++
+		}
+	}
+	if unaligned == 0 {
+		t.Fatalf("no unaligned blocks in %d points", len(x.points))
+	}
+
+	s := NewSeekReader(bytes.NewReader(buf.Bytes()), x)
+	for _, p := range x.points {
+		got := make([]byte, 1000)
+		n, err := s.ReadAt(got, p.out)
+		if err != nil && err != io.EOF {
+			t.Fatalf("ReadAt at %d: %v", p.out, err)
+		}
+		if !bytes.Equal(got[:n], data[p.out:min(p.out+1000, int64(len(data)))]) {
+			t.Fatalf("ReadAt at %d mismatch", p.out)
+		}
+	}
+}
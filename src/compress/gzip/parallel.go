@@ -0,0 +1,168 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+)
+
+// dictSize is the size of the DEFLATE window, the most of the previous
+// block that a block can refer back to.
+const dictSize = 32 << 10
+
+// A block is a piece of the input that is compressed on its own
+// goroutine when compressing concurrently.
+type block struct {
+	in   []byte
+	dict []byte // the input that precedes in
+	last bool   // whether this is the final block of the stream
+	out  bytes.Buffer
+	err  error
+	done chan struct{}
+}
+
+// SetConcurrency makes the [Writer] compress concurrently. The input is
+// split into blocks of blockSize bytes, and up to blocks of them are
+// compressed at once, each on its own goroutine. A good choice is a
+// blockSize of 1 MB and blocks set to [runtime.GOMAXPROCS](0).
+//
+// Each block is compressed with the end of the block before it as a
+// preset dictionary, so that it can refer back to the earlier data,
+// and the compressed blocks are joined into a single DEFLATE stream.
+// The result is a single gzip member that any gzip reader can
+// decompress, although it is usually slightly larger than the output
+// without concurrency. The Writer buffers up to about 2*blocks*blockSize
+// bytes of input and output.
+//
+// SetConcurrency must be called before the first call to Write,
+// Flush, or Close. The setting is kept by [Writer.Reset].
+func (z *Writer) SetConcurrency(blockSize, blocks int) error {
+	if z.wroteHeader {
+		return errors.New("gzip: SetConcurrency called after writing")
+	}
+	if blockSize <= 0 {
+		return errors.New("gzip: block size must be positive")
+	}
+	if blocks <= 0 {
+		return errors.New("gzip: number of blocks must be positive")
+	}
+	z.blockSize = blockSize
+	z.blocks = blocks
+	return nil
+}
+
+// writeBlocks adds p to the current block, starting the compression
+// of each block that it fills.
+func (z *Writer) writeBlocks(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if z.cur == nil {
+			z.cur = z.newBlock()
+		}
+		m := min(len(p), z.blockSize-len(z.cur.in))
+		z.cur.in = append(z.cur.in, p[:m]...)
+		n += m
+		p = p[m:]
+		if len(z.cur.in) == z.blockSize {
+			if z.err = z.startBlock(false); z.err != nil {
+				return n, z.err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flushBlocks starts the compression of the current block, if any,
+// and writes the output of all blocks. If last is set, it ends the
+// DEFLATE stream with a final block, even if it is empty.
+func (z *Writer) flushBlocks(last bool) error {
+	if last && z.cur == nil {
+		z.cur = z.newBlock()
+	}
+	if z.cur != nil {
+		if err := z.startBlock(last); err != nil {
+			return err
+		}
+	}
+	for len(z.pending) > 0 {
+		if err := z.writeBlock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (z *Writer) newBlock() *block {
+	if n := len(z.free); n > 0 {
+		b := z.free[n-1]
+		z.free = z.free[:n-1]
+		return b
+	}
+	return &block{in: make([]byte, 0, z.blockSize)}
+}
+
+// startBlock starts compressing the current block on a new goroutine,
+// first waiting for the oldest block to be done if there are already
+// z.blocks in progress.
+func (z *Writer) startBlock(last bool) error {
+	if len(z.pending) == z.blocks {
+		if err := z.writeBlock(); err != nil {
+			return err
+		}
+	}
+
+	b := z.cur
+	z.cur = nil
+	b.last = last
+	b.out.Reset()
+	b.err = nil
+	b.done = make(chan struct{})
+	// The previous block may still be in use, so b gets its own
+	// copy of the dictionary.
+	b.dict = append(b.dict[:0], z.dict...)
+	d := append(z.dict, b.in[max(len(b.in)-dictSize, 0):]...)
+	z.dict = append(z.dict[:0], d[max(len(d)-dictSize, 0):]...)
+	z.pending = append(z.pending, b)
+
+	go func(level int) {
+		defer close(b.done)
+		fw, err := flate.NewWriterDict(&b.out, level, b.dict)
+		if err != nil {
+			b.err = err
+			return
+		}
+		if _, err := fw.Write(b.in); err != nil {
+			b.err = err
+			return
+		}
+		// Flushing ends the block's output on a byte boundary, without
+		// ending the DEFLATE stream, so that the next block can follow.
+		if b.last {
+			b.err = fw.Close()
+		} else {
+			b.err = fw.Flush()
+		}
+	}(z.level)
+	return nil
+}
+
+// writeBlock waits for the oldest block being compressed and
+// writes its output.
+func (z *Writer) writeBlock() error {
+	b := z.pending[0]
+	<-b.done
+	z.pending = z.pending[1:]
+	if b.err != nil {
+		return b.err
+	}
+	if _, err := z.w.Write(b.out.Bytes()); err != nil {
+		return err
+	}
+	b.in = b.in[:0]
+	z.free = append(z.free, b)
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func readNewton(t testing.TB) []byte {
+	data, err := os.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestConcurrentRoundTrip(t *testing.T) {
+	newton := readNewton(t)
+	random := make([]byte, 100<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	inputs := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"hello", []byte("hello world\n")},
+		{"newton", newton},
+		{"random", random},
+	}
+	for _, in := range inputs {
+		for _, level := range []int{NoCompression, HuffmanOnly, BestSpeed, DefaultCompression, BestCompression} {
+			for _, blockSize := range []int{1000, 64 << 10, 1 << 20} {
+				for _, blocks := range []int{1, 4} {
+					name := fmt.Sprintf("%s/level=%d/blockSize=%d/blocks=%d", in.name, level, blockSize, blocks)
+					t.Run(name, func(t *testing.T) {
+						var buf bytes.Buffer
+						w, err := NewWriterLevel(&buf, level)
+						if err != nil {
+							t.Fatal(err)
+						}
+						w.Name = "name"
+						if err := w.SetConcurrency(blockSize, blocks); err != nil {
+							t.Fatal(err)
+						}
+						// Write in odd-sized pieces, so that the writes
+						// don't line up with the blocks.
+						for p := in.data; len(p) > 0; {
+							n := min(len(p), 7777)
+							if _, err := w.Write(p[:n]); err != nil {
+								t.Fatalf("Write: %v", err)
+							}
+							p = p[n:]
+						}
+						if err := w.Close(); err != nil {
+							t.Fatalf("Close: %v", err)
+						}
+
+						// The output must be a single gzip member.
+						r, err := NewReader(&buf)
+						if err != nil {
+							t.Fatal(err)
+						}
+						r.Multistream(false)
+						got, err := io.ReadAll(r)
+						if err != nil {
+							t.Fatalf("ReadAll: %v", err)
+						}
+						if !bytes.Equal(got, in.data) {
+							t.Fatalf("got %d bytes, want %d", len(got), len(in.data))
+						}
+						if r.Name != "name" {
+							t.Errorf("name is %q, want %q", r.Name, "name")
+						}
+						if buf.Len() != 0 {
+							t.Errorf("%d bytes after the gzip member", buf.Len())
+						}
+					})
+				}
+			}
+		}
+	}
+}
+
+// TestConcurrentDictionary checks that blocks refer back
+// to the data in the previous block.
+func TestConcurrentDictionary(t *testing.T) {
+	newton := readNewton(t)
+	chunk := newton[:16<<10]
+	data := bytes.Repeat(chunk, 64)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.SetConcurrency(len(chunk), 8); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data)
+	w.Close()
+	// Each block after the first is a copy of the block before it,
+	// so it compresses to almost nothing.
+	if max := len(chunk); buf.Len() > max {
+		t.Errorf("compressed %d bytes to %d, want at most %d", len(data), buf.Len(), max)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("output mismatch")
+	}
+}
+
+func TestConcurrentFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.SetConcurrency(1<<10, 2); err != nil {
+		t.Fatal(err)
+	}
+	r := (*Reader)(nil)
+	for i := 0; i < 10; i++ {
+		msg := fmt.Sprintf("message %d\n", i)
+		w.Write([]byte(msg))
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if r == nil {
+			var err error
+			if r, err = NewReader(&buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+		got := make([]byte, len(msg))
+		if _, err := io.ReadFull(r, got); err != nil {
+			t.Fatalf("reading flushed data: %v", err)
+		}
+		if string(got) != msg {
+			t.Fatalf("got %q, want %q", got, msg)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentReset(t *testing.T) {
+	data := readNewton(t)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	if err := w.SetConcurrency(64<<10, 4); err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(data)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output differs after Reset")
+	}
+}
+
+func TestConcurrentErrors(t *testing.T) {
+	w := NewWriter(io.Discard)
+	if err := w.SetConcurrency(0, 1); err == nil {
+		t.Errorf("SetConcurrency(0, 1) succeeded")
+	}
+	if err := w.SetConcurrency(1<<20, 0); err == nil {
+		t.Errorf("SetConcurrency(1<<20, 0) succeeded")
+	}
+	w.Write([]byte("hello"))
+	if err := w.SetConcurrency(1<<20, 1); err == nil {
+		t.Errorf("SetConcurrency after Write succeeded")
+	}
+
+	w = NewWriter(&limitedWriter{100})
+	if err := w.SetConcurrency(1<<10, 2); err != nil {
+		t.Fatal(err)
+	}
+	random := make([]byte, 100<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+	_, err := w.Write(random)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != io.ErrShortWrite {
+		t.Errorf("got error %v, want %v", err, io.ErrShortWrite)
+	}
+}
+
+func BenchmarkConcurrentWriter(b *testing.B) {
+	data := bytes.Repeat(readNewton(b), 8)
+	for _, blocks := range []int{1, 4, 16} {
+		b.Run(fmt.Sprint(blocks), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			w := NewWriter(io.Discard)
+			if err := w.SetConcurrency(1<<20, blocks); err != nil {
+				b.Fatal(err)
+			}
+			for i := 0; i < b.N; i++ {
+				w.Reset(io.Discard)
+				w.Write(data)
+				w.Close()
+			}
+		})
+	}
+}
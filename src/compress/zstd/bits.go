@@ -0,0 +1,57 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// bitWriter writes a little-endian bit stream: the first bit written
+// is the least significant bit of the first byte.
+//
+// The FSE table descriptions are read going forward from the start
+// of such a stream. The FSE and Huffman coded streams are read in
+// reverse, starting from a marker bit added by close, so the values
+// written last are read first. RFC 4.1.
+type bitWriter struct {
+	out   []byte // completed bytes
+	bits  uint64 // pending bits, low bit first
+	nbits uint   // number of valid bits in bits
+}
+
+// reset discards the stream, retaining out as the buffer
+// to which complete bytes are appended.
+func (bw *bitWriter) reset(out []byte) {
+	bw.out = out
+	bw.bits = 0
+	bw.nbits = 0
+}
+
+// addBits writes the low n bits of v. n must be at most 32.
+func (bw *bitWriter) addBits(v uint64, n uint) {
+	bw.bits |= (v & (1<<n - 1)) << bw.nbits
+	bw.nbits += n
+	if bw.nbits >= 32 {
+		bw.out = append(bw.out, byte(bw.bits), byte(bw.bits>>8), byte(bw.bits>>16), byte(bw.bits>>24))
+		bw.bits >>= 32
+		bw.nbits -= 32
+	}
+}
+
+// flush writes out any pending bits, padding the last byte with zeros.
+func (bw *bitWriter) flush() {
+	for bw.nbits > 0 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits >>= 8
+		if bw.nbits < 8 {
+			bw.nbits = 0
+		} else {
+			bw.nbits -= 8
+		}
+	}
+}
+
+// close terminates a stream that is read in reverse by adding the
+// marker bit that tells the reader where the stream begins.
+func (bw *bitWriter) close() {
+	bw.addBits(1, 1)
+	bw.flush()
+}
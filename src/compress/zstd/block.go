@@ -0,0 +1,372 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"math"
+	"math/bits"
+)
+
+// seq is a single sequence: a run of literals followed by a match.
+// RFC 3.1.1.3.2.
+type seq struct {
+	litLen   uint32 // number of literals
+	matchLen uint32 // length of the match, at least 3
+	offBase  uint32 // repeated offset code 1 to 3, or offset plus 3
+}
+
+// The literal length and match length codes, with their baselines
+// and number of extra bits. RFC 3.1.1.3.2.1.1.
+var (
+	llBase = [36]uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+		8192, 16384, 32768, 65536,
+	}
+	llBits = [36]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+		13, 14, 15, 16,
+	}
+	mlBase = [53]uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+		4099, 8195, 16387, 32771, 65539,
+	}
+	mlBits = [53]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16,
+	}
+)
+
+// llCodeTable and mlCodeTable map small literal lengths and
+// match lengths to their codes.
+var (
+	llCodeTable [64]uint8
+	mlCodeTable [128]uint8
+)
+
+func init() {
+	code := 0
+	for v := range llCodeTable {
+		for code+1 < len(llBase) && llBase[code+1] <= uint32(v) {
+			code++
+		}
+		llCodeTable[v] = uint8(code)
+	}
+	code = 0
+	for v := range mlCodeTable {
+		for code+1 < len(mlBase) && mlBase[code+1] <= uint32(v+3) {
+			code++
+		}
+		mlCodeTable[v] = uint8(code)
+	}
+}
+
+// llCode returns the code for a literal length.
+func llCode(litLen uint32) uint8 {
+	if litLen < uint32(len(llCodeTable)) {
+		return llCodeTable[litLen]
+	}
+	return uint8(bits.Len32(litLen)-1) + 19
+}
+
+// mlCode returns the code for a match length.
+func mlCode(matchLen uint32) uint8 {
+	v := matchLen - 3
+	if v < uint32(len(mlCodeTable)) {
+		return mlCodeTable[v]
+	}
+	return uint8(bits.Len32(v)-1) + 36
+}
+
+// ofCode returns the code for an offset value.
+func ofCode(offBase uint32) uint8 {
+	return uint8(bits.Len32(offBase) - 1)
+}
+
+// The predefined distributions for the sequence codes.
+// RFC 3.1.1.3.2.2.
+var (
+	llPredefinedNorm = []int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}
+	ofPredefinedNorm = []int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}
+	mlPredefinedNorm = []int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}
+)
+
+// seqKind describes how to encode one kind of sequence code.
+type seqKind struct {
+	predefNorm    []int16
+	predefLog     uint8
+	maxLog        uint8 // largest accuracy log the decoder accepts
+	predefEncoder fseEncoder
+}
+
+// The kinds of sequence codes, in the order in which
+// their modes and tables are written.
+var seqKinds = [3]seqKind{
+	{predefNorm: llPredefinedNorm, predefLog: 6, maxLog: 9},
+	{predefNorm: ofPredefinedNorm, predefLog: 5, maxLog: 8},
+	{predefNorm: mlPredefinedNorm, predefLog: 6, maxLog: 9},
+}
+
+func init() {
+	for i := range seqKinds {
+		k := &seqKinds[i]
+		k.predefEncoder.build(k.predefNorm, k.predefLog)
+	}
+}
+
+// Symbol compression modes. RFC 3.1.1.3.2.1.1.
+const (
+	modePredefined = 0
+	modeRLE        = 1
+	modeFSE        = 2
+)
+
+// blockEncoder turns the literals and sequences found by the
+// match finder into the content of a compressed block.
+type blockEncoder struct {
+	huff  huffEncoder
+	codes [3][]uint8 // literal length, offset and match codes
+	fse   [3]fseEncoder
+	norm  [maxFSESymbols]int16
+}
+
+// encode appends a compressed block holding lits and seqs to out.
+// RFC 3.1.1.3.
+func (be *blockEncoder) encode(out []byte, lits []byte, seqs []seq) []byte {
+	out = be.appendLiterals(out, lits)
+	return be.appendSequences(out, seqs)
+}
+
+// appendLiterals appends the literals section. RFC 3.1.1.3.1.
+func (be *blockEncoder) appendLiterals(out []byte, lits []byte) []byte {
+	if len(lits) == 0 {
+		return appendLiteralsHeader(out, 0, 0)
+	}
+
+	var counts [256]uint32
+	for _, c := range lits {
+		counts[c]++
+	}
+	if counts[lits[0]] == uint32(len(lits)) {
+		out = appendLiteralsHeader(out, 1, len(lits))
+		return append(out, lits[0])
+	}
+
+	// Huffman coding is only worthwhile for a reasonable amount of
+	// data; the table alone takes several bytes.
+	if len(lits) < 64 || !be.huff.build(&counts) {
+		return appendRawLiterals(out, lits)
+	}
+
+	// Reserve the largest header and move the data down if the
+	// header turns out to be smaller.
+	start := len(out)
+	out = append(out, 0, 0, 0, 0, 0)
+	body := len(out)
+	out, ok := be.huff.appendTable(out)
+	if !ok {
+		return appendRawLiterals(out[:start], lits)
+	}
+	streams := 4
+	if len(lits) <= 256 {
+		streams = 1
+		out = be.huff.appendStream(out, lits)
+	} else if out, ok = be.huff.appendStreams(out, lits); !ok {
+		return appendRawLiterals(out[:start], lits)
+	}
+	compressedSize := len(out) - body
+
+	// Fall back to raw literals unless we saved something.
+	if compressedSize+5 >= len(lits) {
+		return appendRawLiterals(out[:start], lits)
+	}
+
+	var hdr [5]byte
+	var hlen int
+	regen := uint64(len(lits))
+	comp := uint64(compressedSize)
+	switch {
+	case streams == 1 && regen < 1<<10 && comp < 1<<10:
+		v := 2 | 0<<2 | regen<<4 | comp<<14
+		hdr, hlen = [5]byte{byte(v), byte(v >> 8), byte(v >> 16)}, 3
+	case regen < 1<<10 && comp < 1<<10:
+		v := 2 | 1<<2 | regen<<4 | comp<<14
+		hdr, hlen = [5]byte{byte(v), byte(v >> 8), byte(v >> 16)}, 3
+	case streams == 4 && regen < 1<<14 && comp < 1<<14:
+		v := 2 | 2<<2 | regen<<4 | comp<<18
+		hdr, hlen = [5]byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)}, 4
+	case streams == 4 && regen < 1<<18 && comp < 1<<18:
+		v := 2 | 3<<2 | regen<<4 | comp<<22
+		hdr, hlen = [5]byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24), byte(v >> 32)}, 5
+	default:
+		return appendRawLiterals(out[:start], lits)
+	}
+	copy(out[start:], hdr[:hlen])
+	n := copy(out[start+hlen:], out[body:])
+	return out[:start+hlen+n]
+}
+
+// appendLiteralsHeader appends the header of a raw or
+// RLE literals section. RFC 3.1.1.3.1.1.
+func appendLiteralsHeader(out []byte, typ byte, size int) []byte {
+	switch {
+	case size < 1<<5:
+		return append(out, typ|byte(size)<<3)
+	case size < 1<<12:
+		return append(out, typ|1<<2|byte(size)<<4, byte(size>>4))
+	default:
+		return append(out, typ|3<<2|byte(size)<<4, byte(size>>4), byte(size>>12))
+	}
+}
+
+// appendRawLiterals appends a raw literals section.
+func appendRawLiterals(out []byte, lits []byte) []byte {
+	out = appendLiteralsHeader(out, 0, len(lits))
+	return append(out, lits...)
+}
+
+// appendSequences appends the sequences section. RFC 3.1.1.3.2.
+func (be *blockEncoder) appendSequences(out []byte, seqs []seq) []byte {
+	n := len(seqs)
+	switch {
+	case n < 128:
+		out = append(out, byte(n))
+	case n < 0x7f00:
+		out = append(out, byte(n>>8)+128, byte(n))
+	default:
+		out = append(out, 255, byte(n-0x7f00), byte((n-0x7f00)>>8))
+	}
+	if n == 0 {
+		return out
+	}
+
+	for i := range be.codes {
+		be.codes[i] = be.codes[i][:0]
+	}
+	for _, s := range seqs {
+		be.codes[0] = append(be.codes[0], llCode(s.litLen))
+		be.codes[1] = append(be.codes[1], ofCode(s.offBase))
+		be.codes[2] = append(be.codes[2], mlCode(s.matchLen))
+	}
+
+	modesOff := len(out)
+	out = append(out, 0)
+	var encs [3]*fseEncoder
+	var modes [3]byte
+	for i := range seqKinds {
+		out, modes[i], encs[i] = be.chooseTable(out, i, be.codes[i])
+	}
+	out[modesOff] = modes[0]<<6 | modes[1]<<4 | modes[2]<<2
+
+	// The sequences are written last to first, so that
+	// the decoder reads them first to last.
+	// RFC 3.1.1.3.2.2.
+	var bw bitWriter
+	bw.reset(out)
+	ll, of, ml := encs[0], encs[1], encs[2]
+	llc, ofc, mlc := be.codes[0], be.codes[1], be.codes[2]
+	last := n - 1
+	var llState, ofState, mlState uint32
+	if ml != nil {
+		mlState = ml.initState(mlc[last])
+	}
+	if of != nil {
+		ofState = of.initState(ofc[last])
+	}
+	if ll != nil {
+		llState = ll.initState(llc[last])
+	}
+	be.addExtraBits(&bw, seqs[last], llc[last], mlc[last], ofc[last])
+	for i := last - 1; i >= 0; i-- {
+		if of != nil {
+			ofState = of.encode(&bw, ofState, ofc[i])
+		}
+		if ml != nil {
+			mlState = ml.encode(&bw, mlState, mlc[i])
+		}
+		if ll != nil {
+			llState = ll.encode(&bw, llState, llc[i])
+		}
+		be.addExtraBits(&bw, seqs[i], llc[i], mlc[i], ofc[i])
+	}
+	if ml != nil {
+		ml.flushState(&bw, mlState)
+	}
+	if of != nil {
+		of.flushState(&bw, ofState)
+	}
+	if ll != nil {
+		ll.flushState(&bw, llState)
+	}
+	bw.close()
+	return bw.out
+}
+
+// addExtraBits writes the bits that are added to the baselines of
+// the codes of s. The decoder reads the offset bits first.
+func (be *blockEncoder) addExtraBits(bw *bitWriter, s seq, llc, mlc, ofc uint8) {
+	bw.addBits(uint64(s.litLen-llBase[llc]), uint(llBits[llc]))
+	bw.addBits(uint64(s.matchLen-mlBase[mlc]), uint(mlBits[mlc]))
+	bw.addBits(uint64(s.offBase), uint(ofc))
+}
+
+// chooseTable picks the cheapest way to encode the codes of the
+// given kind, appending a table description if needed.
+// It returns the mode and the encoder to use, which is nil if the
+// codes do not require any bits.
+func (be *blockEncoder) chooseTable(out []byte, kind int, codes []uint8) ([]byte, byte, *fseEncoder) {
+	k := &seqKinds[kind]
+
+	var counts [maxFSESymbols]uint32
+	maxSym := 0
+	for _, c := range codes {
+		counts[c]++
+		maxSym = max(maxSym, int(c))
+	}
+	if counts[codes[0]] == uint32(len(codes)) {
+		return append(out, codes[0]), modeRLE, nil
+	}
+
+	predefCost := fseCost(counts[:maxSym+1], k.predefNorm, k.predefLog)
+	if len(codes) < 16 && predefCost != math.MaxInt {
+		return out, modePredefined, &k.predefEncoder
+	}
+
+	tableLog := optimalTableLog(k.maxLog, len(codes), maxSym)
+	norm := be.norm[:maxSym+1]
+	if normalizeCounts(norm, counts[:maxSym+1], len(codes), tableLog, 1<<tableLog) {
+		var bw bitWriter
+		start := len(out)
+		bw.reset(out)
+		writeNCount(&bw, norm, tableLog)
+		tableCost := (len(bw.out) - start) * 8
+		if cost := fseCost(counts[:maxSym+1], norm, tableLog); cost+tableCost < predefCost {
+			be.fse[kind].build(norm, tableLog)
+			return bw.out, modeFSE, &be.fse[kind]
+		}
+		out = bw.out[:start]
+	}
+	if predefCost == math.MaxInt {
+		panic("zstd: sequence code cannot be encoded")
+	}
+	return out, modePredefined, &k.predefEncoder
+}
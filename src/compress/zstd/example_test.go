@@ -0,0 +1,68 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd_test
+
+import (
+	"bytes"
+	"compress/zstd"
+	"io"
+	"log"
+	"os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriterLevel(&buf, zstd.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := zw.Write([]byte("A long time ago in a galaxy far, far away...\n")); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr := zstd.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
+
+func Example_dictionary() {
+	// A dictionary holds data that is likely to appear in the input.
+	// Raw content works; a dictionary trained by "zstd --train"
+	// works better.
+	dict := []byte("A long time ago in a galaxy far, far away...")
+
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriterLevelDict(&buf, zstd.DefaultCompression, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := zw.Write([]byte("A long time ago in a galaxy far, far away, again...\n")); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// The same dictionary is needed to decompress.
+	zr, err := zstd.NewReaderDict(&buf, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away, again...
+}
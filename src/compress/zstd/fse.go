@@ -0,0 +1,290 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	// maxFSESymbols is the largest alphabet we encode with FSE,
+	// which is that of the match length codes.
+	maxFSESymbols = 53
+
+	// minFSETableLog is the smallest accuracy log that can be
+	// written in an FSE table description. RFC 4.1.1.
+	minFSETableLog = 5
+
+	// maxFSETableLog is the largest accuracy log we use.
+	maxFSETableLog = 9
+)
+
+// fseSymbol holds the values needed to encode one symbol
+// with an FSE table.
+type fseSymbol struct {
+	deltaFindState int32  // added to the shifted state to index stateTable
+	deltaNbBits    uint32 // determines the number of bits to output
+}
+
+// fseEncoder is a table for encoding symbols with FSE.
+// The table is the mirror image of the decoding table built by the
+// decoder for the same normalized distribution. RFC 4.1.
+type fseEncoder struct {
+	tableLog   uint8
+	norm       [maxFSESymbols]int16 // normalized counts
+	symbols    int                  // number of symbols in norm
+	stateTable []uint16
+	symbolTT   [maxFSESymbols]fseSymbol
+}
+
+// build builds the encoding table for a normalized distribution.
+// The entries of norm must sum to 1<<tableLog, with -1 counting as 1.
+func (e *fseEncoder) build(norm []int16, tableLog uint8) {
+	e.tableLog = tableLog
+	e.symbols = copy(e.norm[:], norm)
+
+	tableSize := 1 << tableLog
+	if cap(e.stateTable) < tableSize {
+		e.stateTable = make([]uint16, tableSize)
+	}
+	e.stateTable = e.stateTable[:tableSize]
+
+	// Spread the symbols over the table exactly as the decoder does.
+	var tableSymbol [1 << maxFSETableLog]uint8
+	var cumul [maxFSESymbols + 1]int
+	highThreshold := tableSize - 1
+	for s, n := range norm {
+		if n == -1 {
+			cumul[s+1] = cumul[s] + 1
+			tableSymbol[highThreshold] = uint8(s)
+			highThreshold--
+		} else {
+			cumul[s+1] = cumul[s] + int(n)
+		}
+	}
+
+	pos := 0
+	step := (tableSize >> 1) + (tableSize >> 3) + 3
+	mask := tableSize - 1
+	for s, n := range norm {
+		for i := 0; i < int(n); i++ {
+			tableSymbol[pos] = uint8(s)
+			pos = (pos + step) & mask
+			for pos > highThreshold {
+				pos = (pos + step) & mask
+			}
+		}
+	}
+
+	// Each symbol gets a run of states, in the order
+	// in which the decoder assigns them.
+	for u := 0; u < tableSize; u++ {
+		s := tableSymbol[u]
+		e.stateTable[cumul[s]] = uint16(tableSize + u)
+		cumul[s]++
+	}
+
+	total := int32(0)
+	for s, n := range norm {
+		switch n {
+		case 0:
+			e.symbolTT[s] = fseSymbol{
+				deltaNbBits: uint32(tableLog+1)<<16 - uint32(tableSize),
+			}
+		case -1, 1:
+			e.symbolTT[s] = fseSymbol{
+				deltaFindState: total - 1,
+				deltaNbBits:    uint32(tableLog)<<16 - uint32(tableSize),
+			}
+			total++
+		default:
+			maxBitsOut := uint32(tableLog) - uint32(bits.Len32(uint32(n-1))-1)
+			minStatePlus := uint32(n) << maxBitsOut
+			e.symbolTT[s] = fseSymbol{
+				deltaFindState: total - int32(n),
+				deltaNbBits:    maxBitsOut<<16 - minStatePlus,
+			}
+			total += int32(n)
+		}
+	}
+}
+
+// initState returns the starting state for encoding
+// a stream whose final symbol is s.
+func (e *fseEncoder) initState(s uint8) uint32 {
+	st := &e.symbolTT[s]
+	nbBitsOut := (st.deltaNbBits + 1<<15) >> 16
+	value := nbBitsOut<<16 - st.deltaNbBits
+	return uint32(e.stateTable[int32(value>>nbBitsOut)+st.deltaFindState])
+}
+
+// encode writes the bits needed to move from state to
+// a state that encodes s, and returns the new state.
+func (e *fseEncoder) encode(bw *bitWriter, state uint32, s uint8) uint32 {
+	st := &e.symbolTT[s]
+	nbBitsOut := (state + st.deltaNbBits) >> 16
+	bw.addBits(uint64(state), uint(nbBitsOut))
+	return uint32(e.stateTable[int32(state>>nbBitsOut)+st.deltaFindState])
+}
+
+// flushState writes the final state, which the decoder reads first.
+func (e *fseEncoder) flushState(bw *bitWriter, state uint32) {
+	bw.addBits(uint64(state), uint(e.tableLog))
+}
+
+// optimalTableLog returns the accuracy log to use to encode
+// total symbols whose largest value is maxSym.
+func optimalTableLog(maxLog uint8, total int, maxSym int) uint8 {
+	tableLog := int(maxLog)
+	maxBitsSrc := bits.Len32(uint32(total-1)) - 3
+	minBitsSrc := bits.Len32(uint32(total))
+	minBitsSymbols := bits.Len32(uint32(maxSym)) + 1
+	minBits := min(minBitsSrc, minBitsSymbols)
+	if maxBitsSrc < tableLog {
+		tableLog = maxBitsSrc
+	}
+	if minBits > tableLog {
+		tableLog = minBits
+	}
+	return uint8(max(minFSETableLog, min(tableLog, int(maxLog))))
+}
+
+// normalizeCounts converts the symbol counts, which add up to total,
+// into a distribution that adds up to 1<<tableLog and stores it in norm.
+// No entry is made larger than maxNorm.
+// It reports whether that was possible.
+func normalizeCounts(norm []int16, counts []uint32, total int, tableLog uint8, maxNorm int) bool {
+	tableSize := 1 << tableLog
+	lowThreshold := uint32(total >> tableLog)
+	remaining := tableSize
+	largest := -1
+	for s, c := range counts {
+		switch {
+		case c == 0:
+			norm[s] = 0
+		case c <= lowThreshold:
+			norm[s] = -1
+			remaining--
+		default:
+			// Round to the nearest value, but never to 0.
+			p := int((uint64(c)<<tableLog*2 + uint64(total)) / (2 * uint64(total)))
+			p = max(p, 1)
+			norm[s] = int16(p)
+			remaining -= p
+			if largest < 0 || c > counts[largest] {
+				largest = s
+			}
+		}
+	}
+
+	// Rounding can leave the sum slightly off. Adjust the largest
+	// entries, which is where the change costs the least.
+	for remaining != 0 && largest >= 0 {
+		if remaining > 0 {
+			norm[largest]++
+			remaining--
+			continue
+		}
+		big := -1
+		for s, n := range norm {
+			if n > 1 && (big < 0 || n > norm[big]) {
+				big = s
+			}
+		}
+		if big < 0 {
+			return false
+		}
+		norm[big]--
+		remaining++
+	}
+	if remaining != 0 {
+		return false
+	}
+	for _, n := range norm[:len(counts)] {
+		if int(n) > maxNorm {
+			return false
+		}
+	}
+	return true
+}
+
+// writeNCount writes the description of an FSE table.
+// RFC 4.1.1.
+func writeNCount(bw *bitWriter, norm []int16, tableLog uint8) {
+	tableSize := 1 << tableLog
+	bw.addBits(uint64(tableLog-minFSETableLog), 4)
+
+	remaining := tableSize + 1
+	threshold := tableSize
+	nbBits := uint(tableLog) + 1
+	prev0 := false
+	for s := 0; s < len(norm) && remaining > 1; {
+		if prev0 {
+			// Write a run of zero counts as repeat flags.
+			start := s
+			for s < len(norm) && norm[s] == 0 {
+				s++
+			}
+			if s == len(norm) {
+				break
+			}
+			for s >= start+24 {
+				start += 24
+				bw.addBits(0xffff, 16)
+			}
+			for s >= start+3 {
+				start += 3
+				bw.addBits(3, 2)
+			}
+			bw.addBits(uint64(s-start), 2)
+		}
+
+		count := int(norm[s])
+		s++
+		maxSmall := (2*threshold - 1) - remaining
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		count++ // the value written is the count plus one
+		if count >= threshold {
+			count += maxSmall
+		}
+		if count < maxSmall {
+			bw.addBits(uint64(count), nbBits-1)
+		} else {
+			bw.addBits(uint64(count), nbBits)
+		}
+		prev0 = count == 1
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+	}
+	bw.flush()
+}
+
+// fseCost estimates the number of bits needed to encode symbols
+// with the given counts using the normalized distribution norm.
+// It returns math.MaxInt if a symbol cannot be encoded.
+func fseCost(counts []uint32, norm []int16, tableLog uint8) int {
+	cost := 0.0
+	for s, c := range counts {
+		if c == 0 {
+			continue
+		}
+		if s >= len(norm) || norm[s] == 0 {
+			return math.MaxInt
+		}
+		n := float64(norm[s])
+		if n < 0 {
+			n = 1
+		}
+		cost += float64(c) * (float64(tableLog) - math.Log2(n))
+	}
+	return int(cost)
+}
@@ -0,0 +1,320 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// maxHuffmanBits is the longest Huffman code permitted for literals.
+// RFC 4.2.1.
+const maxHuffmanBits = 11
+
+// huffEncoder is a Huffman code for literal bytes.
+type huffEncoder struct {
+	codes     [256]uint16 // code for each symbol
+	lens      [256]uint8  // code length for each symbol, 0 if unused
+	tableBits uint8       // length of the longest code
+	maxSym    int         // largest symbol with a code
+
+	// Scratch space for building the code.
+	nodes  []huffNode
+	fseEnc fseEncoder
+}
+
+// huffNode is a node in the tree used to compute code lengths.
+type huffNode struct {
+	count  uint32
+	parent int16
+	sym    int16 // -1 for internal nodes
+}
+
+// build computes a length-limited Huffman code for literals with the
+// given counts. It reports false if there are fewer than two symbols.
+func (h *huffEncoder) build(counts *[256]uint32) bool {
+	h.nodes = h.nodes[:0]
+	for s, c := range counts {
+		if c > 0 {
+			h.nodes = append(h.nodes, huffNode{count: c, parent: -1, sym: int16(s)})
+		}
+	}
+	leaves := len(h.nodes)
+	if leaves < 2 {
+		return false
+	}
+	slices.SortFunc(h.nodes, func(a, b huffNode) int {
+		if a.count != b.count {
+			if a.count < b.count {
+				return -1
+			}
+			return 1
+		}
+		return int(a.sym) - int(b.sym)
+	})
+
+	// Combine the two lightest nodes until one remains, taking them
+	// from the sorted leaves or from the internal nodes, which are
+	// created in nondecreasing order of count.
+	leaf, inner := 0, leaves
+	pick := func() int {
+		if leaf < leaves && (inner >= len(h.nodes) || h.nodes[leaf].count <= h.nodes[inner].count) {
+			leaf++
+			return leaf - 1
+		}
+		inner++
+		return inner - 1
+	}
+	for i := 0; i < leaves-1; i++ {
+		a := pick()
+		b := pick()
+		h.nodes = append(h.nodes, huffNode{
+			count:  h.nodes[a].count + h.nodes[b].count,
+			parent: -1,
+			sym:    -1,
+		})
+		h.nodes[a].parent = int16(len(h.nodes) - 1)
+		h.nodes[b].parent = int16(len(h.nodes) - 1)
+	}
+
+	// Compute depths from the root down.
+	depth := make([]uint8, len(h.nodes))
+	for i := len(h.nodes) - 2; i >= 0; i-- {
+		depth[i] = depth[h.nodes[i].parent] + 1
+	}
+
+	h.lens = [256]uint8{}
+	for i := 0; i < leaves; i++ {
+		h.lens[h.nodes[i].sym] = depth[i]
+	}
+	h.limitLengths(leaves)
+	h.assignCodes()
+	return true
+}
+
+// limitLengths adjusts the code lengths so that none is longer than
+// maxHuffmanBits while keeping the code complete.
+func (h *huffEncoder) limitLengths(leaves int) {
+	const one = 1 << maxHuffmanBits
+
+	// kraft is the Kraft sum scaled by 1<<maxHuffmanBits.
+	kraft := 0
+	for s, l := range h.lens {
+		if l > maxHuffmanBits {
+			h.lens[s] = maxHuffmanBits
+		}
+		if h.lens[s] > 0 {
+			kraft += one >> h.lens[s]
+		}
+	}
+	if kraft == one {
+		return
+	}
+
+	// The nodes are sorted by count, so walking them in order
+	// visits the least frequent symbols first.
+	syms := make([]int16, leaves)
+	for i := range syms {
+		syms[i] = h.nodes[i].sym
+	}
+
+	// Lengthen the codes of rare symbols until the code is valid.
+	for kraft > one {
+		for _, s := range syms {
+			if l := h.lens[s]; l < maxHuffmanBits {
+				h.lens[s]++
+				kraft -= one >> (l + 1)
+				if kraft <= one {
+					break
+				}
+			}
+		}
+	}
+
+	// Then shorten the codes of frequent symbols while
+	// that fills the code space without overfilling it.
+	for kraft < one {
+		for i := len(syms) - 1; i >= 0 && kraft < one; i-- {
+			s := syms[i]
+			if l := h.lens[s]; l > 1 && kraft+one>>l <= one {
+				h.lens[s]--
+				kraft += one >> l
+			}
+		}
+	}
+}
+
+// assignCodes assigns codes in the order used by the decoder:
+// the longest codes first, and in symbol order for each length.
+// RFC 4.2.1.3.
+func (h *huffEncoder) assignCodes() {
+	h.tableBits = 0
+	h.maxSym = 0
+	for s, l := range h.lens {
+		if l > 0 {
+			h.tableBits = max(h.tableBits, l)
+			h.maxSym = s
+		}
+	}
+
+	var count [maxHuffmanBits + 2]uint32
+	for _, l := range h.lens {
+		if l > 0 {
+			count[h.tableBits+1-l]++
+		}
+	}
+	var start [maxHuffmanBits + 2]uint32
+	next := uint32(0)
+	for w := 1; w <= int(h.tableBits); w++ {
+		start[w] = next
+		next += count[w] << (w - 1)
+	}
+	for s, l := range h.lens {
+		if l == 0 {
+			continue
+		}
+		w := h.tableBits + 1 - l
+		h.codes[s] = uint16(start[w] >> (w - 1))
+		start[w] += 1 << (w - 1)
+	}
+}
+
+// weight returns the weight of the symbol s. RFC 4.2.1.
+func (h *huffEncoder) weight(s int) uint8 {
+	if h.lens[s] == 0 {
+		return 0
+	}
+	return h.tableBits + 1 - h.lens[s]
+}
+
+// appendTable appends the Huffman tree description. It reports false
+// if the table cannot be represented. RFC 4.2.1.1.
+func (h *huffEncoder) appendTable(out []byte) ([]byte, bool) {
+	// The weight of the last symbol is implied.
+	var weights [256]uint8
+	n := h.maxSym
+	for s := 0; s < n; s++ {
+		weights[s] = h.weight(s)
+	}
+
+	if b, ok := h.appendFSEWeights(out, weights[:n]); ok {
+		return b, true
+	}
+	if n > 128 {
+		return out, false
+	}
+
+	// Store the weights directly, 4 bits each.
+	out = append(out, byte(127+n))
+	for i := 0; i < n; i += 2 {
+		out = append(out, weights[i]<<4|weights[i+1])
+	}
+	return out, true
+}
+
+// appendFSEWeights appends the weights compressed with FSE.
+// It reports false if that would not be useful.
+// RFC 4.2.1.2.
+func (h *huffEncoder) appendFSEWeights(out []byte, weights []uint8) ([]byte, bool) {
+	const maxWeightTableLog = 6
+
+	if len(weights) < 2 {
+		return out, false
+	}
+	var counts [maxHuffmanBits + 1]uint32
+	maxW := 0
+	for _, w := range weights {
+		counts[w]++
+		maxW = max(maxW, int(w))
+	}
+	maxCount := slices.Max(counts[:])
+	if maxCount == 1 || int(maxCount) == len(weights) {
+		return out, false
+	}
+
+	tableLog := optimalTableLog(maxWeightTableLog, len(weights), maxW)
+	var norm [maxHuffmanBits + 1]int16
+	// A state that needs no bits makes the end of the weights
+	// ambiguous to the decoder, so keep all probabilities
+	// at most one half.
+	if !normalizeCounts(norm[:maxW+1], counts[:maxW+1], len(weights), tableLog, 1<<(tableLog-1)) {
+		return out, false
+	}
+	enc := &h.fseEnc
+	enc.build(norm[:maxW+1], tableLog)
+
+	start := len(out)
+	out = append(out, 0) // header byte, filled in below
+	var bw bitWriter
+	bw.reset(out)
+	writeNCount(&bw, norm[:maxW+1], tableLog)
+
+	// Two interleaved states, read alternately by the decoder
+	// starting with the first weight.
+	i := len(weights)
+	var state1, state2 uint32
+	if i&1 != 0 {
+		state1 = enc.initState(weights[i-1])
+		state2 = enc.initState(weights[i-2])
+		state1 = enc.encode(&bw, state1, weights[i-3])
+		i -= 3
+	} else {
+		state2 = enc.initState(weights[i-1])
+		state1 = enc.initState(weights[i-2])
+		i -= 2
+	}
+	for i > 0 {
+		state2 = enc.encode(&bw, state2, weights[i-1])
+		state1 = enc.encode(&bw, state1, weights[i-2])
+		i -= 2
+	}
+	enc.flushState(&bw, state2)
+	enc.flushState(&bw, state1)
+	bw.close()
+	out = bw.out
+
+	size := len(out) - start - 1
+	if size >= 128 || size >= (len(weights)+1)/2 {
+		return out[:start], false
+	}
+	out[start] = byte(size)
+	return out, true
+}
+
+// appendStream appends the literals encoded as a single Huffman stream.
+// The decoder reads the stream backward, so we write it in reverse.
+func (h *huffEncoder) appendStream(out []byte, lits []byte) []byte {
+	var bw bitWriter
+	bw.reset(out)
+	for i := len(lits) - 1; i >= 0; i-- {
+		c := lits[i]
+		bw.addBits(uint64(h.codes[c]), uint(h.lens[c]))
+	}
+	bw.close()
+	return bw.out
+}
+
+// appendStreams appends the literals encoded as four Huffman streams,
+// preceded by the jump table. It reports false if a stream is too
+// large for the jump table. RFC 3.1.1.3.1.6.
+func (h *huffEncoder) appendStreams(out []byte, lits []byte) ([]byte, bool) {
+	start := len(out)
+	out = append(out, make([]byte, 6)...)
+	segment := (len(lits) + 3) / 4
+	for i := 0; i < 4; i++ {
+		lo := min(i*segment, len(lits))
+		hi := min(lo+segment, len(lits))
+		streamStart := len(out)
+		out = h.appendStream(out, lits[lo:hi])
+		if i < 3 {
+			size := len(out) - streamStart
+			if size > 0xffff {
+				return out[:start], false
+			}
+			binary.LittleEndian.PutUint16(out[start+2*i:], uint16(size))
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// An Index records the frames of a zstd stream at which decompression
+// can start, so that a [SeekReader] can read the stream's uncompressed
+// data starting at any offset without decompressing everything before it.
+//
+// The frames of a zstd stream are independent of each other, so seeking
+// is only efficient for streams made of many frames. A [Writer] writes
+// a frame each time it is closed; reusing it with [Writer.Reset] on the
+// same output after every megabyte or so of data produces a suitable
+// stream that any zstd reader can decompress.
+//
+// An Index is built by [BuildIndex], which decompresses the whole stream
+// once. It can be saved with [Index.MarshalBinary] and loaded again with
+// [Index.UnmarshalBinary], so that it need only be built once per stream.
+type Index struct {
+	span   int64
+	size   int64
+	points []indexPoint
+}
+
+// An indexPoint is the start of a frame.
+type indexPoint struct {
+	in  int64 // offset of the frame in the stream
+	out int64 // offset of the frame in the uncompressed data
+}
+
+// BuildIndex reads the zstd stream from r and returns an [Index] for it.
+// The checksums of all frames that have them are verified.
+//
+// The index holds a point about every span bytes of uncompressed data,
+// at the start of the first frame after that many bytes. A [SeekReader]
+// decompresses the data that precedes its offset in the frame, and any
+// frames between the point and that frame, and discards it.
+func BuildIndex(r io.Reader, span int64) (*Index, error) {
+	if span <= 0 {
+		return nil, errors.New("zstd: index span must be positive")
+	}
+	x := &Index{span: span}
+	var z Reader
+	last := int64(-1)
+	z.zr.SetFrameHook(func(in int64) {
+		if last >= 0 && x.size-last < span {
+			return
+		}
+		last = x.size
+		x.points = append(x.points, indexPoint{in: in, out: x.size})
+	})
+	z.zr.Reset(bufio.NewReader(r))
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := z.Read(buf)
+		x.size += int64(n)
+		if err == io.EOF {
+			return x, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Size returns the size of the uncompressed data in the stream.
+func (x *Index) Size() int64 { return x.size }
+
+const indexMagic = "ZSIX\x01"
+
+var errIndex = errors.New("zstd: invalid index")
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+func (x *Index) MarshalBinary() ([]byte, error) {
+	b := []byte(indexMagic)
+	b = binary.AppendUvarint(b, uint64(x.span))
+	b = binary.AppendUvarint(b, uint64(x.size))
+	b = binary.AppendUvarint(b, uint64(len(x.points)))
+	var in, out int64
+	for _, p := range x.points {
+		b = binary.AppendUvarint(b, uint64(p.in-in))
+		b = binary.AppendUvarint(b, uint64(p.out-out))
+		in, out = p.in, p.out
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It replaces the contents of x with an index saved by [Index.MarshalBinary].
+func (x *Index) UnmarshalBinary(data []byte) error {
+	if len(data) < len(indexMagic) || string(data[:len(indexMagic)]) != indexMagic {
+		return errIndex
+	}
+	b := data[len(indexMagic):]
+	next := func() int64 {
+		v, n := binary.Uvarint(b)
+		if n <= 0 || v > math.MaxInt64 {
+			b = nil
+			return -1
+		}
+		b = b[n:]
+		return int64(v)
+	}
+	span, size, count := next(), next(), next()
+	if span <= 0 || size < 0 || count <= 0 || count > int64(len(b)) {
+		return errIndex
+	}
+	points := make([]indexPoint, count)
+	var in, out int64
+	for i := range points {
+		din, dout := next(), next()
+		// The first frame starts the data, but not necessarily the
+		// stream, which may start with skippable frames.
+		if din < 0 || dout < 0 || (i > 0 && din == 0) || (i == 0) != (dout == 0) {
+			return errIndex
+		}
+		if in > math.MaxInt64-din || out > size-dout {
+			return errIndex
+		}
+		in, out = in+din, out+dout
+		points[i] = indexPoint{in: in, out: out}
+	}
+	if len(b) != 0 {
+		return errIndex
+	}
+	x.span, x.size, x.points = span, size, points
+	return nil
+}
+
+// A SeekReader reads the uncompressed data of a zstd stream,
+// using an [Index] of the stream to start decompressing at a
+// frame near the offset of each read.
+//
+// The index must have been built from the same stream; if the stream
+// has changed, the output is undefined.
+type SeekReader struct {
+	ra  io.ReaderAt
+	x   *Index
+	c   cursor // for Read and Seek
+	off int64  // the offset for the next Read
+}
+
+// NewSeekReader returns a [SeekReader] that reads the zstd stream r
+// with the help of its index x.
+func NewSeekReader(r io.ReaderAt, x *Index) *SeekReader {
+	return &SeekReader{ra: r, x: x, c: cursor{ra: r, x: x}}
+}
+
+// Size returns the size of the uncompressed data in the stream.
+func (s *SeekReader) Size() int64 { return s.x.size }
+
+// Read implements [io.Reader].
+func (s *SeekReader) Read(p []byte) (int, error) {
+	if s.off >= s.x.size {
+		return 0, io.EOF
+	}
+	if err := s.c.seek(s.off); err != nil {
+		return 0, err
+	}
+	n, err := s.c.Read(p)
+	s.off += int64(n)
+	return n, err
+}
+
+// Seek implements [io.Seeker].
+func (s *SeekReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.off
+	case io.SeekEnd:
+		offset += s.x.size
+	default:
+		return 0, errors.New("zstd.SeekReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("zstd.SeekReader.Seek: negative position")
+	}
+	s.off = offset
+	return offset, nil
+}
+
+// ReadAt implements [io.ReaderAt]. It does not affect the offset
+// used by Read and Seek, and it may be called concurrently with
+// other calls to ReadAt.
+func (s *SeekReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("zstd.SeekReader.ReadAt: negative offset")
+	}
+	if off >= s.x.size {
+		return 0, io.EOF
+	}
+	c := cursor{ra: s.ra, x: s.x}
+	if err := c.seek(off); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(&c, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// A cursor decompresses a zstd stream starting at a point of its index.
+type cursor struct {
+	ra  io.ReaderAt
+	x   *Index
+	pos int64 // the offset of the next byte from Read
+	br  *bufio.Reader
+	z   *Reader
+	err error
+}
+
+// seek positions c to read from offset off. If c is already positioned
+// before off and no index point lies in between, it decompresses and
+// discards the data up to off; otherwise it starts again from the last
+// index point at or before off.
+func (c *cursor) seek(off int64) error {
+	points := c.x.points
+	i := 0
+	for i < len(points) && points[i].out <= off {
+		i++
+	}
+	// points[i-1] is the last point at or before off.
+	if i == 0 {
+		return errIndex
+	}
+	if c.z == nil || c.err != nil || c.pos > off || points[i-1].out > c.pos {
+		p := points[i-1]
+		sr := io.NewSectionReader(c.ra, p.in, math.MaxInt64-p.in)
+		if c.z == nil {
+			c.br = bufio.NewReader(sr)
+			c.z = NewReader(c.br)
+		} else {
+			c.br.Reset(sr)
+			c.z.Reset(c.br)
+		}
+		c.pos = p.out
+		c.err = nil
+	}
+	if _, err := io.CopyN(io.Discard, c, off-c.pos); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
+
+// Read implements [io.Reader].
+func (c *cursor) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	var n int
+	n, c.err = c.z.Read(p)
+	c.pos += int64(n)
+	return n, c.err
+}
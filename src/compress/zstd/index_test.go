@@ -0,0 +1,173 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// seekableStream returns the newton text, compressed into frames of
+// about 20 kB each, with a skippable frame in the middle.
+func seekableStream(t testing.TB) (data, stream []byte) {
+	data = newton(t)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for p := data; len(p) > 0; {
+		n := min(len(p), 20<<10)
+		w.Reset(&buf)
+		w.Write(p[:n])
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		p = p[n:]
+		if len(p) < len(data)/2 && len(p)+n >= len(data)/2 {
+			buf.Write([]byte{0x50, 0x2a, 0x4d, 0x18, 3, 0, 0, 0, 'a', 'b', 'c'})
+		}
+	}
+	return data, buf.Bytes()
+}
+
+func TestSeekReader(t *testing.T) {
+	data, stream := seekableStream(t)
+	x, err := BuildIndex(bytes.NewReader(stream), 50<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Size() != int64(len(data)) {
+		t.Fatalf("Size() = %d, want %d", x.Size(), len(data))
+	}
+	if n, want := len(x.points), (len(data)+60<<10-1)/(60<<10); n != want {
+		t.Errorf("index has %d points, want %d", n, want)
+	}
+
+	// Round trip the index, and use the copy.
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x = new(Index)
+	if err := x.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewSeekReader(bytes.NewReader(stream), x)
+	got, err := io.ReadAll(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("ReadAll mismatch")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		off := r.Int63n(int64(len(data)))
+		n := r.Intn(100 << 10)
+		want := data[off:min(int(off)+n, len(data))]
+
+		p := make([]byte, n)
+		m, err := s.ReadAt(p, off)
+		if m < n && err != io.EOF || m == n && err != nil && err != io.EOF {
+			t.Fatalf("ReadAt(%d bytes, %d) error: %v", n, off, err)
+		}
+		if !bytes.Equal(p[:m], want) {
+			t.Fatalf("ReadAt(%d bytes, %d) mismatch", n, off)
+		}
+
+		if _, err := s.Seek(off, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		m, err = io.ReadFull(s, p)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			t.Fatalf("Read(%d bytes) at %d: %v", n, off, err)
+		}
+		if !bytes.Equal(p[:m], want) {
+			t.Fatalf("Read(%d bytes) at %d mismatch", n, off)
+		}
+	}
+
+	if off, err := s.Seek(-10, io.SeekEnd); err != nil || off != int64(len(data)-10) {
+		t.Fatalf("Seek(-10, io.SeekEnd) = %d, %v", off, err)
+	}
+	got, err = io.ReadAll(s)
+	if err != nil || !bytes.Equal(got, data[len(data)-10:]) {
+		t.Fatalf("reading the last 10 bytes: got %q, %v", got, err)
+	}
+}
+
+// TestSeekReaderLeadingSkippableFrame checks streams that start with a
+// skippable frame, like those written by pzstd, whose first index point
+// is not at the start of the stream.
+func TestSeekReaderLeadingSkippableFrame(t *testing.T) {
+	data, frames := seekableStream(t)
+	stream := append([]byte{0x50, 0x2a, 0x4d, 0x18, 4, 0, 0, 0, 0, 0, 1, 0}, frames...)
+	x, err := BuildIndex(bytes.NewReader(stream), 50<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.points[0].in != 12 || x.points[0].out != 0 {
+		t.Fatalf("first index point is %+v, want {in:12 out:0}", x.points[0])
+	}
+
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := new(Index)
+	if err := y.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(x, y) {
+		t.Fatalf("round trip of index changed it: got %+v, want %+v", y, x)
+	}
+
+	s := NewSeekReader(bytes.NewReader(stream), y)
+	got, err := io.ReadAll(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("ReadAll mismatch")
+	}
+	p := make([]byte, 100)
+	if _, err := s.ReadAt(p, 10); err != nil || !bytes.Equal(p, data[10:110]) {
+		t.Fatalf("ReadAt(100 bytes, 10): %v", err)
+	}
+}
+
+func TestBuildIndexErrors(t *testing.T) {
+	if _, err := BuildIndex(bytes.NewReader(nil), 0); err == nil {
+		t.Errorf("BuildIndex with span 0 succeeded")
+	}
+	_, stream := seekableStream(t)
+	if _, err := BuildIndex(bytes.NewReader(stream[:len(stream)/2]), 1<<20); err == nil {
+		t.Errorf("BuildIndex of truncated stream succeeded")
+	}
+}
+
+func TestIndexUnmarshalErrors(t *testing.T) {
+	_, stream := seekableStream(t)
+	x, err := BuildIndex(bytes.NewReader(stream), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var y Index
+	for i := 0; i < len(b); i++ {
+		if err := y.UnmarshalBinary(b[:i]); err == nil {
+			t.Errorf("UnmarshalBinary of %d of %d bytes succeeded", i, len(b))
+		}
+	}
+	if err := y.UnmarshalBinary(append(b, 0)); err == nil {
+		t.Errorf("UnmarshalBinary with trailing data succeeded")
+	}
+}
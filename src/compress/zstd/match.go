@@ -0,0 +1,360 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	// maxBlockSize is the largest block we produce. RFC 3.1.1.2.3.
+	maxBlockSize = 128 << 10
+
+	// minMatch is the shortest match that we look for.
+	minMatch = 4
+
+	// initialBase is the absolute position of the start of the
+	// history when the tables are clear. It is large enough that
+	// an empty table entry is never mistaken for a position.
+	initialBase = 1 << 24
+
+	// maxBase bounds the absolute positions stored in the tables
+	// before they are cleared.
+	maxBase = 1 << 30
+)
+
+// compressionLevel holds the match finder parameters for one level.
+type compressionLevel struct {
+	windowLog uint8 // log of the window size
+	hashLog   uint8 // log of the hash table size
+	chainLog  uint8 // log of the hash chain size, 0 for none
+	depth     int   // number of candidates to check
+	lazy      int   // number of later positions to check for a better match
+	nice      int   // length of a match that ends the search
+}
+
+// levels holds the parameters for BestSpeed through BestCompression.
+// The largest window is 8 MB, the limit the RFC recommends that
+// all decoders support.
+var levels = [...]compressionLevel{
+	1: {19, 16, 0, 1, 0, 32},
+	2: {20, 17, 16, 2, 0, 32},
+	3: {21, 17, 17, 4, 1, 32},
+	4: {21, 18, 18, 8, 1, 48},
+	5: {22, 18, 19, 16, 1, 64},
+	6: {22, 19, 20, 32, 2, 96},
+	7: {23, 19, 21, 64, 2, 128},
+	8: {23, 20, 22, 128, 2, 256},
+	9: {23, 20, 22, 256, 2, 512},
+}
+
+// matcher finds matches in the history of a frame and turns
+// each block into literals and sequences.
+type matcher struct {
+	level      compressionLevel
+	windowSize int
+
+	// hist holds previous data, of which only the last windowSize
+	// bytes can be referenced, followed by the block being compressed.
+	hist []byte
+
+	// base is the absolute position of hist[0].
+	// The tables hold absolute positions.
+	base int32
+
+	// table maps a hash to the most recent position with that hash.
+	table []int32
+
+	// chain maps a position to the previous one with the same hash.
+	chain []int32
+
+	// next is the first position in hist not yet in the tables.
+	next int
+
+	// The repeated offsets. RFC 3.1.1.5.
+	reps [3]uint32
+
+	// The output of the last call to block.
+	lits []byte
+	seqs []seq
+}
+
+// init prepares m to compress with the given level.
+func (m *matcher) init(level compressionLevel) {
+	m.level = level
+	m.windowSize = 1 << level.windowLog
+	m.table = make([]int32, 1<<level.hashLog)
+	if level.chainLog > 0 {
+		m.chain = make([]int32, 1<<level.chainLog)
+	}
+	m.base = initialBase
+}
+
+// reset prepares m for a new frame. The dictionary content, if any,
+// precedes the frame, and reps are the initial repeated offsets.
+func (m *matcher) reset(dict []byte, reps [3]uint32) {
+	// Rather than clearing the tables, move the base past
+	// all the positions they may contain.
+	m.base += int32(len(m.hist)) + int32(m.windowSize) + 1
+	if m.base > maxBase {
+		clear(m.table)
+		clear(m.chain)
+		m.base = initialBase
+	}
+	if len(dict) > m.windowSize {
+		dict = dict[len(dict)-m.windowSize:]
+	}
+	m.hist = append(m.hist[:0], dict...)
+	m.next = 0
+	m.reps = reps
+	m.insert(len(m.hist))
+}
+
+// slide discards history that is no longer needed, to make room for
+// a new block. It returns the number of bytes discarded.
+//
+// The history is allowed to grow to twice the window size before it is
+// moved, so that each byte is copied about once, rather than the whole
+// window being copied for every block.
+func (m *matcher) slide() int {
+	if len(m.hist) < 2*m.windowSize {
+		return 0
+	}
+	n := len(m.hist) - m.windowSize
+	copy(m.hist, m.hist[n:])
+	m.hist = m.hist[:m.windowSize]
+	m.base += int32(n)
+	m.next = max(m.next-n, 0)
+	if m.base > maxBase {
+		// Rebase the positions in the tables.
+		delta := m.base - initialBase
+		for _, t := range [][]int32{m.table, m.chain} {
+			for i, p := range t {
+				t[i] = max(p-delta, 0)
+			}
+		}
+		m.base = initialBase
+	}
+	return n
+}
+
+// hash returns the hash of the 4 bytes at hist[i].
+func (m *matcher) hash(i int) uint32 {
+	return (binary.LittleEndian.Uint32(m.hist[i:]) * 0x9e3779b1) >> (32 - m.level.hashLog)
+}
+
+// insert adds the positions up to end to the tables.
+func (m *matcher) insert(end int) {
+	end = min(end, len(m.hist)-minMatch+1)
+	for i := m.next; i < end; i++ {
+		h := m.hash(i)
+		if m.chain != nil {
+			m.chain[(int32(i)+m.base)&int32(len(m.chain)-1)] = m.table[h]
+		}
+		m.table[h] = int32(i) + m.base
+	}
+	m.next = max(m.next, end)
+}
+
+// matchLen returns the length of the common prefix of a and b.
+func matchLen(a, b []byte) int {
+	n := 0
+	for len(a) >= 8 && len(b) >= 8 {
+		if x := binary.LittleEndian.Uint64(a) ^ binary.LittleEndian.Uint64(b); x != 0 {
+			return n + bits.TrailingZeros64(x)>>3
+		}
+		a, b, n = a[8:], b[8:], n+8
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// match is a candidate match.
+type match struct {
+	offset uint32
+	length int
+	rep    bool // whether the offset is a repeated offset
+}
+
+// gain estimates the benefit of a match, weighing its length
+// against the cost of encoding its offset.
+func (mt match) gain() int {
+	if mt.length == 0 {
+		return 0
+	}
+	cost := 1
+	if !mt.rep {
+		cost = bits.Len32(mt.offset + 3)
+	}
+	return mt.length*4 - cost
+}
+
+// find returns the best match at hist[i] that ends before end.
+// If haveLits is set, the repeated offsets can be used cheaply.
+func (m *matcher) find(i, end int, haveLits bool) match {
+	var best match
+	limit := m.hist[:end]
+	cur := limit[i:]
+	if len(cur) < minMatch {
+		return best
+	}
+
+	if haveLits {
+		for _, r := range m.reps {
+			if r == 0 || int(r) > i || int(r) > m.windowSize {
+				continue
+			}
+			if l := matchLen(limit[i-int(r):], cur); l >= minMatch && l > best.length {
+				best = match{offset: r, length: l, rep: true}
+			}
+		}
+		if best.length >= m.level.nice {
+			return best
+		}
+	}
+
+	cand := m.table[m.hash(i)] - m.base
+	for depth := m.level.depth; depth > 0 && cand >= 0 && int(cand) < i; depth-- {
+		c := int(cand)
+		off := i - c
+		if off > m.windowSize {
+			break
+		}
+		if best.length >= len(cur) {
+			break
+		}
+		if limit[c+best.length] == cur[best.length] {
+			if l := matchLen(limit[c:], cur); l >= minMatch {
+				mt := match{offset: uint32(off), length: l}
+				if mt.gain() > best.gain() {
+					best = mt
+					if l >= m.level.nice {
+						break
+					}
+				}
+			}
+		}
+		if m.chain == nil {
+			break
+		}
+		prev := m.chain[(cand+m.base)&int32(len(m.chain)-1)] - m.base
+		if prev >= cand || i-int(prev) > len(m.chain) {
+			break
+		}
+		cand = prev
+	}
+	return best
+}
+
+// block finds the sequences for the block that starts at hist[start]
+// and extends to the end of hist, storing them in m.lits and m.seqs.
+func (m *matcher) block(start int) {
+	m.lits = m.lits[:0]
+	m.seqs = m.seqs[:0]
+	end := len(m.hist)
+	anchor := start
+	i := start
+	for i+minMatch <= end {
+		mt := m.find(i, end, i > anchor)
+		if mt.length == 0 {
+			m.insert(i + 1)
+			if m.level.chainLog == 0 {
+				// Skip ahead faster through data that doesn't match,
+				// without indexing the positions skipped.
+				i += 1 + (i-anchor)>>6
+				m.next = max(m.next, i)
+			} else {
+				i++
+			}
+			continue
+		}
+
+		// Look for a better match starting a little later.
+		for k := 0; k < m.level.lazy && i+1+minMatch <= end; k++ {
+			m.insert(i + 1)
+			next := m.find(i+1, end, true)
+			if next.gain() <= mt.gain()+4 {
+				break
+			}
+			mt = next
+			i++
+		}
+
+		// Extend the match backward over the pending literals.
+		for i > anchor && int(mt.offset) < i && m.hist[i-1] == m.hist[i-1-int(mt.offset)] {
+			i--
+			mt.length++
+		}
+
+		m.addSeq(m.hist[anchor:i], mt)
+		i += mt.length
+		anchor = i
+		if m.level.chainLog == 0 {
+			// Only index the start and end of the match.
+			m.next = max(m.next, i-2)
+		}
+		m.insert(i)
+	}
+	m.insert(end)
+	m.lits = append(m.lits, m.hist[anchor:end]...)
+}
+
+// addSeq adds a sequence with the given literals and match,
+// choosing the offset code and updating the repeated offsets
+// in the same way as the decoder. RFC 3.1.1.5.
+func (m *matcher) addSeq(lits []byte, mt match) {
+	m.lits = append(m.lits, lits...)
+	off := mt.offset
+	var offBase uint32
+	if len(lits) > 0 {
+		switch off {
+		case m.reps[0]:
+			offBase = 1
+		case m.reps[1]:
+			offBase = 2
+			m.reps[1] = m.reps[0]
+			m.reps[0] = off
+		case m.reps[2]:
+			offBase = 3
+			m.reps[2] = m.reps[1]
+			m.reps[1] = m.reps[0]
+			m.reps[0] = off
+		}
+	} else {
+		switch off {
+		case m.reps[1]:
+			offBase = 1
+			m.reps[1] = m.reps[0]
+			m.reps[0] = off
+		case m.reps[2]:
+			offBase = 2
+			m.reps[2] = m.reps[1]
+			m.reps[1] = m.reps[0]
+			m.reps[0] = off
+		case m.reps[0] - 1:
+			offBase = 3
+			m.reps[2] = m.reps[1]
+			m.reps[1] = m.reps[0]
+			m.reps[0] = off
+		}
+	}
+	if offBase == 0 {
+		offBase = off + 3
+		m.reps[2] = m.reps[1]
+		m.reps[1] = m.reps[0]
+		m.reps[0] = off
+	}
+	m.seqs = append(m.seqs, seq{
+		litLen:   uint32(len(lits)),
+		matchLen: uint32(mt.length),
+		offBase:  offBase,
+	})
+}
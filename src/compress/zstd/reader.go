@@ -0,0 +1,68 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"internal/zstd"
+	"io"
+)
+
+// A Reader is an [io.Reader] that decompresses a zstd stream.
+// The stream may hold any number of frames, including skippable frames.
+//
+// A Reader is not safe for concurrent use. To decompress several
+// streams, possibly from different goroutines, reuse Readers
+// with [Reader.Reset], for example by keeping them in a [sync.Pool].
+type Reader struct {
+	zr zstd.Reader
+}
+
+// NewReader creates a new Reader that decompresses data from r.
+//
+// The Reader issues many small reads, so if r is not an in-memory
+// reader it should usually be buffered, for example with [bufio.NewReader].
+func NewReader(r io.Reader) *Reader {
+	z := new(Reader)
+	z.zr.Reset(r)
+	return z
+}
+
+// NewReaderDict is like [NewReader] but decompresses with a dictionary.
+// The dictionary may be in the zstd dictionary format, such as that
+// produced by "zstd --train", or may be raw content.
+// It is used for frames that name its ID and for frames that name no
+// dictionary; a frame that names a different dictionary is an error.
+//
+// The contents of dict should not be modified until the Reader is no
+// longer in use.
+func NewReaderDict(r io.Reader, dict []byte) (*Reader, error) {
+	d, err := zstd.ParseDict(dict)
+	if err != nil {
+		return nil, err
+	}
+	z := new(Reader)
+	z.zr.SetDict(d)
+	z.zr.Reset(r)
+	return z, nil
+}
+
+// Reset discards the Reader z's state and makes it equivalent to the
+// result of its original state from [NewReader] or [NewReaderDict],
+// but reading from r instead. Any dictionary is retained.
+// This permits reusing a Reader rather than allocating a new one.
+func (z *Reader) Reset(r io.Reader) {
+	z.zr.Reset(r)
+}
+
+// Read implements [io.Reader], reading uncompressed bytes
+// from its underlying reader.
+func (z *Reader) Read(p []byte) (int, error) {
+	return z.zr.Read(p)
+}
+
+// ReadByte implements [io.ByteReader].
+func (z *Reader) ReadByte() (byte, error) {
+	return z.zr.ReadByte()
+}
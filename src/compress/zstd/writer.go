@@ -0,0 +1,328 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"internal/zstd"
+	"io"
+)
+
+// Compression levels for [NewWriterLevel] and [NewWriterLevelDict]. Higher
+// levels use larger windows and search harder for matches. The values are
+// the same as those of the corresponding [compress/flate] constants.
+const (
+	BestSpeed          = 1  // level 1, with a 512 KB window
+	BestCompression    = 9  // level 9, with an 8 MB window
+	DefaultCompression = -1 // level 3, with a 2 MB window
+
+	// defaultLevel is the level used for DefaultCompression.
+	defaultLevel = 3
+)
+
+const (
+	frameMagic = 0xfd2fb528
+
+	// Block types. RFC 3.1.1.2.2.
+	blockRaw        = 0
+	blockRLE        = 1
+	blockCompressed = 2
+)
+
+var errWriterClosed = errors.New("zstd: write to closed Writer")
+
+// A Writer takes data written to it and writes the compressed
+// form of that data to an underlying writer (see [NewWriter]).
+//
+// Each Writer writes a single zstd frame that ends with
+// a checksum of the uncompressed content.
+//
+// A Writer is not safe for concurrent use. To compress several
+// streams, possibly from different goroutines, reuse Writers
+// with [Writer.Reset], for example by keeping them in a [sync.Pool].
+type Writer struct {
+	w     io.Writer
+	level int
+	dict  *zstd.Dict
+
+	m  matcher
+	be blockEncoder
+
+	// start is the offset in m.hist of the current block.
+	start int
+
+	checksum    zstd.XXHash64
+	wroteHeader bool
+	closed      bool
+	err         error
+	out         []byte
+}
+
+// NewWriter returns a new Writer compressing data at the default level.
+// Writes to the returned Writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the Writer when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevelDict(w, DefaultCompression, nil)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level
+// instead of assuming DefaultCompression.
+//
+// The compression level can be DefaultCompression or any integer value
+// between BestSpeed and BestCompression inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	return NewWriterLevelDict(w, level, nil)
+}
+
+// NewWriterLevelDict is like [NewWriterLevel] but compresses with a
+// dictionary. The dictionary may be nil, may be in the zstd dictionary
+// format such as that produced by "zstd --train", or may be raw content.
+// The frame records the ID of a formatted dictionary so that readers
+// can check that they are using the same one.
+//
+// The contents of dict should not be modified until the Writer is no
+// longer in use.
+func NewWriterLevelDict(w io.Writer, level int, dict []byte) (*Writer, error) {
+	if level == DefaultCompression {
+		level = defaultLevel
+	}
+	if level < BestSpeed || level > BestCompression {
+		return nil, fmt.Errorf("zstd: invalid compression level: %d", level)
+	}
+	z := &Writer{level: level}
+	if dict != nil {
+		d, err := zstd.ParseDict(dict)
+		if err != nil {
+			return nil, err
+		}
+		z.dict = d
+	}
+	z.m.init(levels[level])
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the Writer z's state and makes it equivalent to the
+// result of its original state from NewWriter, NewWriterLevel or
+// NewWriterLevelDict, but writing to w instead.
+// This permits reusing a Writer rather than allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	// z.level, z.dict, z.m's tables and z.be left unchanged.
+	if z.dict != nil {
+		z.m.reset(z.dict.Content, z.dict.RepeatedOffsets)
+	} else {
+		z.m.reset(nil, [3]uint32{1, 4, 8})
+	}
+	z.start = len(z.m.hist)
+	z.checksum.Reset()
+	z.wroteHeader = false
+	z.closed = false
+	z.err = nil
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer].
+// The compressed bytes are not necessarily flushed until the Writer
+// is closed or explicitly flushed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	n := 0
+	for len(p) > 0 {
+		if len(z.m.hist)-z.start == maxBlockSize {
+			if z.err = z.writeBlock(false); z.err != nil {
+				return n, z.err
+			}
+		}
+		if len(z.m.hist) == z.start {
+			z.start -= z.m.slide()
+		}
+		chunk := p[:min(len(p), maxBlockSize-(len(z.m.hist)-z.start))]
+		z.m.hist = append(z.m.hist, chunk...)
+		z.checksum.Write(chunk)
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// Flush writes any pending data to the underlying writer,
+// so that a reader can decompress everything written so far.
+// Flush does not end the frame, so more data may be written.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	if len(z.m.hist) == z.start && z.wroteHeader {
+		return nil
+	}
+	z.err = z.writeBlock(false)
+	return z.err
+}
+
+// Close closes the Writer by flushing any unwritten data to the
+// underlying writer and ending the frame.
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	if z.err = z.writeBlock(true); z.err != nil {
+		return z.err
+	}
+	var sum [4]byte
+	binary.LittleEndian.PutUint32(sum[:], uint32(z.checksum.Sum64()))
+	_, z.err = z.w.Write(sum[:])
+	return z.err
+}
+
+// appendFrameHeader appends the frame header. RFC 3.1.1.1.
+// If the whole frame is known to consist of the content of the
+// current block, the header records its size and uses it as the
+// window size.
+func (z *Writer) appendFrameHeader(out []byte, last bool) []byte {
+	out = binary.LittleEndian.AppendUint32(out, frameMagic)
+
+	// Content checksum flag.
+	descriptor := byte(1 << 2)
+
+	var dictID uint32
+	if z.dict != nil {
+		dictID = z.dict.ID
+	}
+	switch {
+	case dictID == 0:
+	case dictID < 1<<8:
+		descriptor |= 1
+	case dictID < 1<<16:
+		descriptor |= 2
+	default:
+		descriptor |= 3
+	}
+
+	// A single segment frame lets the decoder size its window
+	// to the content. Dictionary content is not part of the frame,
+	// so we only use a single segment without a dictionary.
+	size := uint64(len(z.m.hist) - z.start)
+	singleSegment := last && z.dict == nil
+	var fcsFlag byte
+	if singleSegment {
+		descriptor |= 1 << 5
+		switch {
+		case size < 1<<8:
+			fcsFlag = 0
+		case size < 1<<16+1<<8:
+			fcsFlag = 1
+		case size < 1<<32:
+			fcsFlag = 2
+		default:
+			fcsFlag = 3
+		}
+		descriptor |= fcsFlag << 6
+	}
+	out = append(out, descriptor)
+
+	if !singleSegment {
+		// Window descriptor with a zero mantissa. RFC 3.1.1.1.2.
+		out = append(out, (z.m.level.windowLog-10)<<3)
+	}
+
+	switch descriptor & 3 {
+	case 1:
+		out = append(out, byte(dictID))
+	case 2:
+		out = binary.LittleEndian.AppendUint16(out, uint16(dictID))
+	case 3:
+		out = binary.LittleEndian.AppendUint32(out, dictID)
+	}
+
+	if singleSegment {
+		switch fcsFlag {
+		case 0:
+			out = append(out, byte(size))
+		case 1:
+			out = binary.LittleEndian.AppendUint16(out, uint16(size-256))
+		case 2:
+			out = binary.LittleEndian.AppendUint32(out, uint32(size))
+		case 3:
+			out = binary.LittleEndian.AppendUint64(out, size)
+		}
+	}
+	return out
+}
+
+// writeBlock compresses the current block and writes it,
+// preceded by the frame header if it has not been written.
+// RFC 3.1.1.2.
+func (z *Writer) writeBlock(last bool) error {
+	out := z.out[:0]
+	if !z.wroteHeader {
+		out = z.appendFrameHeader(out, last)
+		z.wroteHeader = true
+	}
+
+	src := z.m.hist[z.start:]
+	hdrOff := len(out)
+	out = append(out, 0, 0, 0)
+	typ, size := blockRaw, len(src)
+	switch {
+	case len(src) == 0:
+	case allSame(src):
+		typ = blockRLE
+		out = append(out, src[0])
+		z.m.insert(len(z.m.hist))
+	default:
+		reps := z.m.reps
+		z.m.block(z.start)
+		out = z.be.encode(out, z.m.lits, z.m.seqs)
+		if n := len(out) - hdrOff - 3; n < len(src) {
+			typ, size = blockCompressed, n
+		} else {
+			// The decoder won't see these sequences.
+			z.m.reps = reps
+			out = append(out[:hdrOff+3], src...)
+		}
+	}
+
+	hdr := uint32(size)<<3 | uint32(typ)<<1
+	if last {
+		hdr |= 1
+	}
+	out[hdrOff] = byte(hdr)
+	out[hdrOff+1] = byte(hdr >> 8)
+	out[hdrOff+2] = byte(hdr >> 16)
+
+	z.out = out
+	z.start = len(z.m.hist)
+	_, err := z.w.Write(out)
+	return err
+}
+
+// allSame reports whether all the bytes in b are the same.
+func allSame(b []byte) bool {
+	for _, c := range b[1:] {
+		if c != b[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package zstd implements reading and writing of zstd format compressed
+data, as specified in RFC 8878.
+
+The implementation provides filters that uncompress during reading
+and compress during writing. For example, to write compressed data
+to a buffer:
+
+	var b bytes.Buffer
+	w := zstd.NewWriter(&b)
+	w.Write([]byte("hello, world\n"))
+	w.Close()
+
+and to read that data back:
+
+	r := zstd.NewReader(&b)
+	io.Copy(os.Stdout, r)
+
+Both directions support dictionaries, either in the zstd dictionary
+format or as raw content. The Writer always records a checksum of the
+uncompressed content, and the Reader verifies checksums when present.
+*/
+package zstd
@@ -0,0 +1,373 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"fmt"
+	"internal/testenv"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func newton(t testing.TB) []byte {
+	data, err := os.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readDict(t testing.TB) []byte {
+	dict, err := os.ReadFile("testdata/newton.dict")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dict
+}
+
+// testInputs returns inputs that exercise the different block types.
+func testInputs(t testing.TB) []struct {
+	name string
+	data []byte
+} {
+	random := make([]byte, 300000)
+	rand.New(rand.NewSource(1)).Read(random)
+	text := newton(t)
+	return []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"hello", []byte("hello, world\n")},
+		{"zeros", make([]byte, 500000)},
+		{"random", random},
+		{"short-text", text[:1000]},
+		{"text", text},
+		{"repeated-text", bytes.Repeat(text[:100000], 12)},
+	}
+}
+
+func compress(t testing.TB, data []byte, level int, dict []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriterLevelDict(&buf, level, dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func decompress(t testing.TB, data []byte, dict []byte) []byte {
+	var r *Reader
+	if dict == nil {
+		r = NewReader(bytes.NewReader(data))
+	} else {
+		var err error
+		r, err = NewReaderDict(bytes.NewReader(data), dict)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	return got
+}
+
+func TestRoundTrip(t *testing.T) {
+	levels := []int{BestSpeed, 2, DefaultCompression, 6, BestCompression}
+	if testing.Short() {
+		levels = []int{BestSpeed, DefaultCompression}
+	}
+	for _, test := range testInputs(t) {
+		for _, level := range levels {
+			t.Run(fmt.Sprintf("%s/%d", test.name, level), func(t *testing.T) {
+				compressed := compress(t, test.data, level, nil)
+				got := decompress(t, compressed, nil)
+				if !bytes.Equal(got, test.data) {
+					t.Errorf("round trip mismatch: got %d bytes, want %d", len(got), len(test.data))
+				}
+			})
+		}
+	}
+}
+
+// TestWindow checks that matches are found up to the window size, across the
+// points where the history is moved.
+func TestWindow(t *testing.T) {
+	// BestSpeed has a 512 KB window.
+	chunk := make([]byte, 400<<10)
+	rand.New(rand.NewSource(1)).Read(chunk)
+	data := bytes.Repeat(chunk, 8)
+	compressed := compress(t, data, BestSpeed, nil)
+	if got := decompress(t, compressed, nil); !bytes.Equal(got, data) {
+		t.Fatalf("round trip mismatch: got %d bytes, want %d", len(got), len(data))
+	}
+	if len(compressed) > len(chunk)*11/10 {
+		t.Errorf("compressed %d bytes to %d, want about %d", len(data), len(compressed), len(chunk))
+	}
+}
+
+func TestCompressionRatio(t *testing.T) {
+	text := newton(t)
+	prev := len(text)
+	for level := BestSpeed; level <= BestCompression; level++ {
+		n := len(compress(t, text, level, nil))
+		if n > prev*21/20 {
+			t.Errorf("level %d: compressed to %d bytes, level %d to %d", level, n, level-1, prev)
+		}
+		prev = n
+	}
+	if prev > len(text)/3 {
+		t.Errorf("BestCompression: compressed %d bytes to %d", len(text), prev)
+	}
+}
+
+func TestInvalidLevel(t *testing.T) {
+	for _, level := range []int{-2, 0, 10} {
+		if _, err := NewWriterLevel(io.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel(%d) succeeded, want error", level)
+		}
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	text := newton(t)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	if _, err := w.Write(text); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	w.Reset(&buf2)
+	if _, err := w.Write(text); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output after Reset differs")
+	}
+}
+
+func TestReaderReset(t *testing.T) {
+	text := newton(t)
+	c1 := compress(t, text[:5000], BestSpeed, nil)
+	c2 := compress(t, text, BestSpeed, nil)
+	r := NewReader(bytes.NewReader(c1))
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		t.Fatal(err)
+	}
+	r.Reset(bytes.NewReader(c2))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, text) {
+		t.Errorf("mismatch after Reset")
+	}
+}
+
+func TestFlush(t *testing.T) {
+	text := newton(t)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	r := NewReader(&buf)
+	var got []byte
+	for i := 0; i < 3; i++ {
+		chunk := text[i*1000 : (i+1)*1000]
+		if _, err := w.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		// Everything written so far is available
+		// before the frame ends.
+		p := make([]byte, len(chunk))
+		if _, err := io.ReadFull(r, p); err != nil {
+			t.Fatalf("reading flushed data: %v", err)
+		}
+		got = append(got, p...)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, rest...)
+	if !bytes.Equal(got, text[:3000]) {
+		t.Errorf("mismatch")
+	}
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	w := NewWriter(io.Discard)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+}
+
+func TestCorruptChecksum(t *testing.T) {
+	compressed := compress(t, []byte("hello, world\n"), DefaultCompression, nil)
+	compressed[len(compressed)-1] ^= 1
+	if _, err := io.ReadAll(NewReader(bytes.NewReader(compressed))); err == nil {
+		t.Errorf("corrupt checksum not detected")
+	}
+}
+
+func TestDict(t *testing.T) {
+	dict := readDict(t)
+	text := newton(t)
+	raw := text[len(text)-20000:]
+	for _, d := range []struct {
+		name string
+		dict []byte
+	}{
+		{"formatted", dict},
+		{"raw", raw},
+	} {
+		for _, level := range []int{BestSpeed, DefaultCompression, BestCompression} {
+			t.Run(fmt.Sprintf("%s/%d", d.name, level), func(t *testing.T) {
+				for _, data := range [][]byte{text[:2000], text[:200000]} {
+					with := compress(t, data, level, d.dict)
+					without := compress(t, data, level, nil)
+					if len(data) == 2000 && len(with) >= len(without) {
+						t.Errorf("%d bytes compressed to %d with dictionary, %d without", len(data), len(with), len(without))
+					}
+					got := decompress(t, with, d.dict)
+					if !bytes.Equal(got, data) {
+						t.Errorf("round trip mismatch: got %d bytes, want %d", len(got), len(data))
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestDictMismatch(t *testing.T) {
+	dict := readDict(t)
+	compressed := compress(t, []byte("hello, world\n"), DefaultCompression, dict)
+
+	_, err := io.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err == nil || !strings.Contains(err.Error(), "dictionary") {
+		t.Errorf("reading without dictionary: got error %v, want dictionary error", err)
+	}
+
+	other := bytes.Clone(dict)
+	other[4]++ // dictionary ID
+	r, err := NewReaderDict(bytes.NewReader(compressed), other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(r); err == nil || !strings.Contains(err.Error(), "dictionary") {
+		t.Errorf("reading with wrong dictionary: got error %v, want dictionary error", err)
+	}
+}
+
+func TestDictFile(t *testing.T) {
+	compressed, err := os.ReadFile("testdata/newton-2000.zst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decompress(t, compressed, readDict(t))
+	if want := newton(t)[:2000]; !bytes.Equal(got, want) {
+		t.Errorf("mismatch decompressing newton-2000.zst")
+	}
+}
+
+func findZstd(t testing.TB) string {
+	zstd, err := exec.LookPath("zstd")
+	if err != nil {
+		t.Skip("skipping because zstd not found")
+	}
+	return zstd
+}
+
+// TestZstdCommand checks that the zstd command can decompress
+// what we write, and that we can decompress what it writes.
+func TestZstdCommand(t *testing.T) {
+	testenv.MustHaveExec(t)
+	zstd := findZstd(t)
+
+	dir := t.TempDir()
+	dictFile := dir + "/dict"
+	if err := os.WriteFile(dictFile, readDict(t), 0o666); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range testInputs(t) {
+		for _, useDict := range []bool{false, true} {
+			var dict []byte
+			var dictArgs []string
+			if useDict {
+				dict = readDict(t)
+				dictArgs = []string{"-D", dictFile}
+			}
+			t.Run(fmt.Sprintf("%s/dict=%v", test.name, useDict), func(t *testing.T) {
+				for _, level := range []int{BestSpeed, BestCompression} {
+					cmd := exec.Command(zstd, append([]string{"-d", "-c"}, dictArgs...)...)
+					cmd.Stdin = bytes.NewReader(compress(t, test.data, level, dict))
+					got, err := cmd.Output()
+					if err != nil {
+						t.Fatalf("zstd -d at level %d: %v", level, err)
+					}
+					if !bytes.Equal(got, test.data) {
+						t.Errorf("zstd -d at level %d: mismatch", level)
+					}
+				}
+
+				cmd := exec.Command(zstd, append([]string{"-c", "-19"}, dictArgs...)...)
+				cmd.Stdin = bytes.NewReader(test.data)
+				compressed, err := cmd.Output()
+				if err != nil {
+					t.Fatalf("zstd: %v", err)
+				}
+				if got := decompress(t, compressed, dict); !bytes.Equal(got, test.data) {
+					t.Errorf("reading zstd output: mismatch")
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkWriter(b *testing.B) {
+	text := newton(b)
+	for _, level := range []int{BestSpeed, DefaultCompression, BestCompression} {
+		b.Run(fmt.Sprint(level), func(b *testing.B) {
+			w, err := NewWriterLevel(io.Discard, level)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.SetBytes(int64(len(text)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				w.Reset(io.Discard)
+				w.Write(text)
+				w.Close()
+			}
+		})
+	}
+}
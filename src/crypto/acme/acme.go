@@ -0,0 +1,527 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package acme implements a client of the Automatic Certificate Management
+// Environment (ACME) protocol, as specified in RFC 8555, which certificate
+// authorities such as Let's Encrypt use to issue certificates.
+//
+// Most programs should use package [crypto/acme/autocert], which obtains and
+// renews certificates on demand from within a [crypto/tls.Config].
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LetsEncryptURL is the directory URL of the production Let's Encrypt CA.
+const LetsEncryptURL = "https://acme-v02.api.letsencrypt.org/directory"
+
+// defaultPollInterval is the interval between requests that wait for
+// a change of status, if the server doesn't specify one with Retry-After.
+const defaultPollInterval = time.Second
+
+// A Client is an ACME client. It is safe for concurrent use once
+// configured.
+type Client struct {
+	// Key is the account key. It must be an RSA key, or an ECDSA key
+	// on the P-256 or P-384 curve.
+	Key crypto.Signer
+
+	// HTTPClient is used to make requests to the server.
+	// If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// DirectoryURL is the URL of the directory of the server.
+	// If empty, LetsEncryptURL is used.
+	DirectoryURL string
+
+	// UserAgent is prepended to the User-Agent header of the requests.
+	UserAgent string
+
+	// KID is the URL of the account of Key, which is set by Register and
+	// GetReg. If it's known in advance, it can be set to skip that lookup.
+	KID string
+
+	dirMu sync.Mutex
+	dir   *Directory
+
+	noncesMu sync.Mutex
+	nonces   []string
+}
+
+func (c *Client) directoryURL() string {
+	if c.DirectoryURL != "" {
+		return c.DirectoryURL
+	}
+	return LetsEncryptURL
+}
+
+// Discover returns the directory of the server. The directory is fetched
+// once and then cached.
+func (c *Client) Discover(ctx context.Context) (Directory, error) {
+	c.dirMu.Lock()
+	defer c.dirMu.Unlock()
+	if c.dir != nil {
+		return *c.dir, nil
+	}
+
+	res, err := c.get(ctx, c.directoryURL(), http.StatusOK)
+	if err != nil {
+		return Directory{}, err
+	}
+	var v struct {
+		NewNonce   string
+		NewAccount string
+		NewOrder   string
+		NewAuthz   string
+		RevokeCert string
+		KeyChange  string
+		Meta       struct {
+			TermsOfService          string
+			Website                 string
+			CAAIdentities           []string
+			ExternalAccountRequired bool
+		}
+	}
+	if err := decodeResponse(res, &v); err != nil {
+		return Directory{}, err
+	}
+	if v.NewNonce == "" || v.NewAccount == "" || v.NewOrder == "" {
+		return Directory{}, errors.New("acme: incomplete directory")
+	}
+	c.dir = &Directory{
+		NonceURL:                v.NewNonce,
+		RegURL:                  v.NewAccount,
+		OrderURL:                v.NewOrder,
+		AuthzURL:                v.NewAuthz,
+		RevokeURL:               v.RevokeCert,
+		KeyChangeURL:            v.KeyChange,
+		Terms:                   v.Meta.TermsOfService,
+		Website:                 v.Meta.Website,
+		CAA:                     v.Meta.CAAIdentities,
+		ExternalAccountRequired: v.Meta.ExternalAccountRequired,
+	}
+	return *c.dir, nil
+}
+
+// wireAccount is the JSON encoding of Account.
+type wireAccount struct {
+	Status  string
+	Contact []string
+	Orders  string
+}
+
+func (a *wireAccount) account(uri string) *Account {
+	return &Account{
+		URI:       uri,
+		Contact:   a.Contact,
+		Status:    a.Status,
+		OrdersURL: a.Orders,
+	}
+}
+
+// Register creates an account for Key with the contacts of acct, and sets
+// KID to its URL.
+//
+// If the server has terms of service, prompt is called with their URL and
+// must return true to agree to them. Otherwise, or if prompt is nil,
+// Register fails.
+//
+// If Key is already registered, Register returns the existing account
+// and ErrAccountAlreadyExists.
+func (c *Client) Register(ctx context.Context, acct *Account, prompt func(tosURL string) bool) (*Account, error) {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir.ExternalAccountRequired {
+		return nil, errors.New("acme: external account binding is required by the server")
+	}
+	req := struct {
+		Contact              []string `json:"contact,omitempty"`
+		TermsOfServiceAgreed bool     `json:"termsOfServiceAgreed,omitempty"`
+	}{
+		Contact: acct.Contact,
+	}
+	if dir.Terms != "" {
+		if prompt == nil || !prompt(dir.Terms) {
+			return nil, errors.New("acme: terms of service " + dir.Terms + " were not accepted")
+		}
+		req.TermsOfServiceAgreed = true
+	}
+	res, err := c.post(ctx, c.Key, dir.RegURL, req, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	a, err := c.responseAccount(res)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusOK {
+		return a, ErrAccountAlreadyExists
+	}
+	return a, nil
+}
+
+// GetReg returns the account of Key, and sets KID to its URL.
+// If Key isn't registered, GetReg returns ErrNoAccount.
+func (c *Client) GetReg(ctx context.Context) (*Account, error) {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := struct {
+		OnlyReturnExisting bool `json:"onlyReturnExisting"`
+	}{true}
+	res, err := c.post(ctx, c.Key, dir.RegURL, req, http.StatusOK)
+	if err != nil {
+		if e, ok := err.(*Error); ok && e.ProblemType == problemAccountNotExists {
+			return nil, ErrNoAccount
+		}
+		return nil, err
+	}
+	return c.responseAccount(res)
+}
+
+// responseAccount decodes the account in res, and sets KID to its URL.
+func (c *Client) responseAccount(res *http.Response) (*Account, error) {
+	var v wireAccount
+	if err := decodeResponse(res, &v); err != nil {
+		return nil, err
+	}
+	uri := res.Header.Get("Location")
+	if uri == "" {
+		return nil, errors.New("acme: account URL missing from response")
+	}
+	c.KID = uri
+	return v.account(uri), nil
+}
+
+// AuthorizeOrder creates an order for a certificate for ids.
+// Each of the authorizations of the order must then be fulfilled before
+// the order can be finalized with CreateOrderCert.
+func (c *Client) AuthorizeOrder(ctx context.Context, ids []AuthzID) (*Order, error) {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := struct {
+		Identifiers []AuthzID `json:"identifiers"`
+	}{ids}
+	res, err := c.post(ctx, nil, dir.OrderURL, req, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return responseOrder(res)
+}
+
+// GetOrder returns the order at url.
+func (c *Client) GetOrder(ctx context.Context, url string) (*Order, error) {
+	res, err := c.post(ctx, nil, url, &noPayload, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	o, err := responseOrder(res)
+	if err != nil {
+		return nil, err
+	}
+	o.URI = url
+	return o, nil
+}
+
+// WaitOrder polls the order at url until it is ready or valid, and returns
+// it. If the order becomes invalid, WaitOrder returns its error.
+func (c *Client) WaitOrder(ctx context.Context, url string) (*Order, error) {
+	for {
+		res, err := c.post(ctx, nil, url, &noPayload, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+		o, err := responseOrder(res)
+		if err != nil {
+			return nil, err
+		}
+		o.URI = url
+		switch o.Status {
+		case StatusReady, StatusValid:
+			return o, nil
+		case StatusPending, StatusProcessing:
+		default:
+			return nil, orderError(o)
+		}
+		if err := sleep(ctx, retryAfter(res.Header, defaultPollInterval)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// orderError returns the error that made o invalid.
+func orderError(o *Order) error {
+	if o.Error != nil {
+		return o.Error
+	}
+	return fmt.Errorf("acme: order %s is %s", o.URI, o.Status)
+}
+
+// responseOrder decodes the order in res, whose URL is in the Location
+// header, if any.
+func responseOrder(res *http.Response) (*Order, error) {
+	var v wireOrder
+	if err := decodeResponse(res, &v); err != nil {
+		return nil, err
+	}
+	return v.order(res.Header.Get("Location")), nil
+}
+
+// GetAuthorization returns the authorization at url.
+func (c *Client) GetAuthorization(ctx context.Context, url string) (*Authorization, error) {
+	res, err := c.post(ctx, nil, url, &noPayload, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	var v wireAuthz
+	if err := decodeResponse(res, &v); err != nil {
+		return nil, err
+	}
+	return v.authorization(url), nil
+}
+
+// WaitAuthorization polls the authorization at url until it is valid, and
+// returns it. If the authorization becomes invalid, WaitAuthorization returns
+// the error of the failed challenge.
+func (c *Client) WaitAuthorization(ctx context.Context, url string) (*Authorization, error) {
+	for {
+		res, err := c.post(ctx, nil, url, &noPayload, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+		var v wireAuthz
+		if err := decodeResponse(res, &v); err != nil {
+			return nil, err
+		}
+		z := v.authorization(url)
+		switch z.Status {
+		case StatusValid:
+			return z, nil
+		case StatusPending, StatusProcessing:
+		default:
+			return nil, z.error()
+		}
+		if err := sleep(ctx, retryAfter(res.Header, defaultPollInterval)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Accept informs the server that the response to chal is in place, so that
+// it starts validating it. The result of the validation is reported by
+// WaitAuthorization.
+func (c *Client) Accept(ctx context.Context, chal *Challenge) (*Challenge, error) {
+	res, err := c.post(ctx, nil, chal.URI, struct{}{}, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	var v wireChallenge
+	if err := decodeResponse(res, &v); err != nil {
+		return nil, err
+	}
+	return v.challenge(), nil
+}
+
+// CreateOrderCert finalizes a ready order with the DER-encoded certificate
+// signing request csr, waits for the certificate to be issued and returns it,
+// along with its URL.
+//
+// If bundle is true, der holds the certificate followed by its chain of
+// intermediates. Otherwise, it only holds the certificate.
+func (c *Client) CreateOrderCert(ctx context.Context, finalizeURL string, csr []byte, bundle bool) (der [][]byte, certURL string, err error) {
+	req := struct {
+		CSR string `json:"csr"`
+	}{base64.RawURLEncoding.EncodeToString(csr)}
+	res, err := c.post(ctx, nil, finalizeURL, req, http.StatusOK)
+	if err != nil {
+		return nil, "", err
+	}
+	o, err := responseOrder(res)
+	if err != nil {
+		return nil, "", err
+	}
+	for o.Status != StatusValid {
+		if o.Status != StatusProcessing {
+			return nil, "", orderError(o)
+		}
+		if o.URI == "" {
+			return nil, "", errors.New("acme: order URL missing from response")
+		}
+		if err := sleep(ctx, retryAfter(res.Header, defaultPollInterval)); err != nil {
+			return nil, "", err
+		}
+		o, err = c.GetOrder(ctx, o.URI)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	der, err = c.FetchCert(ctx, o.CertURL, bundle)
+	if err != nil {
+		return nil, "", err
+	}
+	return der, o.CertURL, nil
+}
+
+// maxChainLen bounds the number of certificates of a chain.
+const maxChainLen = 5
+
+// FetchCert returns the certificate at url, as a list of DER-encoded
+// certificates. If bundle is true, the list holds the certificate followed
+// by its chain of intermediates. Otherwise, it only holds the certificate.
+func (c *Client) FetchCert(ctx context.Context, url string, bundle bool) ([][]byte, error) {
+	res, err := c.post(ctx, nil, url, &noPayload, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxResponseSize {
+		return nil, errors.New("acme: certificate chain is too large")
+	}
+
+	var der [][]byte
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			break
+		}
+		if b.Type != "CERTIFICATE" {
+			return nil, errors.New("acme: unexpected PEM block " + b.Type + " in certificate chain")
+		}
+		if len(der) == maxChainLen {
+			return nil, errors.New("acme: certificate chain is too long")
+		}
+		der = append(der, b.Bytes)
+		if !bundle {
+			break
+		}
+	}
+	if len(der) == 0 {
+		return nil, errors.New("acme: no certificate in response")
+	}
+	return der, nil
+}
+
+// RevokeCert revokes the DER-encoded certificate cert. If key is nil, the
+// request is authorized by the account that obtained the certificate.
+// Otherwise, key must be the private key of the certificate.
+func (c *Client) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason CRLReasonCode) error {
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	req := struct {
+		Certificate string        `json:"certificate"`
+		Reason      CRLReasonCode `json:"reason"`
+	}{base64.RawURLEncoding.EncodeToString(cert), reason}
+	res, err := c.post(ctx, key, dir.RevokeURL, req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+// keyAuthorization returns the key authorization of token,
+// RFC 8555, Section 8.1.
+func keyAuthorization(key crypto.Signer, token string) (string, error) {
+	th, err := JWKThumbprint(key.Public())
+	if err != nil {
+		return "", err
+	}
+	return token + "." + th, nil
+}
+
+// HTTP01ChallengeResponse returns the body that must be served at
+// HTTP01ChallengePath(token) to fulfill an HTTP-01 challenge,
+// RFC 8555, Section 8.3.
+func (c *Client) HTTP01ChallengeResponse(token string) (string, error) {
+	return keyAuthorization(c.Key, token)
+}
+
+// HTTP01ChallengePath returns the path at which the response to an HTTP-01
+// challenge must be served over HTTP on port 80.
+func (c *Client) HTTP01ChallengePath(token string) string {
+	return "/.well-known/acme-challenge/" + token
+}
+
+// idPeACMEIdentifier is the OID of the acmeIdentifier extension,
+// RFC 8737, Section 6.1.
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// TLSALPN01ChallengeCert returns the certificate that must be presented for
+// domain to fulfill a TLS-ALPN-01 challenge, RFC 8737, to connections that
+// negotiate the ALPNProto protocol.
+func (c *Client) TLSALPN01ChallengeCert(token, domain string) (tls.Certificate, error) {
+	ka, err := keyAuthorization(c.Key, token)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	h := sha256.Sum256([]byte(ka))
+	ext, err := asn1.Marshal(h[:])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "ACME challenge"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{domain},
+		ExtraExtensions: []pkix.Extension{{
+			Id:       idPeACMEIdentifier,
+			Critical: true,
+			Value:    ext,
+		}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
@@ -0,0 +1,341 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/acme/internal/acmetest"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestClient(t *testing.T, ca *acmetest.CAServer) *Client {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Client{Key: key, DirectoryURL: ca.URL()}
+}
+
+func registerTestClient(t *testing.T, ca *acmetest.CAServer) *Client {
+	c := newTestClient(t, ca)
+	if _, err := c.Register(context.Background(), &Account{}, func(string) bool { return true }); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRegister(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	c := newTestClient(t, ca)
+	ctx := context.Background()
+
+	if _, err := c.GetReg(ctx); err != ErrNoAccount {
+		t.Fatalf("GetReg before Register: got error %v, want ErrNoAccount", err)
+	}
+	if _, err := c.Register(ctx, &Account{}, nil); err == nil {
+		t.Fatal("Register without prompt succeeded")
+	}
+	if _, err := c.Register(ctx, &Account{}, func(string) bool { return false }); err == nil {
+		t.Fatal("Register with rejected terms succeeded")
+	}
+
+	var tos string
+	contact := []string{"mailto:admin@example.org"}
+	a, err := c.Register(ctx, &Account{Contact: contact}, func(u string) bool {
+		tos = u
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(tos, "/terms") {
+		t.Errorf("prompt called with %q, want the terms URL", tos)
+	}
+	if a.URI == "" || c.KID != a.URI {
+		t.Errorf("account URI %q, KID %q", a.URI, c.KID)
+	}
+	if a.Status != StatusValid || len(a.Contact) != 1 || a.Contact[0] != contact[0] {
+		t.Errorf("unexpected account %+v", a)
+	}
+
+	if _, err := c.Register(ctx, &Account{}, func(string) bool { return true }); err != ErrAccountAlreadyExists {
+		t.Errorf("second Register: got error %v, want ErrAccountAlreadyExists", err)
+	}
+	c2 := &Client{Key: c.Key, DirectoryURL: ca.URL()}
+	a2, err := c2.GetReg(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a2.URI != a.URI || c2.KID != a.URI {
+		t.Errorf("GetReg returned account %q, want %q", a2.URI, a.URI)
+	}
+}
+
+// serveChallenge puts the response to chal for domain in place, and makes
+// ca validate it.
+func serveChallenge(t *testing.T, ca *acmetest.CAServer, c *Client, chal *Challenge, domain string) {
+	switch chal.Type {
+	case ChallengeHTTP01:
+		resp, err := c.HTTP01ChallengeResponse(chal.Token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := c.HTTP01ChallengePath(chal.Token)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path || r.Host != domain {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(resp))
+		}))
+		t.Cleanup(srv.Close)
+		ca.Resolve(domain, srv.Listener.Addr().String())
+
+	case ChallengeTLSALPN01:
+		cert, err := c.TLSALPN01ChallengeCert(chal.Token, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   []string{ALPNProto},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { ln.Close() })
+		go func() {
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}
+		}()
+		ca.Resolve(domain, ln.Addr().String())
+
+	default:
+		t.Fatalf("unexpected challenge type %q", chal.Type)
+	}
+}
+
+// authorize creates an order for domain and fulfills it with challenges of
+// type typ.
+func authorize(t *testing.T, ca *acmetest.CAServer, c *Client, domain, typ string) (*Order, error) {
+	ctx := context.Background()
+	o, err := c.AuthorizeOrder(ctx, DomainIDs(domain))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != StatusPending || o.URI == "" || len(o.AuthzURLs) != 1 {
+		t.Fatalf("unexpected new order %+v", o)
+	}
+	z, err := c.GetAuthorization(ctx, o.AuthzURLs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.Identifier.Value != domain || z.Status != StatusPending {
+		t.Fatalf("unexpected authorization %+v", z)
+	}
+	var chal *Challenge
+	for _, ch := range z.Challenges {
+		if ch.Type == typ {
+			chal = ch
+		}
+	}
+	if chal == nil {
+		t.Fatalf("no %s challenge offered", typ)
+	}
+	serveChallenge(t, ca, c, chal, domain)
+	if _, err := c.Accept(ctx, chal); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.WaitAuthorization(ctx, z.URI); err != nil {
+		return nil, err
+	}
+	return c.WaitOrder(ctx, o.URI)
+}
+
+// issue obtains a certificate for domain with challenges of type typ.
+func issue(t *testing.T, ca *acmetest.CAServer, c *Client, domain, typ string) ([][]byte, crypto.Signer) {
+	o, err := authorize(t, ca, c, domain, typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != StatusReady {
+		t.Fatalf("order is %s, want %s", o.Status, StatusReady)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: []string{domain},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	der, certURL, err := c.CreateOrderCert(ctx, o.FinalizeURL, csr, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(der) != 2 {
+		t.Fatalf("got a chain of %d certificates, want 2", len(der))
+	}
+	leaf, err := x509.ParseCertificate(der[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{DNSName: domain, Roots: ca.Roots()}); err != nil {
+		t.Fatal(err)
+	}
+	if !leaf.PublicKey.(*ecdsa.PublicKey).Equal(key.Public()) {
+		t.Error("certificate doesn't match the CSR key")
+	}
+
+	single, err := c.FetchCert(ctx, certURL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(single) != 1 || string(single[0]) != string(der[0]) {
+		t.Error("FetchCert without bundle didn't return the leaf only")
+	}
+	return der, key
+}
+
+func TestIssue(t *testing.T) {
+	for _, typ := range []string{ChallengeHTTP01, ChallengeTLSALPN01} {
+		t.Run(typ, func(t *testing.T) {
+			ca := acmetest.NewCAServer(t)
+			c := registerTestClient(t, ca)
+			issue(t, ca, c, "example.org", typ)
+			if n := ca.IssuedCerts(); n != 1 {
+				t.Errorf("CA issued %d certificates, want 1", n)
+			}
+		})
+	}
+}
+
+func TestFailedChallenge(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	ca.ChallengeTypes(ChallengeHTTP01)
+	c := registerTestClient(t, ca)
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	ca.Resolve("example.org", srv.Listener.Addr().String())
+
+	o, err := c.AuthorizeOrder(ctx, DomainIDs("example.org"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, err := c.GetAuthorization(ctx, o.AuthzURLs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Accept(ctx, z.Challenges[0]); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.WaitAuthorization(ctx, z.URI)
+	var e *Error
+	if !errors.As(err, &e) || e.ProblemType != "urn:ietf:params:acme:error:unauthorized" {
+		t.Errorf("WaitAuthorization: got error %v, want an unauthorized ACME error", err)
+	}
+	if _, err := c.WaitOrder(ctx, o.URI); err == nil {
+		t.Error("WaitOrder succeeded for an invalid order")
+	}
+}
+
+func TestBadNonce(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	c := registerTestClient(t, ca)
+	ctx := context.Background()
+
+	ca.RejectNonces(maxNonceRetries)
+	if _, err := c.GetReg(ctx); err != nil {
+		t.Fatalf("request wasn't retried: %v", err)
+	}
+
+	ca.RejectNonces(maxNonceRetries + 1)
+	_, err := c.GetReg(ctx)
+	var e *Error
+	if !errors.As(err, &e) || e.ProblemType != problemBadNonce {
+		t.Errorf("got error %v, want badNonce", err)
+	}
+}
+
+func TestRevokeCert(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	c := registerTestClient(t, ca)
+	ctx := context.Background()
+
+	// Revocation by the account.
+	der, _ := issue(t, ca, c, "example.org", ChallengeHTTP01)
+	if err := c.RevokeCert(ctx, nil, der[0], CRLReasonSuperseded); err != nil {
+		t.Fatal(err)
+	}
+	if !ca.Revoked(der[0]) {
+		t.Error("certificate not revoked")
+	}
+	if err := c.RevokeCert(ctx, nil, der[0], CRLReasonSuperseded); err == nil {
+		t.Error("second revocation succeeded")
+	}
+
+	// Revocation with the certificate key, by another client.
+	der, key := issue(t, ca, c, "example.org", ChallengeHTTP01)
+	other := newTestClient(t, ca)
+	if err := other.RevokeCert(ctx, key, der[0], CRLReasonKeyCompromise); err != nil {
+		t.Fatal(err)
+	}
+	if !ca.Revoked(der[0]) {
+		t.Error("certificate not revoked")
+	}
+}
+
+func TestTLSALPN01ChallengeCert(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Client{Key: key}
+	cert, err := c.TLSALPN01ChallengeCert("token", "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != "example.org" {
+		t.Errorf("DNSNames = %q", leaf.DNSNames)
+	}
+	var found bool
+	for _, ext := range leaf.Extensions {
+		if ext.Id.Equal(idPeACMEIdentifier) {
+			found = true
+			if !ext.Critical {
+				t.Error("acmeIdentifier extension is not critical")
+			}
+		}
+	}
+	if !found {
+		t.Error("no acmeIdentifier extension")
+	}
+}
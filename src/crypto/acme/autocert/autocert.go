@@ -0,0 +1,707 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package autocert obtains certificates from an ACME certificate authority,
+// such as Let's Encrypt, on demand, and renews them before they expire.
+//
+// A [Manager] is plugged into a server through its GetCertificate method,
+// usually with [Manager.TLSConfig]:
+//
+//	m := &autocert.Manager{
+//		Prompt:     autocert.AcceptTOS,
+//		Cache:      autocert.DirCache("certs"),
+//		HostPolicy: autocert.HostAllowlist("example.org", "www.example.org"),
+//	}
+//	s := &http.Server{
+//		Addr:      ":https",
+//		TLSConfig: m.TLSConfig(),
+//	}
+//	s.ListenAndServeTLS("", "")
+//
+// The Manager renews the certificates in the background until
+// [Manager.Close] is called.
+//
+// Ownership of the domains is proven with the TLS-ALPN-01 challenge, which
+// is answered by GetCertificate on port 443, and, if [Manager.HTTPHandler]
+// is used to serve port 80, with the HTTP-01 challenge.
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/acme"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// accountKeyName is the cache key of the account key.
+const accountKeyName = "acme_account+key"
+
+// createCertTimeout bounds the time GetCertificate spends obtaining a
+// certificate.
+const createCertTimeout = 5 * time.Minute
+
+// AcceptTOS is a Manager.Prompt that always accepts the terms of service.
+func AcceptTOS(tosURL string) bool { return true }
+
+// HostPolicy decides whether Manager may obtain a certificate for host.
+// It returns a non-nil error to refuse.
+type HostPolicy func(ctx context.Context, host string) error
+
+// HostAllowlist returns a policy that only allows the host names hosts.
+// Names are compared case-insensitively.
+func HostAllowlist(hosts ...string) HostPolicy {
+	allowed := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		allowed[strings.ToLower(h)] = true
+	}
+	return func(_ context.Context, host string) error {
+		if !allowed[strings.ToLower(host)] {
+			return fmt.Errorf("acme/autocert: host %q not configured in HostAllowlist", host)
+		}
+		return nil
+	}
+}
+
+func defaultHostPolicy(context.Context, string) error {
+	return nil
+}
+
+// Manager obtains and renews certificates automatically, and answers the
+// challenges of the certificate authority.
+//
+// The zero value is not usable: Prompt must be set. A Manager must not be
+// copied or modified once in use.
+type Manager struct {
+	// Prompt is called with the URL of the terms of service of the CA, and
+	// must return true to agree to them. It must be set; AcceptTOS accepts
+	// them unconditionally.
+	Prompt func(tosURL string) bool
+
+	// Cache stores the account key and the certificates. If nil,
+	// certificates are only kept in memory, and a new account is created
+	// each time the program starts.
+	Cache Cache
+
+	// HostPolicy controls the host names that certificates are obtained
+	// for. If nil, any host name is allowed, which lets anyone make the
+	// Manager request certificates; most servers should use HostAllowlist.
+	HostPolicy HostPolicy
+
+	// RenewBefore is how long before expiration certificates are renewed.
+	// If zero, they are renewed 30 days before they expire.
+	RenewBefore time.Duration
+
+	// Client is used to talk to the CA. If nil, a client for Let's Encrypt
+	// is used. If Client.Key is nil, the account key is loaded from Cache,
+	// or generated.
+	Client *acme.Client
+
+	// Email is the contact address of the account, if any.
+	Email string
+
+	clientMu sync.Mutex
+	client   *acme.Client // registered client, once initialized
+
+	stateMu sync.Mutex
+	state   map[string]*certState // by host name
+
+	// tokensMu guards the challenge responses.
+	tokensMu   sync.RWMutex
+	tryHTTP01  bool                        // HTTPHandler was called
+	httpTokens map[string][]byte           // HTTP-01 responses by URL path
+	certTokens map[string]*tls.Certificate // TLS-ALPN-01 certificates by host name
+
+	renewalMu sync.Mutex
+	renewal   map[string]*domainRenewal // by host name
+	closed    bool                      // Close was called
+}
+
+// certState holds the certificate of a host name. It is locked for
+// writing while the certificate is being obtained.
+type certState struct {
+	sync.RWMutex
+	key  crypto.Signer
+	cert [][]byte
+	leaf *x509.Certificate
+}
+
+// tlscert returns the certificate of s, which must be locked for reading.
+func (s *certState) tlscert() (*tls.Certificate, error) {
+	if s.leaf == nil {
+		return nil, errors.New("acme/autocert: certificate not available")
+	}
+	return &tls.Certificate{
+		Certificate: s.cert,
+		PrivateKey:  s.key,
+		Leaf:        s.leaf,
+	}, nil
+}
+
+// TLSConfig returns a tls.Config that uses m for its certificates and
+// negotiates HTTP/2, HTTP/1.1 and the ALPN protocol of TLS-ALPN-01
+// challenges.
+func (m *Manager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: m.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1", acme.ALPNProto},
+	}
+}
+
+// GetCertificate implements the tls.Config.GetCertificate hook. It returns
+// the certificate of the server name of hello, obtaining it first if it's
+// not in memory or in the cache, and answers TLS-ALPN-01 challenges.
+func (m *Manager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if m.Prompt == nil {
+		return nil, errors.New("acme/autocert: Manager.Prompt not set")
+	}
+	name := hello.ServerName
+	if name == "" {
+		return nil, errors.New("acme/autocert: missing server name")
+	}
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if !strings.Contains(name, ".") {
+		return nil, errors.New("acme/autocert: server name component count invalid")
+	}
+	// The name is used as a Cache key, so it must not be able to refer to
+	// other entries, or to files outside of a DirCache.
+	if strings.ContainsAny(name, `+/\`) || strings.Contains(name, "..") {
+		return nil, errors.New("acme/autocert: server name contains invalid character")
+	}
+
+	if len(hello.SupportedProtos) == 1 && hello.SupportedProtos[0] == acme.ALPNProto {
+		m.tokensMu.RLock()
+		defer m.tokensMu.RUnlock()
+		if cert := m.certTokens[name]; cert != nil {
+			return cert, nil
+		}
+		return nil, fmt.Errorf("acme/autocert: no TLS-ALPN-01 challenge in progress for %q", name)
+	}
+
+	ctx := hello.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, createCertTimeout)
+	defer cancel()
+	cert, err := m.cert(ctx, name)
+	if err == nil {
+		return cert, nil
+	}
+	if err != ErrCacheMiss {
+		return nil, err
+	}
+	if err := m.hostPolicy()(ctx, name); err != nil {
+		return nil, err
+	}
+	return m.createCert(ctx, name)
+}
+
+// cert returns the certificate of name from memory or from the cache.
+// It returns ErrCacheMiss if there is none.
+func (m *Manager) cert(ctx context.Context, name string) (*tls.Certificate, error) {
+	m.stateMu.Lock()
+	if s, ok := m.state[name]; ok {
+		m.stateMu.Unlock()
+		s.RLock()
+		defer s.RUnlock()
+		if s.leaf == nil {
+			// The call that published s failed to load or obtain
+			// the certificate.
+			return nil, ErrCacheMiss
+		}
+		return s.tlscert()
+	}
+	// As in createCert, publish a locked state, so that concurrent calls
+	// for name wait for this lookup, while calls for other names don't
+	// wait for the cache.
+	s := &certState{}
+	s.Lock()
+	defer s.Unlock()
+	if m.state == nil {
+		m.state = make(map[string]*certState)
+	}
+	m.state[name] = s
+	m.stateMu.Unlock()
+
+	cert, err := m.cacheGet(ctx, name)
+	if err != nil {
+		m.deleteState(name)
+		return nil, err
+	}
+	s.key, s.cert, s.leaf = cert.PrivateKey.(crypto.Signer), cert.Certificate, cert.Leaf
+	m.startRenew(name, s.key, s.leaf.NotAfter)
+	return cert, nil
+}
+
+// createCert obtains a certificate for name from the CA, unless another
+// call is already doing so, in which case it waits for its result.
+func (m *Manager) createCert(ctx context.Context, name string) (*tls.Certificate, error) {
+	m.stateMu.Lock()
+	if s, ok := m.state[name]; ok {
+		m.stateMu.Unlock()
+		s.RLock()
+		defer s.RUnlock()
+		return s.tlscert()
+	}
+	// The new state is locked before it's published, so that concurrent
+	// calls wait for this one.
+	s := &certState{}
+	s.Lock()
+	defer s.Unlock()
+	if m.state == nil {
+		m.state = make(map[string]*certState)
+	}
+	m.state[name] = s
+	m.stateMu.Unlock()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		m.deleteState(name)
+		return nil, err
+	}
+	der, leaf, err := m.authorizedCert(ctx, key, name)
+	if err != nil {
+		// Let the next call try again.
+		m.deleteState(name)
+		return nil, err
+	}
+	s.key, s.cert, s.leaf = key, der, leaf
+	cert, err := s.tlscert()
+	if err != nil {
+		return nil, err
+	}
+	// The certificate is usable even if it can't be cached.
+	m.cachePut(ctx, name, cert)
+	m.startRenew(name, key, leaf.NotAfter)
+	return cert, nil
+}
+
+func (m *Manager) deleteState(name string) {
+	m.stateMu.Lock()
+	defer m.stateMu.Unlock()
+	delete(m.state, name)
+}
+
+// cacheGet returns the valid certificate of name from the cache, or
+// ErrCacheMiss.
+func (m *Manager) cacheGet(ctx context.Context, name string) (*tls.Certificate, error) {
+	if m.Cache == nil {
+		return nil, ErrCacheMiss
+	}
+	data, err := m.Cache.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The data is the private key followed by the certificate chain.
+	priv, rest := pem.Decode(data)
+	if priv == nil || priv.Type != "PRIVATE KEY" {
+		return nil, ErrCacheMiss
+	}
+	key, err := parsePrivateKey(priv.Bytes)
+	if err != nil {
+		return nil, ErrCacheMiss
+	}
+	var der [][]byte
+	for len(rest) > 0 {
+		var b *pem.Block
+		b, rest = pem.Decode(rest)
+		if b == nil || b.Type != "CERTIFICATE" {
+			return nil, ErrCacheMiss
+		}
+		der = append(der, b.Bytes)
+	}
+	leaf, err := validCert(name, der, key, time.Now())
+	if err != nil {
+		return nil, ErrCacheMiss
+	}
+	return &tls.Certificate{
+		Certificate: der,
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// cachePut stores cert as the certificate of name in the cache.
+func (m *Manager) cachePut(ctx context.Context, name string, cert *tls.Certificate) error {
+	if m.Cache == nil {
+		return nil
+	}
+	b, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	pem.Encode(&buf, &pem.Block{Type: "PRIVATE KEY", Bytes: b})
+	for _, der := range cert.Certificate {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	return m.Cache.Put(ctx, name, buf.Bytes())
+}
+
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("acme/autocert: unsupported private key type")
+	}
+	return signer, nil
+}
+
+// validCert parses the chain der and checks that its leaf is valid for name
+// at now, and that it belongs to key. The chain itself is not verified.
+func validCert(name string, der [][]byte, key crypto.Signer, now time.Time) (*x509.Certificate, error) {
+	if len(der) == 0 {
+		return nil, errors.New("acme/autocert: no certificate")
+	}
+	var certs []*x509.Certificate
+	for _, b := range der {
+		c, err := x509.ParseCertificate(b)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+	leaf := certs[0]
+	if now.Before(leaf.NotBefore) {
+		return nil, errors.New("acme/autocert: certificate is not valid yet")
+	}
+	if now.After(leaf.NotAfter) {
+		return nil, errors.New("acme/autocert: expired certificate")
+	}
+	if err := leaf.VerifyHostname(name); err != nil {
+		return nil, err
+	}
+	pub, ok := leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(key.Public()) {
+		return nil, errors.New("acme/autocert: certificate doesn't match the private key")
+	}
+	return leaf, nil
+}
+
+func (m *Manager) hostPolicy() HostPolicy {
+	if m.HostPolicy != nil {
+		return m.HostPolicy
+	}
+	return defaultHostPolicy
+}
+
+func (m *Manager) renewBefore() time.Duration {
+	if m.RenewBefore > 0 {
+		return m.RenewBefore
+	}
+	return 30 * 24 * time.Hour
+}
+
+// authorizedCert proves control of name to the CA and obtains a certificate
+// for key. It returns the certificate chain and its parsed leaf.
+func (m *Manager) authorizedCert(ctx context.Context, key crypto.Signer, name string) ([][]byte, *x509.Certificate, error) {
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+	}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := m.acmeClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	o, err := m.verify(ctx, client, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	der, _, err := client.CreateOrderCert(ctx, o.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	leaf, err := validCert(name, der, key, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return der, leaf, nil
+}
+
+// verify creates an order for name and fulfills its authorizations, trying
+// each supported challenge type in turn with a new order. It returns the
+// order once it's ready.
+func (m *Manager) verify(ctx context.Context, client *acme.Client, name string) (*acme.Order, error) {
+	var errs []error
+	for _, typ := range m.challengeTypes() {
+		o, err := client.AuthorizeOrder(ctx, acme.DomainIDs(name))
+		if err != nil {
+			return nil, err
+		}
+		switch o.Status {
+		case acme.StatusReady:
+			// The account is still authorized from an earlier order.
+			return o, nil
+		case acme.StatusPending:
+		default:
+			return nil, fmt.Errorf("acme/autocert: new order for %q is %s", name, o.Status)
+		}
+		if err := m.authorize(ctx, client, o, typ); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return client.WaitOrder(ctx, o.URI)
+	}
+	return nil, fmt.Errorf("acme/autocert: failed to verify %q: %w", name, errors.Join(errs...))
+}
+
+// challengeTypes returns the challenge types that m can fulfill, in order of
+// preference.
+func (m *Manager) challengeTypes() []string {
+	types := []string{acme.ChallengeTLSALPN01}
+	m.tokensMu.RLock()
+	defer m.tokensMu.RUnlock()
+	if m.tryHTTP01 {
+		types = append(types, acme.ChallengeHTTP01)
+	}
+	return types
+}
+
+// authorize fulfills the pending authorizations of o with challenges of
+// type typ.
+func (m *Manager) authorize(ctx context.Context, client *acme.Client, o *acme.Order, typ string) error {
+	for _, u := range o.AuthzURLs {
+		z, err := client.GetAuthorization(ctx, u)
+		if err != nil {
+			return err
+		}
+		switch z.Status {
+		case acme.StatusValid:
+			continue
+		case acme.StatusPending:
+		default:
+			return fmt.Errorf("acme/autocert: authorization for %q is %s", z.Identifier.Value, z.Status)
+		}
+		i := slices.IndexFunc(z.Challenges, func(c *acme.Challenge) bool { return c.Type == typ })
+		if i < 0 {
+			return fmt.Errorf("acme/autocert: %s challenge not offered for %q", typ, z.Identifier.Value)
+		}
+		chal := z.Challenges[i]
+		cleanup, err := m.fulfill(ctx, client, chal, z.Identifier.Value)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if _, err := client.Accept(ctx, chal); err != nil {
+			return err
+		}
+		if _, err := client.WaitAuthorization(ctx, z.URI); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fulfill puts the response to chal in place, and returns a function that
+// removes it.
+func (m *Manager) fulfill(ctx context.Context, client *acme.Client, chal *acme.Challenge, name string) (cleanup func(), err error) {
+	switch chal.Type {
+	case acme.ChallengeTLSALPN01:
+		cert, err := client.TLSALPN01ChallengeCert(chal.Token, name)
+		if err != nil {
+			return nil, err
+		}
+		m.tokensMu.Lock()
+		defer m.tokensMu.Unlock()
+		if m.certTokens == nil {
+			m.certTokens = make(map[string]*tls.Certificate)
+		}
+		m.certTokens[name] = &cert
+		return func() {
+			m.tokensMu.Lock()
+			defer m.tokensMu.Unlock()
+			delete(m.certTokens, name)
+		}, nil
+
+	case acme.ChallengeHTTP01:
+		resp, err := client.HTTP01ChallengeResponse(chal.Token)
+		if err != nil {
+			return nil, err
+		}
+		path := client.HTTP01ChallengePath(chal.Token)
+		// The response is also cached, for other instances that may
+		// receive the validation request.
+		if m.Cache != nil {
+			if err := m.Cache.Put(ctx, httpTokenCacheKey(path), []byte(resp)); err != nil {
+				return nil, err
+			}
+		}
+		m.tokensMu.Lock()
+		defer m.tokensMu.Unlock()
+		if m.httpTokens == nil {
+			m.httpTokens = make(map[string][]byte)
+		}
+		m.httpTokens[path] = []byte(resp)
+		return func() {
+			m.tokensMu.Lock()
+			delete(m.httpTokens, path)
+			m.tokensMu.Unlock()
+			if m.Cache != nil {
+				m.Cache.Delete(context.Background(), httpTokenCacheKey(path))
+			}
+		}, nil
+	}
+	return nil, fmt.Errorf("acme/autocert: unsupported challenge type %q", chal.Type)
+}
+
+// httpTokenCacheKey returns the cache key of the HTTP-01 response at path.
+func httpTokenCacheKey(path string) string {
+	return strings.TrimPrefix(path, httpChallengePrefix) + "+http-01"
+}
+
+const httpChallengePrefix = "/.well-known/acme-challenge/"
+
+// HTTPHandler returns a handler that answers HTTP-01 challenges and passes
+// other requests to fallback. If fallback is nil, other GET and HEAD
+// requests are redirected to HTTPS, and the rest are rejected.
+//
+// Calling HTTPHandler enables the HTTP-01 challenge type, which is tried
+// after TLS-ALPN-01. The handler must serve port 80.
+func (m *Manager) HTTPHandler(fallback http.Handler) http.Handler {
+	m.tokensMu.Lock()
+	m.tryHTTP01 = true
+	m.tokensMu.Unlock()
+
+	if fallback == nil {
+		fallback = http.HandlerFunc(handleHTTPRedirect)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, httpChallengePrefix) {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if err := m.hostPolicy()(r.Context(), host); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		resp, err := m.httpToken(r.Context(), r.URL.Path)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Write(resp)
+	})
+}
+
+// httpToken returns the HTTP-01 response at path, from memory or from the
+// cache.
+func (m *Manager) httpToken(ctx context.Context, path string) ([]byte, error) {
+	m.tokensMu.RLock()
+	resp, ok := m.httpTokens[path]
+	m.tokensMu.RUnlock()
+	if ok {
+		return resp, nil
+	}
+	if m.Cache == nil {
+		return nil, errors.New("acme/autocert: no token at " + path)
+	}
+	return m.Cache.Get(ctx, httpTokenCacheKey(path))
+}
+
+func handleHTTPRedirect(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "Use HTTPS", http.StatusBadRequest)
+		return
+	}
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusFound)
+}
+
+// acmeClient returns the client used to talk to the CA, registering its
+// account first if needed.
+func (m *Manager) acmeClient(ctx context.Context) (*acme.Client, error) {
+	m.clientMu.Lock()
+	defer m.clientMu.Unlock()
+	if m.client != nil {
+		return m.client, nil
+	}
+
+	client := m.Client
+	if client == nil {
+		client = &acme.Client{DirectoryURL: acme.LetsEncryptURL}
+	}
+	if client.Key == nil {
+		key, err := m.accountKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		client.Key = key
+	}
+	if client.UserAgent == "" {
+		client.UserAgent = "autocert"
+	}
+	var contact []string
+	if m.Email != "" {
+		contact = []string{"mailto:" + m.Email}
+	}
+	_, err := client.Register(ctx, &acme.Account{Contact: contact}, m.Prompt)
+	if err != nil && err != acme.ErrAccountAlreadyExists {
+		return nil, err
+	}
+	m.client = client
+	return client, nil
+}
+
+// accountKey returns the account key from the cache, or generates and
+// caches a new one.
+func (m *Manager) accountKey(ctx context.Context) (crypto.Signer, error) {
+	if m.Cache != nil {
+		data, err := m.Cache.Get(ctx, accountKeyName)
+		switch {
+		case err == nil:
+			b, _ := pem.Decode(data)
+			if b == nil || b.Type != "PRIVATE KEY" {
+				return nil, errors.New("acme/autocert: invalid account key in cache")
+			}
+			return parsePrivateKey(b.Bytes)
+		case err != ErrCacheMiss:
+			return nil, err
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if m.Cache != nil {
+		b, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})
+		if err := m.Cache.Put(ctx, accountKeyName, data); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
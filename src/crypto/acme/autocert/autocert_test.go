@@ -0,0 +1,414 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autocert
+
+import (
+	"context"
+	"crypto/acme"
+	"crypto/acme/internal/acmetest"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testDomain = "example.org"
+
+func newTestManager(t *testing.T, ca *acmetest.CAServer, cache Cache) *Manager {
+	m := &Manager{
+		Prompt: AcceptTOS,
+		Cache:  cache,
+		Client: &acme.Client{DirectoryURL: ca.URL()},
+	}
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+// serveTLS serves connections with the TLSConfig of m, and makes ca validate
+// the challenges of testDomain there. It returns the address of the server.
+func serveTLS(t *testing.T, ca *acmetest.CAServer, m *Manager) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", m.TLSConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}()
+		}
+	}()
+	ca.Resolve(testDomain, ln.Addr().String())
+	return ln.Addr().String()
+}
+
+// dial connects to addr, and returns the certificate of testDomain it
+// presents, after verifying it with the roots of ca.
+func dial(t *testing.T, ca *acmetest.CAServer, addr string) *x509.Certificate {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		ServerName: testDomain,
+		RootCAs:    ca.Roots(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0]
+}
+
+// getCertificate calls GetCertificate for testDomain, and verifies the
+// certificate with the roots of ca.
+func getCertificate(t *testing.T, ca *acmetest.CAServer, m *Manager) *x509.Certificate {
+	t.Helper()
+	cert, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: testDomain})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cert.Leaf.Verify(x509.VerifyOptions{DNSName: testDomain, Roots: ca.Roots()}); err != nil {
+		t.Fatal(err)
+	}
+	return cert.Leaf
+}
+
+func TestGetCertificateTLSALPN01(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	addr := serveTLS(t, ca, m)
+
+	leaf := dial(t, ca, addr)
+	if n := ca.IssuedCerts(); n != 1 {
+		t.Errorf("CA issued %d certificates, want 1", n)
+	}
+	if again := dial(t, ca, addr); !again.Equal(leaf) {
+		t.Error("certificate wasn't reused")
+	}
+	if n := ca.IssuedCerts(); n != 1 {
+		t.Errorf("CA issued %d certificates, want 1", n)
+	}
+}
+
+func TestGetCertificateHTTP01(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	srv := httptest.NewServer(m.HTTPHandler(nil))
+	defer srv.Close()
+	// The address only serves HTTP, so the TLS-ALPN-01 challenge, which is
+	// tried first, fails, and the HTTP-01 challenge is used instead.
+	ca.Resolve(testDomain, srv.Listener.Addr().String())
+
+	getCertificate(t, ca, m)
+	if n := ca.IssuedCerts(); n != 1 {
+		t.Errorf("CA issued %d certificates, want 1", n)
+	}
+	if len(m.httpTokens) != 0 || len(m.certTokens) != 0 {
+		t.Error("challenge responses weren't removed")
+	}
+}
+
+func TestGetCertificateConcurrent(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	serveTLS(t, ca, m)
+
+	const n = 4
+	leaves := make(chan *x509.Certificate, n)
+	for range n {
+		go func() {
+			cert, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: testDomain})
+			if err != nil {
+				t.Error(err)
+				leaves <- nil
+				return
+			}
+			leaves <- cert.Leaf
+		}()
+	}
+	first := <-leaves
+	for range n - 1 {
+		if leaf := <-leaves; first == nil || leaf == nil || !leaf.Equal(first) {
+			t.Error("concurrent calls returned different certificates")
+		}
+	}
+	if n := ca.IssuedCerts(); n != 1 {
+		t.Errorf("CA issued %d certificates, want 1", n)
+	}
+}
+
+func TestCache(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	cache := DirCache(t.TempDir())
+	m := newTestManager(t, ca, cache)
+	serveTLS(t, ca, m)
+	leaf := getCertificate(t, ca, m)
+
+	ctx := context.Background()
+	for _, key := range []string{accountKeyName, testDomain} {
+		if _, err := cache.Get(ctx, key); err != nil {
+			t.Errorf("%s not cached: %v", key, err)
+		}
+	}
+
+	// A new Manager loads the certificate from the cache.
+	m2 := newTestManager(t, ca, cache)
+	if got := getCertificate(t, ca, m2); !got.Equal(leaf) {
+		t.Error("certificate wasn't loaded from the cache")
+	}
+	if n := ca.IssuedCerts(); n != 1 {
+		t.Errorf("CA issued %d certificates, want 1", n)
+	}
+
+	// A new Manager reuses the cached account key for new certificates.
+	if err := cache.Delete(ctx, testDomain); err != nil {
+		t.Fatal(err)
+	}
+	m3 := newTestManager(t, ca, cache)
+	serveTLS(t, ca, m3)
+	getCertificate(t, ca, m3)
+	if got, want := m3.Client.KID, m.Client.KID; got != want {
+		t.Errorf("account %q, want %q", got, want)
+	}
+
+	// Invalid cache entries are ignored.
+	if err := cache.Put(ctx, testDomain, []byte("garbage")); err != nil {
+		t.Fatal(err)
+	}
+	m4 := newTestManager(t, ca, cache)
+	serveTLS(t, ca, m4)
+	getCertificate(t, ca, m4)
+	if n := ca.IssuedCerts(); n != 3 {
+		t.Errorf("CA issued %d certificates, want 3", n)
+	}
+}
+
+func TestHostPolicy(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	m.HostPolicy = HostAllowlist("Example.org")
+	serveTLS(t, ca, m)
+
+	for _, name := range []string{"other.example.org", "", "localhost", "a+b.example.org", "a/b.example.org"} {
+		if _, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: name}); err == nil {
+			t.Errorf("GetCertificate(%q) succeeded", name)
+		}
+	}
+	if n := ca.IssuedCerts(); n != 0 {
+		t.Errorf("CA issued %d certificates, want 0", n)
+	}
+	getCertificate(t, ca, m)
+
+	h := m.HTTPHandler(nil)
+	req := httptest.NewRequest("GET", "http://other.example.org/.well-known/acme-challenge/token", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("challenge request for disallowed host: got status %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestHTTPHandlerFallback(t *testing.T) {
+	m := &Manager{Prompt: AcceptTOS}
+	h := m.HTTPHandler(nil)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "http://example.org:80/path?q=1", nil))
+	if rec.Code != http.StatusFound || rec.Header().Get("Location") != "https://example.org/path?q=1" {
+		t.Errorf("GET: got status %d, Location %q", rec.Code, rec.Header().Get("Location"))
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("POST", "http://example.org/path", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("POST: got status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "http://example.org/.well-known/acme-challenge/unknown", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown token: got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	h = m.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "http://example.org/", nil))
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("fallback: got status %d, want %d", rec.Code, http.StatusTeapot)
+	}
+}
+
+func TestRenewal(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	ca.CertLifetime(24 * time.Hour)
+	cache := DirCache(t.TempDir())
+	m := newTestManager(t, ca, cache)
+	m.RenewBefore = 48 * time.Hour
+	serveTLS(t, ca, m)
+	leaf := getCertificate(t, ca, m)
+
+	m.renewalMu.Lock()
+	dr := m.renewal[testDomain]
+	m.renewalMu.Unlock()
+	if dr == nil {
+		t.Fatal("renewal not scheduled")
+	}
+	next, err := dr.do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != minRenewInterval {
+		t.Errorf("next renewal in %v, want %v", next, minRenewInterval)
+	}
+
+	renewed := getCertificate(t, ca, m)
+	if renewed.Equal(leaf) {
+		t.Fatal("certificate wasn't renewed")
+	}
+	if !renewed.PublicKey.(*ecdsa.PublicKey).Equal(leaf.PublicKey) {
+		t.Error("renewed certificate has a new key")
+	}
+	m2 := newTestManager(t, ca, cache)
+	if got := getCertificate(t, ca, m2); !got.Equal(renewed) {
+		t.Error("renewed certificate wasn't cached")
+	}
+
+	// A certificate renewed by another instance is picked up from the cache.
+	m.RenewBefore = time.Hour
+	next, err = dr.do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next <= minRenewInterval || next > 23*time.Hour {
+		t.Errorf("next renewal in %v", next)
+	}
+	if n := ca.IssuedCerts(); n != 2 {
+		t.Errorf("CA issued %d certificates, want 2", n)
+	}
+}
+
+// blockingCache is a Cache whose lookups of slowName block until release
+// is closed. Other lookups miss.
+type blockingCache struct {
+	slowName string
+	started  chan struct{}
+	release  chan struct{}
+}
+
+func (c *blockingCache) Get(ctx context.Context, key string) ([]byte, error) {
+	if key == c.slowName {
+		close(c.started)
+		<-c.release
+	}
+	return nil, ErrCacheMiss
+}
+
+func (c *blockingCache) Put(ctx context.Context, key string, data []byte) error { return nil }
+func (c *blockingCache) Delete(ctx context.Context, key string) error           { return nil }
+
+func TestSlowCache(t *testing.T) {
+	cache := &blockingCache{
+		slowName: "slow.example.org",
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+	}
+	m := &Manager{Prompt: AcceptTOS, Cache: cache}
+	ctx := context.Background()
+	slow := make(chan error)
+	go func() {
+		_, err := m.cert(ctx, cache.slowName)
+		slow <- err
+	}()
+	<-cache.started
+
+	// A slow lookup doesn't hold up the other host names.
+	if _, err := m.cert(ctx, testDomain); err != ErrCacheMiss {
+		t.Errorf("cert(%q): got error %v, want %v", testDomain, err, ErrCacheMiss)
+	}
+
+	// Concurrent calls for the same name wait for the lookup in progress.
+	same := make(chan error)
+	go func() {
+		_, err := m.cert(ctx, cache.slowName)
+		same <- err
+	}()
+	select {
+	case err := <-same:
+		t.Fatalf("cert returned %v during the lookup", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(cache.release)
+	for _, c := range []chan error{slow, same} {
+		if err := <-c; err != ErrCacheMiss {
+			t.Errorf("cert(%q): got error %v, want %v", cache.slowName, err, ErrCacheMiss)
+		}
+	}
+}
+
+func TestClose(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	serveTLS(t, ca, m)
+	leaf := getCertificate(t, ca, m)
+
+	m.renewalMu.Lock()
+	dr := m.renewal[testDomain]
+	m.renewalMu.Unlock()
+	if dr == nil {
+		t.Fatal("renewal not scheduled")
+	}
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	dr.timerMu.Lock()
+	stopped := dr.timer == nil
+	dr.timerMu.Unlock()
+	if !stopped || len(m.renewal) != 0 {
+		t.Error("renewal not stopped")
+	}
+
+	// The certificate is still served, and no renewal is scheduled
+	// for new ones.
+	if got := getCertificate(t, ca, m); !got.Equal(leaf) {
+		t.Error("certificate not served after Close")
+	}
+	m.startRenew("other.example.org", dr.key, leaf.NotAfter)
+	if len(m.renewal) != 0 {
+		t.Error("renewal scheduled after Close")
+	}
+}
+
+func TestGetCertificateErrors(t *testing.T) {
+	ca := acmetest.NewCAServer(t)
+	m := newTestManager(t, ca, nil)
+	m.Prompt = nil
+	if _, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: testDomain}); err == nil || !strings.Contains(err.Error(), "Prompt") {
+		t.Errorf("GetCertificate without Prompt: got error %v", err)
+	}
+
+	m.Prompt = AcceptTOS
+	for _, name := range []string{"example", "a+b.example", "a/b.example", `a\b.example`, "..example.org", "a..example.org"} {
+		if _, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: name}); err == nil {
+			t.Errorf("GetCertificate(%q) succeeded", name)
+		}
+	}
+
+	// The challenge is not served anywhere.
+	if _, err := m.GetCertificate(&tls.ClientHelloInfo{ServerName: testDomain}); err == nil {
+		t.Error("GetCertificate succeeded without a challenge server")
+	}
+	hello := &tls.ClientHelloInfo{ServerName: testDomain, SupportedProtos: []string{acme.ALPNProto}}
+	if _, err := m.GetCertificate(hello); err == nil {
+		t.Error("GetCertificate returned a challenge certificate with no challenge in progress")
+	}
+}
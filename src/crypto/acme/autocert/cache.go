@@ -0,0 +1,102 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autocert
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ErrCacheMiss is returned by a Cache when a certificate is not found.
+var ErrCacheMiss = errors.New("acme/autocert: certificate cache miss")
+
+// Cache is used by Manager to store and retrieve certificates, keys and
+// other data, so that they survive restarts and can be shared by instances
+// serving the same domains.
+//
+// Keys are host names, or names containing a '+' character for other data.
+// Values are opaque; they hold private keys and must be kept secret.
+//
+// A Cache must be safe for concurrent use.
+type Cache interface {
+	// Get returns the data stored for key, or ErrCacheMiss if there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores data for key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the data stored for key. It is not an error if there
+	// is none.
+	Delete(ctx context.Context, key string) error
+}
+
+// DirCache implements Cache using a directory on the local file system.
+// The directory is created with permissions 0700 if it doesn't exist, and
+// files are written atomically with permissions 0600.
+//
+// Keys are cleaned as rooted paths before use, so that they can't refer to
+// files outside of the directory.
+type DirCache string
+
+// path returns the name of the file holding key.
+func (d DirCache) path(key string) string {
+	return filepath.Join(string(d), filepath.Clean("/"+key))
+}
+
+// Get reads the file named key in the directory.
+func (d DirCache) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(d.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrCacheMiss
+	}
+	return data, err
+}
+
+// Put writes data to the file named key in the directory, replacing it
+// atomically.
+func (d DirCache) Put(ctx context.Context, key string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(string(d), 0700); err != nil {
+		return err
+	}
+	name := d.path(key)
+	// CreateTemp creates files with permissions 0600.
+	f, err := os.CreateTemp(filepath.Dir(name), "tmp-"+filepath.Base(name)+"-")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// Delete removes the file named key from the directory.
+func (d DirCache) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := os.Remove(d.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestDirCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "certs")
+	cache := DirCache(dir)
+	ctx := context.Background()
+
+	if _, err := cache.Get(ctx, "example.org"); err != ErrCacheMiss {
+		t.Fatalf("Get before Put: got error %v, want ErrCacheMiss", err)
+	}
+	data := []byte("data")
+	if err := cache.Put(ctx, "example.org", data); err != nil {
+		t.Fatal(err)
+	}
+	got, err := cache.Get(ctx, "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get = %q, want %q", got, data)
+	}
+	if err := cache.Put(ctx, "example.org", []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := cache.Get(ctx, "example.org"); string(got) != "new" {
+		t.Errorf("Get after second Put = %q, want %q", got, "new")
+	}
+
+	if runtime.GOOS != "windows" {
+		for name, want := range map[string]os.FileMode{dir: 0700, filepath.Join(dir, "example.org"): 0600} {
+			fi, err := os.Stat(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := fi.Mode().Perm(); perm != want {
+				t.Errorf("%s has permissions %v, want %v", name, perm, want)
+			}
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("cache directory holds %d files, want 1", len(entries))
+	}
+
+	if err := cache.Delete(ctx, "example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Get(ctx, "example.org"); err != ErrCacheMiss {
+		t.Errorf("Get after Delete: got error %v, want ErrCacheMiss", err)
+	}
+	if err := cache.Delete(ctx, "example.org"); err != nil {
+		t.Errorf("Delete of missing key: %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := cache.Put(canceled, "example.org", data); err == nil {
+		t.Error("Put with canceled context succeeded")
+	}
+}
+
+func TestDirCacheTraversal(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "certs")
+	cache := DirCache(dir)
+	ctx := context.Background()
+
+	secret := filepath.Join(root, "secret")
+	if err := os.WriteFile(secret, []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Get(ctx, "../secret"); err != ErrCacheMiss {
+		t.Errorf("Get(../secret): got error %v, want ErrCacheMiss", err)
+	}
+	if err := cache.Put(ctx, "../secret", []byte("overwritten")); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "secret")); err != nil || string(got) != "overwritten" {
+		t.Errorf("file inside of the cache is %q, %v; want %q", got, err, "overwritten")
+	}
+	if err := cache.Delete(ctx, "../../secret"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "secret")); err == nil {
+		t.Error("Delete(../../secret) didn't remove the file inside of the cache")
+	}
+	if got, err := os.ReadFile(secret); err != nil || string(got) != "secret" {
+		t.Errorf("file outside of the cache is %q, %v; want %q", got, err, "secret")
+	}
+}
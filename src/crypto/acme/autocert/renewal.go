@@ -0,0 +1,163 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autocert
+
+import (
+	"context"
+	"crypto"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	// renewJitter is the maximum random delay subtracted from the renewal
+	// time, so that instances sharing a cache don't renew at once.
+	renewJitter = time.Hour
+
+	// minRenewInterval is the minimum delay between renewals, which keeps
+	// a RenewBefore longer than the lifetime of the certificates from
+	// causing a renewal loop.
+	minRenewInterval = time.Minute
+
+	// renewTimeout bounds the time spent renewing a certificate.
+	renewTimeout = 10 * time.Minute
+)
+
+// domainRenewal renews the certificate of a host name, reusing its key.
+type domainRenewal struct {
+	m    *Manager
+	name string
+	key  crypto.Signer
+
+	timerMu sync.Mutex
+	timer   *time.Timer        // nil once stopped
+	cancel  context.CancelFunc // cancels the renewal in progress, if any
+	retry   time.Duration
+}
+
+// startRenew schedules the renewal of the certificate of name, which
+// expires at exp, unless it's already scheduled or m is closed.
+func (m *Manager) startRenew(name string, key crypto.Signer, exp time.Time) {
+	m.renewalMu.Lock()
+	defer m.renewalMu.Unlock()
+	if m.renewal[name] != nil || m.closed {
+		return
+	}
+	if m.renewal == nil {
+		m.renewal = make(map[string]*domainRenewal)
+	}
+	dr := &domainRenewal{m: m, name: name, key: key}
+	m.renewal[name] = dr
+	dr.timer = time.AfterFunc(dr.next(exp), dr.renew)
+}
+
+// Close stops the renewal of the certificates of m, and cancels the
+// renewals in progress without waiting for them to return. It should be
+// called once m is no longer used, to release the timers that schedule
+// the renewals.
+//
+// Certificates obtained after Close are not renewed either, but m keeps
+// serving the certificates it has. Close always returns nil.
+func (m *Manager) Close() error {
+	m.renewalMu.Lock()
+	defer m.renewalMu.Unlock()
+	m.closed = true
+	for name, dr := range m.renewal {
+		dr.stop()
+		delete(m.renewal, name)
+	}
+	return nil
+}
+
+func (dr *domainRenewal) stop() {
+	dr.timerMu.Lock()
+	defer dr.timerMu.Unlock()
+	if dr.timer != nil {
+		dr.timer.Stop()
+		dr.timer = nil
+	}
+	if dr.cancel != nil {
+		dr.cancel()
+	}
+}
+
+// renew renews the certificate and schedules the next renewal. Failed
+// renewals are retried with an exponential backoff.
+func (dr *domainRenewal) renew() {
+	dr.timerMu.Lock()
+	if dr.timer == nil {
+		dr.timerMu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), renewTimeout)
+	defer cancel()
+	dr.cancel = cancel
+	dr.timerMu.Unlock()
+
+	next, err := dr.do(ctx)
+
+	dr.timerMu.Lock()
+	defer dr.timerMu.Unlock()
+	dr.cancel = nil
+	if dr.timer == nil {
+		return
+	}
+	if err != nil {
+		dr.retry = min(max(2*dr.retry, minRenewInterval), renewJitter)
+		next = dr.retry
+	} else {
+		dr.retry = 0
+	}
+	dr.timer = time.AfterFunc(next, dr.renew)
+}
+
+// do obtains a new certificate, unless the one in the cache was already
+// renewed by another instance, and returns the delay until the next renewal.
+func (dr *domainRenewal) do(ctx context.Context) (time.Duration, error) {
+	if cert, err := dr.m.cacheGet(ctx, dr.name); err == nil {
+		if next := dr.next(cert.Leaf.NotAfter); next > minRenewInterval {
+			dr.m.setState(dr.name, &certState{
+				key:  cert.PrivateKey.(crypto.Signer),
+				cert: cert.Certificate,
+				leaf: cert.Leaf,
+			})
+			return next, nil
+		}
+	}
+
+	der, leaf, err := dr.m.authorizedCert(ctx, dr.key, dr.name)
+	if err != nil {
+		return 0, err
+	}
+	s := &certState{key: dr.key, cert: der, leaf: leaf}
+	cert, err := s.tlscert()
+	if err != nil {
+		return 0, err
+	}
+	if err := dr.m.cachePut(ctx, dr.name, cert); err != nil {
+		return 0, err
+	}
+	dr.m.setState(dr.name, s)
+	return dr.next(leaf.NotAfter), nil
+}
+
+// next returns the delay until the renewal of a certificate that expires
+// at exp.
+func (dr *domainRenewal) next(exp time.Time) time.Duration {
+	d := time.Until(exp) - dr.m.renewBefore()
+	d -= rand.N(renewJitter)
+	return max(d, minRenewInterval)
+}
+
+// setState replaces the certificate of name in memory.
+func (m *Manager) setState(name string, s *certState) {
+	m.stateMu.Lock()
+	defer m.stateMu.Unlock()
+	if m.state == nil {
+		m.state = make(map[string]*certState)
+	}
+	m.state[name] = s
+}
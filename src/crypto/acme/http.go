@@ -0,0 +1,226 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxResponseSize bounds the size of the responses that are read.
+const maxResponseSize = 1 << 20
+
+// maxNonceRetries is the number of times a request rejected because of
+// a bad nonce is retried with a new one, RFC 8555, Section 6.5.
+const maxNonceRetries = 3
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) userAgent() string {
+	const ua = "Go-http-client crypto/acme"
+	if c.UserAgent != "" {
+		return c.UserAgent + " " + ua
+	}
+	return ua
+}
+
+// do sends req and stores the nonce of the response.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", c.userAgent())
+	res, err := c.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	c.addNonce(res.Header)
+	return res, nil
+}
+
+// get sends a GET request to url, and returns the response if its status code
+// is one of ok. Otherwise, it returns the error reported by the server.
+func (c *Client) get(ctx context.Context, url string, ok ...int) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res, ok); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// post sends a request to url with the JWS of body, signed by key.
+// If key is nil, the request is signed by the account key and refers to
+// the account by its URL. Otherwise, the public key is embedded in the
+// request. If body is &noPayload, it's a POST-as-GET request.
+//
+// post returns the response if its status code is one of ok. Otherwise,
+// it returns the error reported by the server, after retrying requests
+// rejected because of a bad nonce.
+func (c *Client) post(ctx context.Context, key crypto.Signer, url string, body any, ok ...int) (*http.Response, error) {
+	kid := ""
+	if key == nil {
+		key = c.Key
+		kid = c.KID
+		if kid == "" {
+			return nil, errors.New("acme: no account registered")
+		}
+	}
+	for retry := 0; ; retry++ {
+		nonce, err := c.nonce(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := jwsEncodeJSON(body, key, kid, nonce, url)
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/jose+json")
+		res, err := c.do(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		err = checkResponse(res, ok)
+		if err == nil {
+			return res, nil
+		}
+		if e, ok := err.(*Error); !ok || e.ProblemType != problemBadNonce || retry == maxNonceRetries {
+			return nil, err
+		}
+	}
+}
+
+// checkResponse returns nil if the status code of res is one of ok, and
+// otherwise closes the body of res and returns the error it reports.
+func checkResponse(res *http.Response, ok []int) error {
+	for _, code := range ok {
+		if res.StatusCode == code {
+			return nil
+		}
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	var e wireError
+	if json.Unmarshal(body, &e) != nil || e.Type == "" {
+		// The server didn't send a problem document.
+		return &Error{
+			StatusCode:  res.StatusCode,
+			ProblemType: "about:blank",
+			Detail:      strings.TrimSpace(string(body)),
+			Header:      res.Header,
+		}
+	}
+	e.Status = res.StatusCode
+	return e.error(res.Header)
+}
+
+// decodeResponse decodes the JSON body of res into v and closes it.
+func decodeResponse(res *http.Response, v any) error {
+	defer res.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(v); err != nil {
+		return fmt.Errorf("acme: invalid response: %v", err)
+	}
+	return nil
+}
+
+// nonce returns an unused nonce, fetching a new one if none is left.
+func (c *Client) nonce(ctx context.Context) (string, error) {
+	c.noncesMu.Lock()
+	if n := len(c.nonces); n > 0 {
+		nonce := c.nonces[n-1]
+		c.nonces = c.nonces[:n-1]
+		c.noncesMu.Unlock()
+		return nonce, nil
+	}
+	c.noncesMu.Unlock()
+
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest("HEAD", dir.NonceURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", c.userAgent())
+	res, err := c.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	res.Body.Close()
+	nonce := res.Header.Get("Replay-Nonce")
+	if nonce == "" {
+		if res.StatusCode >= 400 {
+			return "", &Error{StatusCode: res.StatusCode, ProblemType: "about:blank", Header: res.Header}
+		}
+		return "", errors.New("acme: server did not provide a nonce")
+	}
+	return nonce, nil
+}
+
+// maxNonces bounds the number of nonces kept for later requests.
+const maxNonces = 100
+
+// addNonce stores the nonce in h, if any.
+func (c *Client) addNonce(h http.Header) {
+	nonce := h.Get("Replay-Nonce")
+	if nonce == "" {
+		return
+	}
+	c.noncesMu.Lock()
+	defer c.noncesMu.Unlock()
+	if len(c.nonces) < maxNonces {
+		c.nonces = append(c.nonces, nonce)
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header of h,
+// or def if there is none.
+func retryAfter(h http.Header, def time.Duration) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return def
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return def
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,1011 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package acmetest provides an in-process ACME certificate authority, in the
+// spirit of Pebble, for testing ACME clients without network access.
+//
+// The server implements the subset of RFC 8555 used by crypto/acme, and
+// validates HTTP-01 and TLS-ALPN-01 challenges synchronously, against
+// addresses registered with [CAServer.Resolve]. It deliberately doesn't
+// import crypto/acme, so that it checks the client independently.
+package acmetest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A CAServer is an ACME server backed by a test certificate authority.
+type CAServer struct {
+	t      testing.TB
+	srv    *httptest.Server
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	mu             sync.Mutex
+	challengeTypes []string
+	certLifetime   time.Duration
+	resolve        map[string]string // domain name to address
+	badNonces      int               // requests to reject with badNonce
+	nextID         int
+	accounts       []*account
+	orders         []*order
+	authzs         []*authz
+	challenges     []*challenge
+	certs          []*issuedCert
+
+	// noncesMu guards nonces, which are added to responses while mu is
+	// held.
+	noncesMu sync.Mutex
+	nonces   map[string]bool
+}
+
+type account struct {
+	url     string
+	key     crypto.PublicKey
+	contact []string
+}
+
+type order struct {
+	id          int
+	acct        *account
+	status      string
+	identifiers []identifier
+	authzs      []*authz
+	cert        *issuedCert
+	err         *problem
+}
+
+type authz struct {
+	id         int
+	acct       *account
+	order      *order
+	status     string
+	identifier identifier
+	challenges []*challenge
+}
+
+type challenge struct {
+	id        int
+	authz     *authz
+	typ       string
+	token     string
+	status    string
+	validated time.Time
+	err       *problem
+}
+
+type issuedCert struct {
+	id      int
+	acct    *account
+	der     []byte
+	revoked bool
+}
+
+type identifier struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type problem struct {
+	Type   string `json:"type"`
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+}
+
+const problemPrefix = "urn:ietf:params:acme:error:"
+
+// NewCAServer starts a CAServer that offers HTTP-01 and TLS-ALPN-01
+// challenges and issues certificates valid for 90 days. It is closed when
+// the test ends.
+func NewCAServer(t testing.TB) *CAServer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "acmetest root"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca := &CAServer{
+		t:              t,
+		caKey:          key,
+		caCert:         cert,
+		challengeTypes: []string{"tls-alpn-01", "http-01"},
+		certLifetime:   90 * 24 * time.Hour,
+		resolve:        make(map[string]string),
+		nonces:         make(map[string]bool),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /directory", ca.handleDirectory)
+	mux.HandleFunc("/new-nonce", ca.handleNewNonce)
+	mux.HandleFunc("POST /new-account", ca.handleNewAccount)
+	mux.HandleFunc("POST /new-order", ca.handleNewOrder)
+	mux.HandleFunc("POST /revoke-cert", ca.handleRevokeCert)
+	mux.HandleFunc("POST /acct/{id}", ca.handleAccount)
+	mux.HandleFunc("POST /order/{id}", ca.handleOrder)
+	mux.HandleFunc("POST /authz/{id}", ca.handleAuthz)
+	mux.HandleFunc("POST /chal/{id}", ca.handleChallenge)
+	mux.HandleFunc("POST /finalize/{id}", ca.handleFinalize)
+	mux.HandleFunc("POST /cert/{id}", ca.handleCert)
+	ca.srv = httptest.NewServer(mux)
+	t.Cleanup(ca.srv.Close)
+	return ca
+}
+
+// URL returns the directory URL of the server.
+func (ca *CAServer) URL() string {
+	return ca.srv.URL + "/directory"
+}
+
+// Roots returns a pool that holds the root certificate of the CA.
+func (ca *CAServer) Roots() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.caCert)
+	return pool
+}
+
+// ChallengeTypes sets the types of the challenges offered in new
+// authorizations, in order.
+func (ca *CAServer) ChallengeTypes(types ...string) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.challengeTypes = types
+}
+
+// CertLifetime sets the validity period of the certificates issued from
+// then on.
+func (ca *CAServer) CertLifetime(d time.Duration) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.certLifetime = d
+}
+
+// Resolve makes the server validate challenges for domain by connecting to
+// addr, in place of ports 80 and 443 of the domain.
+func (ca *CAServer) Resolve(domain, addr string) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.resolve[domain] = addr
+}
+
+// RejectNonces makes the server reject the nonces of the next n signed
+// requests with a badNonce error.
+func (ca *CAServer) RejectNonces(n int) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	ca.badNonces = n
+}
+
+// IssuedCerts returns the number of certificates issued so far.
+func (ca *CAServer) IssuedCerts() int {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	return len(ca.certs)
+}
+
+// Revoked reports whether the DER-encoded certificate der was revoked.
+func (ca *CAServer) Revoked(der []byte) bool {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	for _, c := range ca.certs {
+		if bytes.Equal(c.der, der) {
+			return c.revoked
+		}
+	}
+	return false
+}
+
+func (ca *CAServer) url(path string, id int) string {
+	return ca.srv.URL + "/" + path + "/" + strconv.Itoa(id)
+}
+
+func (ca *CAServer) newID() int {
+	ca.nextID++
+	return ca.nextID
+}
+
+// addNonce sets a new nonce in the Replay-Nonce header of w.
+func (ca *CAServer) addNonce(w http.ResponseWriter) {
+	b := make([]byte, 16)
+	rand.Read(b)
+	nonce := base64.RawURLEncoding.EncodeToString(b)
+	ca.noncesMu.Lock()
+	ca.nonces[nonce] = true
+	ca.noncesMu.Unlock()
+	w.Header().Set("Replay-Nonce", nonce)
+}
+
+func (ca *CAServer) writeJSON(w http.ResponseWriter, status int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		ca.t.Errorf("acmetest: %v", err)
+		status, b = http.StatusInternalServerError, nil
+	}
+	ca.addNonce(w)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+func (ca *CAServer) writeProblem(w http.ResponseWriter, p *problem) {
+	b, _ := json.Marshal(p)
+	ca.addNonce(w)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	w.Write(b)
+}
+
+func newProblem(status int, typ, format string, args ...any) *problem {
+	return &problem{
+		Type:   problemPrefix + typ,
+		Detail: fmt.Sprintf(format, args...),
+		Status: status,
+	}
+}
+
+func (ca *CAServer) handleDirectory(w http.ResponseWriter, r *http.Request) {
+	ca.writeJSON(w, http.StatusOK, map[string]any{
+		"newNonce":   ca.srv.URL + "/new-nonce",
+		"newAccount": ca.srv.URL + "/new-account",
+		"newOrder":   ca.srv.URL + "/new-order",
+		"revokeCert": ca.srv.URL + "/revoke-cert",
+		"meta": map[string]any{
+			"termsOfService": ca.srv.URL + "/terms",
+		},
+	})
+}
+
+func (ca *CAServer) handleNewNonce(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "HEAD" && r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ca.addNonce(w)
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Method == "GET" {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// request is a verified JWS request.
+type request struct {
+	payload []byte
+	key     crypto.PublicKey
+	acct    *account // nil if the request is signed with an embedded key
+}
+
+// postAsGet reports whether req has an empty payload.
+func (req *request) postAsGet() bool {
+	return len(req.payload) == 0
+}
+
+// verify reads and verifies the JWS body of r. The request must be signed
+// by an account, unless jwkAllowed is set. It is called without ca.mu held.
+func (ca *CAServer) verify(r *http.Request, jwkAllowed bool) (*request, *problem) {
+	if ct := r.Header.Get("Content-Type"); ct != "application/jose+json" {
+		return nil, newProblem(http.StatusUnsupportedMediaType, "malformed", "unexpected Content-Type %q", ct)
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, "malformed", "%v", err)
+	}
+	var jws struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Signature string `json:"signature"`
+	}
+	if err := json.Unmarshal(body, &jws); err != nil {
+		return nil, newProblem(http.StatusBadRequest, "malformed", "invalid JWS: %v", err)
+	}
+	phead, err1 := base64.RawURLEncoding.DecodeString(jws.Protected)
+	payload, err2 := base64.RawURLEncoding.DecodeString(jws.Payload)
+	sig, err3 := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return nil, newProblem(http.StatusBadRequest, "malformed", "invalid JWS encoding: %v", err)
+	}
+	var head struct {
+		Alg   string          `json:"alg"`
+		Nonce string          `json:"nonce"`
+		URL   string          `json:"url"`
+		JWK   json.RawMessage `json:"jwk"`
+		KID   string          `json:"kid"`
+	}
+	if err := json.Unmarshal(phead, &head); err != nil {
+		return nil, newProblem(http.StatusBadRequest, "malformed", "invalid protected header: %v", err)
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+
+	ca.noncesMu.Lock()
+	valid := ca.nonces[head.Nonce]
+	delete(ca.nonces, head.Nonce)
+	ca.noncesMu.Unlock()
+	if ca.badNonces > 0 {
+		ca.badNonces--
+		return nil, newProblem(http.StatusBadRequest, "badNonce", "nonce rejected for testing")
+	}
+	if !valid {
+		return nil, newProblem(http.StatusBadRequest, "badNonce", "unknown nonce %q", head.Nonce)
+	}
+	if want := ca.srv.URL + r.URL.Path; head.URL != want {
+		return nil, newProblem(http.StatusUnauthorized, "unauthorized", "url %q in header doesn't match %q", head.URL, want)
+	}
+
+	req := &request{payload: payload}
+	switch {
+	case head.JWK != nil && head.KID != "":
+		return nil, newProblem(http.StatusBadRequest, "malformed", "both jwk and kid in header")
+	case head.JWK != nil:
+		if !jwkAllowed {
+			return nil, newProblem(http.StatusBadRequest, "malformed", "request must be signed by an account")
+		}
+		if req.key, err = parseJWK(head.JWK); err != nil {
+			return nil, newProblem(http.StatusBadRequest, "badPublicKey", "%v", err)
+		}
+	case head.KID != "":
+		for _, a := range ca.accounts {
+			if a.url == head.KID {
+				req.acct = a
+			}
+		}
+		if req.acct == nil {
+			return nil, newProblem(http.StatusBadRequest, "accountDoesNotExist", "unknown account %q", head.KID)
+		}
+		req.key = req.acct.key
+	default:
+		return nil, newProblem(http.StatusBadRequest, "malformed", "neither jwk nor kid in header")
+	}
+	if err := verifySignature(head.Alg, req.key, []byte(jws.Protected+"."+jws.Payload), sig); err != nil {
+		return nil, newProblem(http.StatusBadRequest, "malformed", "%v", err)
+	}
+	return req, nil
+}
+
+func parseJWK(b []byte) (crypto.PublicKey, error) {
+	var jwk struct {
+		Kty string `json:"kty"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+	if err := json.Unmarshal(b, &jwk); err != nil {
+		return nil, err
+	}
+	decode := func(s string) *big.Int {
+		b, _ := base64.RawURLEncoding.DecodeString(s)
+		return new(big.Int).SetBytes(b)
+	}
+	switch jwk.Kty {
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: decode(jwk.X), Y: decode(jwk.Y)}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return pub, nil
+	case "RSA":
+		e := decode(jwk.E)
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: decode(jwk.N), E: int(e.Int64())}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		var h crypto.Hash
+		switch {
+		case alg == "ES256" && key.Curve == elliptic.P256():
+			h = crypto.SHA256
+		case alg == "ES384" && key.Curve == elliptic.P384():
+			h = crypto.SHA384
+		default:
+			return fmt.Errorf("algorithm %q doesn't match the key", alg)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		d := h.New()
+		d.Write(signed)
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, d.Sum(nil), r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if alg != "RS256" {
+			return fmt.Errorf("algorithm %q doesn't match the key", alg)
+		}
+		d := sha256.Sum256(signed)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, d[:], sig)
+	}
+	return errors.New("unsupported key")
+}
+
+// thumbprint returns the RFC 7638 thumbprint of pub.
+func thumbprint(pub crypto.PublicKey) string {
+	var jwk string
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk = fmt.Sprintf(`{"crv":"%s","kty":"EC","x":"%s","y":"%s"}`, pub.Curve.Params().Name,
+			enc(pub.X.FillBytes(make([]byte, size))), enc(pub.Y.FillBytes(make([]byte, size))))
+	case *rsa.PublicKey:
+		jwk = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+			enc(big.NewInt(int64(pub.E)).Bytes()), enc(pub.N.Bytes()))
+	}
+	h := sha256.Sum256([]byte(jwk))
+	return enc(h[:])
+}
+
+func sameKey(a, b crypto.PublicKey) bool {
+	k, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && k.Equal(b)
+}
+
+func (ca *CAServer) handleNewAccount(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, true)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	var v struct {
+		Contact              []string `json:"contact"`
+		TermsOfServiceAgreed bool     `json:"termsOfServiceAgreed"`
+		OnlyReturnExisting   bool     `json:"onlyReturnExisting"`
+	}
+	if err := json.Unmarshal(req.payload, &v); err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "%v", err))
+		return
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	for _, a := range ca.accounts {
+		if sameKey(a.key, req.key) {
+			w.Header().Set("Location", a.url)
+			ca.writeJSON(w, http.StatusOK, accountJSON(a))
+			return
+		}
+	}
+	if v.OnlyReturnExisting {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "accountDoesNotExist", "no account for key"))
+		return
+	}
+	if !v.TermsOfServiceAgreed {
+		ca.writeProblem(w, newProblem(http.StatusForbidden, "userActionRequired", "terms of service not agreed"))
+		return
+	}
+	a := &account{
+		url:     ca.url("acct", ca.newID()),
+		key:     req.key,
+		contact: v.Contact,
+	}
+	ca.accounts = append(ca.accounts, a)
+	w.Header().Set("Location", a.url)
+	ca.writeJSON(w, http.StatusCreated, accountJSON(a))
+}
+
+func accountJSON(a *account) any {
+	return map[string]any{
+		"status":  "valid",
+		"contact": a.contact,
+	}
+}
+
+func (ca *CAServer) handleAccount(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	if req.acct.url != ca.srv.URL+r.URL.Path {
+		ca.writeProblem(w, newProblem(http.StatusUnauthorized, "unauthorized", "not the account of the key"))
+		return
+	}
+	ca.writeJSON(w, http.StatusOK, accountJSON(req.acct))
+}
+
+func (ca *CAServer) handleNewOrder(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	var v struct {
+		Identifiers []identifier `json:"identifiers"`
+	}
+	if err := json.Unmarshal(req.payload, &v); err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "%v", err))
+		return
+	}
+	if len(v.Identifiers) == 0 {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "no identifiers"))
+		return
+	}
+	for _, id := range v.Identifiers {
+		if id.Type != "dns" || id.Value == "" || strings.HasPrefix(id.Value, "*.") {
+			ca.writeProblem(w, newProblem(http.StatusBadRequest, "rejectedIdentifier", "unsupported identifier %q", id.Value))
+			return
+		}
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	o := &order{
+		id:          ca.newID(),
+		acct:        req.acct,
+		status:      "pending",
+		identifiers: v.Identifiers,
+	}
+	for _, id := range v.Identifiers {
+		z := &authz{
+			id:         ca.newID(),
+			acct:       req.acct,
+			order:      o,
+			status:     "pending",
+			identifier: id,
+		}
+		for _, typ := range ca.challengeTypes {
+			b := make([]byte, 16)
+			rand.Read(b)
+			c := &challenge{
+				id:     ca.newID(),
+				authz:  z,
+				typ:    typ,
+				token:  base64.RawURLEncoding.EncodeToString(b),
+				status: "pending",
+			}
+			z.challenges = append(z.challenges, c)
+			ca.challenges = append(ca.challenges, c)
+		}
+		o.authzs = append(o.authzs, z)
+		ca.authzs = append(ca.authzs, z)
+	}
+	ca.orders = append(ca.orders, o)
+	w.Header().Set("Location", ca.url("order", o.id))
+	ca.writeJSON(w, http.StatusCreated, ca.orderJSON(o))
+}
+
+func (ca *CAServer) orderJSON(o *order) any {
+	v := map[string]any{
+		"status":      o.status,
+		"expires":     time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		"identifiers": o.identifiers,
+		"finalize":    ca.url("finalize", o.id),
+	}
+	var authzs []string
+	for _, z := range o.authzs {
+		authzs = append(authzs, ca.url("authz", z.id))
+	}
+	v["authorizations"] = authzs
+	if o.cert != nil {
+		v["certificate"] = ca.url("cert", o.cert.id)
+	}
+	if o.err != nil {
+		v["error"] = o.err
+	}
+	return v
+}
+
+func (ca *CAServer) challengeJSON(c *challenge) any {
+	v := map[string]any{
+		"type":   c.typ,
+		"url":    ca.url("chal", c.id),
+		"token":  c.token,
+		"status": c.status,
+	}
+	if !c.validated.IsZero() {
+		v["validated"] = c.validated.UTC().Format(time.RFC3339)
+	}
+	if c.err != nil {
+		v["error"] = c.err
+	}
+	return v
+}
+
+// lookup returns the element of objs with the id of r, if it belongs to
+// acct, or writes an error.
+func lookup[T any](ca *CAServer, w http.ResponseWriter, r *http.Request, acct *account, objs []T, id func(T) int, owner func(T) *account) (T, bool) {
+	n, _ := strconv.Atoi(r.PathValue("id"))
+	i := slices.IndexFunc(objs, func(o T) bool { return id(o) == n })
+	if i < 0 {
+		ca.writeProblem(w, newProblem(http.StatusNotFound, "malformed", "no such object"))
+		var zero T
+		return zero, false
+	}
+	if owner(objs[i]) != acct {
+		ca.writeProblem(w, newProblem(http.StatusUnauthorized, "unauthorized", "object belongs to another account"))
+		var zero T
+		return zero, false
+	}
+	return objs[i], true
+}
+
+func (ca *CAServer) lookupOrder(w http.ResponseWriter, r *http.Request, acct *account) (*order, bool) {
+	return lookup(ca, w, r, acct, ca.orders,
+		func(o *order) int { return o.id }, func(o *order) *account { return o.acct })
+}
+
+func (ca *CAServer) lookupAuthz(w http.ResponseWriter, r *http.Request, acct *account) (*authz, bool) {
+	return lookup(ca, w, r, acct, ca.authzs,
+		func(z *authz) int { return z.id }, func(z *authz) *account { return z.acct })
+}
+
+func (ca *CAServer) lookupChallenge(w http.ResponseWriter, r *http.Request, acct *account) (*challenge, bool) {
+	return lookup(ca, w, r, acct, ca.challenges,
+		func(c *challenge) int { return c.id }, func(c *challenge) *account { return c.authz.acct })
+}
+
+func (ca *CAServer) handleOrder(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	o, ok := ca.lookupOrder(w, r, req.acct)
+	if !ok {
+		return
+	}
+	ca.writeJSON(w, http.StatusOK, ca.orderJSON(o))
+}
+
+func (ca *CAServer) handleAuthz(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	z, ok := ca.lookupAuthz(w, r, req.acct)
+	if !ok {
+		return
+	}
+	var chals []any
+	for _, c := range z.challenges {
+		chals = append(chals, ca.challengeJSON(c))
+	}
+	ca.writeJSON(w, http.StatusOK, map[string]any{
+		"status":     z.status,
+		"identifier": z.identifier,
+		"expires":    time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		"challenges": chals,
+	})
+}
+
+func (ca *CAServer) handleChallenge(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	ca.mu.Lock()
+	c, ok := ca.lookupChallenge(w, r, req.acct)
+	if !ok {
+		ca.mu.Unlock()
+		return
+	}
+	if req.postAsGet() || c.status != "pending" || c.authz.status != "pending" {
+		ca.writeJSON(w, http.StatusOK, ca.challengeJSON(c))
+		ca.mu.Unlock()
+		return
+	}
+	c.status = "processing"
+	domain, addr := c.authz.identifier.Value, ca.resolve[c.authz.identifier.Value]
+	keyAuth := c.token + "." + thumbprint(req.acct.key)
+	ca.mu.Unlock()
+
+	// Validate the challenge before responding, without holding the lock,
+	// so that clients see the final status when they poll.
+	var err error
+	switch {
+	case addr == "":
+		err = fmt.Errorf("no address for %s", domain)
+	case c.typ == "http-01":
+		err = validateHTTP01(addr, domain, c.token, keyAuth)
+	case c.typ == "tls-alpn-01":
+		err = validateTLSALPN01(addr, domain, keyAuth)
+	default:
+		err = fmt.Errorf("unsupported challenge type %q", c.typ)
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	z, o := c.authz, c.authz.order
+	if err != nil {
+		c.status = "invalid"
+		c.err = newProblem(http.StatusForbidden, "unauthorized", "%s challenge failed: %v", c.typ, err)
+		z.status = "invalid"
+		o.status = "invalid"
+		o.err = c.err
+	} else {
+		c.status = "valid"
+		c.validated = time.Now()
+		z.status = "valid"
+		if !slices.ContainsFunc(o.authzs, func(z *authz) bool { return z.status != "valid" }) {
+			o.status = "ready"
+		}
+	}
+	ca.writeJSON(w, http.StatusOK, ca.challengeJSON(c))
+}
+
+var validationClient = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func validateHTTP01(addr, domain, token, keyAuth string) error {
+	req, err := http.NewRequest("GET", "http://"+addr+"/.well-known/acme-challenge/"+token, nil)
+	if err != nil {
+		return err
+	}
+	req.Host = domain
+	res, err := validationClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("response status %s", res.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+	if err != nil {
+		return err
+	}
+	if got := strings.TrimSpace(string(body)); got != keyAuth {
+		return fmt.Errorf("key authorization %q, want %q", got, keyAuth)
+	}
+	return nil
+}
+
+var idPeACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+func validateTLSALPN01(addr, domain, keyAuth string) error {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		ServerName:         domain,
+		NextProtos:         []string{"acme-tls/1"},
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	cs := conn.ConnectionState()
+	if cs.NegotiatedProtocol != "acme-tls/1" {
+		return fmt.Errorf("negotiated protocol %q", cs.NegotiatedProtocol)
+	}
+	leaf := cs.PeerCertificates[0]
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != domain {
+		return fmt.Errorf("certificate names %q, want %q", leaf.DNSNames, domain)
+	}
+	want := sha256.Sum256([]byte(keyAuth))
+	for _, ext := range leaf.Extensions {
+		if !ext.Id.Equal(idPeACMEIdentifier) {
+			continue
+		}
+		if !ext.Critical {
+			return errors.New("acmeIdentifier extension is not critical")
+		}
+		var got []byte
+		if rest, err := asn1.Unmarshal(ext.Value, &got); err != nil || len(rest) != 0 {
+			return errors.New("invalid acmeIdentifier extension")
+		}
+		if !bytes.Equal(got, want[:]) {
+			return errors.New("acmeIdentifier doesn't match the key authorization")
+		}
+		return nil
+	}
+	return errors.New("no acmeIdentifier extension")
+}
+
+func (ca *CAServer) handleFinalize(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	var v struct {
+		CSR string `json:"csr"`
+	}
+	if err := json.Unmarshal(req.payload, &v); err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "%v", err))
+		return
+	}
+	der, err := base64.RawURLEncoding.DecodeString(v.CSR)
+	if err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "badCSR", "%v", err))
+		return
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err == nil {
+		err = csr.CheckSignature()
+	}
+	if err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "badCSR", "%v", err))
+		return
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	o, ok := ca.lookupOrder(w, r, req.acct)
+	if !ok {
+		return
+	}
+	if o.status != "ready" {
+		ca.writeProblem(w, newProblem(http.StatusForbidden, "orderNotReady", "order is %s", o.status))
+		return
+	}
+	names := slices.Clone(csr.DNSNames)
+	if csr.Subject.CommonName != "" && !slices.Contains(names, csr.Subject.CommonName) {
+		names = append(names, csr.Subject.CommonName)
+	}
+	var want []string
+	for _, id := range o.identifiers {
+		want = append(want, id.Value)
+	}
+	slices.Sort(names)
+	slices.Sort(want)
+	if !slices.Equal(slices.Compact(names), slices.Compact(want)) {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "badCSR", "CSR names %q don't match the order %q", names, want))
+		return
+	}
+
+	id := ca.newID()
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(id)),
+		Subject:      pkix.Name{CommonName: want[0]},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(ca.certLifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     want,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, ca.caCert, csr.PublicKey, ca.caKey)
+	if err != nil {
+		ca.writeProblem(w, newProblem(http.StatusInternalServerError, "serverInternal", "%v", err))
+		return
+	}
+	o.cert = &issuedCert{id: id, acct: req.acct, der: certDER}
+	o.status = "valid"
+	ca.certs = append(ca.certs, o.cert)
+	w.Header().Set("Location", ca.url("order", o.id))
+	ca.writeJSON(w, http.StatusOK, ca.orderJSON(o))
+}
+
+func (ca *CAServer) handleCert(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, false)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	c, ok := lookup(ca, w, r, req.acct, ca.certs,
+		func(c *issuedCert) int { return c.id }, func(c *issuedCert) *account { return c.acct })
+	if !ok {
+		return
+	}
+	var b bytes.Buffer
+	pem.Encode(&b, &pem.Block{Type: "CERTIFICATE", Bytes: c.der})
+	pem.Encode(&b, &pem.Block{Type: "CERTIFICATE", Bytes: ca.caCert.Raw})
+	ca.addNonce(w)
+	w.Header().Set("Content-Type", "application/pem-certificate-chain")
+	w.Write(b.Bytes())
+}
+
+func (ca *CAServer) handleRevokeCert(w http.ResponseWriter, r *http.Request) {
+	req, p := ca.verify(r, true)
+	if p != nil {
+		ca.writeProblem(w, p)
+		return
+	}
+	var v struct {
+		Certificate string `json:"certificate"`
+		Reason      int    `json:"reason"`
+	}
+	if err := json.Unmarshal(req.payload, &v); err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "%v", err))
+		return
+	}
+	der, err := base64.RawURLEncoding.DecodeString(v.Certificate)
+	if err != nil {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "malformed", "%v", err))
+		return
+	}
+	if v.Reason < 0 || v.Reason > 10 || v.Reason == 7 {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "badRevocationReason", "reason %d", v.Reason))
+		return
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	i := slices.IndexFunc(ca.certs, func(c *issuedCert) bool { return bytes.Equal(c.der, der) })
+	if i < 0 {
+		ca.writeProblem(w, newProblem(http.StatusNotFound, "malformed", "unknown certificate"))
+		return
+	}
+	c := ca.certs[i]
+	if req.acct != nil && req.acct != c.acct {
+		ca.writeProblem(w, newProblem(http.StatusForbidden, "unauthorized", "certificate belongs to another account"))
+		return
+	}
+	if req.acct == nil {
+		cert, err := x509.ParseCertificate(c.der)
+		if err != nil || !sameKey(cert.PublicKey, req.key) {
+			ca.writeProblem(w, newProblem(http.StatusForbidden, "unauthorized", "request not signed by the certificate key"))
+			return
+		}
+	}
+	if c.revoked {
+		ca.writeProblem(w, newProblem(http.StatusBadRequest, "alreadyRevoked", "certificate already revoked"))
+		return
+	}
+	c.revoked = true
+	ca.addNonce(w)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// This file implements the JSON Web Signature (RFC 7515) encoding of
+// requests, as profiled by RFC 8555, Section 6.2.
+
+// noPayload is passed by address as the claimset of POST-as-GET requests,
+// which have an empty payload. See RFC 8555, Section 6.3.
+var noPayload = ""
+
+// jwsEncodeJSON signs the JSON encoding of claimset with key and returns the
+// JWS in the flattened JSON serialization. If kid is empty, the public key is
+// embedded in the protected header as a JWK, otherwise it is referred to by
+// the account URL kid. If claimset is &noPayload, the payload is empty.
+func jwsEncodeJSON(claimset any, key crypto.Signer, kid, nonce, url string) ([]byte, error) {
+	alg, hash, err := jwsAlgorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	protected := map[string]any{
+		"alg":   alg,
+		"nonce": nonce,
+		"url":   url,
+	}
+	if kid == "" {
+		jwk, err := jwkEncode(key.Public())
+		if err != nil {
+			return nil, err
+		}
+		protected["jwk"] = json.RawMessage(jwk)
+	} else {
+		protected["kid"] = kid
+	}
+	phead, err := json.Marshal(protected)
+	if err != nil {
+		return nil, err
+	}
+	phead64 := base64.RawURLEncoding.EncodeToString(phead)
+
+	var payload64 string
+	if claimset != &noPayload {
+		payload, err := json.Marshal(claimset)
+		if err != nil {
+			return nil, err
+		}
+		payload64 = base64.RawURLEncoding.EncodeToString(payload)
+	}
+
+	h := hash.New()
+	h.Write([]byte(phead64 + "." + payload64))
+	sig, err := jwsSign(key, hash, h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	enc := struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Sig       string `json:"signature"`
+	}{
+		Protected: phead64,
+		Payload:   payload64,
+		Sig:       base64.RawURLEncoding.EncodeToString(sig),
+	}
+	return json.Marshal(enc)
+}
+
+// jwsAlgorithm returns the JWS algorithm name for pub, and the hash it uses.
+func jwsAlgorithm(pub crypto.PublicKey) (string, crypto.Hash, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return "RS256", crypto.SHA256, nil
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			return "ES256", crypto.SHA256, nil
+		case elliptic.P384():
+			return "ES384", crypto.SHA384, nil
+		}
+		return "", 0, errors.New("acme: unsupported ECDSA curve " + pub.Curve.Params().Name)
+	}
+	return "", 0, fmt.Errorf("acme: unsupported key type %T", pub)
+}
+
+// jwsSign signs digest with key. ECDSA signatures are converted to the
+// fixed-size concatenation of r and s required by RFC 7518, Section 3.4.
+func jwsSign(key crypto.Signer, hash crypto.Hash, digest []byte) ([]byte, error) {
+	sig, err := key.Sign(rand.Reader, digest, hash)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return sig, nil
+	}
+	var rs struct {
+		R, S *big.Int
+	}
+	if rest, err := asn1.Unmarshal(sig, &rs); err != nil || len(rest) != 0 {
+		return nil, errors.New("acme: invalid ECDSA signature")
+	}
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	out := make([]byte, 2*size)
+	rs.R.FillBytes(out[:size])
+	rs.S.FillBytes(out[size:])
+	return out, nil
+}
+
+// jwkEncode returns the JSON Web Key encoding of pub, RFC 7517, with the
+// members in lexicographic order, as required for thumbprints by RFC 7638.
+func jwkEncode(pub crypto.PublicKey) (string, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		n := pub.N.Bytes()
+		e := big.NewInt(int64(pub.E)).Bytes()
+		return fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(e),
+			base64.RawURLEncoding.EncodeToString(n),
+		), nil
+	case *ecdsa.PublicKey:
+		p := pub.Curve.Params()
+		size := (p.BitSize + 7) / 8
+		x := pub.X.FillBytes(make([]byte, size))
+		y := pub.Y.FillBytes(make([]byte, size))
+		return fmt.Sprintf(`{"crv":"%s","kty":"EC","x":"%s","y":"%s"}`,
+			p.Name,
+			base64.RawURLEncoding.EncodeToString(x),
+			base64.RawURLEncoding.EncodeToString(y),
+		), nil
+	}
+	return "", fmt.Errorf("acme: unsupported key type %T", pub)
+}
+
+// JWKThumbprint returns the base64url-encoded SHA-256 thumbprint of the
+// JSON Web Key of pub, as specified in RFC 7638. It identifies the account
+// in challenge responses.
+func JWKThumbprint(pub crypto.PublicKey) (string, error) {
+	jwk, err := jwkEncode(pub)
+	if err != nil {
+		return "", err
+	}
+	b := sha256.Sum256([]byte(jwk))
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
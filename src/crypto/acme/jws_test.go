@@ -0,0 +1,154 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestJWKThumbprint(t *testing.T) {
+	// RFC 7638, Section 3.1.
+	n, _ := base64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+	got, err := JWKThumbprint(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"; got != want {
+		t.Errorf("JWKThumbprint = %q, want %q", got, want)
+	}
+
+	// Leading zeros of EC coordinates are kept.
+	ec := &ecdsa.PublicKey{Curve: elliptic.P256(), X: big.NewInt(1), Y: big.NewInt(2)}
+	jwk, err := jwkEncode(ec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"crv":"P-256","kty":"EC","x":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE","y":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAI"}`
+	if jwk != want {
+		t.Errorf("jwkEncode = %s, want %s", jwk, want)
+	}
+}
+
+// decodeJWS decodes the protected header and the payload of the flattened
+// JWS b, and checks its signature with pub.
+func decodeJWS(t *testing.T, b []byte, pub crypto.PublicKey) (head map[string]any, payload []byte) {
+	t.Helper()
+	var jws struct {
+		Protected, Payload, Signature string
+	}
+	if err := json.Unmarshal(b, &jws); err != nil {
+		t.Fatal(err)
+	}
+	phead, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(phead, &head); err != nil {
+		t.Fatal(err)
+	}
+	payload, err = base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte(jws.Protected + "." + jws.Payload))
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		if len(sig) != 64 {
+			t.Fatalf("ES256 signature is %d bytes, want 64", len(sig))
+		}
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(pub, digest[:], r, s) {
+			t.Error("invalid ES256 signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig); err != nil {
+			t.Errorf("invalid RS256 signature: %v", err)
+		}
+	}
+	return head, payload
+}
+
+func TestJWSEncodeJSON(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		alg string
+		key crypto.Signer
+	}{
+		{"ES256", ecKey},
+		{"RS256", rsaKey},
+	} {
+		t.Run(tt.alg, func(t *testing.T) {
+			claims := struct {
+				Foo string `json:"foo"`
+			}{"bar"}
+			b, err := jwsEncodeJSON(claims, tt.key, "", "nonce", "https://example.org/new-account")
+			if err != nil {
+				t.Fatal(err)
+			}
+			head, payload := decodeJWS(t, b, tt.key.Public())
+			if head["alg"] != tt.alg || head["nonce"] != "nonce" || head["url"] != "https://example.org/new-account" {
+				t.Errorf("unexpected protected header %v", head)
+			}
+			if _, ok := head["kid"]; ok {
+				t.Error("kid in protected header of request with embedded key")
+			}
+			jwk, _ := json.Marshal(head["jwk"])
+			want, _ := jwkEncode(tt.key.Public())
+			if string(jwk) != want {
+				t.Errorf("jwk = %s, want %s", jwk, want)
+			}
+			if string(payload) != `{"foo":"bar"}` {
+				t.Errorf("payload = %s", payload)
+			}
+
+			b, err = jwsEncodeJSON(&noPayload, tt.key, "https://example.org/acct/1", "nonce", "https://example.org/order/1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			head, payload = decodeJWS(t, b, tt.key.Public())
+			if head["kid"] != "https://example.org/acct/1" {
+				t.Errorf("kid = %v", head["kid"])
+			}
+			if _, ok := head["jwk"]; ok {
+				t.Error("jwk in protected header of request with key ID")
+			}
+			if len(payload) != 0 {
+				t.Errorf("POST-as-GET payload = %q, want empty", payload)
+			}
+		})
+	}
+
+	p521, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwsEncodeJSON(nil, p521, "", "nonce", "url"); err == nil || !strings.Contains(err.Error(), "P-521") {
+		t.Errorf("P-521 key: got error %v, want unsupported curve", err)
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package acme
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Status values of ACME objects, RFC 8555, Section 7.1.6.
+const (
+	StatusPending     = "pending"
+	StatusReady       = "ready"
+	StatusProcessing  = "processing"
+	StatusValid       = "valid"
+	StatusInvalid     = "invalid"
+	StatusDeactivated = "deactivated"
+	StatusExpired     = "expired"
+	StatusRevoked     = "revoked"
+)
+
+// Challenge types, RFC 8555, Section 8, and RFC 8737.
+const (
+	ChallengeHTTP01    = "http-01"
+	ChallengeTLSALPN01 = "tls-alpn-01"
+)
+
+// ALPNProto is the ALPN protocol name used by the TLS-ALPN-01 challenge,
+// RFC 8737, Section 6.2. A server that fulfills such challenges must list it
+// in [crypto/tls.Config.NextProtos].
+const ALPNProto = "acme-tls/1"
+
+var (
+	// ErrAccountAlreadyExists is returned by [Client.Register] when the
+	// account key is already registered. The Client is then set up to use
+	// the existing account.
+	ErrAccountAlreadyExists = errors.New("acme: account already exists")
+
+	// ErrNoAccount is returned by [Client.GetReg] when the account key is
+	// not registered.
+	ErrNoAccount = errors.New("acme: account does not exist")
+)
+
+// Error is an ACME error, reported by the server as a problem document,
+// RFC 8555, Section 6.7.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// ProblemType is a URI that identifies the error, such as
+	// "urn:ietf:params:acme:error:malformed".
+	ProblemType string
+	// Detail is a human-readable explanation of the error.
+	Detail string
+	// Instance identifies the occurrence of the error, if set.
+	Instance string
+	// Header is the header of the response.
+	Header http.Header
+	// Subproblems holds the errors of individual identifiers, if any.
+	Subproblems []Subproblem
+}
+
+// Subproblem is an error about an identifier that is part of an [Error],
+// RFC 8555, Section 6.7.1.
+type Subproblem struct {
+	ProblemType string
+	Detail      string
+	Identifier  *AuthzID
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "acme: %d %s: %s", e.StatusCode, e.ProblemType, e.Detail)
+	for _, sub := range e.Subproblems {
+		b.WriteString("; ")
+		if sub.Identifier != nil {
+			b.WriteString(sub.Identifier.Value + ": ")
+		}
+		b.WriteString(sub.ProblemType + ": " + sub.Detail)
+	}
+	return b.String()
+}
+
+// Problem types used by the client, RFC 8555, Section 6.7.
+const (
+	problemPrefix           = "urn:ietf:params:acme:error:"
+	problemBadNonce         = problemPrefix + "badNonce"
+	problemAccountNotExists = problemPrefix + "accountDoesNotExist"
+)
+
+// wireError is the JSON encoding of Error.
+type wireError struct {
+	Status      int
+	Type        string
+	Detail      string
+	Instance    string
+	Subproblems []struct {
+		Type       string
+		Detail     string
+		Identifier *AuthzID
+	}
+}
+
+func (e *wireError) error(h http.Header) *Error {
+	err := &Error{
+		StatusCode:  e.Status,
+		ProblemType: e.Type,
+		Detail:      e.Detail,
+		Instance:    e.Instance,
+		Header:      h,
+	}
+	for _, sub := range e.Subproblems {
+		err.Subproblems = append(err.Subproblems, Subproblem{
+			ProblemType: sub.Type,
+			Detail:      sub.Detail,
+			Identifier:  sub.Identifier,
+		})
+	}
+	return err
+}
+
+// Directory holds the URLs of the resources of an ACME server,
+// RFC 8555, Section 7.1.1.
+type Directory struct {
+	NonceURL     string // newNonce
+	RegURL       string // newAccount
+	OrderURL     string // newOrder
+	AuthzURL     string // newAuthz, which may be empty
+	RevokeURL    string // revokeCert
+	KeyChangeURL string // keyChange
+
+	// Terms is the URL of the terms of service, if any.
+	Terms string
+	// Website is the URL of the website of the CA, if any.
+	Website string
+	// CAA holds the domain names that the CA recognizes as referring to
+	// itself in CAA records.
+	CAA []string
+	// ExternalAccountRequired reports whether the CA requires accounts to
+	// be bound to an external account.
+	ExternalAccountRequired bool
+}
+
+// Account is an ACME account, RFC 8555, Section 7.1.2.
+type Account struct {
+	// URI is the account URL, which is also its key ID.
+	URI string
+	// Contact holds the contact URLs of the account,
+	// such as "mailto:admin@example.com".
+	Contact []string
+	// Status is one of StatusValid, StatusDeactivated or StatusRevoked.
+	Status string
+	// OrdersURL is the URL of the list of orders of the account.
+	OrdersURL string
+}
+
+// AuthzID is an identifier that an account can be authorized for,
+// RFC 8555, Section 9.7.7.
+type AuthzID struct {
+	Type  string `json:"type"`  // "dns" or "ip"
+	Value string `json:"value"` // a domain name or an IP address
+}
+
+// DomainIDs returns the identifiers of the domain names.
+func DomainIDs(names ...string) []AuthzID {
+	ids := make([]AuthzID, len(names))
+	for i, name := range names {
+		ids[i] = AuthzID{Type: "dns", Value: name}
+	}
+	return ids
+}
+
+// Order is a request for a certificate, RFC 8555, Section 7.1.3.
+type Order struct {
+	// URI is the URL of the order.
+	URI string
+	// Status is one of StatusPending, StatusReady, StatusProcessing,
+	// StatusValid or StatusInvalid.
+	Status string
+	// Expires is the time after which the server considers the order
+	// invalid, if set.
+	Expires time.Time
+	// Identifiers are the identifiers that the order is for.
+	Identifiers []AuthzID
+	// NotBefore and NotAfter are the requested validity of the certificate,
+	// if set.
+	NotBefore, NotAfter time.Time
+	// AuthzURLs are the URLs of the authorizations that must be completed
+	// before the order can be finalized.
+	AuthzURLs []string
+	// FinalizeURL is the URL that a CSR is sent to once the order is ready.
+	FinalizeURL string
+	// CertURL is the URL of the certificate once the order is valid.
+	CertURL string
+	// Error is the error that made the order invalid, if any.
+	Error *Error
+}
+
+type wireOrder struct {
+	Status         string
+	Expires        time.Time
+	Identifiers    []AuthzID
+	NotBefore      time.Time
+	NotAfter       time.Time
+	Authorizations []string
+	Finalize       string
+	Certificate    string
+	Error          *wireError
+}
+
+func (o *wireOrder) order(uri string) *Order {
+	order := &Order{
+		URI:         uri,
+		Status:      o.Status,
+		Expires:     o.Expires,
+		Identifiers: o.Identifiers,
+		NotBefore:   o.NotBefore,
+		NotAfter:    o.NotAfter,
+		AuthzURLs:   o.Authorizations,
+		FinalizeURL: o.Finalize,
+		CertURL:     o.Certificate,
+	}
+	if o.Error != nil {
+		order.Error = o.Error.error(nil)
+	}
+	return order
+}
+
+// Authorization is an authorization of the account for an identifier,
+// RFC 8555, Section 7.1.4.
+type Authorization struct {
+	// URI is the URL of the authorization.
+	URI string
+	// Status is one of StatusPending, StatusValid, StatusInvalid,
+	// StatusDeactivated, StatusExpired or StatusRevoked.
+	Status string
+	// Identifier is the identifier that the account is authorized for.
+	Identifier AuthzID
+	// Expires is the time after which the server considers the
+	// authorization invalid, if set.
+	Expires time.Time
+	// Wildcard reports whether the authorization is for a wildcard name,
+	// in which case Identifier holds the name without the "*." prefix.
+	Wildcard bool
+	// Challenges are the ways in which the account can prove control of
+	// the identifier. Only one of them needs to be fulfilled.
+	Challenges []*Challenge
+}
+
+type wireAuthz struct {
+	Identifier AuthzID
+	Status     string
+	Expires    time.Time
+	Wildcard   bool
+	Challenges []wireChallenge
+}
+
+func (z *wireAuthz) authorization(uri string) *Authorization {
+	a := &Authorization{
+		URI:        uri,
+		Status:     z.Status,
+		Identifier: z.Identifier,
+		Expires:    z.Expires,
+		Wildcard:   z.Wildcard,
+	}
+	for i := range z.Challenges {
+		a.Challenges = append(a.Challenges, z.Challenges[i].challenge())
+	}
+	return a
+}
+
+// error returns the error of the first failed challenge of z, if any.
+func (z *Authorization) error() error {
+	for _, c := range z.Challenges {
+		if c.Error != nil {
+			return c.Error
+		}
+	}
+	return fmt.Errorf("acme: authorization %s for %s is %s", z.URI, z.Identifier.Value, z.Status)
+}
+
+// Challenge is a way of proving control of an identifier,
+// RFC 8555, Section 7.1.5.
+type Challenge struct {
+	// Type is the challenge type, such as ChallengeHTTP01.
+	Type string
+	// URI is the URL that the response to the challenge is posted to.
+	URI string
+	// Token is the value that identifies the challenge in the response.
+	Token string
+	// Status is one of StatusPending, StatusProcessing, StatusValid or
+	// StatusInvalid.
+	Status string
+	// Validated is the time at which the server validated the challenge,
+	// if it did.
+	Validated time.Time
+	// Error is the error of the last validation attempt, if any.
+	Error *Error
+}
+
+type wireChallenge struct {
+	Type      string
+	URL       string
+	Token     string
+	Status    string
+	Validated time.Time
+	Error     *wireError
+}
+
+func (c *wireChallenge) challenge() *Challenge {
+	ch := &Challenge{
+		Type:      c.Type,
+		URI:       c.URL,
+		Token:     c.Token,
+		Status:    c.Status,
+		Validated: c.Validated,
+	}
+	if c.Error != nil {
+		ch.Error = c.Error.error(nil)
+	}
+	return ch
+}
+
+// CRLReasonCode is the reason a certificate is revoked, RFC 5280,
+// Section 5.3.1.
+type CRLReasonCode int
+
+const (
+	CRLReasonUnspecified          CRLReasonCode = 0
+	CRLReasonKeyCompromise        CRLReasonCode = 1
+	CRLReasonCACompromise         CRLReasonCode = 2
+	CRLReasonAffiliationChanged   CRLReasonCode = 3
+	CRLReasonSuperseded           CRLReasonCode = 4
+	CRLReasonCessationOfOperation CRLReasonCode = 5
+	CRLReasonCertificateHold      CRLReasonCode = 6
+	CRLReasonRemoveFromCRL        CRLReasonCode = 8
+	CRLReasonPrivilegeWithdrawn   CRLReasonCode = 9
+	CRLReasonAACompromise         CRLReasonCode = 10
+)
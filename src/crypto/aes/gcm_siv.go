@@ -0,0 +1,210 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes
+
+import (
+	"crypto/cipher"
+	"crypto/internal/alias"
+	"crypto/internal/fips140only"
+	"crypto/subtle"
+	"errors"
+	"internal/byteorder"
+)
+
+const (
+	gcmSIVNonceSize = 12
+	gcmSIVTagSize   = 16
+	// gcmSIVMaxSize is the maximum size of the plaintext and of the
+	// additional data, per RFC 8452, Section 6.
+	gcmSIVMaxSize = 1 << 36
+)
+
+var errOpen = errors.New("cipher: message authentication failed")
+
+// NewGCMSIV returns an AES-GCM-SIV AEAD, the nonce misuse-resistant AEAD
+// specified in RFC 8452, that uses the given key. The key must be 16 or 32
+// bytes long, to select AES-128 or AES-256. The nonce is 12 bytes long and the
+// tag is 16 bytes long.
+//
+// Unlike GCM, reusing a nonce with the same key only reveals whether the same
+// plaintext and additional data were encrypted, but nonces should still be
+// unique, or random, whenever possible. Encrypting a message requires two
+// passes over the plaintext, and messages and additional data are limited to
+// 2^36 bytes.
+//
+// On amd64 and arm64, the POLYVAL hash and the counter mode encryption have
+// assembly implementations, but NewGCMSIV is still slower than
+// [cipher.NewGCM], since each message is processed twice and a new key
+// schedule is derived for each nonce.
+//
+// AES-GCM-SIV is not a FIPS 140-3 approved algorithm, so NewGCMSIV fails in
+// FIPS 140-only mode.
+func NewGCMSIV(key []byte) (cipher.AEAD, error) {
+	if fips140only.Enabled {
+		return nil, errors.New("crypto/aes: use of AES-GCM-SIV is not allowed in FIPS 140-only mode")
+	}
+	if len(key) != 16 && len(key) != 32 {
+		return nil, errors.New("crypto/aes: GCM-SIV requires a 128-bit or 256-bit key")
+	}
+	kgk, err := NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmSIV{kgk: kgk, keySize: len(key)}, nil
+}
+
+// gcmSIV implements AES-GCM-SIV as specified in RFC 8452.
+type gcmSIV struct {
+	// kgk is the key-generating key, from which the per-nonce message
+	// authentication and encryption keys are derived.
+	kgk cipher.Block
+	// keySize is the size of kgk and of the derived encryption keys.
+	keySize int
+}
+
+func (g *gcmSIV) NonceSize() int {
+	return gcmSIVNonceSize
+}
+
+func (g *gcmSIV) Overhead() int {
+	return gcmSIVTagSize
+}
+
+func (g *gcmSIV) Seal(dst, nonce, plaintext, data []byte) []byte {
+	if len(nonce) != gcmSIVNonceSize {
+		panic("crypto/cipher: incorrect nonce length given to GCM-SIV")
+	}
+	if uint64(len(plaintext)) > gcmSIVMaxSize || uint64(len(data)) > gcmSIVMaxSize {
+		panic("crypto/cipher: message too large for GCM-SIV")
+	}
+
+	authKey, enc := g.deriveKeys(nonce)
+	var tag [gcmSIVTagSize]byte
+	g.tag(&tag, &authKey, enc, nonce, plaintext, data)
+
+	ret, out := sliceForAppend(dst, len(plaintext)+gcmSIVTagSize)
+	if alias.InexactOverlap(out, plaintext) {
+		panic("crypto/cipher: invalid buffer overlap")
+	}
+	gcmSIVCounterCrypt(enc, out[:len(plaintext)], plaintext, &tag)
+	copy(out[len(plaintext):], tag[:])
+	return ret
+}
+
+func (g *gcmSIV) Open(dst, nonce, ciphertext, data []byte) ([]byte, error) {
+	if len(nonce) != gcmSIVNonceSize {
+		panic("crypto/cipher: incorrect nonce length given to GCM-SIV")
+	}
+	if len(ciphertext) < gcmSIVTagSize {
+		return nil, errOpen
+	}
+	if uint64(len(ciphertext)) > gcmSIVMaxSize+gcmSIVTagSize || uint64(len(data)) > gcmSIVMaxSize {
+		return nil, errOpen
+	}
+
+	var tag [gcmSIVTagSize]byte
+	copy(tag[:], ciphertext[len(ciphertext)-gcmSIVTagSize:])
+	ciphertext = ciphertext[:len(ciphertext)-gcmSIVTagSize]
+
+	authKey, enc := g.deriveKeys(nonce)
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if alias.InexactOverlap(out, ciphertext) {
+		panic("crypto/cipher: invalid buffer overlap")
+	}
+	gcmSIVCounterCrypt(enc, out, ciphertext, &tag)
+
+	var expectedTag [gcmSIVTagSize]byte
+	g.tag(&expectedTag, &authKey, enc, nonce, out, data)
+	if subtle.ConstantTimeCompare(expectedTag[:], tag[:]) != 1 {
+		// Don't return, or leave behind, unauthenticated plaintext.
+		clear(out)
+		return nil, errOpen
+	}
+	return ret, nil
+}
+
+// deriveKeys returns the message-authentication key and the message-encryption
+// block cipher for nonce, as specified in RFC 8452, Section 4.
+func (g *gcmSIV) deriveKeys(nonce []byte) (authKey [16]byte, enc cipher.Block) {
+	var keys [16 + 32]byte
+	var in, out [BlockSize]byte
+	copy(in[4:], nonce)
+	for i := 0; i < 2+g.keySize/8; i++ {
+		byteorder.LePutUint32(in[:4], uint32(i))
+		g.kgk.Encrypt(out[:], in[:])
+		copy(keys[8*i:], out[:8])
+	}
+	copy(authKey[:], keys[:16])
+	enc, err := NewCipher(keys[16 : 16+g.keySize])
+	if err != nil {
+		panic("crypto/aes: internal error: " + err.Error())
+	}
+	return authKey, enc
+}
+
+// tag computes the authentication tag of plaintext and data.
+func (g *gcmSIV) tag(out, authKey *[16]byte, enc cipher.Block, nonce, plaintext, data []byte) {
+	var p polyval
+	p.init(authKey[:])
+	p.update(data)
+	p.update(plaintext)
+	var lengths [BlockSize]byte
+	byteorder.LePutUint64(lengths[:8], uint64(len(data))*8)
+	byteorder.LePutUint64(lengths[8:], uint64(len(plaintext))*8)
+	p.update(lengths[:])
+
+	var s [BlockSize]byte
+	p.sum(&s)
+	subtle.XORBytes(s[:gcmSIVNonceSize], s[:gcmSIVNonceSize], nonce)
+	s[15] &= 0x7f
+	enc.Encrypt(out[:], s[:])
+}
+
+// ctrLE32Able is implemented by the AES blocks of crypto/internal/fips140/aes
+// that have an assembly implementation of the GCM-SIV counter mode.
+type ctrLE32Able interface {
+	XORKeyStreamLE32(dst, src []byte, ctr *[BlockSize]byte)
+}
+
+// gcmSIVCounterCrypt encrypts or decrypts in into out with AES in counter
+// mode. The initial counter block is the tag with its most significant bit
+// set, and only its first 32 bits are incremented, as a little-endian
+// integer, wrapping around on overflow.
+//
+// The GCM assembly can't be reused, as it increments the last 32 bits of the
+// counter as a big-endian integer. Instead, if enc implements ctrLE32Able,
+// the full blocks are encrypted eight at a time with AES-NI or the ARMv8
+// AES instructions.
+func gcmSIVCounterCrypt(enc cipher.Block, out, in []byte, tag *[16]byte) {
+	counter := *tag
+	counter[15] |= 0x80
+	if c, ok := enc.(ctrLE32Able); ok {
+		n := len(in) &^ (BlockSize - 1)
+		c.XORKeyStreamLE32(out[:n], in[:n], &counter)
+		out, in = out[n:], in[n:]
+	}
+	var keystream [BlockSize]byte
+	for len(in) > 0 {
+		enc.Encrypt(keystream[:], counter[:])
+		n := subtle.XORBytes(out, in, keystream[:])
+		out, in = out[n:], in[n:]
+		byteorder.LePutUint32(counter[:4], byteorder.LeUint32(counter[:4])+1)
+	}
+}
+
+// sliceForAppend takes a slice and a requested number of bytes. It returns a
+// slice with the contents of the given slice followed by that many bytes and a
+// second slice that aliases into it and contains only the extra bytes. If the
+// original slice has sufficient capacity then no allocation is performed.
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+	tail = head[len(in):]
+	return
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"encoding/hex"
+	"testing"
+)
+
+var aesGCMSIVTests = []struct {
+	key, nonce, plaintext, ad, result string
+}{
+	// RFC 8452, Appendix C.1
+	{
+		"01000000000000000000000000000000",
+		"030000000000000000000000",
+		"",
+		"",
+		"dc20e2d83f25705bb49e439eca56de25",
+	},
+	{
+		"01000000000000000000000000000000",
+		"030000000000000000000000",
+		"0100000000000000",
+		"",
+		"b5d839330ac7b786578782fff6013b815b287c22493a364c",
+	},
+	{
+		"01000000000000000000000000000000",
+		"030000000000000000000000",
+		"010000000000000000000000",
+		"",
+		"7323ea61d05932260047d942a4978db357391a0bc4fdec8b0d106639",
+	},
+	// RFC 8452, Appendix C.2
+	{
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"030000000000000000000000",
+		"",
+		"",
+		"07f5f4169bbf55a8400cd47ea6fd400f",
+	},
+	// Generated with an independent implementation.
+	{
+		"af73cd4542b77e975d4d4ac44ff2c6e4",
+		"d27f14240d537b96d7410504",
+		"3841e062ef19eb7d03fd3c0db155d1d8bdf58e99f8606c8c3b6a421c466c7f",
+		"40be4973257fddf7164b87c1d0",
+		"73f23756398a88872bfe71cabcab878e4c8acbb134d6a776a25e422d256753d789cb20fea80d6cd10b9c7124816131",
+	},
+	{
+		"e2e39da3fad11fc8e14e6e4aab879aa9",
+		"27595baf5d2a25b94e3fbac1",
+		"06b264ab04914fe342e9b8713f13e9609f13494b5980b103fe925cedf91bba77dbddcfdd6041ae47f1189b6080ce082318ab7174ef1bc49705c20fdaddf7c7b5",
+		"9a10ad6a2db2e1c5349f058f49670cded7c98e7f",
+		"6dcaa6c64a4a9df7d17f765530013555714ff3c4564e15be8c0789b6754bbd34ecdaa0d3e34eda7d04d774fb860c1fa461f3e2bf64f8a4d08302effe9db8680686d6b9490214563cc1876fcb9c9b50a5",
+	},
+	{
+		"504db545cf3251e1f59bdc4dfd3b632d",
+		"55dd65e065aa62a1b27de0ee",
+		"643abf4291defbdcf877e5e227f8416c7ee0c2c8df10b9ad2da813812b02fa2589cca618fdbddca6dc3974ff86e6a9a8c6e3d21c01e254f4c1dd9ec306d03af9b6408fdebb05fd207a092fb793f1de648a8a789cbd2b293458c24592d2d047e5045dce5fca18f46b308975ee2e18509c9392ca8e678dc1a9c0102d41a05affd729",
+		"",
+		"0172d922027dc2c79e8e2c0a88d5e96cfbf59fdc0aa01ccfd34e321b04186370a53614d54d092a54a76bbf08136acf6a227c19698a0cb67e3164c7bda1a645ea258e2fc7dc8025161beb023c9639c93c3e6734ca4d88c37423765a56a6fbe85eb96bd8f7d6eb2f4165819c2b205b32f544674200ff640e736a71d48fe08bae0b9e89f902bc3e2da8f400072ee252d7369d",
+	},
+	{
+		"3d0736e53e98c93a24f5fcffd467a958",
+		"c25d9ae3df1a7f1f5a428b1f",
+		"",
+		"c42ab2ec098f9e084133873db975b58f213f223ce642bc9bbc8c637c79ee9baca2",
+		"a3f27669204b788440320de07ea47fd0",
+	},
+	{
+		"74429103526b4398f8a232f89c8a885eb5dcbebbf658bf7800408d7f49e15c72",
+		"e5db50ef9fd1f54daad9293a",
+		"0ede74bddcdee4160fc9c1e5b5a3d5854c835db973b5595e5e9c75c9afcceb",
+		"2502b0494692ab6d5ef3650a6f",
+		"22e8258115ff7093d6ba173ad6399443c34ec4a9feac3a087ec5b4d25d724d88cc2473b3c6d45cd3f7b05cd859d84d",
+	},
+	{
+		"b83cce0346e8c26ee29d688d33fffeaaa6b572a860a5f68b2622ad683b000887",
+		"39425e2f3606750ae384d94e",
+		"973d6f256febf633b36825728b02a24734ccaad803c6aa3d777a42333ccbd2e464409cda77925fd01f5a35973f10d42076535707c2c5ded67c1b96b326aade69",
+		"03f19055b973c9a91b7f40a6c20284ef376d4f43",
+		"bb9671d22d324f5c9d72c91d151d57a60bf51a77a11a8d1f29cc09f841894fb690bc6aeebda678cb231f07616d699f51505c6cd7a575c9f9e1d48bf9234f64c925dd5503223b4e39b4e536ecb7185117",
+	},
+	{
+		"337d86f64dd512c23a6eaa22979b334b3079fbec47be93ce31d829ca5e04b13c",
+		"a7ca7ff1cc31af7d5031b84d",
+		"c678df4ed9e1072e4704ec19074550d01e9c788e0c6a20132776ef7bd8d7e37088ebaea01632b04ff9c5f8a3e43f26360e9b5d53afb0907553e6d892cd46af905c9e07a73794b2a7e53e499bfbc5a099a890ac3f5cd5b3c5595a1decb5c90fd415c3216787c1c60d11d05d5644142e7b2f253b28fda41fc0ff3098811fca03d4f5",
+		"",
+		"671b86e491c8f7ce48841e553565f00118ad8074bf8471de54274f192412097563b7943bee2c036baec3cdbdfee3038001b950930ea98497aee8a6a88140a9620462ab2637df9eee50e66301cb9ad5703bb2f4a36d03d94d354303c27c940134c7f70eb4f4c3354d8792e25d9d88857a35209f1dc40e5d827733d99b7233d1dbae8d371ca3764aa7b930ced910dc7ff068",
+	},
+	{
+		"1e15728c3ea9789060506a383cc68b4826907cbc2603521345ee676b0d941263",
+		"d4fca0f71d7148cfd6ed8bc3",
+		"",
+		"9fc6279880c4af33bfafd361dfa539f4152e3002a9681f1772be168776efb3953f",
+		"e10e489e110f7f4ca42eb114dd2b483b",
+	},
+}
+
+func TestAESGCMSIV(t *testing.T) {
+	for i, test := range aesGCMSIVTests {
+		key, _ := hex.DecodeString(test.key)
+		aead, err := aes.NewGCMSIV(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if aead.NonceSize() != 12 || aead.Overhead() != 16 {
+			t.Fatalf("NonceSize, Overhead = %d, %d, want 12, 16", aead.NonceSize(), aead.Overhead())
+		}
+
+		nonce, _ := hex.DecodeString(test.nonce)
+		plaintext, _ := hex.DecodeString(test.plaintext)
+		ad, _ := hex.DecodeString(test.ad)
+
+		ct := aead.Seal(nil, nonce, plaintext, ad)
+		if ctHex := hex.EncodeToString(ct); ctHex != test.result {
+			t.Errorf("#%d: got %s, want %s", i, ctHex, test.result)
+			continue
+		}
+
+		plaintext2, err := aead.Open(nil, nonce, ct, ad)
+		if err != nil {
+			t.Errorf("#%d: Open failed", i)
+			continue
+		}
+		if !bytes.Equal(plaintext, plaintext2) {
+			t.Errorf("#%d: plaintext's don't match: got %x vs %x", i, plaintext2, plaintext)
+			continue
+		}
+
+		// Encrypt and decrypt in place.
+		buf := append(make([]byte, 0, len(ct)), plaintext...)
+		if got := aead.Seal(buf[:0], nonce, buf, ad); !bytes.Equal(got, ct) {
+			t.Errorf("#%d: in-place Seal: got %x, want %x", i, got, ct)
+		}
+		if got, err := aead.Open(buf[:0], nonce, buf[:len(ct)], ad); err != nil || !bytes.Equal(got, plaintext) {
+			t.Errorf("#%d: in-place Open: got %x, %v, want %x", i, got, err, plaintext)
+		}
+
+		if len(ad) > 0 {
+			ad[0] ^= 0x80
+			if _, err := aead.Open(nil, nonce, ct, ad); err == nil {
+				t.Errorf("#%d: Open was successful after altering additional data", i)
+			}
+			ad[0] ^= 0x80
+		}
+
+		nonce[0] ^= 0x80
+		if _, err := aead.Open(nil, nonce, ct, ad); err == nil {
+			t.Errorf("#%d: Open was successful after altering nonce", i)
+		}
+		nonce[0] ^= 0x80
+
+		ct[0] ^= 0x80
+		dst := bytes.Repeat([]byte{42}, len(plaintext))
+		if _, err := aead.Open(dst[:0], nonce, ct, ad); err == nil {
+			t.Errorf("#%d: Open was successful after altering ciphertext", i)
+		}
+		if !bytes.Equal(dst, make([]byte, len(dst))) {
+			t.Errorf("#%d: failed Open didn't zero dst buffer", i)
+		}
+		ct[0] ^= 0x80
+	}
+}
+
+func TestGCMSIVInvalidKey(t *testing.T) {
+	for _, size := range []int{0, 15, 24, 33} {
+		if _, err := aes.NewGCMSIV(make([]byte, size)); err == nil {
+			t.Errorf("NewGCMSIV accepted a %d-byte key", size)
+		}
+	}
+}
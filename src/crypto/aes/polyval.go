@@ -0,0 +1,103 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes
+
+import (
+	"internal/byteorder"
+	"math/bits"
+)
+
+// polyval implements the POLYVAL universal hash function of RFC 8452,
+// Section 3. Field elements are 128-bit little-endian integers, stored as a
+// low and a high 64-bit half, where bit i is the coefficient of x^i.
+type polyval struct {
+	h [2]uint64 // the hash key
+	s [2]uint64 // the accumulator
+}
+
+func (p *polyval) init(key []byte) {
+	p.h[0] = byteorder.LeUint64(key[:8])
+	p.h[1] = byteorder.LeUint64(key[8:16])
+	p.s = [2]uint64{}
+}
+
+// update absorbs data into the accumulator, padding the final partial block
+// (if any) with zeroes.
+func (p *polyval) update(data []byte) {
+	if n := len(data) &^ (BlockSize - 1); n > 0 {
+		polyvalBlocks(p, data[:n])
+		data = data[n:]
+	}
+	if len(data) > 0 {
+		var block [BlockSize]byte
+		copy(block[:], data)
+		polyvalBlocks(p, block[:])
+	}
+}
+
+// sum returns the current value of the accumulator.
+func (p *polyval) sum(out *[BlockSize]byte) {
+	byteorder.LePutUint64(out[:8], p.s[0])
+	byteorder.LePutUint64(out[8:], p.s[1])
+}
+
+// polyvalBlocksGeneric updates the accumulator with each 16-byte block of
+// blocks, computing s = dot(s ^ block, h), in constant time.
+func polyvalBlocksGeneric(p *polyval, blocks []byte) {
+	h0, h1 := p.h[0], p.h[1]
+	s0, s1 := p.s[0], p.s[1]
+	for len(blocks) >= BlockSize {
+		s0 ^= byteorder.LeUint64(blocks[:8])
+		s1 ^= byteorder.LeUint64(blocks[8:16])
+
+		// Karatsuba multiplication of (s1:s0) by (h1:h0) into (c3:c2:c1:c0).
+		lh, ll := clmul64(s0, h0)
+		hh, hl := clmul64(s1, h1)
+		mh, ml := clmul64(s0^s1, h0^h1)
+		mh ^= lh ^ hh
+		ml ^= ll ^ hl
+		c0, c1, c2, c3 := ll, lh^ml, hl^mh, hh
+
+		// Montgomery reduction, computing (c3:c2:c1:c0) * x^-128 mod P,
+		// where P = x^128 + x^127 + x^126 + x^121 + 1. Each step adds a
+		// multiple of P that cancels the lowest remaining 64-bit word.
+		c1 ^= c0<<63 ^ c0<<62 ^ c0<<57
+		c2 ^= c0 ^ c0>>1 ^ c0>>2 ^ c0>>7
+		c2 ^= c1<<63 ^ c1<<62 ^ c1<<57
+		c3 ^= c1 ^ c1>>1 ^ c1>>2 ^ c1>>7
+		s0, s1 = c2, c3
+
+		blocks = blocks[BlockSize:]
+	}
+	p.s[0], p.s[1] = s0, s1
+}
+
+// clmul64 returns the 128-bit carry-less product of x and y.
+func clmul64(x, y uint64) (hi, lo uint64) {
+	lo = bmul64(x, y)
+	hi = bits.Reverse64(bmul64(bits.Reverse64(x), bits.Reverse64(y))) >> 1
+	return hi, lo
+}
+
+// bmul64 returns the low 64 bits of the carry-less product of x and y.
+//
+// It uses integer multiplications of operands with "holes" every four bits,
+// so that carries can't spill into the bits that are kept. This technique is
+// from BearSSL's ghash_ctmul64.c.
+func bmul64(x, y uint64) uint64 {
+	const (
+		m0 = 0x1111111111111111
+		m1 = 0x2222222222222222
+		m2 = 0x4444444444444444
+		m3 = 0x8888888888888888
+	)
+	x0, x1, x2, x3 := x&m0, x&m1, x&m2, x&m3
+	y0, y1, y2, y3 := y&m0, y&m1, y&m2, y&m3
+	z0 := (x0 * y0) ^ (x1 * y3) ^ (x2 * y2) ^ (x3 * y1)
+	z1 := (x0 * y1) ^ (x1 * y0) ^ (x2 * y3) ^ (x3 * y2)
+	z2 := (x0 * y2) ^ (x1 * y1) ^ (x2 * y0) ^ (x3 * y3)
+	z3 := (x0 * y3) ^ (x1 * y2) ^ (x2 * y1) ^ (x3 * y0)
+	return z0&m0 | z1&m1 | z2&m2 | z3&m3
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// POLYVAL (RFC 8452, Section 3) using PCLMULQDQ. Field elements are kept in
+// their natural little-endian order, so blocks are loaded without any byte
+// swapping. Each block is multiplied in with a schoolbook product, followed by
+// a two-step Montgomery reduction by x^128. Each step multiplies the lowest
+// 64-bit word by polyvalPoly, x^63 + x^62 + x^57, and adds it (and the word
+// itself) back in 64 bits higher, which adds a multiple of
+// P = x^128 + x^127 + x^126 + x^121 + 1 that cancels that word.
+
+DATA polyvalPoly<>+0x00(SB)/8, $0xc200000000000000
+DATA polyvalPoly<>+0x08(SB)/8, $0x0000000000000000
+GLOBL polyvalPoly<>(SB), (NOPTR+RODATA), $16
+
+#define ACC X0
+#define H X1
+#define POLY X7
+
+// func polyvalBlocksAsm(s, h *[2]uint64, blocks []byte)
+TEXT ·polyvalBlocksAsm(SB), NOSPLIT, $0-40
+	MOVQ s+0(FP), DI
+	MOVQ h+8(FP), SI
+	MOVQ blocks_base+16(FP), DX
+	MOVQ blocks_len+24(FP), CX
+
+	MOVOU (DI), ACC
+	MOVOU (SI), H
+	MOVOU polyvalPoly<>(SB), POLY
+
+	SHRQ $4, CX
+	JZ   done
+
+loop:
+	MOVOU (DX), X2
+	PXOR  X2, ACC
+
+	// (X4:X3) = ACC * H
+	MOVOU     ACC, X3
+	PCLMULQDQ $0x00, H, X3
+	MOVOU     ACC, X4
+	PCLMULQDQ $0x11, H, X4
+	MOVOU     ACC, X5
+	PCLMULQDQ $0x01, H, X5
+	PCLMULQDQ $0x10, H, ACC
+	PXOR      ACC, X5
+	MOVOU     X5, X6
+	PSLLDQ    $8, X6
+	PXOR      X6, X3
+	PSRLDQ    $8, X5
+	PXOR      X5, X4
+
+	// Cancel the low 64 bits of X3, then the (new) high 64 bits.
+	MOVOU     X3, X8
+	PCLMULQDQ $0x00, POLY, X8
+	PSHUFD    $0x4e, X3, X3
+	PXOR      X8, X3
+	MOVOU     X3, X8
+	PCLMULQDQ $0x00, POLY, X8
+	PSHUFD    $0x4e, X3, X3
+	PXOR      X8, X3
+
+	PXOR  X4, X3
+	MOVOU X3, ACC
+
+	ADDQ $16, DX
+	DECQ CX
+	JNZ  loop
+
+done:
+	MOVOU ACC, (DI)
+	RET
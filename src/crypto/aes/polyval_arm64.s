@@ -0,0 +1,72 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// POLYVAL (RFC 8452, Section 3) using PMULL. This is the same algorithm as
+// polyval_amd64.s: field elements are kept in their natural little-endian
+// order, and each product is reduced with two Montgomery steps that multiply
+// the lowest 64-bit word by x^63 + x^62 + x^57 and add it back 64 bits higher.
+
+#define ACC V0
+#define H V1
+#define HSWAP V2
+#define T0 V3
+#define T1 V4
+#define MID V5
+#define ZERO V6
+#define POLY V7
+#define T2 V10
+
+// func polyvalBlocksAsm(s, h *[2]uint64, blocks []byte)
+TEXT ·polyvalBlocksAsm(SB), NOSPLIT, $0-40
+	MOVD	s+0(FP), R0
+	MOVD	h+8(FP), R1
+	MOVD	blocks_base+16(FP), R2
+	MOVD	blocks_len+24(FP), R3
+
+	VLD1	(R0), [ACC.B16]
+	VLD1	(R1), [H.B16]
+	VEXT	$8, H.B16, H.B16, HSWAP.B16
+	VEOR	ZERO.B16, ZERO.B16, ZERO.B16
+	MOVD	$0xc2, R4
+	LSL	$56, R4
+	VMOV	R4, POLY.D[0]
+
+	LSR	$4, R3
+	CBZ	R3, done
+
+loop:
+	VLD1.P	16(R2), [T0.B16]
+	VEOR	T0.B16, ACC.B16, ACC.B16
+
+	// (T1:T0) = ACC * H
+	VPMULL	ACC.D1, H.D1, T0.Q1
+	VPMULL2	ACC.D2, H.D2, T1.Q1
+	VPMULL	ACC.D1, HSWAP.D1, MID.Q1
+	VPMULL2	ACC.D2, HSWAP.D2, T2.Q1
+	VEOR	T2.B16, MID.B16, MID.B16
+	VEXT	$8, MID.B16, ZERO.B16, T2.B16
+	VEOR	T2.B16, T0.B16, T0.B16
+	VEXT	$8, ZERO.B16, MID.B16, T2.B16
+	VEOR	T2.B16, T1.B16, T1.B16
+
+	// Cancel the low 64 bits of T0, then the (new) high 64 bits.
+	VPMULL	POLY.D1, T0.D1, T2.Q1
+	VEXT	$8, T0.B16, T0.B16, T0.B16
+	VEOR	T2.B16, T0.B16, T0.B16
+	VPMULL	POLY.D1, T0.D1, T2.Q1
+	VEXT	$8, T0.B16, T0.B16, T0.B16
+	VEOR	T2.B16, T0.B16, T0.B16
+
+	VEOR	T1.B16, T0.B16, ACC.B16
+
+	SUB	$1, R3
+	CBNZ	R3, loop
+
+done:
+	VST1	[ACC.B16], (R0)
+	RET
@@ -0,0 +1,24 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (amd64 || arm64) && !purego
+
+package aes
+
+import "internal/cpu"
+
+// defined in polyval_*.s
+
+//go:noescape
+func polyvalBlocksAsm(s, h *[2]uint64, blocks []byte)
+
+var supportsPolyvalAsm = cpu.X86.HasPCLMULQDQ || cpu.ARM64.HasPMULL
+
+func polyvalBlocks(p *polyval, blocks []byte) {
+	if supportsPolyvalAsm {
+		polyvalBlocksAsm(&p.s, &p.h, blocks)
+		return
+	}
+	polyvalBlocksGeneric(p, blocks)
+}
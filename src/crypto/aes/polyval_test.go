@@ -0,0 +1,154 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/hex"
+	"internal/byteorder"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+func TestPOLYVAL(t *testing.T) {
+	// RFC 8452, Appendix A.
+	key, _ := hex.DecodeString("25629347589242761d31f826ba4b757b")
+	data, _ := hex.DecodeString("4f4f95668c83dfb6401762bb2d01a262d1a24ddd2721d006bbe45f20d3c9f362")
+	want, _ := hex.DecodeString("f7a3b47b846119fae5b7866cf5e5b77e")
+
+	var p polyval
+	p.init(key)
+	p.update(data)
+	var got [BlockSize]byte
+	p.sum(&got)
+	if !bytes.Equal(got[:], want) {
+		t.Errorf("POLYVAL = %x, want %x", got, want)
+	}
+
+	p.init(key)
+	polyvalBlocksGeneric(&p, data)
+	p.sum(&got)
+	if !bytes.Equal(got[:], want) {
+		t.Errorf("generic POLYVAL = %x, want %x", got, want)
+	}
+}
+
+// TestPOLYVALGeneric checks that polyvalBlocks, which might use assembly,
+// matches the generic implementation.
+func TestPOLYVALGeneric(t *testing.T) {
+	key := make([]byte, BlockSize)
+	data := make([]byte, 50*BlockSize)
+	for i := 0; i < 20; i++ {
+		randomBytes(key)
+		randomBytes(data)
+		n := i * BlockSize
+
+		var p1, p2 polyval
+		p1.init(key)
+		p2.init(key)
+		polyvalBlocks(&p1, data[:n])
+		polyvalBlocksGeneric(&p2, data[:n])
+		if p1 != p2 {
+			t.Fatalf("polyvalBlocks = %x, generic = %x", p1.s, p2.s)
+		}
+	}
+}
+
+func randomBytes(b []byte) {
+	for i := range b {
+		b[i] = byte(rand.Uint32())
+	}
+}
+
+func TestCLMUL64(t *testing.T) {
+	clmulRef := func(x, y uint64) (hi, lo uint64) {
+		for i := 0; i < 64; i++ {
+			if y>>i&1 == 1 {
+				lo ^= x << i
+				if i > 0 {
+					hi ^= x >> (64 - i)
+				}
+			}
+		}
+		return hi, lo
+	}
+	for i := 0; i < 1000; i++ {
+		x, y := rand.Uint64(), rand.Uint64()
+		if i == 0 {
+			x, y = ^uint64(0), ^uint64(0)
+		}
+		hi, lo := clmul64(x, y)
+		wantHi, wantLo := clmulRef(x, y)
+		if hi != wantHi || lo != wantLo {
+			t.Fatalf("clmul64(%#x, %#x) = %#x:%#x, want %#x:%#x", x, y, hi, lo, wantHi, wantLo)
+		}
+	}
+}
+
+// onlyBlock hides the optional interfaces of a cipher.Block.
+type onlyBlock struct {
+	cipher.Block
+}
+
+// TestGCMSIVCounterCrypt checks that gcmSIVCounterCrypt, which might use
+// assembly, matches a block at a time implementation, including when the
+// counter wraps around.
+func TestGCMSIVCounterCrypt(t *testing.T) {
+	want := func(enc cipher.Block, out, in []byte, tag *[16]byte) {
+		counter := *tag
+		counter[15] |= 0x80
+		var keystream [BlockSize]byte
+		for i := 0; i < len(in); i += BlockSize {
+			enc.Encrypt(keystream[:], counter[:])
+			subtle.XORBytes(out[i:], in[i:], keystream[:])
+			byteorder.LePutUint32(counter[:4], byteorder.LeUint32(counter[:4])+1)
+		}
+	}
+	in := make([]byte, 40*BlockSize+7)
+	randomBytes(in)
+	for _, keySize := range []int{16, 24, 32} {
+		key := make([]byte, keySize)
+		randomBytes(key)
+		enc, err := NewCipher(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, ctr := range []uint32{0, 0xfffffffb} {
+			var tag [16]byte
+			randomBytes(tag[:])
+			byteorder.LePutUint32(tag[:4], ctr)
+			for n := 0; n <= len(in); n += 5 {
+				got := make([]byte, n)
+				expected := make([]byte, n)
+				gcmSIVCounterCrypt(enc, got, in[:n], &tag)
+				want(onlyBlock{enc}, expected, in[:n], &tag)
+				if !bytes.Equal(got, expected) {
+					t.Fatalf("key size %d, counter %#x, length %d: got %x, want %x", keySize, ctr, n, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkGCMSIV(b *testing.B) {
+	for _, length := range []int{64, 1350, 8 * 1024} {
+		b.Run(strconv.Itoa(length), func(b *testing.B) {
+			aead, err := NewGCMSIV(make([]byte, 16))
+			if err != nil {
+				b.Fatal(err)
+			}
+			nonce := make([]byte, aead.NonceSize())
+			buf := make([]byte, length, length+aead.Overhead())
+			b.SetBytes(int64(length))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				aead.Seal(buf[:0], nonce, buf, nil)
+			}
+		})
+	}
+}
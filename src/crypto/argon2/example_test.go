@@ -0,0 +1,35 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package argon2_test
+
+import (
+	"crypto/argon2"
+	"fmt"
+	"log"
+)
+
+func Example() {
+	// Lower parameters than the defaults are used to keep the example fast.
+	params := &argon2.Params{Time: 1, Memory: 1024, Threads: 1, SaltLength: 16, KeyLength: 32}
+
+	// When the user sets their password, store the PHC-encoded hash.
+	hash, err := argon2.GenerateFromPassword([]byte("correct horse battery staple"), params)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// When the user logs in, check the password against the stored hash.
+	err = argon2.CompareHashAndPassword(hash, []byte("correct horse battery staple"))
+	fmt.Println("password ok:", err == nil)
+
+	// If the stored hash uses outdated parameters, replace it with a new one.
+	if rehash, err := argon2.NeedsRehash(hash, nil); err == nil && rehash {
+		fmt.Println("rehashing with the default parameters")
+	}
+
+	// Output:
+	// password ok: true
+	// rehashing with the default parameters
+}
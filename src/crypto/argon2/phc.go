@@ -0,0 +1,167 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package argon2
+
+import (
+	"crypto/internal/phc"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"math"
+)
+
+// Params are the Argon2id parameters used by [GenerateFromPassword].
+type Params struct {
+	// Time is the number of passes over the memory. It must be at least 1.
+	Time uint32
+	// Memory is the size of the memory in KiB. It must be at least 8 times
+	// Threads.
+	Memory uint32
+	// Threads is the degree of parallelism. It must be at least 1.
+	Threads uint8
+	// SaltLength is the length in bytes of the random salt. It must be at
+	// least 8, and 16 is recommended.
+	SaltLength uint32
+	// KeyLength is the length in bytes of the hash. It must be at least 4.
+	KeyLength uint32
+}
+
+// defaultParams are the second recommended option of RFC 9106, Section 4.
+var defaultParams = Params{
+	Time:       3,
+	Memory:     64 * 1024,
+	Threads:    4,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+// ErrMismatchedHashAndPassword is returned from [CompareHashAndPassword] when
+// a password and hash do not match.
+var ErrMismatchedHashAndPassword = errors.New("argon2: hashedPassword is not the hash of the given password")
+
+func (p *Params) check() error {
+	switch {
+	case p.Time < 1:
+		return errors.New("argon2: number of passes must be at least 1")
+	case p.Threads < 1:
+		return errors.New("argon2: parallelism degree must be at least 1")
+	case p.Memory < 8*uint32(p.Threads):
+		return errors.New("argon2: memory must be at least 8 KiB per thread")
+	case p.SaltLength < 8:
+		return errors.New("argon2: salt must be at least 8 bytes")
+	case p.KeyLength < 4:
+		return errors.New("argon2: key length must be at least 4 bytes")
+	}
+	return nil
+}
+
+// GenerateFromPassword returns the Argon2id hash of the password with a new
+// random salt, encoded as a PHC string along with the parameters.
+//
+// If params is nil, the second recommended option of RFC 9106 is used: three
+// passes over 64 MiB of memory with four threads, a 16-byte salt and a 32-byte
+// hash.
+func GenerateFromPassword(password []byte, params *Params) (string, error) {
+	if params == nil {
+		params = &defaultParams
+	}
+	if err := params.check(); err != nil {
+		return "", err
+	}
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	h := &phc.Hash{
+		ID:         "argon2id",
+		HasVersion: true,
+		Version:    Version,
+		Params: []phc.Param{
+			{Name: "m", Value: params.Memory},
+			{Name: "t", Value: params.Time},
+			{Name: "p", Value: uint32(params.Threads)},
+		},
+		Salt: salt,
+		Hash: IDKey(password, salt, params.Time, params.Memory, params.Threads, params.KeyLength),
+	}
+	return h.String(), nil
+}
+
+// CompareHashAndPassword compares a PHC-encoded Argon2 hash with a possible
+// plaintext equivalent. It returns nil on success, [ErrMismatchedHashAndPassword]
+// if the password does not match, or another error if the hash can't be parsed.
+//
+// Both Argon2id and Argon2i hashes are supported, but only of version 19
+// (0x13), the current version of the algorithm.
+//
+// Verifying a hash requires as much memory and time as computing it, so the
+// hash must come from a trusted source.
+func CompareHashAndPassword(hashedPassword string, password []byte) error {
+	mode, params, h, err := parseHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+	other := deriveKey(mode, password, h.Salt, nil, nil, params.Time, params.Memory, params.Threads, params.KeyLength)
+	if subtle.ConstantTimeCompare(h.Hash, other) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+// NeedsRehash reports whether the PHC-encoded Argon2 hash was computed with
+// something other than Argon2id and exactly the given parameters, in which
+// case the application should compute a new hash with [GenerateFromPassword]
+// the next time it verifies the password successfully.
+//
+// If params is nil, the defaults of [GenerateFromPassword] are used.
+func NeedsRehash(hashedPassword string, params *Params) (bool, error) {
+	if params == nil {
+		params = &defaultParams
+	}
+	mode, p, _, err := parseHash(hashedPassword)
+	if err != nil {
+		return false, err
+	}
+	return mode != argon2id || *p != *params, nil
+}
+
+func parseHash(s string) (mode int, params *Params, h *phc.Hash, err error) {
+	h, err = phc.Parse(s)
+	if err != nil {
+		return 0, nil, nil, errors.New("argon2: " + err.Error())
+	}
+	switch h.ID {
+	case "argon2id":
+		mode = argon2id
+	case "argon2i":
+		mode = argon2i
+	default:
+		return 0, nil, nil, errors.New("argon2: unsupported algorithm " + h.ID)
+	}
+	if !h.HasVersion || h.Version != Version {
+		return 0, nil, nil, errors.New("argon2: unsupported version")
+	}
+	v, err := h.Uint32Params("m", "t", "p")
+	if err != nil {
+		return 0, nil, nil, errors.New("argon2: " + err.Error())
+	}
+	if v[2] > math.MaxUint8 {
+		return 0, nil, nil, errors.New("argon2: unsupported parallelism degree")
+	}
+	if uint64(len(h.Salt)) > math.MaxUint32 || uint64(len(h.Hash)) > math.MaxUint32 {
+		return 0, nil, nil, errors.New("argon2: invalid hash")
+	}
+	params = &Params{
+		Memory:     v[0],
+		Time:       v[1],
+		Threads:    uint8(v[2]),
+		SaltLength: uint32(len(h.Salt)),
+		KeyLength:  uint32(len(h.Hash)),
+	}
+	if err := params.check(); err != nil {
+		return 0, nil, nil, err
+	}
+	return mode, params, h, nil
+}
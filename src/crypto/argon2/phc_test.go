@@ -0,0 +1,131 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package argon2
+
+import (
+	"strings"
+	"testing"
+)
+
+// From the tests of the reference implementation at
+// https://github.com/P-H-C/phc-winner-argon2.
+var phcVectors = []struct {
+	password, hash string
+}{
+	{"password", "$argon2i$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$wWKIMhR9lyDFvRz9YTZweHKfbftvj+qf+YFY4NeBbtA"},
+	{"password", "$argon2i$v=19$m=256,t=2,p=1$c29tZXNhbHQ$iekCn0Y3spW+sCcFanM2xBT63UP2sghkUoHLIUpWRS8"},
+	{"password", "$argon2i$v=19$m=256,t=2,p=2$c29tZXNhbHQ$T/XOJ2mh1/TIpJHfCdQan76Q5esCFVoT5MAeIM1Oq2E"},
+	{"differentpassword", "$argon2i$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$FK6NoBr+qHAMI1jc73xTWNkCEoK9iGY6RWL1n7dNIu4"},
+	{"password", "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc"},
+	{"password", "$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc"},
+}
+
+func TestCompareHashAndPassword(t *testing.T) {
+	for _, v := range phcVectors {
+		if err := CompareHashAndPassword(v.hash, []byte(v.password)); err != nil {
+			t.Errorf("%s: %v", v.hash, err)
+		}
+		if err := CompareHashAndPassword(v.hash, []byte(v.password+"x")); err != ErrMismatchedHashAndPassword {
+			t.Errorf("%s: wrong password: got %v, want ErrMismatchedHashAndPassword", v.hash, err)
+		}
+	}
+}
+
+func TestGenerateFromPassword(t *testing.T) {
+	params := &Params{Time: 1, Memory: 64, Threads: 2, SaltLength: 16, KeyLength: 24}
+	h, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(h, "$argon2id$v=19$m=64,t=1,p=2$") {
+		t.Errorf("unexpected encoding %q", h)
+	}
+	if err := CompareHashAndPassword(h, []byte("hunter2")); err != nil {
+		t.Error(err)
+	}
+	if err := CompareHashAndPassword(h, []byte("hunter3")); err != ErrMismatchedHashAndPassword {
+		t.Errorf("wrong password: got %v, want ErrMismatchedHashAndPassword", err)
+	}
+	h2, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h == h2 {
+		t.Error("two hashes of the same password are equal")
+	}
+
+	for _, p := range []Params{
+		{Time: 0, Memory: 64, Threads: 1, SaltLength: 16, KeyLength: 32},
+		{Time: 1, Memory: 64, Threads: 0, SaltLength: 16, KeyLength: 32},
+		{Time: 1, Memory: 15, Threads: 2, SaltLength: 16, KeyLength: 32},
+		{Time: 1, Memory: 64, Threads: 1, SaltLength: 7, KeyLength: 32},
+		{Time: 1, Memory: 64, Threads: 1, SaltLength: 16, KeyLength: 3},
+	} {
+		if _, err := GenerateFromPassword([]byte("hunter2"), &p); err == nil {
+			t.Errorf("GenerateFromPassword succeeded with %+v", p)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	params := &Params{Time: 2, Memory: 256, Threads: 2, SaltLength: 8, KeyLength: 32}
+	for _, tt := range []struct {
+		hash string
+		want bool
+	}{
+		{"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc", false},
+		{"$argon2i$v=19$m=256,t=2,p=2$c29tZXNhbHQ$T/XOJ2mh1/TIpJHfCdQan76Q5esCFVoT5MAeIM1Oq2E", true},
+		{"$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", true},
+	} {
+		got, err := NeedsRehash(tt.hash, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("NeedsRehash(%q) = %v, want %v", tt.hash, got, tt.want)
+		}
+	}
+
+	h, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := NeedsRehash(h, params); err != nil || got {
+		t.Errorf("NeedsRehash of a fresh hash = %v, %v", got, err)
+	}
+	if got, err := NeedsRehash(h, nil); err != nil || !got {
+		t.Errorf("NeedsRehash with default parameters = %v, %v", got, err)
+	}
+}
+
+func TestInvalidHash(t *testing.T) {
+	for _, h := range []string{
+		"",
+		"argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2d$v=19$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=16$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=019$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$t=2,m=256,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2,p=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=02,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=0,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2,p=256$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=8,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ=$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHR$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc",
+		"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ",
+		"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ$",
+		"$argon2id$v=19$m=256,t=2,p=2$c29tZXNhbHQ$bQk8UB/VmZZF4Oo79iDXuL5/0ttZwg2f/5U52iv1cDc$",
+	} {
+		if err := CompareHashAndPassword(h, []byte("password")); err == nil || err == ErrMismatchedHashAndPassword {
+			t.Errorf("CompareHashAndPassword(%q) = %v, want parsing error", h, err)
+		}
+		if _, err := NeedsRehash(h, nil); err == nil {
+			t.Errorf("NeedsRehash(%q) succeeded", h)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package chacha20poly1305 implements the XChaCha20-Poly1305 AEAD, as
+// specified in [draft-irtf-cfrg-xchacha-03].
+//
+// XChaCha20-Poly1305 is the ChaCha20-Poly1305 AEAD of [RFC 8439] with a
+// 24-byte nonce, which is long enough to be generated at random for any
+// number of messages under the same key without risk of collisions.
+//
+// This package uses the same implementation as crypto/tls, which has assembly
+// versions for amd64, arm64, ppc64le and s390x. It is separate from
+// [crypto/cipher] because that implementation depends on crypto/cipher.
+//
+// [RFC 8439]: https://www.rfc-editor.org/rfc/rfc8439.html
+// [draft-irtf-cfrg-xchacha-03]: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-xchacha-03
+package chacha20poly1305
+
+import (
+	"crypto/cipher"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// KeySize is the size of the key, in bytes.
+	KeySize = chacha20poly1305.KeySize
+
+	// NonceSizeX is the size of the XChaCha20-Poly1305 nonce, in bytes.
+	NonceSizeX = chacha20poly1305.NonceSizeX
+
+	// Overhead is the size of the authentication tag, in bytes.
+	Overhead = chacha20poly1305.Overhead
+)
+
+// NewX returns an XChaCha20-Poly1305 AEAD that uses the given 256-bit key.
+func NewX(key []byte) (cipher.AEAD, error) {
+	return chacha20poly1305.NewX(key)
+}
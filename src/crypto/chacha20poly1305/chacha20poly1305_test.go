@@ -0,0 +1,58 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chacha20poly1305_test
+
+import (
+	"bytes"
+	"crypto/chacha20poly1305"
+	"encoding/hex"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestVector(t *testing.T) {
+	// draft-irtf-cfrg-xchacha-03, Appendix A.3.1.
+	key := decodeHex("808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f")
+	nonce := decodeHex("404142434445464748494a4b4c4d4e4f5051525354555657")
+	ad := decodeHex("50515253c0c1c2c3c4c5c6c7")
+	plaintext := []byte("Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it.")
+	want := "bd6d179d3e83d43b9576579493c0e939572a1700252bfaccbed2902c21396cbb731c7f1b0b4aa6440bf3a82f4eda7e39" +
+		"ae64c6708c54c216cb96b72e1213b4522f8c9ba40db5d945b11b69b982c1bb9e3f3fac2bc369488f76b2383565d3ff" +
+		"f921f9664c97637da9768812f615c68b13b52e" + "c0875924c1c7987947deafd8780acf49"
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aead.NonceSize() != 24 || aead.Overhead() != 16 {
+		t.Errorf("NonceSize, Overhead = %d, %d; want 24, 16", aead.NonceSize(), aead.Overhead())
+	}
+	ct := aead.Seal(nil, nonce, plaintext, ad)
+	if got := hex.EncodeToString(ct); got != want {
+		t.Errorf("Seal = %s, want %s", got, want)
+	}
+	pt, err := aead.Open(nil, nonce, ct, ad)
+	if err != nil || !bytes.Equal(pt, plaintext) {
+		t.Errorf("Open = %q, %v", pt, err)
+	}
+	for _, i := range []int{0, len(plaintext), len(ct) - 1} {
+		ct[i] ^= 1
+		if _, err := aead.Open(nil, nonce, ct, ad); err == nil {
+			t.Errorf("Open succeeded after altering byte %d of the ciphertext", i)
+		}
+		ct[i] ^= 1
+	}
+
+	if _, err := chacha20poly1305.NewX(key[:31]); err == nil {
+		t.Error("NewX accepted a short key")
+	}
+}
@@ -6,6 +6,7 @@ package cipher
 
 import (
 	"crypto/internal/alias"
+	"crypto/internal/sysrand"
 	"crypto/subtle"
 	"errors"
 	"internal/byteorder"
@@ -109,6 +110,24 @@ func NewGCMWithTagSize(cipher Block, tagSize int) (AEAD, error) {
 	return newGCMWithNonceAndTagSize(cipher, gcmStandardNonceSize, tagSize)
 }
 
+// NewGCMWithRandomNonce returns the given 128-bit, block cipher wrapped in
+// Galois Counter Mode, with randomly-generated nonces.
+//
+// Seal generates a random 96-bit nonce and prepends it to the ciphertext, and
+// Open extracts it from there. The nonce passed to Seal and Open must be empty.
+// The NonceSize of the AEAD is zero, and its Overhead is 28 bytes: the nonce
+// size plus the tag size.
+//
+// A given key MUST NOT be used to encrypt more than 2^32 messages, to keep the
+// risk of a random nonce collision negligible.
+func NewGCMWithRandomNonce(cipher Block) (AEAD, error) {
+	aead, err := NewGCM(cipher)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmWithRandomNonce{aead}, nil
+}
+
 func newGCMWithNonceAndTagSize(cipher Block, nonceSize, tagSize int) (AEAD, error) {
 	if tagSize < gcmMinimumTagSize || tagSize > gcmBlockSize {
 		return nil, errors.New("cipher: incorrect tag size given to GCM")
@@ -243,6 +262,60 @@ func (g *gcm) Open(dst, nonce, ciphertext, data []byte) ([]byte, error) {
 	return ret, nil
 }
 
+type gcmWithRandomNonce struct {
+	aead AEAD
+}
+
+func (g *gcmWithRandomNonce) NonceSize() int {
+	return 0
+}
+
+func (g *gcmWithRandomNonce) Overhead() int {
+	return gcmStandardNonceSize + gcmTagSize
+}
+
+func (g *gcmWithRandomNonce) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != 0 {
+		panic("crypto/cipher: non-empty nonce passed to GCM with random nonce")
+	}
+
+	ret, out := sliceForAppend(dst, gcmStandardNonceSize+len(plaintext)+gcmTagSize)
+	if alias.InexactOverlap(out, plaintext) {
+		panic("crypto/cipher: invalid buffer overlap")
+	}
+	if alias.AnyOverlap(out, additionalData) {
+		panic("crypto/cipher: invalid buffer overlap of output and additional data")
+	}
+	nonce, out = out[:gcmStandardNonceSize], out[gcmStandardNonceSize:]
+
+	// When encrypting in place, plaintext needs to be moved forward to make
+	// room for the nonce, before the nonce is written over it.
+	copy(out, plaintext)
+	if err := sysrand.Read(nonce); err != nil {
+		panic("crypto/cipher: failed to generate nonce: " + err.Error())
+	}
+	g.aead.Seal(out[:0], nonce, out[:len(plaintext)], additionalData)
+	return ret
+}
+
+func (g *gcmWithRandomNonce) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != 0 {
+		panic("crypto/cipher: non-empty nonce passed to GCM with random nonce")
+	}
+	if len(ciphertext) < gcmStandardNonceSize+gcmTagSize {
+		return nil, errOpen
+	}
+
+	// When decrypting in place, the plaintext would be written at an offset
+	// from the ciphertext, which GCM doesn't support, so decrypt a copy.
+	_, out := sliceForAppend(dst, len(ciphertext))
+	if alias.AnyOverlap(out, ciphertext) {
+		ciphertext = append([]byte(nil), ciphertext...)
+	}
+	nonce, ciphertext = ciphertext[:gcmStandardNonceSize], ciphertext[gcmStandardNonceSize:]
+	return g.aead.Open(dst, nonce, ciphertext, additionalData)
+}
+
 // reverseBits reverses the order of the bits of 4-bit number in i.
 func reverseBits(i int) int {
 	i = ((i << 2) & 0xc) | ((i >> 2) & 0x3)
@@ -654,3 +654,67 @@ func TestGCMAsm(t *testing.T) {
 		}
 	}
 }
+
+func TestGCMWithRandomNonce(t *testing.T) {
+	key := make([]byte, 16)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := cipher.NewGCMWithRandomNonce(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aead.NonceSize() != 0 || aead.Overhead() != 12+16 {
+		t.Fatalf("NonceSize, Overhead = %d, %d, want 0, 28", aead.NonceSize(), aead.Overhead())
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := []byte("the quick brown fox")
+	ad := []byte("additional data")
+	ct := aead.Seal(nil, nil, plaintext, ad)
+	if len(ct) != len(plaintext)+aead.Overhead() {
+		t.Fatalf("len(ct) = %d, want %d", len(ct), len(plaintext)+aead.Overhead())
+	}
+	if got, err := gcm.Open(nil, ct[:12], ct[12:], ad); err != nil || !bytes.Equal(got, plaintext) {
+		t.Errorf("ciphertext is not a prepended nonce followed by GCM output")
+	}
+	if got, err := aead.Open(nil, nil, ct, ad); err != nil || !bytes.Equal(got, plaintext) {
+		t.Errorf("Open: got %q, %v, want %q", got, err, plaintext)
+	}
+	if ct2 := aead.Seal(nil, nil, plaintext, ad); bytes.Equal(ct[:12], ct2[:12]) {
+		t.Errorf("Seal reused a nonce")
+	}
+
+	// Encrypt and decrypt in place.
+	buf := append(make([]byte, 0, len(ct)), plaintext...)
+	sealed := aead.Seal(buf[:0], nil, buf, ad)
+	if got, err := aead.Open(sealed[:0], nil, sealed, ad); err != nil || !bytes.Equal(got, plaintext) {
+		t.Errorf("in-place Open: got %q, %v, want %q", got, err, plaintext)
+	}
+
+	ct[0] ^= 0x80
+	if _, err := aead.Open(nil, nil, ct, ad); err == nil {
+		t.Errorf("Open was successful after altering the nonce")
+	}
+	ct[0] ^= 0x80
+	ct[len(ct)-1] ^= 0x80
+	if _, err := aead.Open(nil, nil, ct, ad); err == nil {
+		t.Errorf("Open was successful after altering the tag")
+	}
+	if _, err := aead.Open(nil, nil, ct[:27], ad); err == nil {
+		t.Errorf("Open was successful on a truncated ciphertext")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Seal didn't panic with a non-empty nonce")
+			}
+		}()
+		aead.Seal(nil, make([]byte, 12), plaintext, ad)
+	}()
+}
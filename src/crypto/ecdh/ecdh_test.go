@@ -153,6 +153,13 @@ var vectors = map[ecdh.Curve]struct {
 		PeerPublicKey: "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f",
 		SharedSecret:  "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742",
 	},
+	// X448 test vector from RFC 7748, Section 6.2.
+	ecdh.X448(): {
+		PrivateKey:    "9a8f4925d1519f5775cf46b04b5800d4ee9ee8bae8bc5565d498c28dd9c9baf574a9419744897391006382a6f127ab1d9ac2d8c0a598726b",
+		PublicKey:     "9b08f7cc31b7e3e67d22d5aea121074a273bd2b83de09c63faa73d2c22c5d9bbc836647241d953d40c5b12da88120d53177f80e532c41fa0",
+		PeerPublicKey: "3eb7a829b0cd20f5bcfc0b599b6feccf6da4627107bdb0d4f345b43027d8b972fc3e34fb4232a13ca706dcb57aec3dae07bdc1c67bf33609",
+		SharedSecret:  "07fff4181ac6cc95ec1c16a94a0f74d12da232ce40a77552281d282bb60c0b56fd2464c335543936521c24403085d59a449a5037514a879d",
+	},
 }
 
 func TestVectors(t *testing.T) {
@@ -207,12 +214,27 @@ func TestX25519Failure(t *testing.T) {
 	t.Run("low order point", func(t *testing.T) { testX25519Failure(t, randomScalar, lowOrderPoint) })
 }
 
+func TestX448Failure(t *testing.T) {
+	identity := make([]byte, 56)
+	// u = 1 has order four, and is the only low order point other than zero.
+	lowOrderPoint := append([]byte{1}, make([]byte, 55)...)
+	randomScalar := make([]byte, 56)
+	rand.Read(randomScalar)
+
+	t.Run("identity point", func(t *testing.T) { testFailure(t, ecdh.X448(), randomScalar, identity) })
+	t.Run("low order point", func(t *testing.T) { testFailure(t, ecdh.X448(), randomScalar, lowOrderPoint) })
+}
+
 func testX25519Failure(t *testing.T, private, public []byte) {
-	priv, err := ecdh.X25519().NewPrivateKey(private)
+	testFailure(t, ecdh.X25519(), private, public)
+}
+
+func testFailure(t *testing.T, curve ecdh.Curve, private, public []byte) {
+	priv, err := curve.NewPrivateKey(private)
 	if err != nil {
 		t.Fatal(err)
 	}
-	pub, err := ecdh.X25519().NewPublicKey(public)
+	pub, err := curve.NewPublicKey(public)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -277,6 +299,14 @@ var invalidPrivateKeys = map[ecdh.Curve][]string{
 		"000101010101010101010101010101010101010101010101010101010101010101",
 		strings.Repeat("01", 200),
 	},
+	ecdh.X448(): {
+		// X448 only rejects bad lengths.
+		"",
+		"01",
+		strings.Repeat("01", 55),
+		strings.Repeat("01", 57),
+		strings.Repeat("01", 200),
+	},
 }
 
 func TestNewPrivateKey(t *testing.T) {
@@ -338,6 +368,12 @@ var invalidPublicKeys = map[ecdh.Curve][]string{
 		"04000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 	},
 	ecdh.X25519(): {},
+	ecdh.X448(): {
+		// X448 only rejects bad lengths.
+		"",
+		strings.Repeat("05", 32),
+		strings.Repeat("05", 57),
+	},
 }
 
 func TestNewPublicKey(t *testing.T) {
@@ -360,6 +396,7 @@ func testAllCurves(t *testing.T, f func(t *testing.T, curve ecdh.Curve)) {
 	t.Run("P384", func(t *testing.T) { f(t, ecdh.P384()) })
 	t.Run("P521", func(t *testing.T) { f(t, ecdh.P521()) })
 	t.Run("X25519", func(t *testing.T) { f(t, ecdh.X25519()) })
+	t.Run("X448", func(t *testing.T) { f(t, ecdh.X448()) })
 }
 
 func BenchmarkECDH(b *testing.B) {
@@ -406,6 +443,7 @@ func benchmarkAllCurves(b *testing.B, f func(b *testing.B, curve ecdh.Curve)) {
 	b.Run("P384", func(b *testing.B) { f(b, ecdh.P384()) })
 	b.Run("P521", func(b *testing.B) { f(b, ecdh.P521()) })
 	b.Run("X25519", func(b *testing.B) { f(b, ecdh.X25519()) })
+	b.Run("X448", func(b *testing.B) { f(b, ecdh.X448()) })
 }
 
 type zr struct{}
@@ -495,6 +533,7 @@ func TestMismatchedCurves(t *testing.T) {
 		{"P384", ecdh.P384()},
 		{"P521", ecdh.P521()},
 		{"X25519", ecdh.X25519()},
+		{"X448", ecdh.X448()},
 	}
 
 	for _, privCurve := range curves {
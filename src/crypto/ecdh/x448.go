@@ -0,0 +1,137 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto/internal/edwards448/field"
+	"crypto/internal/randutil"
+	"errors"
+	"io"
+)
+
+var (
+	x448PublicKeySize    = 56
+	x448PrivateKeySize   = 56
+	x448SharedSecretSize = 56
+)
+
+// X448 returns a [Curve] which implements the X448 function over Curve448
+// (RFC 7748, Section 5).
+//
+// Multiple invocations of this function will return the same value, so it can
+// be used for equality checks and switch statements.
+func X448() Curve { return x448 }
+
+var x448 = &x448Curve{}
+
+type x448Curve struct{}
+
+func (c *x448Curve) String() string {
+	return "X448"
+}
+
+func (c *x448Curve) GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	key := make([]byte, x448PrivateKeySize)
+	randutil.MaybeReadByte(rand)
+	if _, err := io.ReadFull(rand, key); err != nil {
+		return nil, err
+	}
+	return c.NewPrivateKey(key)
+}
+
+func (c *x448Curve) NewPrivateKey(key []byte) (*PrivateKey, error) {
+	if len(key) != x448PrivateKeySize {
+		return nil, errors.New("crypto/ecdh: invalid private key size")
+	}
+	return &PrivateKey{
+		curve:      c,
+		privateKey: append([]byte{}, key...),
+	}, nil
+}
+
+func (c *x448Curve) privateKeyToPublicKey(key *PrivateKey) *PublicKey {
+	if key.curve != c {
+		panic("crypto/ecdh: internal error: converting the wrong key type")
+	}
+	k := &PublicKey{
+		curve:     key.curve,
+		publicKey: make([]byte, x448PublicKeySize),
+	}
+	x448Basepoint := [56]byte{5}
+	x448ScalarMult(k.publicKey, key.privateKey, x448Basepoint[:])
+	return k
+}
+
+func (c *x448Curve) NewPublicKey(key []byte) (*PublicKey, error) {
+	if len(key) != x448PublicKeySize {
+		return nil, errors.New("crypto/ecdh: invalid public key")
+	}
+	return &PublicKey{
+		curve:     c,
+		publicKey: append([]byte{}, key...),
+	}, nil
+}
+
+func (c *x448Curve) ecdh(local *PrivateKey, remote *PublicKey) ([]byte, error) {
+	out := make([]byte, x448SharedSecretSize)
+	x448ScalarMult(out, local.privateKey, remote.publicKey)
+	if isZero(out) {
+		return nil, errors.New("crypto/ecdh: bad X448 remote ECDH input: low order point")
+	}
+	return out, nil
+}
+
+func x448ScalarMult(dst, scalar, point []byte) {
+	var e [56]byte
+
+	copy(e[:], scalar[:])
+	e[0] &= 252
+	e[55] |= 128
+
+	var x1, x2, z2, x3, z3, a, aa, b, bb, c, d, da, cb field.Element
+	x1.SetBytes(point[:])
+	x2.One()
+	x3.Set(&x1)
+	z3.One()
+
+	// This is the Montgomery ladder of RFC 7748, Section 5.
+	swap := 0
+	for pos := 447; pos >= 0; pos-- {
+		bit := e[pos/8] >> uint(pos&7)
+		bit &= 1
+		swap ^= int(bit)
+		x2.Swap(&x3, swap)
+		z2.Swap(&z3, swap)
+		swap = int(bit)
+
+		a.Add(&x2, &z2)
+		aa.Square(&a)
+		b.Subtract(&x2, &z2)
+		bb.Square(&b)
+		c.Add(&x3, &z3)
+		d.Subtract(&x3, &z3)
+		da.Multiply(&d, &a)
+		cb.Multiply(&c, &b)
+
+		x3.Add(&da, &cb)
+		x3.Square(&x3)
+		z3.Subtract(&da, &cb)
+		z3.Square(&z3)
+		z3.Multiply(&x1, &z3)
+
+		x2.Multiply(&aa, &bb)
+		b.Subtract(&aa, &bb) // E = AA - BB
+		z2.Mult32(&b, 39081) // a24 * E
+		z2.Add(&aa, &z2)
+		z2.Multiply(&b, &z2)
+	}
+
+	x2.Swap(&x3, swap)
+	z2.Swap(&z3, swap)
+
+	z2.Invert(&z2)
+	x2.Multiply(&x2, &z2)
+	copy(dst[:], x2.Bytes())
+}
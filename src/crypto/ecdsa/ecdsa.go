@@ -5,9 +5,11 @@
 // Package ecdsa implements the Elliptic Curve Digital Signature Algorithm, as
 // defined in FIPS 186-4 and SEC 1, Version 2.0.
 //
-// Signatures generated by this package are not deterministic, but entropy is
-// mixed with the private key and the message, achieving the same level of
-// security in case of randomness source failure.
+// Signatures generated by this package are not deterministic by default, but
+// entropy is mixed with the private key and the message, achieving the same
+// level of security in case of randomness source failure. Deterministic
+// signatures, as specified in RFC 6979, can be produced by passing a nil
+// rand to [PrivateKey.Sign].
 //
 // Operations involving private keys are implemented using constant-time
 // algorithms, as long as an [elliptic.Curve] returned by [elliptic.P224],
@@ -24,23 +26,18 @@ package ecdsa
 // [SEC 1, Version 2.0]: https://www.secg.org/sec1-v2.pdf
 
 import (
-	"bytes"
 	"crypto"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/ecdh"
 	"crypto/elliptic"
-	"crypto/internal/bigmod"
 	"crypto/internal/boring"
 	"crypto/internal/boring/bbig"
-	"crypto/internal/nistec"
+	"crypto/internal/fips140/ecdsa"
+	"crypto/internal/fips140only"
 	"crypto/internal/randutil"
-	"crypto/sha512"
 	"crypto/subtle"
 	"errors"
 	"io"
 	"math/big"
-	"sync"
 
 	"golang.org/x/crypto/cryptobyte"
 	"golang.org/x/crypto/cryptobyte/asn1"
@@ -144,13 +141,26 @@ func bigIntEqual(a, b *big.Int) bool {
 }
 
 // Sign signs digest with priv, reading randomness from rand. The opts argument
-// is not currently used but, in keeping with the crypto.Signer interface,
-// should be the hash function used to digest the message.
+// is only used for deterministic signatures but, in keeping with the
+// crypto.Signer interface, should be the hash function used to digest the
+// message.
+//
+// If rand is nil, Sign produces a deterministic signature according to RFC
+// 6979. In that case, opts.HashFunc() must be the hash function used to
+// produce digest, which is also used to generate the nonce, and priv.Curve
+// must be one of the curves returned by [elliptic.P224], [elliptic.P256],
+// [elliptic.P384], or [elliptic.P521].
 //
 // This method implements crypto.Signer, which is an interface to support keys
 // where the private part is kept in, for example, a hardware module. Common
 // uses can use the [SignASN1] function in this package directly.
 func (priv *PrivateKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if rand == nil {
+		if opts == nil {
+			return nil, errors.New("ecdsa: deterministic signatures require a hash function")
+		}
+		return signDeterministic(priv, opts.HashFunc(), digest)
+	}
 	return SignASN1(rand, priv, digest)
 }
 
@@ -173,82 +183,26 @@ func GenerateKey(c elliptic.Curve, rand io.Reader) (*PrivateKey, error) {
 
 	switch c.Params() {
 	case elliptic.P224().Params():
-		return generateNISTEC(p224(), rand)
+		return generateFIPS(c, ecdsa.P224(), rand)
 	case elliptic.P256().Params():
-		return generateNISTEC(p256(), rand)
+		return generateFIPS(c, ecdsa.P256(), rand)
 	case elliptic.P384().Params():
-		return generateNISTEC(p384(), rand)
+		return generateFIPS(c, ecdsa.P384(), rand)
 	case elliptic.P521().Params():
-		return generateNISTEC(p521(), rand)
+		return generateFIPS(c, ecdsa.P521(), rand)
 	default:
 		return generateLegacy(c, rand)
 	}
 }
 
-func generateNISTEC[Point nistPoint[Point]](c *nistCurve[Point], rand io.Reader) (*PrivateKey, error) {
-	k, Q, err := randomPoint(c, rand)
+func generateFIPS[P ecdsa.Point[P]](curve elliptic.Curve, c *ecdsa.Curve[P], rand io.Reader) (*PrivateKey, error) {
+	privateKey, err := ecdsa.GenerateKey(c, rand)
 	if err != nil {
 		return nil, err
 	}
-
-	priv := new(PrivateKey)
-	priv.PublicKey.Curve = c.curve
-	priv.D = new(big.Int).SetBytes(k.Bytes(c.N))
-	priv.PublicKey.X, priv.PublicKey.Y, err = c.pointToAffine(Q)
-	if err != nil {
-		return nil, err
-	}
-	return priv, nil
-}
-
-// randomPoint returns a random scalar and the corresponding point using the
-// procedure given in FIPS 186-4, Appendix B.5.2 (rejection sampling).
-func randomPoint[Point nistPoint[Point]](c *nistCurve[Point], rand io.Reader) (k *bigmod.Nat, p Point, err error) {
-	k = bigmod.NewNat()
-	for {
-		b := make([]byte, c.N.Size())
-		if _, err = io.ReadFull(rand, b); err != nil {
-			return
-		}
-
-		// Mask off any excess bits to increase the chance of hitting a value in
-		// (0, N). These are the most dangerous lines in the package and maybe in
-		// the library: a single bit of bias in the selection of nonces would likely
-		// lead to key recovery, but no tests would fail. Look but DO NOT TOUCH.
-		if excess := len(b)*8 - c.N.BitLen(); excess > 0 {
-			// Just to be safe, assert that this only happens for the one curve that
-			// doesn't have a round number of bits.
-			if excess != 0 && c.curve.Params().Name != "P-521" {
-				panic("ecdsa: internal error: unexpectedly masking off bits")
-			}
-			b[0] >>= excess
-		}
-
-		// FIPS 186-4 makes us check k <= N - 2 and then add one.
-		// Checking 0 < k <= N - 1 is strictly equivalent.
-		// None of this matters anyway because the chance of selecting
-		// zero is cryptographically negligible.
-		if _, err = k.SetBytes(b, c.N); err == nil && k.IsZero() == 0 {
-			break
-		}
-
-		if testingOnlyRejectionSamplingLooped != nil {
-			testingOnlyRejectionSamplingLooped()
-		}
-	}
-
-	p, err = c.newPoint().ScalarBaseMult(k.Bytes(c.N))
-	return
+	return privateKeyFromFIPS(curve, privateKey)
 }
 
-// testingOnlyRejectionSamplingLooped is called when rejection sampling in
-// randomPoint rejects a candidate for being higher than the modulus.
-var testingOnlyRejectionSamplingLooped func()
-
-// errNoAsm is returned by signAsm and verifyAsm when the assembly
-// implementation is not available.
-var errNoAsm = errors.New("no assembly implementation available")
-
 // SignASN1 signs a hash (which should be the result of hashing a larger message)
 // using the private key, priv. If the hash is longer than the bit-length of the
 // private key's curve order, the hash will be truncated to that length. It
@@ -269,74 +223,70 @@ func SignASN1(rand io.Reader, priv *PrivateKey, hash []byte) ([]byte, error) {
 	}
 	boring.UnreachableExceptTests()
 
-	csprng, err := mixedCSPRNG(rand, priv, hash)
-	if err != nil {
-		return nil, err
-	}
-
-	if sig, err := signAsm(priv, csprng, hash); err != errNoAsm {
-		return sig, err
-	}
-
 	switch priv.Curve.Params() {
 	case elliptic.P224().Params():
-		return signNISTEC(p224(), priv, csprng, hash)
+		return signFIPS(ecdsa.P224(), priv, rand, hash)
 	case elliptic.P256().Params():
-		return signNISTEC(p256(), priv, csprng, hash)
+		return signFIPS(ecdsa.P256(), priv, rand, hash)
 	case elliptic.P384().Params():
-		return signNISTEC(p384(), priv, csprng, hash)
+		return signFIPS(ecdsa.P384(), priv, rand, hash)
 	case elliptic.P521().Params():
-		return signNISTEC(p521(), priv, csprng, hash)
+		return signFIPS(ecdsa.P521(), priv, rand, hash)
 	default:
-		return signLegacy(priv, csprng, hash)
+		return signLegacy(priv, rand, hash)
 	}
 }
 
-func signNISTEC[Point nistPoint[Point]](c *nistCurve[Point], priv *PrivateKey, csprng io.Reader, hash []byte) (sig []byte, err error) {
-	// SEC 1, Version 2.0, Section 4.1.3
-
-	k, R, err := randomPoint(c, csprng)
+func signFIPS[P ecdsa.Point[P]](c *ecdsa.Curve[P], priv *PrivateKey, rand io.Reader, hash []byte) ([]byte, error) {
+	k, err := privateKeyToFIPS(c, priv)
 	if err != nil {
 		return nil, err
 	}
-
-	// kInv = k⁻¹
-	kInv := bigmod.NewNat()
-	inverse(c, kInv, k)
-
-	Rx, err := R.BytesX()
-	if err != nil {
-		return nil, err
-	}
-	r, err := bigmod.NewNat().SetOverflowingBytes(Rx, c.N)
+	sig, err := ecdsa.Sign(c, k, rand, hash)
 	if err != nil {
 		return nil, err
 	}
+	return encodeSignature(sig.R, sig.S)
+}
 
-	// The spec wants us to retry here, but the chance of hitting this condition
-	// on a large prime-order group like the NIST curves we support is
-	// cryptographically negligible. If we hit it, something is awfully wrong.
-	if r.IsZero() == 1 {
-		return nil, errors.New("ecdsa: internal error: r is zero")
+// signDeterministic signs hash with priv using a nonce derived from the
+// private key and hash as specified in RFC 6979, with HMAC_DRBG instantiated
+// with h.
+func signDeterministic(priv *PrivateKey, h crypto.Hash, hash []byte) ([]byte, error) {
+	if !h.Available() {
+		return nil, errors.New("ecdsa: hash function for deterministic signature is not available")
+	}
+	if len(hash) != h.Size() {
+		return nil, errors.New("ecdsa: digest length does not match the hash function")
+	}
+	if fips140only.Enabled && !fips140only.ApprovedHash(h.New()) {
+		return nil, errors.New("crypto/ecdsa: use of hash functions other than SHA-2 or SHA-3 is not allowed in FIPS 140-only mode")
 	}
 
-	e := bigmod.NewNat()
-	hashToNat(c, e, hash)
+	switch priv.Curve.Params() {
+	case elliptic.P224().Params():
+		return signFIPSDeterministic(ecdsa.P224(), h, priv, hash)
+	case elliptic.P256().Params():
+		return signFIPSDeterministic(ecdsa.P256(), h, priv, hash)
+	case elliptic.P384().Params():
+		return signFIPSDeterministic(ecdsa.P384(), h, priv, hash)
+	case elliptic.P521().Params():
+		return signFIPSDeterministic(ecdsa.P521(), h, priv, hash)
+	default:
+		return nil, errors.New("ecdsa: deterministic signatures are not supported on custom curves")
+	}
+}
 
-	s, err := bigmod.NewNat().SetBytes(priv.D.Bytes(), c.N)
+func signFIPSDeterministic[P ecdsa.Point[P]](c *ecdsa.Curve[P], h crypto.Hash, priv *PrivateKey, hash []byte) ([]byte, error) {
+	k, err := privateKeyToFIPS(c, priv)
 	if err != nil {
 		return nil, err
 	}
-	s.Mul(r, c.N)
-	s.Add(e, c.N)
-	s.Mul(kInv, c.N)
-
-	// Again, the chance of this happening is cryptographically negligible.
-	if s.IsZero() == 1 {
-		return nil, errors.New("ecdsa: internal error: s is zero")
+	sig, err := ecdsa.SignDeterministic(c, h.New, k, hash)
+	if err != nil {
+		return nil, err
 	}
-
-	return encodeSignature(r.Bytes(c.N), s.Bytes(c.N))
+	return encodeSignature(sig.R, sig.S)
 }
 
 func encodeSignature(r, s []byte) ([]byte, error) {
@@ -366,105 +316,6 @@ func addASN1IntBytes(b *cryptobyte.Builder, bytes []byte) {
 	})
 }
 
-// inverse sets kInv to the inverse of k modulo the order of the curve.
-func inverse[Point nistPoint[Point]](c *nistCurve[Point], kInv, k *bigmod.Nat) {
-	if c.curve.Params().Name == "P-256" {
-		kBytes, err := nistec.P256OrdInverse(k.Bytes(c.N))
-		// Some platforms don't implement P256OrdInverse, and always return an error.
-		if err == nil {
-			_, err := kInv.SetBytes(kBytes, c.N)
-			if err != nil {
-				panic("ecdsa: internal error: P256OrdInverse produced an invalid value")
-			}
-			return
-		}
-	}
-
-	// Calculate the inverse of s in GF(N) using Fermat's method
-	// (exponentiation modulo P - 2, per Euler's theorem)
-	kInv.Exp(k, c.nMinus2, c.N)
-}
-
-// hashToNat sets e to the left-most bits of hash, according to
-// SEC 1, Section 4.1.3, point 5 and Section 4.1.4, point 3.
-func hashToNat[Point nistPoint[Point]](c *nistCurve[Point], e *bigmod.Nat, hash []byte) {
-	// ECDSA asks us to take the left-most log2(N) bits of hash, and use them as
-	// an integer modulo N. This is the absolute worst of all worlds: we still
-	// have to reduce, because the result might still overflow N, but to take
-	// the left-most bits for P-521 we have to do a right shift.
-	if size := c.N.Size(); len(hash) >= size {
-		hash = hash[:size]
-		if excess := len(hash)*8 - c.N.BitLen(); excess > 0 {
-			hash = bytes.Clone(hash)
-			for i := len(hash) - 1; i >= 0; i-- {
-				hash[i] >>= excess
-				if i > 0 {
-					hash[i] |= hash[i-1] << (8 - excess)
-				}
-			}
-		}
-	}
-	_, err := e.SetOverflowingBytes(hash, c.N)
-	if err != nil {
-		panic("ecdsa: internal error: truncated hash is too long")
-	}
-}
-
-// mixedCSPRNG returns a CSPRNG that mixes entropy from rand with the message
-// and the private key, to protect the key in case rand fails. This is
-// equivalent in security to RFC 6979 deterministic nonce generation, but still
-// produces randomized signatures.
-func mixedCSPRNG(rand io.Reader, priv *PrivateKey, hash []byte) (io.Reader, error) {
-	// This implementation derives the nonce from an AES-CTR CSPRNG keyed by:
-	//
-	//    SHA2-512(priv.D || entropy || hash)[:32]
-	//
-	// The CSPRNG key is indifferentiable from a random oracle as shown in
-	// [Coron], the AES-CTR stream is indifferentiable from a random oracle
-	// under standard cryptographic assumptions (see [Larsson] for examples).
-	//
-	// [Coron]: https://cs.nyu.edu/~dodis/ps/merkle.pdf
-	// [Larsson]: https://web.archive.org/web/20040719170906/https://www.nada.kth.se/kurser/kth/2D1441/semteo03/lecturenotes/assump.pdf
-
-	// Get 256 bits of entropy from rand.
-	entropy := make([]byte, 32)
-	if _, err := io.ReadFull(rand, entropy); err != nil {
-		return nil, err
-	}
-
-	// Initialize an SHA-512 hash context; digest...
-	md := sha512.New()
-	md.Write(priv.D.Bytes()) // the private key,
-	md.Write(entropy)        // the entropy,
-	md.Write(hash)           // and the input hash;
-	key := md.Sum(nil)[:32]  // and compute ChopMD-256(SHA-512),
-	// which is an indifferentiable MAC.
-
-	// Create an AES-CTR instance to use as a CSPRNG.
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create a CSPRNG that xors a stream of zeros with
-	// the output of the AES-CTR instance.
-	const aesIV = "IV for ECDSA CTR"
-	return &cipher.StreamReader{
-		R: zeroReader,
-		S: cipher.NewCTR(block, []byte(aesIV)),
-	}, nil
-}
-
-type zr struct{}
-
-var zeroReader = zr{}
-
-// Read replaces the contents of dst with zeros. It is safe for concurrent use.
-func (zr) Read(dst []byte) (n int, err error) {
-	clear(dst)
-	return len(dst), nil
-}
-
 // VerifyASN1 verifies the ASN.1 encoded signature, sig, of hash using the
 // public key, pub. Its return value records whether the signature is valid.
 //
@@ -480,75 +331,30 @@ func VerifyASN1(pub *PublicKey, hash, sig []byte) bool {
 	}
 	boring.UnreachableExceptTests()
 
-	if err := verifyAsm(pub, hash, sig); err != errNoAsm {
-		return err == nil
-	}
-
 	switch pub.Curve.Params() {
 	case elliptic.P224().Params():
-		return verifyNISTEC(p224(), pub, hash, sig)
+		return verifyFIPS(ecdsa.P224(), pub, hash, sig)
 	case elliptic.P256().Params():
-		return verifyNISTEC(p256(), pub, hash, sig)
+		return verifyFIPS(ecdsa.P256(), pub, hash, sig)
 	case elliptic.P384().Params():
-		return verifyNISTEC(p384(), pub, hash, sig)
+		return verifyFIPS(ecdsa.P384(), pub, hash, sig)
 	case elliptic.P521().Params():
-		return verifyNISTEC(p521(), pub, hash, sig)
+		return verifyFIPS(ecdsa.P521(), pub, hash, sig)
 	default:
 		return verifyLegacy(pub, hash, sig)
 	}
 }
 
-func verifyNISTEC[Point nistPoint[Point]](c *nistCurve[Point], pub *PublicKey, hash, sig []byte) bool {
-	rBytes, sBytes, err := parseSignature(sig)
-	if err != nil {
-		return false
-	}
-
-	Q, err := c.pointFromAffine(pub.X, pub.Y)
-	if err != nil {
-		return false
-	}
-
-	// SEC 1, Version 2.0, Section 4.1.4
-
-	r, err := bigmod.NewNat().SetBytes(rBytes, c.N)
-	if err != nil || r.IsZero() == 1 {
-		return false
-	}
-	s, err := bigmod.NewNat().SetBytes(sBytes, c.N)
-	if err != nil || s.IsZero() == 1 {
-		return false
-	}
-
-	e := bigmod.NewNat()
-	hashToNat(c, e, hash)
-
-	// w = s⁻¹
-	w := bigmod.NewNat()
-	inverse(c, w, s)
-
-	// p₁ = [e * s⁻¹]G
-	p1, err := c.newPoint().ScalarBaseMult(e.Mul(w, c.N).Bytes(c.N))
+func verifyFIPS[P ecdsa.Point[P]](c *ecdsa.Curve[P], pub *PublicKey, hash, sig []byte) bool {
+	r, s, err := parseSignature(sig)
 	if err != nil {
 		return false
 	}
-	// p₂ = [r * s⁻¹]Q
-	p2, err := Q.ScalarMult(Q, w.Mul(r, c.N).Bytes(c.N))
+	k, err := publicKeyToFIPS(c, pub)
 	if err != nil {
 		return false
 	}
-	// BytesX returns an error for the point at infinity.
-	Rx, err := p1.Add(p1, p2).BytesX()
-	if err != nil {
-		return false
-	}
-
-	v, err := bigmod.NewNat().SetOverflowingBytes(Rx, c.N)
-	if err != nil {
-		return false
-	}
-
-	return v.Equal(r) == 1
+	return ecdsa.Verify(c, k, hash, &ecdsa.Signature{R: r, S: s}) == nil
 }
 
 func parseSignature(sig []byte) (r, s []byte, err error) {
@@ -564,114 +370,67 @@ func parseSignature(sig []byte) (r, s []byte, err error) {
 	return r, s, nil
 }
 
-type nistCurve[Point nistPoint[Point]] struct {
-	newPoint func() Point
-	curve    elliptic.Curve
-	N        *bigmod.Modulus
-	nMinus2  []byte
+// publicKeyToFIPS converts pub to a key of the FIPS 140-3 module.
+func publicKeyToFIPS[P ecdsa.Point[P]](c *ecdsa.Curve[P], pub *PublicKey) (*ecdsa.PublicKey, error) {
+	Q, err := pointFromAffine(pub.Curve, pub.X, pub.Y)
+	if err != nil {
+		return nil, err
+	}
+	return ecdsa.NewPublicKey(c, Q)
+}
+
+// privateKeyToFIPS converts priv to a key of the FIPS 140-3 module.
+func privateKeyToFIPS[P ecdsa.Point[P]](c *ecdsa.Curve[P], priv *PrivateKey) (*ecdsa.PrivateKey, error) {
+	Q, err := pointFromAffine(priv.Curve, priv.X, priv.Y)
+	if err != nil {
+		return nil, err
+	}
+	size := c.N.Size()
+	if priv.D.Sign() <= 0 || priv.D.BitLen() > size*8 {
+		return nil, errors.New("ecdsa: invalid private key")
+	}
+	return ecdsa.NewPrivateKey(c, priv.D.FillBytes(make([]byte, size)), Q)
 }
 
-// nistPoint is a generic constraint for the nistec Point types.
-type nistPoint[T any] interface {
-	Bytes() []byte
-	BytesX() ([]byte, error)
-	SetBytes([]byte) (T, error)
-	Add(T, T) T
-	ScalarMult(T, []byte) (T, error)
-	ScalarBaseMult([]byte) (T, error)
+// privateKeyFromFIPS converts a key of the FIPS 140-3 module to a PrivateKey.
+func privateKeyFromFIPS(curve elliptic.Curve, priv *ecdsa.PrivateKey) (*PrivateKey, error) {
+	x, y, err := pointToAffine(curve, priv.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{
+		PublicKey: PublicKey{Curve: curve, X: x, Y: y},
+		D:         new(big.Int).SetBytes(priv.Bytes()),
+	}, nil
 }
 
-// pointFromAffine is used to convert the PublicKey to a nistec Point.
-func (curve *nistCurve[Point]) pointFromAffine(x, y *big.Int) (p Point, err error) {
-	bitSize := curve.curve.Params().BitSize
+// pointFromAffine returns the uncompressed encoding of the point x, y.
+func pointFromAffine(curve elliptic.Curve, x, y *big.Int) ([]byte, error) {
+	bitSize := curve.Params().BitSize
 	// Reject values that would not get correctly encoded.
 	if x.Sign() < 0 || y.Sign() < 0 {
-		return p, errors.New("negative coordinate")
+		return nil, errors.New("negative coordinate")
 	}
 	if x.BitLen() > bitSize || y.BitLen() > bitSize {
-		return p, errors.New("overflowing coordinate")
+		return nil, errors.New("overflowing coordinate")
 	}
-	// Encode the coordinates and let SetBytes reject invalid points.
+	// Encode the coordinates and let the module reject invalid points.
 	byteLen := (bitSize + 7) / 8
 	buf := make([]byte, 1+2*byteLen)
 	buf[0] = 4 // uncompressed point
 	x.FillBytes(buf[1 : 1+byteLen])
 	y.FillBytes(buf[1+byteLen : 1+2*byteLen])
-	return curve.newPoint().SetBytes(buf)
+	return buf, nil
 }
 
-// pointToAffine is used to convert a nistec Point to a PublicKey.
-func (curve *nistCurve[Point]) pointToAffine(p Point) (x, y *big.Int, err error) {
-	out := p.Bytes()
-	if len(out) == 1 && out[0] == 0 {
+// pointToAffine returns the coordinates of the uncompressed point p.
+func pointToAffine(curve elliptic.Curve, p []byte) (x, y *big.Int, err error) {
+	if len(p) == 1 && p[0] == 0 {
 		// This is the encoding of the point at infinity.
 		return nil, nil, errors.New("ecdsa: public key point is the infinity")
 	}
-	byteLen := (curve.curve.Params().BitSize + 7) / 8
-	x = new(big.Int).SetBytes(out[1 : 1+byteLen])
-	y = new(big.Int).SetBytes(out[1+byteLen:])
+	byteLen := (curve.Params().BitSize + 7) / 8
+	x = new(big.Int).SetBytes(p[1 : 1+byteLen])
+	y = new(big.Int).SetBytes(p[1+byteLen:])
 	return x, y, nil
 }
-
-var p224Once sync.Once
-var _p224 *nistCurve[*nistec.P224Point]
-
-func p224() *nistCurve[*nistec.P224Point] {
-	p224Once.Do(func() {
-		_p224 = &nistCurve[*nistec.P224Point]{
-			newPoint: func() *nistec.P224Point { return nistec.NewP224Point() },
-		}
-		precomputeParams(_p224, elliptic.P224())
-	})
-	return _p224
-}
-
-var p256Once sync.Once
-var _p256 *nistCurve[*nistec.P256Point]
-
-func p256() *nistCurve[*nistec.P256Point] {
-	p256Once.Do(func() {
-		_p256 = &nistCurve[*nistec.P256Point]{
-			newPoint: func() *nistec.P256Point { return nistec.NewP256Point() },
-		}
-		precomputeParams(_p256, elliptic.P256())
-	})
-	return _p256
-}
-
-var p384Once sync.Once
-var _p384 *nistCurve[*nistec.P384Point]
-
-func p384() *nistCurve[*nistec.P384Point] {
-	p384Once.Do(func() {
-		_p384 = &nistCurve[*nistec.P384Point]{
-			newPoint: func() *nistec.P384Point { return nistec.NewP384Point() },
-		}
-		precomputeParams(_p384, elliptic.P384())
-	})
-	return _p384
-}
-
-var p521Once sync.Once
-var _p521 *nistCurve[*nistec.P521Point]
-
-func p521() *nistCurve[*nistec.P521Point] {
-	p521Once.Do(func() {
-		_p521 = &nistCurve[*nistec.P521Point]{
-			newPoint: func() *nistec.P521Point { return nistec.NewP521Point() },
-		}
-		precomputeParams(_p521, elliptic.P521())
-	})
-	return _p521
-}
-
-func precomputeParams[Point nistPoint[Point]](c *nistCurve[Point], curve elliptic.Curve) {
-	params := curve.Params()
-	c.curve = curve
-	var err error
-	c.N, err = bigmod.NewModulusFromBig(params.N)
-	if err != nil {
-		panic(err)
-	}
-	c.nMinus2 = new(big.Int).Sub(params.N, big.NewInt(2)).Bytes()
-}
@@ -8,8 +8,8 @@ import (
 	"bufio"
 	"bytes"
 	"compress/bzip2"
+	"crypto"
 	"crypto/elliptic"
-	"crypto/internal/bigmod"
 	"crypto/rand"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -339,80 +339,6 @@ func testZeroHashSignature(t *testing.T, curve elliptic.Curve) {
 	}
 }
 
-func TestRandomPoint(t *testing.T) {
-	t.Run("P-224", func(t *testing.T) { testRandomPoint(t, p224()) })
-	t.Run("P-256", func(t *testing.T) { testRandomPoint(t, p256()) })
-	t.Run("P-384", func(t *testing.T) { testRandomPoint(t, p384()) })
-	t.Run("P-521", func(t *testing.T) { testRandomPoint(t, p521()) })
-}
-
-func testRandomPoint[Point nistPoint[Point]](t *testing.T, c *nistCurve[Point]) {
-	t.Cleanup(func() { testingOnlyRejectionSamplingLooped = nil })
-	var loopCount int
-	testingOnlyRejectionSamplingLooped = func() { loopCount++ }
-
-	// A sequence of all ones will generate 2^N-1, which should be rejected.
-	// (Unless, for example, we are masking too many bits.)
-	r := io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0xff}, 100)), rand.Reader)
-	if k, p, err := randomPoint(c, r); err != nil {
-		t.Fatal(err)
-	} else if k.IsZero() == 1 {
-		t.Error("k is zero")
-	} else if p.Bytes()[0] != 4 {
-		t.Error("p is infinity")
-	}
-	if loopCount == 0 {
-		t.Error("overflow was not rejected")
-	}
-	loopCount = 0
-
-	// A sequence of all zeroes will generate zero, which should be rejected.
-	r = io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0}, 100)), rand.Reader)
-	if k, p, err := randomPoint(c, r); err != nil {
-		t.Fatal(err)
-	} else if k.IsZero() == 1 {
-		t.Error("k is zero")
-	} else if p.Bytes()[0] != 4 {
-		t.Error("p is infinity")
-	}
-	if loopCount == 0 {
-		t.Error("zero was not rejected")
-	}
-	loopCount = 0
-
-	// P-256 has a 2⁻³² chance or randomly hitting a rejection. For P-224 it's
-	// 2⁻¹¹², for P-384 it's 2⁻¹⁹⁴, and for P-521 it's 2⁻²⁶², so if we hit in
-	// tests, something is horribly wrong. (For example, we are masking the
-	// wrong bits.)
-	if c.curve == elliptic.P256() {
-		return
-	}
-	if k, p, err := randomPoint(c, rand.Reader); err != nil {
-		t.Fatal(err)
-	} else if k.IsZero() == 1 {
-		t.Error("k is zero")
-	} else if p.Bytes()[0] != 4 {
-		t.Error("p is infinity")
-	}
-	if loopCount > 0 {
-		t.Error("unexpected rejection")
-	}
-}
-
-func TestHashToNat(t *testing.T) {
-	t.Run("P-224", func(t *testing.T) { testHashToNat(t, p224()) })
-	t.Run("P-256", func(t *testing.T) { testHashToNat(t, p256()) })
-	t.Run("P-384", func(t *testing.T) { testHashToNat(t, p384()) })
-	t.Run("P-521", func(t *testing.T) { testHashToNat(t, p521()) })
-}
-
-func testHashToNat[Point nistPoint[Point]](t *testing.T, c *nistCurve[Point]) {
-	for l := 0; l < 600; l++ {
-		h := bytes.Repeat([]byte{0xff}, l)
-		hashToNat(c, bigmod.NewNat(), h)
-	}
-}
-
 func TestZeroSignature(t *testing.T) {
 	testAllCurves(t, testZeroSignature)
 }
@@ -494,25 +420,6 @@ func testRMinusNSignature(t *testing.T, curve elliptic.Curve) {
 	}
 }
 
-func randomPointForCurve(curve elliptic.Curve, rand io.Reader) error {
-	switch curve.Params() {
-	case elliptic.P224().Params():
-		_, _, err := randomPoint(p224(), rand)
-		return err
-	case elliptic.P256().Params():
-		_, _, err := randomPoint(p256(), rand)
-		return err
-	case elliptic.P384().Params():
-		_, _, err := randomPoint(p384(), rand)
-		return err
-	case elliptic.P521().Params():
-		_, _, err := randomPoint(p521(), rand)
-		return err
-	default:
-		panic("unknown curve")
-	}
-}
-
 func benchmarkAllCurves(b *testing.B, f func(*testing.B, elliptic.Curve)) {
 	tests := []struct {
 		name  string
@@ -587,3 +494,125 @@ func BenchmarkGenerateKey(b *testing.B) {
 		}
 	})
 }
+
+func TestRFC6979(t *testing.T) {
+	// Test vectors from RFC 6979, Appendix A.2, with SHA-256.
+	p224Key := []string{
+		"F220266E1105BFE3083E03EC7A3A654651F45E37167E88600BF257C1",
+		"00CF08DA5AD719E42707FA431292DEA11244D64FC51610D94B130D6C",
+		"EEAB6F3DEBE455E3DBF85416F7030CBD94F34F2D6F232C69F3C1385A",
+	}
+	p256Key := []string{
+		"C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721",
+		"60FED4BA255A9D31C961EB74C6356D68C049B8923B61FA6CE669622E60F29FB6",
+		"7903FE1008B8BC99A41AE9E95628BC64F2F1B20C2D7E9F5177A3C294D4462299",
+	}
+	p384Key := []string{
+		"6B9D3DAD2E1B8C1C05B19875B6659F4DE23C3B667BF297BA9AA47740787137D896D5724E4C70A825F872C9EA60D2EDF5",
+		"EC3A4E415B4E19A4568618029F427FA5DA9A8BC4AE92E02E06AAE5286B300C64DEF8F0EA9055866064A254515480BC13",
+		"8015D9B72D7D57244EA8EF9AC0C621896708A59367F9DFB9F54CA84B3F1C9DB1288B231C3AE0D4FE7344FD2533264720",
+	}
+	p521Key := []string{
+		"0FAD06DAA62BA3B25D2FB40133DA757205DE67F5BB0018FEE8C86E1B68C7E75CAA896EB32F1F47C70855836A6D16FCC1466F6D8FBEC67DB89EC0C08B0E996B83538",
+		"1894550D0785932E00EAA23B694F213F8C3121F86DC97A04E5A7167DB4E5BCD371123D46E45DB6B5D5370A7F20FB633155D38FFA16D2BD761DCAC474B9A2F5023A4",
+		"0493101C962CD4D2FDDF782285E64584139C2F91B47F87FF82354D6630F746A28A0DB25741B5B34A828008B22ACC23F924FAAFBD4D33F81EA66956DFEAA2BFDFCF5",
+	}
+	tests := []struct {
+		curve elliptic.Curve
+		key   []string // D, X, Y
+		msg   string
+		r, s  string
+	}{
+		{elliptic.P224(), p224Key, "sample",
+			"61AA3DA010E8E8406C656BC477A7A7189895E7E840CDFE8FF42307BA",
+			"BC814050DAB5D23770879494F9E0A680DC1AF7161991BDE692B10101"},
+		{elliptic.P224(), p224Key, "test",
+			"AD04DDE87B84747A243A631EA47A1BA6D1FAA059149AD2440DE6FBA6",
+			"178D49B1AE90E3D8B629BE3DB5683915F4E8C99FDF6E666CF37ADCFD"},
+		{elliptic.P256(), p256Key, "sample",
+			"EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716",
+			"F7CB1C942D657C41D436C7A1B6E29F65F3E900DBB9AFF4064DC4AB2F843ACDA8"},
+		{elliptic.P256(), p256Key, "test",
+			"F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367",
+			"019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F0083"},
+		// This message was chosen to make the first candidate nonce exceed
+		// the order of the curve, exercising step h.3 of Section 3.2. See also
+		// TestDeterministicRejection in crypto/internal/fips140/ecdsa.
+		{elliptic.P256(), p256Key, "wv[vnX",
+			"EFD9073B652E76DA1B5A019C0E4A2E3FA529B035A6ABB91EF67F0ED7A1F21234",
+			"3DB4706C9D9F4A4FE13BB5E08EF0FAB53A57DBAB2061C83A35FA411C68D2BA33"},
+		{elliptic.P384(), p384Key, "sample",
+			"21B13D1E013C7FA1392D03C5F99AF8B30C570C6F98D4EA8E354B63A21D3DAA33BDE1E888E63355D92FA2B3C36D8FB2CD",
+			"F3AA443FB107745BF4BD77CB3891674632068A10CA67E3D45DB2266FA7D1FEEBEFDC63ECCD1AC42EC0CB8668A4FA0AB0"},
+		{elliptic.P384(), p384Key, "test",
+			"6D6DEFAC9AB64DABAFE36C6BF510352A4CC27001263638E5B16D9BB51D451559F918EEDAF2293BE5B475CC8F0188636B",
+			"2D46F3BECBCC523D5F1A1256BF0C9B024D879BA9E838144C8BA6BAEB4B53B47D51AB373F9845C0514EEFB14024787265"},
+		{elliptic.P521(), p521Key, "sample",
+			"1511BB4D675114FE266FC4372B87682BAECC01D3CC62CF2303C92B3526012659D16876E25C7C1E57648F23B73564D67F61C6F14D527D54972810421E7D87589E1A7",
+			"04A171143A83163D6DF460AAF61522695F207A58B95C0644D87E52AA1A347916E4F7A72930B1BC06DBE22CE3F58264AFD23704CBB63B29B931F7DE6C9D949A7ECFC"},
+		{elliptic.P521(), p521Key, "test",
+			"00E871C4A14F993C6C7369501900C4BC1E9C7B0B4BA44E04868B30B41D8071042EB28C4C250411D0CE08CD197E4188EA4876F279F90B3D8D74A3C76E6F1E4656AA8",
+			"0CD52DBAA33B063C3A6CD8058A1FB0A46A4754B034FCC644766CA14DA8CA5CA9FDE00E88C1AD60CCBA759025299079D7A427EC3CC5B619BFBC828E7769BCD694E86"},
+	}
+	for _, tt := range tests {
+		priv := &PrivateKey{
+			PublicKey: PublicKey{Curve: tt.curve, X: fromHex(tt.key[1]), Y: fromHex(tt.key[2])},
+			D:         fromHex(tt.key[0]),
+		}
+		h := sha256.Sum256([]byte(tt.msg))
+		sig, err := priv.Sign(nil, h[:], crypto.SHA256)
+		if err != nil {
+			t.Fatalf("%s/%s: %v", tt.curve.Params().Name, tt.msg, err)
+		}
+		want, err := encodeSignature(fromHex(tt.r).Bytes(), fromHex(tt.s).Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, want) {
+			t.Errorf("%s/%s: got signature %x, want %x", tt.curve.Params().Name, tt.msg, sig, want)
+		}
+	}
+}
+
+func TestSignDeterministic(t *testing.T) {
+	testAllCurves(t, testSignDeterministic)
+}
+
+func testSignDeterministic(t *testing.T, c elliptic.Curve) {
+	priv, _ := GenerateKey(c, rand.Reader)
+
+	for _, h := range []crypto.Hash{crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512} {
+		hf := h.New()
+		hf.Write([]byte("testing"))
+		digest := hf.Sum(nil)
+
+		sig, err := priv.Sign(nil, digest, h)
+		if _, ok := c.(*elliptic.CurveParams); ok {
+			if err == nil {
+				t.Fatal("deterministic signature on a generic curve succeeded")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%v: %v", h, err)
+		}
+		if !VerifyASN1(&priv.PublicKey, digest, sig) {
+			t.Errorf("%v: deterministic signature failed to verify", h)
+		}
+		sig2, err := priv.Sign(nil, digest, h)
+		if err != nil {
+			t.Fatalf("%v: %v", h, err)
+		}
+		if !bytes.Equal(sig, sig2) {
+			t.Errorf("%v: deterministic signatures differ", h)
+		}
+	}
+
+	digest := sha256.Sum256([]byte("testing"))
+	if _, err := priv.Sign(nil, digest[:], crypto.SHA384); err == nil {
+		t.Error("deterministic signature with mismatched digest length succeeded")
+	}
+	if _, err := priv.Sign(nil, digest[:], nil); err == nil {
+		t.Error("deterministic signature without a hash function succeeded")
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ed448 implements the Ed448 signature algorithm, as defined in
+// RFC 8032, including the Ed448ph pre-hashed variant and context strings.
+//
+// As in [crypto/ed25519], this package's private key representation includes
+// a public key suffix to make multiple signing operations with the same key
+// more efficient. This package refers to the RFC 8032 private key as the
+// “seed”.
+//
+// Operations involving private keys are implemented using constant-time
+// algorithms.
+package ed448
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/internal/edwards448"
+	cryptorand "crypto/rand"
+	"crypto/sha3"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"strconv"
+)
+
+const (
+	// PublicKeySize is the size, in bytes, of public keys as used in this package.
+	PublicKeySize = 57
+	// PrivateKeySize is the size, in bytes, of private keys as used in this package.
+	PrivateKeySize = 114
+	// SignatureSize is the size, in bytes, of signatures generated and verified by this package.
+	SignatureSize = 114
+	// SeedSize is the size, in bytes, of private key seeds. These are the private key representations used by RFC 8032.
+	SeedSize = 57
+	// PrehashSize is the size, in bytes, of the SHAKE256 message digests
+	// signed and verified by Ed448ph.
+	PrehashSize = 64
+)
+
+// PublicKey is the type of Ed448 public keys.
+type PublicKey []byte
+
+// Any methods implemented on PublicKey might need to also be implemented on
+// PrivateKey, as the latter embeds the former and will expose its methods.
+
+// Equal reports whether pub and x have the same value.
+func (pub PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(PublicKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(pub, xx) == 1
+}
+
+// PrivateKey is the type of Ed448 private keys. It implements [crypto.Signer].
+type PrivateKey []byte
+
+// Public returns the [PublicKey] corresponding to priv.
+func (priv PrivateKey) Public() crypto.PublicKey {
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, priv[SeedSize:])
+	return PublicKey(publicKey)
+}
+
+// Equal reports whether priv and x have the same value.
+func (priv PrivateKey) Equal(x crypto.PrivateKey) bool {
+	xx, ok := x.(PrivateKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(priv, xx) == 1
+}
+
+// Seed returns the private key seed corresponding to priv. It is provided for
+// interoperability with RFC 8032. RFC 8032's private keys correspond to seeds
+// in this package.
+func (priv PrivateKey) Seed() []byte {
+	return bytes.Clone(priv[:SeedSize])
+}
+
+// Sign signs the given message with priv. rand is ignored and can be nil.
+//
+// opts.HashFunc() must be [crypto.Hash](0), as there is no [crypto.Hash] value
+// for SHAKE256. A value of type [Options] can be used as opts to select
+// Ed448ph, in which case message is expected to be a [PrehashSize]-byte
+// SHAKE256 digest, or to provide a context string. Otherwise, plain Ed448 is
+// used and the message must not be hashed, as Ed448 performs two passes over
+// messages to be signed.
+func (priv PrivateKey) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed448: expected opts.HashFunc() zero (use Options to select Ed448ph)")
+	}
+	var o Options
+	if opts, ok := opts.(*Options); ok {
+		o = *opts
+	}
+	if err := o.check(message); err != nil {
+		return nil, err
+	}
+	signature = make([]byte, SignatureSize)
+	sign(signature, priv, message, o.Prehash, o.Context)
+	return signature, nil
+}
+
+// Options can be used with [PrivateKey.Sign] or [VerifyWithOptions]
+// to select Ed448 variants.
+type Options struct {
+	// Prehash selects Ed448ph, in which case the message must be the
+	// [PrehashSize]-byte SHAKE256 digest of the message to be signed.
+	Prehash bool
+
+	// Context is the context string of Ed448 or Ed448ph. It can be at most
+	// 255 bytes in length.
+	Context string
+}
+
+// HashFunc returns zero, as SHAKE256 has no corresponding [crypto.Hash] value.
+// It is provided so that Options implements [crypto.SignerOpts].
+func (o *Options) HashFunc() crypto.Hash { return crypto.Hash(0) }
+
+func (o *Options) check(message []byte) error {
+	if l := len(o.Context); l > 255 {
+		return errors.New("ed448: bad context length: " + strconv.Itoa(l))
+	}
+	if l := len(message); o.Prehash && l != PrehashSize {
+		return errors.New("ed448: bad Ed448ph message hash length: " + strconv.Itoa(l))
+	}
+	return nil
+}
+
+// GenerateKey generates a public/private key pair using entropy from rand.
+// If rand is nil, [crypto/rand.Reader] will be used.
+//
+// The output of this function is deterministic, and equivalent to reading
+// [SeedSize] bytes from rand, and passing them to [NewKeyFromSeed].
+func GenerateKey(rand io.Reader) (PublicKey, PrivateKey, error) {
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, err
+	}
+
+	privateKey := NewKeyFromSeed(seed)
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, privateKey[SeedSize:])
+
+	return publicKey, privateKey, nil
+}
+
+// NewKeyFromSeed calculates a private key from a seed. It will panic if
+// len(seed) is not [SeedSize]. This function is provided for interoperability
+// with RFC 8032. RFC 8032's private keys correspond to seeds in this
+// package.
+func NewKeyFromSeed(seed []byte) PrivateKey {
+	// Outline the function body so that the returned key can be stack-allocated.
+	privateKey := make([]byte, PrivateKeySize)
+	newKeyFromSeed(privateKey, seed)
+	return privateKey
+}
+
+func newKeyFromSeed(privateKey, seed []byte) {
+	if l := len(seed); l != SeedSize {
+		panic("ed448: bad seed length: " + strconv.Itoa(l))
+	}
+
+	h := sha3.SumSHAKE256(seed, 114)
+	s, err := edwards448.NewScalar().SetBytesWithClamping(h[:57])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+	A := new(edwards448.Point).ScalarBaseMult(s)
+
+	copy(privateKey, seed)
+	copy(privateKey[SeedSize:], A.Bytes())
+}
+
+// Sign signs the message with privateKey and returns a signature. It will
+// panic if len(privateKey) is not [PrivateKeySize].
+func Sign(privateKey PrivateKey, message []byte) []byte {
+	// Outline the function body so that the returned signature can be
+	// stack-allocated.
+	signature := make([]byte, SignatureSize)
+	sign(signature, privateKey, message, false, "")
+	return signature
+}
+
+// domPrefix is the prefix of dom4, which is prepended to every hash input
+// with the phflag and the uint8-length prefixed context. Unlike Ed25519,
+// Ed448 uses it even when the context is empty. See RFC 8032, Section 5.2.
+const domPrefix = "SigEd448"
+
+// newHash returns a SHAKE256 instance that absorbed dom4(phflag, context).
+func newHash(prehash bool, context string) *sha3.SHAKE {
+	h := sha3.NewSHAKE256()
+	h.Write([]byte(domPrefix))
+	if prehash {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+	}
+	h.Write([]byte{byte(len(context))})
+	h.Write([]byte(context))
+	return h
+}
+
+func sign(signature, privateKey, message []byte, prehash bool, context string) {
+	if l := len(privateKey); l != PrivateKeySize {
+		panic("ed448: bad private key length: " + strconv.Itoa(l))
+	}
+	seed, publicKey := privateKey[:SeedSize], privateKey[SeedSize:]
+
+	h := sha3.SumSHAKE256(seed, 114)
+	s, err := edwards448.NewScalar().SetBytesWithClamping(h[:57])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+	prefix := h[57:]
+
+	mh := newHash(prehash, context)
+	mh.Write(prefix)
+	mh.Write(message)
+	messageDigest := make([]byte, 114)
+	mh.Read(messageDigest)
+	r, err := edwards448.NewScalar().SetUniformBytes(messageDigest)
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	R := new(edwards448.Point).ScalarBaseMult(r)
+
+	kh := newHash(prehash, context)
+	kh.Write(R.Bytes())
+	kh.Write(publicKey)
+	kh.Write(message)
+	hramDigest := make([]byte, 114)
+	kh.Read(hramDigest)
+	k, err := edwards448.NewScalar().SetUniformBytes(hramDigest)
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	S := edwards448.NewScalar().MultiplyAdd(k, s, r)
+
+	copy(signature[:57], R.Bytes())
+	copy(signature[57:], S.Bytes())
+}
+
+// Verify reports whether sig is a valid Ed448 signature of message by
+// publicKey, with an empty context string. It will panic if len(publicKey) is
+// not [PublicKeySize].
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func Verify(publicKey PublicKey, message, sig []byte) bool {
+	return verify(publicKey, message, sig, false, "")
+}
+
+// VerifyWithOptions reports whether sig is a valid signature of message by
+// publicKey. A valid signature is indicated by returning a nil error. It will
+// panic if len(publicKey) is not [PublicKeySize].
+//
+// If opts.Prehash is true, the pre-hashed variant Ed448ph is used and message
+// is expected to be a [PrehashSize]-byte SHAKE256 digest.
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func VerifyWithOptions(publicKey PublicKey, message, sig []byte, opts *Options) error {
+	if err := opts.check(message); err != nil {
+		return err
+	}
+	if !verify(publicKey, message, sig, opts.Prehash, opts.Context) {
+		return errors.New("ed448: invalid signature")
+	}
+	return nil
+}
+
+func verify(publicKey PublicKey, message, sig []byte, prehash bool, context string) bool {
+	if l := len(publicKey); l != PublicKeySize {
+		panic("ed448: bad public key length: " + strconv.Itoa(l))
+	}
+
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	A, err := new(edwards448.Point).SetBytes(publicKey)
+	if err != nil {
+		return false
+	}
+
+	kh := newHash(prehash, context)
+	kh.Write(sig[:57])
+	kh.Write(publicKey)
+	kh.Write(message)
+	hramDigest := make([]byte, 114)
+	kh.Read(hramDigest)
+	k, err := edwards448.NewScalar().SetUniformBytes(hramDigest)
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	S, err := edwards448.NewScalar().SetCanonicalBytes(sig[57:])
+	if err != nil {
+		return false
+	}
+
+	// [S]B = R + [k]A --> [k](-A) + [S]B = R
+	minusA := new(edwards448.Point).Negate(A)
+	R := new(edwards448.Point).ScalarMult(k, minusA)
+	R.Add(R, new(edwards448.Point).ScalarBaseMult(S))
+
+	return bytes.Equal(sig[:57], R.Bytes())
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ed448
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha3"
+	"encoding/hex"
+	"testing"
+)
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// Test vectors from RFC 8032, Sections 7.4 and 7.5.
+var rfcVectors = []struct {
+	name               string
+	seed, pub, message string
+	context            string
+	prehash            bool
+	sig                string
+}{
+	{
+		name:    "blank",
+		seed:    "6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b",
+		pub:     "5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180",
+		message: "",
+		sig:     "533a37f6bbe457251f023c0d88f976ae2dfb504a843e34d2074fd823d41a591f2b233f034f628281f2fd7a22ddd47d7828c59bd0a21bfd3980ff0d2028d4b18a9df63e006c5d1c2d345b925d8dc00b4104852db99ac5c7cdda8530a113a0f4dbb61149f05a7363268c71d95808ff2e652600",
+	},
+	{
+		name:    "1 octet",
+		seed:    "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		pub:     "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message: "03",
+		sig:     "26b8f91727bd62897af15e41eb43c377efb9c610d48f2335cb0bd0087810f4352541b143c4b981b7e18f62de8ccdf633fc1bf037ab7cd779805e0dbcc0aae1cbcee1afb2e027df36bc04dcecbf154336c19f0af7e0a6472905e799f1953d2a0ff3348ab21aa4adafd1d234441cf807c03a00",
+	},
+	{
+		name:    "1 octet with context",
+		seed:    "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		pub:     "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message: "03",
+		context: "foo",
+		sig:     "d4f8f6131770dd46f40867d6fd5d5055de43541f8c5e35abbcd001b32a89f7d2151f7647f11d8ca2ae279fb842d607217fce6e042f6815ea000c85741de5c8da1144a6a1aba7f96de42505d7a7298524fda538fccbbb754f578c1cad10d54d0d5428407e85dcbc98a49155c13764e66c3c00",
+	},
+	{
+		name:    "Ed448ph",
+		seed:    "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42ef7822e0d5104127dc05d6dbefde69e3ab2cec7c867c6e2c49",
+		pub:     "259b71c19f83ef77a7abd26524cbdb3161b590a48f7d17de3ee0ba9c52beb743c09428a131d6b1b57303d90d8132c276d5ed3d5d01c0f53880",
+		message: "616263",
+		prehash: true,
+		sig:     "822f6901f7480f3d5f562c592994d9693602875614483256505600bbc281ae381f54d6bce2ea911574932f52a4e6cadd78769375ec3ffd1b801a0d9b3f4030cd433964b6457ea39476511214f97469b57dd32dbc560a9a94d00bff07620464a3ad203df7dc7ce360c3cd3696d9d9fab90f00",
+	},
+	{
+		name:    "Ed448ph with context",
+		seed:    "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42ef7822e0d5104127dc05d6dbefde69e3ab2cec7c867c6e2c49",
+		pub:     "259b71c19f83ef77a7abd26524cbdb3161b590a48f7d17de3ee0ba9c52beb743c09428a131d6b1b57303d90d8132c276d5ed3d5d01c0f53880",
+		message: "616263",
+		context: "foo",
+		prehash: true,
+		sig:     "c32299d46ec8ff02b54540982814dce9a05812f81962b649d528095916a2aa481065b1580423ef927ecf0af5888f90da0f6a9a85ad5dc3f280d91224ba9911a3653d00e484e2ce232521481c8658df304bb7745a73514cdb9bf3e15784ab71284f8d0704a608c54a6b62d97beb511d132100",
+	},
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	for _, tt := range rfcVectors {
+		t.Run(tt.name, func(t *testing.T) {
+			priv := NewKeyFromSeed(decodeHex(t, tt.seed))
+			pub := priv.Public().(PublicKey)
+			if want := decodeHex(t, tt.pub); !bytes.Equal(pub, want) {
+				t.Fatalf("public key = %x, want %x", pub, want)
+			}
+
+			message := decodeHex(t, tt.message)
+			if tt.prehash {
+				message = sha3.SumSHAKE256(message, PrehashSize)
+			}
+			opts := &Options{Prehash: tt.prehash, Context: tt.context}
+			sig, err := priv.Sign(nil, message, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := decodeHex(t, tt.sig); !bytes.Equal(sig, want) {
+				t.Errorf("signature = %x, want %x", sig, want)
+			}
+			if err := VerifyWithOptions(pub, message, sig, opts); err != nil {
+				t.Errorf("valid signature rejected: %v", err)
+			}
+			if !tt.prehash && tt.context == "" {
+				if !bytes.Equal(Sign(priv, message), sig) {
+					t.Error("Sign doesn't match PrivateKey.Sign")
+				}
+				if !Verify(pub, message, sig) {
+					t.Error("Verify rejected a valid signature")
+				}
+			}
+
+			// The signature must not verify under any other variant.
+			for _, other := range []*Options{
+				{Prehash: !tt.prehash, Context: tt.context},
+				{Prehash: tt.prehash, Context: tt.context + "x"},
+			} {
+				if other.Prehash && len(message) != PrehashSize {
+					continue
+				}
+				if err := VerifyWithOptions(pub, message, sig, other); err == nil {
+					t.Errorf("signature verified with options %+v", other)
+				}
+			}
+		})
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	public, private, _ := GenerateKey(rand.Reader)
+
+	message := []byte("test message")
+	sig := Sign(private, message)
+	if !Verify(public, message, sig) {
+		t.Errorf("valid signature rejected")
+	}
+
+	wrongMessage := []byte("wrong message")
+	if Verify(public, wrongMessage, sig) {
+		t.Errorf("signature of different message accepted")
+	}
+
+	// Adding l to S must not produce another valid signature.
+	malleable := bytes.Clone(sig)
+	l := decodeHex(t, "f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f00")
+	var carry int
+	for i := range l {
+		v := int(malleable[57+i]) + int(l[i]) + carry
+		malleable[57+i], carry = byte(v), v>>8
+	}
+	if Verify(public, message, malleable) {
+		t.Errorf("non-canonical S accepted")
+	}
+}
+
+func TestCryptoSigner(t *testing.T) {
+	var zero zeroReader
+	public, private, _ := GenerateKey(zero)
+
+	signer := crypto.Signer(private)
+
+	publicInterface := signer.Public()
+	public2, ok := publicInterface.(PublicKey)
+	if !ok {
+		t.Fatalf("expected PublicKey from Public() but got %T", publicInterface)
+	}
+
+	if !bytes.Equal(public, public2) {
+		t.Errorf("public keys do not match: original:%x vs Public():%x", public, public2)
+	}
+
+	message := []byte("message")
+	var noHash crypto.Hash
+	signature, err := signer.Sign(zero, message, noHash)
+	if err != nil {
+		t.Fatalf("error from Sign(): %s", err)
+	}
+
+	if !Verify(public, message, signature) {
+		t.Errorf("Verify failed on signature from Sign()")
+	}
+
+	if _, err := signer.Sign(zero, message, crypto.SHA512); err == nil {
+		t.Errorf("Sign accepted a non-zero hash")
+	}
+	if _, err := signer.Sign(zero, message, &Options{Prehash: true}); err == nil {
+		t.Errorf("Sign accepted an Ed448ph message of the wrong length")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	public, private, _ := GenerateKey(rand.Reader)
+
+	if !public.Equal(public) {
+		t.Errorf("public key is not equal to itself: %q", public)
+	}
+	if !public.Equal(crypto.Signer(private).Public()) {
+		t.Errorf("private.Public() is not Equal to public: %q", public)
+	}
+	if !private.Equal(private) {
+		t.Errorf("private key is not equal to itself: %q", private)
+	}
+
+	otherPub, otherPriv, _ := GenerateKey(rand.Reader)
+	if public.Equal(otherPub) {
+		t.Errorf("different public keys are Equal")
+	}
+	if private.Equal(otherPriv) {
+		t.Errorf("different private keys are Equal")
+	}
+}
+
+func TestContextLength(t *testing.T) {
+	_, private, _ := GenerateKey(rand.Reader)
+	context := string(make([]byte, 256))
+	if _, err := private.Sign(nil, []byte("message"), &Options{Context: context}); err == nil {
+		t.Errorf("Sign accepted a 256-byte context")
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(buf []byte) (int, error) {
+	clear(buf)
+	return len(buf), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hkdf implements the HMAC-based Extract-and-Expand Key Derivation
+// Function (HKDF) as defined in [RFC 5869].
+//
+// HKDF is a cryptographic key derivation function (KDF) with the goal of
+// expanding limited input keying material into one or more cryptographically
+// strong secret keys.
+//
+// [RFC 5869]: https://datatracker.ietf.org/doc/html/rfc5869
+package hkdf
+
+import (
+	"crypto/internal/fips140/hkdf"
+	"crypto/internal/fips140only"
+	"errors"
+	"hash"
+)
+
+// Key derives a key from the given hash, secret, salt and context info,
+// returning a []byte of length keyLength that can be used as cryptographic key.
+// The extraction step is performed with [Extract], and the expansion step
+// with [Expand].
+//
+// Salt and info can be nil.
+func Key[Hash hash.Hash](h func() Hash, secret, salt []byte, info string, keyLength int) ([]byte, error) {
+	hf := func() hash.Hash { return h() }
+	if err := checkFIPS140Only(hf, secret); err != nil {
+		return nil, err
+	}
+	if err := checkKeyLength(hf, keyLength); err != nil {
+		return nil, err
+	}
+	return hkdf.Key(hf, secret, salt, info, keyLength), nil
+}
+
+// Extract generates a pseudorandom key for use with [Expand] from an input
+// secret and an optional independent salt.
+//
+// Only use this function if you need to reuse the extracted key with multiple
+// Expand invocations and different context values. Most common scenarios,
+// including the generation of multiple keys, should use [Key] instead.
+func Extract[Hash hash.Hash](h func() Hash, secret, salt []byte) ([]byte, error) {
+	hf := func() hash.Hash { return h() }
+	if err := checkFIPS140Only(hf, secret); err != nil {
+		return nil, err
+	}
+	return hkdf.Extract(hf, secret, salt), nil
+}
+
+// Expand derives a key from the given hash, key, and optional context info,
+// returning a []byte of length keyLength that can be used as cryptographic key.
+// The extraction step is skipped.
+//
+// The key should have been generated by [Extract], or be a uniformly
+// random or pseudorandom cryptographically strong key. See RFC 5869, Section
+// 3.3. Most common scenarios will want to use [Key] instead.
+//
+// keyLength must be at most 255 times the output size of the hash.
+func Expand[Hash hash.Hash](h func() Hash, pseudorandomKey []byte, info string, keyLength int) ([]byte, error) {
+	hf := func() hash.Hash { return h() }
+	if err := checkFIPS140Only(hf, pseudorandomKey); err != nil {
+		return nil, err
+	}
+	if err := checkKeyLength(hf, keyLength); err != nil {
+		return nil, err
+	}
+	return hkdf.Expand(hf, pseudorandomKey, info, keyLength), nil
+}
+
+func checkKeyLength(h func() hash.Hash, keyLength int) error {
+	if keyLength < 0 {
+		return errors.New("hkdf: negative key length")
+	}
+	if keyLength > 255*h().Size() {
+		return errors.New("hkdf: requested key length too large")
+	}
+	return nil
+}
+
+func checkFIPS140Only(h func() hash.Hash, key []byte) error {
+	if !fips140only.Enabled {
+		return nil
+	}
+	if len(key) < 112/8 {
+		return errors.New("crypto/hkdf: use of keys shorter than 112 bits is not allowed in FIPS 140-only mode")
+	}
+	if !fips140only.ApprovedHash(h()) {
+		return errors.New("crypto/hkdf: use of hash functions other than SHA-2 or SHA-3 is not allowed in FIPS 140-only mode")
+	}
+	return nil
+}
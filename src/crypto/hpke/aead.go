@@ -0,0 +1,109 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// An AEAD is an Authenticated Encryption with Associated Data cipher, one of
+// the three components of an HPKE ciphersuite.
+//
+// The AEAD implementations are returned by [AES128GCM], [AES256GCM],
+// [ChaCha20Poly1305], [ExportOnly], and [NewAEAD].
+type AEAD interface {
+	// ID returns the HPKE AEAD identifier.
+	ID() uint16
+
+	keySize() int   // Nk
+	nonceSize() int // Nn
+	aead(key []byte) (cipher.AEAD, error)
+}
+
+// exportOnlyID is the identifier of the export-only AEAD.
+const exportOnlyID = 0xFFFF
+
+// NewAEAD returns the AEAD implementation for the given AEAD identifier.
+//
+// Applications are encouraged to use specific implementations like
+// [AES128GCM] or [ChaCha20Poly1305] instead, unless runtime agility is
+// required.
+func NewAEAD(id uint16) (AEAD, error) {
+	switch id {
+	case 0x0001: // AES-128-GCM
+		return aes128GCM, nil
+	case 0x0002: // AES-256-GCM
+		return aes256GCM, nil
+	case 0x0003: // ChaCha20Poly1305
+		return chacha20Poly1305, nil
+	case exportOnlyID:
+		return exportOnly, nil
+	default:
+		return nil, errors.New("hpke: unsupported AEAD")
+	}
+}
+
+// AES128GCM returns the AES-128-GCM AEAD.
+func AES128GCM() AEAD { return aes128GCM }
+
+// AES256GCM returns the AES-256-GCM AEAD.
+func AES256GCM() AEAD { return aes256GCM }
+
+// ChaCha20Poly1305 returns the ChaCha20Poly1305 AEAD.
+func ChaCha20Poly1305() AEAD { return chacha20Poly1305 }
+
+// ExportOnly returns a placeholder AEAD that can't be used to encrypt or
+// decrypt, but only to export secrets with [Sender.Export] and
+// [Recipient.Export]. When it is used, [Sender.Seal] and [Recipient.Open]
+// return an error.
+func ExportOnly() AEAD { return exportOnly }
+
+type aead struct {
+	id  uint16
+	nK  int
+	nN  int
+	new func(key []byte) (cipher.AEAD, error)
+}
+
+var (
+	aes128GCM        = &aead{id: 0x0001, nK: 16, nN: 12, new: newAESGCM}
+	aes256GCM        = &aead{id: 0x0002, nK: 32, nN: 12, new: newAESGCM}
+	chacha20Poly1305 = &aead{id: 0x0003, nK: chacha20poly1305.KeySize, nN: chacha20poly1305.NonceSize, new: chacha20poly1305.New}
+	exportOnly       = &aead{id: exportOnlyID}
+)
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (a *aead) ID() uint16 {
+	return a.id
+}
+
+func (a *aead) keySize() int {
+	return a.nK
+}
+
+func (a *aead) nonceSize() int {
+	return a.nN
+}
+
+func (a *aead) aead(key []byte) (cipher.AEAD, error) {
+	if a.new == nil {
+		return nil, errors.New("hpke: export-only AEAD")
+	}
+	if len(key) != a.nK {
+		return nil, errors.New("hpke: invalid AEAD key size")
+	}
+	return a.new(key)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke_test
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/hpke"
+	"fmt"
+	"log"
+)
+
+func Example() {
+	// This example uses MLKEM768-X25519 as the KEM, HKDF-SHA256 as the KDF,
+	// and AES-256-GCM as the AEAD to encrypt a single message from a sender
+	// to a recipient.
+	kem, kdf, aead := hpke.MLKEM768X25519(), hpke.HKDFSHA256(), hpke.AES256GCM()
+	info := []byte("example")
+
+	// The recipient generates a key pair and publishes the public key.
+	privateKey, err := kem.GenerateKey()
+	if err != nil {
+		log.Fatal(err)
+	}
+	publicKeyBytes := privateKey.PublicKey().Bytes()
+
+	// The sender encrypts a message to the public key.
+	publicKey, err := kem.NewPublicKey(publicKeyBytes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ciphertext, err := hpke.Seal(publicKey, kdf, aead, info, []byte("hello, world"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The recipient decrypts the message.
+	plaintext, err := hpke.Open(privateKey, kdf, aead, info, ciphertext)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", plaintext)
+	// Output: hello, world
+}
+
+func ExampleNewSender() {
+	kem, kdf, aead := hpke.DHKEM(ecdh.X25519()), hpke.HKDFSHA256(), hpke.ChaCha20Poly1305()
+	info := []byte("example session")
+
+	privateKey, err := kem.GenerateKey()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The sender establishes a context, and transmits enc to the recipient
+	// along with the ciphertexts.
+	enc, sender, err := hpke.NewSender(privateKey.PublicKey(), kdf, aead, info)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ct1, _ := sender.Seal(nil, []byte("first"))
+	ct2, _ := sender.Seal(nil, []byte("second"))
+
+	// The recipient establishes the matching context, and opens the
+	// ciphertexts in order.
+	recipient, err := hpke.NewRecipient(enc, privateKey, kdf, aead, info)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, ct := range [][]byte{ct1, ct2} {
+		pt, err := recipient.Open(nil, ct)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s\n", pt)
+	}
+
+	// Both sides can export the same secret.
+	s1, _ := sender.Export([]byte("exporter context"), 32)
+	s2, _ := recipient.Export([]byte("exporter context"), 32)
+	fmt.Println(bytes.Equal(s1, s2))
+	// Output:
+	// first
+	// second
+	// true
+}
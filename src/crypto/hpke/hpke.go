@@ -0,0 +1,368 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hpke implements Hybrid Public Key Encryption (HPKE) as defined in
+// [RFC 9180].
+//
+// An HPKE ciphersuite is the combination of a [KEM], a [KDF], and an [AEAD].
+// A sender uses the recipient's public key to establish a [Sender] context,
+// and transmits the resulting encapsulated key to the recipient, which uses it
+// to establish the matching [Recipient] context. All four modes of RFC 9180
+// are supported: base (e.g. [NewSender]), PSK (e.g. [NewSenderWithPSK]), auth
+// (e.g. [NewAuthSender]), and auth-PSK (e.g. [NewAuthSenderWithPSK]).
+//
+// In addition to the KEMs of RFC 9180, this package implements the ML-KEM and
+// hybrid ML-KEM KEMs of draft-ietf-hpke-pq, such as [MLKEM768X25519].
+//
+// [RFC 9180]: https://www.rfc-editor.org/rfc/rfc9180.html
+package hpke
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// The modes of RFC 9180, Section 5.
+const (
+	modeBase    = 0x00
+	modePSK     = 0x01
+	modeAuth    = 0x02
+	modeAuthPSK = 0x03
+)
+
+type context struct {
+	aead      cipher.AEAD
+	suiteID   []byte
+	kdf       KDF
+	expSecret []byte
+	baseNonce []byte
+	// seqNum starts at zero and is incremented for each Seal/Open call.
+	seqNum uint64
+}
+
+// Sender is a sending HPKE context. It is instantiated with a specific KEM
+// encapsulation key (i.e. the public key), and it is stateful, incrementing
+// the nonce counter for each [Sender.Seal] call.
+//
+// A Sender is not safe for concurrent use.
+type Sender struct {
+	*context
+}
+
+// Recipient is a receiving HPKE context. It is instantiated with a specific KEM
+// decapsulation key (i.e. the private key), and it is stateful, incrementing
+// the nonce counter for each successful [Recipient.Open] call.
+//
+// A Recipient is not safe for concurrent use.
+type Recipient struct {
+	*context
+}
+
+// newContext implements KeySchedule from RFC 9180, Section 5.1.
+func newContext(mode uint8, sharedSecret []byte, kemID uint16, kdf KDF, aead AEAD, info, psk, pskID []byte) (*context, error) {
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, err
+	}
+	sid := suiteID(kemID, kdf.ID(), aead.ID())
+
+	pskIDHash, err := kdf.labeledExtract(sid, nil, "psk_id_hash", pskID)
+	if err != nil {
+		return nil, err
+	}
+	infoHash, err := kdf.labeledExtract(sid, nil, "info_hash", info)
+	if err != nil {
+		return nil, err
+	}
+	ksContext := make([]byte, 0, 1+len(pskIDHash)+len(infoHash))
+	ksContext = append(ksContext, mode)
+	ksContext = append(ksContext, pskIDHash...)
+	ksContext = append(ksContext, infoHash...)
+
+	secret, err := kdf.labeledExtract(sid, sharedSecret, "secret", psk)
+	if err != nil {
+		return nil, err
+	}
+
+	expSecret, err := kdf.labeledExpand(sid, secret, "exp", ksContext, uint16(kdf.size()))
+	if err != nil {
+		return nil, err
+	}
+	ctx := &context{
+		suiteID:   sid,
+		kdf:       kdf,
+		expSecret: expSecret,
+	}
+	if aead.ID() == exportOnlyID {
+		return ctx, nil
+	}
+
+	key, err := kdf.labeledExpand(sid, secret, "key", ksContext, uint16(aead.keySize()))
+	if err != nil {
+		return nil, err
+	}
+	ctx.baseNonce, err = kdf.labeledExpand(sid, secret, "base_nonce", ksContext, uint16(aead.nonceSize()))
+	if err != nil {
+		return nil, err
+	}
+	ctx.aead, err = aead.aead(key)
+	if err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// verifyPSKInputs implements VerifyPSKInputs from RFC 9180, Section 5.1.
+func verifyPSKInputs(mode uint8, psk, pskID []byte) error {
+	gotPSK, gotPSKID := len(psk) > 0, len(pskID) > 0
+	if gotPSK != gotPSKID {
+		return errors.New("hpke: inconsistent PSK inputs")
+	}
+	switch mode {
+	case modePSK, modeAuthPSK:
+		if !gotPSK {
+			return errors.New("hpke: missing required PSK input")
+		}
+	default:
+		if gotPSK {
+			return errors.New("hpke: PSK input provided when not needed")
+		}
+	}
+	return nil
+}
+
+// NewSender returns a sending HPKE context for the provided KEM encapsulation
+// key (i.e. the public key), and using the ciphersuite defined by the
+// combination of KEM, KDF, and AEAD. It implements SetupBaseS from RFC 9180.
+//
+// The info parameter is additional public information that must match between
+// sender and recipient.
+//
+// The returned enc ciphertext can be used to instantiate a matching receiving
+// HPKE context with the corresponding KEM decapsulation key.
+func NewSender(pk PublicKey, kdf KDF, aead AEAD, info []byte) (enc []byte, s *Sender, err error) {
+	return newSender(modeBase, pk, nil, kdf, aead, info, nil, nil)
+}
+
+// NewSenderWithPSK is like [NewSender], but additionally authenticates the
+// context with a pre-shared key psk, identified by pskID. It implements
+// SetupPSKS from RFC 9180.
+//
+// psk and pskID must not be empty, and psk must have at least 32 bytes of
+// entropy.
+func NewSenderWithPSK(pk PublicKey, kdf KDF, aead AEAD, info, psk, pskID []byte) (enc []byte, s *Sender, err error) {
+	return newSender(modePSK, pk, nil, kdf, aead, info, psk, pskID)
+}
+
+// NewAuthSender is like [NewSender], but additionally authenticates the sender
+// as the holder of the private key sk, which must be for the same KEM as pk.
+// It implements SetupAuthS from RFC 9180.
+//
+// Only the DHKEM KEMs support the auth mode.
+func NewAuthSender(pk PublicKey, sk PrivateKey, kdf KDF, aead AEAD, info []byte) (enc []byte, s *Sender, err error) {
+	return newSender(modeAuth, pk, sk, kdf, aead, info, nil, nil)
+}
+
+// NewAuthSenderWithPSK combines [NewAuthSender] and [NewSenderWithPSK]. It
+// implements SetupAuthPSKS from RFC 9180.
+func NewAuthSenderWithPSK(pk PublicKey, sk PrivateKey, kdf KDF, aead AEAD, info, psk, pskID []byte) (enc []byte, s *Sender, err error) {
+	return newSender(modeAuthPSK, pk, sk, kdf, aead, info, psk, pskID)
+}
+
+func newSender(mode uint8, pk PublicKey, sk PrivateKey, kdf KDF, aead AEAD, info, psk, pskID []byte) ([]byte, *Sender, error) {
+	var sharedSecret, enc []byte
+	var err error
+	if mode == modeAuth || mode == modeAuthPSK {
+		pkR, skS, err := authKeys(pk, sk)
+		if err != nil {
+			return nil, nil, err
+		}
+		sharedSecret, enc, err = pkR.authEncap(skS)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		sharedSecret, enc, err = pk.encap()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	ctx, err := newContext(mode, sharedSecret, pk.KEM().ID(), kdf, aead, info, psk, pskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{ctx}, nil
+}
+
+// NewRecipient returns a receiving HPKE context for the provided KEM
+// decapsulation key (i.e. the private key), and using the ciphersuite defined
+// by the combination of KEM, KDF, and AEAD. It implements SetupBaseR from
+// RFC 9180.
+//
+// The enc parameter must have been produced by a matching sending HPKE context
+// with the corresponding KEM encapsulation key. The info parameter is
+// additional public information that must match between sender and recipient.
+func NewRecipient(enc []byte, k PrivateKey, kdf KDF, aead AEAD, info []byte) (*Recipient, error) {
+	return newRecipient(modeBase, enc, k, nil, kdf, aead, info, nil, nil)
+}
+
+// NewRecipientWithPSK is like [NewRecipient], but for a context established
+// with [NewSenderWithPSK]. It implements SetupPSKR from RFC 9180.
+func NewRecipientWithPSK(enc []byte, k PrivateKey, kdf KDF, aead AEAD, info, psk, pskID []byte) (*Recipient, error) {
+	return newRecipient(modePSK, enc, k, nil, kdf, aead, info, psk, pskID)
+}
+
+// NewAuthRecipient is like [NewRecipient], but for a context established with
+// [NewAuthSender] by the holder of the private key corresponding to pk. It
+// implements SetupAuthR from RFC 9180.
+func NewAuthRecipient(enc []byte, k PrivateKey, pk PublicKey, kdf KDF, aead AEAD, info []byte) (*Recipient, error) {
+	return newRecipient(modeAuth, enc, k, pk, kdf, aead, info, nil, nil)
+}
+
+// NewAuthRecipientWithPSK is like [NewRecipient], but for a context
+// established with [NewAuthSenderWithPSK]. It implements SetupAuthPSKR from
+// RFC 9180.
+func NewAuthRecipientWithPSK(enc []byte, k PrivateKey, pk PublicKey, kdf KDF, aead AEAD, info, psk, pskID []byte) (*Recipient, error) {
+	return newRecipient(modeAuthPSK, enc, k, pk, kdf, aead, info, psk, pskID)
+}
+
+func newRecipient(mode uint8, enc []byte, k PrivateKey, pk PublicKey, kdf KDF, aead AEAD, info, psk, pskID []byte) (*Recipient, error) {
+	var sharedSecret []byte
+	var err error
+	if mode == modeAuth || mode == modeAuthPSK {
+		pkS, skR, err := authKeys(pk, k)
+		if err != nil {
+			return nil, err
+		}
+		sharedSecret, err = skR.authDecap(enc, pkS)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		sharedSecret, err = k.decap(enc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx, err := newContext(mode, sharedSecret, k.KEM().ID(), kdf, aead, info, psk, pskID)
+	if err != nil {
+		return nil, err
+	}
+	return &Recipient{ctx}, nil
+}
+
+// Seal encrypts the provided plaintext, optionally binding to the additional
+// public data aad.
+//
+// Seal uses incrementing counters for each call, and Open on the receiving side
+// must be called in the same order as Seal.
+func (s *Sender) Seal(aad, plaintext []byte) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errors.New("hpke: export-only instantiation")
+	}
+	nonce, err := s.nextNonce()
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := s.aead.Seal(nil, nonce, plaintext, aad)
+	s.seqNum++
+	return ciphertext, nil
+}
+
+// Export produces a secret value derived from the shared key between sender
+// and recipient. length must be at most 255 times the output size of the KDF.
+func (s *Sender) Export(exporterContext []byte, length int) ([]byte, error) {
+	return s.export(exporterContext, length)
+}
+
+// Open decrypts the provided ciphertext, optionally binding to the additional
+// public data aad, or returns an error if decryption fails.
+//
+// Open uses incrementing counters for each successful call, and must be called
+// in the same order as Seal on the sending side.
+func (r *Recipient) Open(aad, ciphertext []byte) ([]byte, error) {
+	if r.aead == nil {
+		return nil, errors.New("hpke: export-only instantiation")
+	}
+	nonce, err := r.nextNonce()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := r.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, err
+	}
+	r.seqNum++
+	return plaintext, nil
+}
+
+// Export produces a secret value derived from the shared key between sender
+// and recipient. length must be at most 255 times the output size of the KDF.
+func (r *Recipient) Export(exporterContext []byte, length int) ([]byte, error) {
+	return r.export(exporterContext, length)
+}
+
+// Seal instantiates a single-use sending HPKE context like [NewSender], and
+// then encrypts the provided plaintext like [Sender.Seal] (with no aad).
+// Seal returns the concatenation of the encapsulated key and the ciphertext.
+func Seal(pk PublicKey, kdf KDF, aead AEAD, info, plaintext []byte) ([]byte, error) {
+	enc, s, err := NewSender(pk, kdf, aead, info)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := s.Seal(nil, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append(enc, ct...), nil
+}
+
+// Open instantiates a single-use receiving HPKE context like [NewRecipient],
+// and then decrypts the provided ciphertext like [Recipient.Open] (with no
+// aad). ciphertext must be the concatenation of the encapsulated key and the
+// actual ciphertext, as returned by [Seal].
+func Open(k PrivateKey, kdf KDF, aead AEAD, info, ciphertext []byte) ([]byte, error) {
+	encSize := k.KEM().encSize()
+	if len(ciphertext) < encSize {
+		return nil, errors.New("hpke: ciphertext too short")
+	}
+	enc, ciphertext := ciphertext[:encSize], ciphertext[encSize:]
+	r, err := NewRecipient(enc, k, kdf, aead, info)
+	if err != nil {
+		return nil, err
+	}
+	return r.Open(nil, ciphertext)
+}
+
+func (ctx *context) export(exporterContext []byte, length int) ([]byte, error) {
+	if length < 0 || length > math.MaxUint16 {
+		return nil, errors.New("hpke: invalid export length")
+	}
+	return ctx.kdf.labeledExpand(ctx.suiteID, ctx.expSecret, "sec", exporterContext, uint16(length))
+}
+
+// nextNonce implements ComputeNonce from RFC 9180, Section 5.2.
+func (ctx *context) nextNonce() ([]byte, error) {
+	// The sequence number would need to reach 2^96-1 to exhaust the nonce
+	// space, but a uint64 wrapping around would reuse a nonce.
+	if ctx.seqNum == math.MaxUint64 {
+		return nil, errors.New("hpke: message limit reached")
+	}
+	nonce := make([]byte, ctx.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], ctx.seqNum)
+	for i := range ctx.baseNonce {
+		nonce[i] ^= ctx.baseNonce[i]
+	}
+	return nonce, nil
+}
+
+func suiteID(kemID, kdfID, aeadID uint16) []byte {
+	suiteID := make([]byte, 0, 4+2+2+2)
+	suiteID = append(suiteID, "HPKE"...)
+	suiteID = binary.BigEndian.AppendUint16(suiteID, kemID)
+	suiteID = binary.BigEndian.AppendUint16(suiteID, kdfID)
+	suiteID = binary.BigEndian.AppendUint16(suiteID, aeadID)
+	return suiteID
+}
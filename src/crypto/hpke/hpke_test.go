@@ -0,0 +1,449 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/internal/mlkem"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+func mustDecodeHex(t *testing.T, in string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+type vector struct {
+	Mode   uint8  `json:"mode"`
+	KEM    uint16 `json:"kem_id"`
+	KDF    uint16 `json:"kdf_id"`
+	AEAD   uint16 `json:"aead_id"`
+	Info   string `json:"info"`
+	IkmE   string `json:"ikmE"`
+	IkmR   string `json:"ikmR"`
+	IkmS   string `json:"ikmS"`
+	SkRm   string `json:"skRm"`
+	PkRm   string `json:"pkRm"`
+	PkSm   string `json:"pkSm"`
+	PSK    string `json:"psk"`
+	PSKID  string `json:"psk_id"`
+	Enc    string `json:"enc"`
+	Shared string `json:"shared_secret"`
+
+	Encryptions []struct {
+		Aad string `json:"aad"`
+		Ct  string `json:"ct"`
+		Pt  string `json:"pt"`
+	} `json:"encryptions"`
+	Exports []struct {
+		Context string `json:"exporter_context"`
+		L       int    `json:"L"`
+		Value   string `json:"exported_value"`
+	} `json:"exports"`
+
+	// To avoid checking in the very large full set of RFC 9180 vectors,
+	// rfc9180.json includes accumulated hashes of the encryptions and exports.
+	AccEncryptions string `json:"encryptions_accumulated"`
+	AccExports     string `json:"exports_accumulated"`
+}
+
+// TestVectors checks the vectors of RFC 9180 and draft-ietf-hpke-pq for the
+// base mode, and the vectors for the PSK and auth modes in
+// rfc9180-modes.json, which were generated with an independent implementation.
+func TestVectors(t *testing.T) {
+	for _, name := range []string{"rfc9180", "rfc9180-modes", "hpke-pq"} {
+		t.Run(name, func(t *testing.T) {
+			vectorsJSON, err := os.ReadFile("testdata/" + name + ".json")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var vectors []vector
+			if err := json.Unmarshal(vectorsJSON, &vectors); err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range vectors {
+				name := fmt.Sprintf("mode %02x kem %04x kdf %04x aead %04x", v.Mode, v.KEM, v.KDF, v.AEAD)
+				t.Run(name, func(t *testing.T) { testVector(t, v) })
+			}
+		})
+	}
+}
+
+func testVector(t *testing.T, v vector) {
+	kem, err := NewKEM(v.KEM)
+	if err != nil {
+		t.Skip(err)
+	}
+	kdf, err := NewKDF(v.KDF)
+	if err != nil {
+		t.Skip(err)
+	}
+	aead, err := NewAEAD(v.AEAD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kem.ID() != v.KEM || kdf.ID() != v.KDF || aead.ID() != v.AEAD {
+		t.Errorf("unexpected IDs: %04x %04x %04x", kem.ID(), kdf.ID(), aead.ID())
+	}
+
+	pkRBytes := mustDecodeHex(t, v.PkRm)
+	pkR, err := kem.NewPublicKey(pkRBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkR.KEM() != kem {
+		t.Errorf("unexpected KEM %04x", pkR.KEM().ID())
+	}
+	if !bytes.Equal(pkR.Bytes(), pkRBytes) {
+		t.Errorf("unexpected public key bytes: got %x, want %x", pkR.Bytes(), pkRBytes)
+	}
+
+	skRBytes := mustDecodeHex(t, v.SkRm)
+	skR, err := kem.NewPrivateKey(skRBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// X25519 serialized private keys are clamped, so they might not match.
+	if kem != DHKEM(ecdh.X25519()) && !bytes.Equal(skR.Bytes(), skRBytes) {
+		t.Errorf("unexpected private key bytes: got %x, want %x", skR.Bytes(), skRBytes)
+	}
+	if !bytes.Equal(skR.PublicKey().Bytes(), pkRBytes) {
+		t.Errorf("unexpected public key from private key: got %x, want %x", skR.PublicKey().Bytes(), pkRBytes)
+	}
+	derived, err := kem.DeriveKeyPair(mustDecodeHex(t, v.IkmR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(derived.PublicKey().Bytes(), pkRBytes) {
+		t.Errorf("unexpected derived public key: got %x, want %x", derived.PublicKey().Bytes(), pkRBytes)
+	}
+
+	var skS PrivateKey
+	var pkS PublicKey
+	if v.IkmS != "" {
+		skS, err = kem.DeriveKeyPair(mustDecodeHex(t, v.IkmS))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkS, err = kem.NewPublicKey(mustDecodeHex(t, v.PkSm))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(skS.PublicKey().Bytes(), pkS.Bytes()) {
+			t.Errorf("unexpected derived sender public key: got %x, want %x", skS.PublicKey().Bytes(), pkS.Bytes())
+		}
+	}
+
+	setupDerandomizedEncap(t, mustDecodeHex(t, v.IkmE), pkR)
+
+	info, psk, pskID := mustDecodeHex(t, v.Info), mustDecodeHex(t, v.PSK), mustDecodeHex(t, v.PSKID)
+	var enc []byte
+	var sender *Sender
+	var recipient *Recipient
+	switch v.Mode {
+	case modeBase:
+		enc, sender, err = NewSender(pkR, kdf, aead, info)
+	case modePSK:
+		enc, sender, err = NewSenderWithPSK(pkR, kdf, aead, info, psk, pskID)
+	case modeAuth:
+		enc, sender, err = NewAuthSender(pkR, skS, kdf, aead, info)
+	case modeAuthPSK:
+		enc, sender, err = NewAuthSenderWithPSK(pkR, skS, kdf, aead, info, psk, pskID)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != kem.encSize() {
+		t.Errorf("unexpected encapsulated key size: got %d, want %d", len(enc), kem.encSize())
+	}
+	if expected := mustDecodeHex(t, v.Enc); !bytes.Equal(enc, expected) {
+		t.Errorf("unexpected encapsulated key: got %x, want %x", enc, expected)
+	}
+	switch v.Mode {
+	case modeBase:
+		recipient, err = NewRecipient(enc, skR, kdf, aead, info)
+	case modePSK:
+		recipient, err = NewRecipientWithPSK(enc, skR, kdf, aead, info, psk, pskID)
+	case modeAuth:
+		recipient, err = NewAuthRecipient(enc, skR, pkS, kdf, aead, info)
+	case modeAuthPSK:
+		recipient, err = NewAuthRecipientWithPSK(enc, skR, pkS, kdf, aead, info, psk, pskID)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case aead == ExportOnly():
+		if _, err := sender.Seal(nil, nil); err == nil {
+			t.Error("expected error from Seal with export-only AEAD")
+		}
+		if _, err := recipient.Open(nil, nil); err == nil {
+			t.Error("expected error from Open with export-only AEAD")
+		}
+	case v.AccEncryptions != "":
+		source, sink := sha3.NewSHAKE128(), sha3.NewSHAKE128()
+		for i := 0; i < 1000; i++ {
+			aad, plaintext := drawRandomInput(t, source), drawRandomInput(t, source)
+			ciphertext, err := sender.Seal(aad, plaintext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sink.Write(ciphertext)
+			got, err := recipient.Open(aad, ciphertext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Errorf("unexpected plaintext: got %x, want %x", got, plaintext)
+			}
+		}
+		encryptions := make([]byte, 16)
+		sink.Read(encryptions)
+		if expected := mustDecodeHex(t, v.AccEncryptions); !bytes.Equal(encryptions, expected) {
+			t.Errorf("unexpected accumulated encryptions: got %x, want %x", encryptions, expected)
+		}
+	default:
+		for _, e := range v.Encryptions {
+			aad, plaintext := mustDecodeHex(t, e.Aad), mustDecodeHex(t, e.Pt)
+			ciphertext, err := sender.Seal(aad, plaintext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expected := mustDecodeHex(t, e.Ct); !bytes.Equal(ciphertext, expected) {
+				t.Errorf("unexpected ciphertext: got %x, want %x", ciphertext, expected)
+			}
+			got, err := recipient.Open(aad, ciphertext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Errorf("unexpected plaintext: got %x, want %x", got, plaintext)
+			}
+		}
+	}
+
+	if v.AccExports != "" {
+		source, sink := sha3.NewSHAKE128(), sha3.NewSHAKE128()
+		for l := 0; l < 1000; l++ {
+			context := drawRandomInput(t, source)
+			value, err := sender.Export(context, l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sink.Write(value)
+			got, err := recipient.Export(context, l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, value) {
+				t.Errorf("unexpected recipient export: got %x, want %x", got, value)
+			}
+		}
+		exports := make([]byte, 16)
+		sink.Read(exports)
+		if expected := mustDecodeHex(t, v.AccExports); !bytes.Equal(exports, expected) {
+			t.Errorf("unexpected accumulated exports: got %x, want %x", exports, expected)
+		}
+	} else {
+		for _, e := range v.Exports {
+			context := mustDecodeHex(t, e.Context)
+			value, err := sender.Export(context, e.L)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expected := mustDecodeHex(t, e.Value); !bytes.Equal(value, expected) {
+				t.Errorf("unexpected export: got %x, want %x", value, expected)
+			}
+			got, err := recipient.Export(context, e.L)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, value) {
+				t.Errorf("unexpected recipient export: got %x, want %x", got, value)
+			}
+		}
+	}
+}
+
+func drawRandomInput(t *testing.T, r io.Reader) []byte {
+	t.Helper()
+	l := make([]byte, 1)
+	if _, err := r.Read(l); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, int(l[0]))
+	if _, err := r.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// setupDerandomizedEncap makes the next encapsulations to pk deterministic,
+// using randBytes as the ikmE value of the test vectors.
+func setupDerandomizedEncap(t *testing.T, randBytes []byte, pk PublicKey) {
+	t.Cleanup(func() {
+		testingOnlyGenerateKey = nil
+		testingOnlyEncapsulate = nil
+	})
+	switch kem := pk.KEM().(type) {
+	case *dhKEM:
+		k, err := kem.DeriveKeyPair(randBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testingOnlyGenerateKey = func() *ecdh.PrivateKey {
+			return k.(*dhKEMPrivateKey).priv
+		}
+	case *mlkemKEM:
+		setupDerandomizedMLKEM(t, randBytes)
+	case *hybridKEM:
+		// The rest of randBytes are the following candidates for rejection
+		// sampling of the curve key, which are never reached.
+		pqRand, tRand := randBytes[:32], randBytes[32:32+kem.curveSeedSize]
+		k, err := kem.curve.NewPrivateKey(tRand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testingOnlyGenerateKey = func() *ecdh.PrivateKey { return k }
+		setupDerandomizedMLKEM(t, pqRand)
+	default:
+		t.Fatalf("unsupported KEM %04x", pk.KEM().ID())
+	}
+}
+
+func setupDerandomizedMLKEM(t *testing.T, m []byte) {
+	testingOnlyEncapsulate = func(ek encapsulationKey) ([]byte, []byte) {
+		switch len(ek.Bytes()) {
+		case mlkem.EncapsulationKeySize768:
+			k, err := mlkem.NewEncapsulationKey768(ek.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return k.EncapsulateInternal((*[32]byte)(m))
+		case mlkem.EncapsulationKeySize1024:
+			k, err := mlkem.NewEncapsulationKey1024(ek.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return k.EncapsulateInternal((*[32]byte)(m))
+		}
+		t.Fatal("unexpected encapsulation key size")
+		return nil, nil
+	}
+}
+
+var allKEMs = []KEM{
+	DHKEM(ecdh.P256()), DHKEM(ecdh.P384()), DHKEM(ecdh.P521()), DHKEM(ecdh.X25519()),
+	MLKEM768(), MLKEM1024(), MLKEM768X25519(), MLKEM768P256(), MLKEM1024P384(),
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, kem := range allKEMs {
+		t.Run(fmt.Sprintf("%04x", kem.ID()), func(t *testing.T) {
+			if k, err := NewKEM(kem.ID()); err != nil || k != kem {
+				t.Errorf("NewKEM(%04x) = %v, %v", kem.ID(), k, err)
+			}
+			k, err := kem.GenerateKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			k1, err := kem.NewPrivateKey(k.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(k1.PublicKey().Bytes(), k.PublicKey().Bytes()) {
+				t.Error("private key did not round-trip")
+			}
+
+			info, msg := []byte("info"), []byte("message")
+			ct, err := Seal(k.PublicKey(), HKDFSHA256(), AES128GCM(), info, msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pt, err := Open(k1, HKDFSHA256(), AES128GCM(), info, ct)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(pt, msg) {
+				t.Errorf("got %q, want %q", pt, msg)
+			}
+			if _, err := Open(k1, HKDFSHA256(), AES128GCM(), []byte("other"), ct); err == nil {
+				t.Error("expected error with mismatched info")
+			}
+			if _, err := Open(k1, HKDFSHA256(), AES128GCM(), info, ct[:kem.encSize()-1]); err == nil {
+				t.Error("expected error with short ciphertext")
+			}
+
+			other, err := kem.GenerateKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = NewAuthSender(k.PublicKey(), other, HKDFSHA256(), AES128GCM(), info)
+			if _, ok := kem.(*dhKEM); ok && err != nil {
+				t.Errorf("NewAuthSender: %v", err)
+			} else if !ok && err == nil {
+				t.Error("expected error from NewAuthSender with non-DH KEM")
+			}
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	k, err := MLKEM768X25519().GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	psk, pskID := bytes.Repeat([]byte{0x42}, 32), []byte("psk")
+	enc, s, err := NewSenderWithPSK(k.PublicKey(), HKDFSHA384(), ChaCha20Poly1305(), nil, psk, pskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRecipientWithPSK(enc, k, HKDFSHA384(), ChaCha20Poly1305(), nil, psk, pskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cts [][]byte
+	for i := 0; i < 3; i++ {
+		ct, err := s.Seal([]byte{byte(i)}, []byte("hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cts = append(cts, ct)
+	}
+	// Out of order messages fail, and don't advance the counter.
+	if _, err := r.Open([]byte{1}, cts[1]); err == nil {
+		t.Error("expected error for out of order message")
+	}
+	for i, ct := range cts {
+		if _, err := r.Open([]byte{byte(i)}, ct); err != nil {
+			t.Errorf("message %d: %v", i, err)
+		}
+	}
+
+	if _, err := NewRecipientWithPSK(enc, k, HKDFSHA384(), ChaCha20Poly1305(), nil, []byte("wrong"), pskID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRecipientWithPSK(enc, k, HKDFSHA384(), ChaCha20Poly1305(), nil, nil, pskID); err == nil {
+		t.Error("expected error for inconsistent PSK inputs")
+	}
+	if _, err := NewRecipientWithPSK(enc, k, HKDFSHA384(), ChaCha20Poly1305(), nil, nil, nil); err == nil {
+		t.Error("expected error for missing PSK")
+	}
+	if _, err := s.Export(nil, 255*48+1); err == nil {
+		t.Error("expected error for export length too large")
+	}
+}
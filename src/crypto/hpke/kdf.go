@@ -0,0 +1,95 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+)
+
+// A KDF is a Key Derivation Function, one of the three components of an HPKE
+// ciphersuite.
+//
+// The KDF implementations are returned by [HKDFSHA256], [HKDFSHA384],
+// [HKDFSHA512], and [NewKDF].
+type KDF interface {
+	// ID returns the HPKE KDF identifier.
+	ID() uint16
+
+	size() int // Nh
+	labeledExtract(suiteID, salt []byte, label string, inputKey []byte) ([]byte, error)
+	labeledExpand(suiteID, randomKey []byte, label string, info []byte, length uint16) ([]byte, error)
+}
+
+// NewKDF returns the KDF implementation for the given KDF identifier.
+//
+// Applications are encouraged to use specific implementations like
+// [HKDFSHA256] instead, unless runtime agility is required.
+func NewKDF(id uint16) (KDF, error) {
+	switch id {
+	case 0x0001: // HKDF-SHA256
+		return hkdfSHA256, nil
+	case 0x0002: // HKDF-SHA384
+		return hkdfSHA384, nil
+	case 0x0003: // HKDF-SHA512
+		return hkdfSHA512, nil
+	default:
+		return nil, errors.New("hpke: unsupported KDF")
+	}
+}
+
+// HKDFSHA256 returns the HKDF-SHA256 KDF.
+func HKDFSHA256() KDF { return hkdfSHA256 }
+
+// HKDFSHA384 returns the HKDF-SHA384 KDF.
+func HKDFSHA384() KDF { return hkdfSHA384 }
+
+// HKDFSHA512 returns the HKDF-SHA512 KDF.
+func HKDFSHA512() KDF { return hkdfSHA512 }
+
+type hkdfKDF struct {
+	id   uint16
+	hash func() hash.Hash
+	nH   int
+}
+
+var (
+	hkdfSHA256 = &hkdfKDF{id: 0x0001, hash: sha256.New, nH: sha256.Size}
+	hkdfSHA384 = &hkdfKDF{id: 0x0002, hash: sha512.New384, nH: sha512.Size384}
+	hkdfSHA512 = &hkdfKDF{id: 0x0003, hash: sha512.New, nH: sha512.Size}
+)
+
+func (kdf *hkdfKDF) ID() uint16 {
+	return kdf.id
+}
+
+func (kdf *hkdfKDF) size() int {
+	return kdf.nH
+}
+
+// labeledExtract implements LabeledExtract from RFC 9180, Section 4.
+func (kdf *hkdfKDF) labeledExtract(suiteID, salt []byte, label string, inputKey []byte) ([]byte, error) {
+	labeledIKM := make([]byte, 0, 7+len(suiteID)+len(label)+len(inputKey))
+	labeledIKM = append(labeledIKM, "HPKE-v1"...)
+	labeledIKM = append(labeledIKM, suiteID...)
+	labeledIKM = append(labeledIKM, label...)
+	labeledIKM = append(labeledIKM, inputKey...)
+	return hkdf.Extract(kdf.hash, labeledIKM, salt)
+}
+
+// labeledExpand implements LabeledExpand from RFC 9180, Section 4.
+func (kdf *hkdfKDF) labeledExpand(suiteID, randomKey []byte, label string, info []byte, length uint16) ([]byte, error) {
+	labeledInfo := make([]byte, 0, 2+7+len(suiteID)+len(label)+len(info))
+	labeledInfo = binary.BigEndian.AppendUint16(labeledInfo, length)
+	labeledInfo = append(labeledInfo, "HPKE-v1"...)
+	labeledInfo = append(labeledInfo, suiteID...)
+	labeledInfo = append(labeledInfo, label...)
+	labeledInfo = append(labeledInfo, info...)
+	return hkdf.Expand(kdf.hash, randomKey, string(labeledInfo), int(length))
+}
@@ -0,0 +1,359 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+)
+
+// A KEM is a Key Encapsulation Mechanism, one of the three components of an
+// HPKE ciphersuite.
+//
+// The KEM implementations are returned by [DHKEM], [MLKEM768], [MLKEM1024],
+// [MLKEM768X25519], [MLKEM768P256], [MLKEM1024P384], and [NewKEM].
+type KEM interface {
+	// ID returns the HPKE KEM identifier.
+	ID() uint16
+
+	// GenerateKey generates a new random key pair.
+	GenerateKey() (PrivateKey, error)
+
+	// NewPublicKey parses a public key. It implements DeserializePublicKey
+	// from RFC 9180.
+	NewPublicKey([]byte) (PublicKey, error)
+
+	// NewPrivateKey parses a private key. It implements
+	// DeserializePrivateKey from RFC 9180.
+	NewPrivateKey([]byte) (PrivateKey, error)
+
+	// DeriveKeyPair deterministically derives a key pair from the input
+	// keying material ikm. It implements DeriveKeyPair from RFC 9180.
+	DeriveKeyPair(ikm []byte) (PrivateKey, error)
+
+	encSize() int // Nenc
+}
+
+// NewKEM returns the KEM implementation for the given KEM identifier.
+//
+// Applications are encouraged to use specific implementations like [DHKEM]
+// or [MLKEM768X25519] instead, unless runtime agility is required.
+func NewKEM(id uint16) (KEM, error) {
+	switch id {
+	case 0x0010: // DHKEM(P-256, HKDF-SHA256)
+		return dhKEMP256, nil
+	case 0x0011: // DHKEM(P-384, HKDF-SHA384)
+		return dhKEMP384, nil
+	case 0x0012: // DHKEM(P-521, HKDF-SHA512)
+		return dhKEMP521, nil
+	case 0x0020: // DHKEM(X25519, HKDF-SHA256)
+		return dhKEMX25519, nil
+	case 0x0041: // ML-KEM-768
+		return mlkem768, nil
+	case 0x0042: // ML-KEM-1024
+		return mlkem1024, nil
+	case 0x0050: // MLKEM768-P256
+		return mlkem768P256, nil
+	case 0x0051: // MLKEM1024-P384
+		return mlkem1024P384, nil
+	case 0x647a: // MLKEM768-X25519
+		return mlkem768X25519, nil
+	default:
+		return nil, errors.New("hpke: unsupported KEM")
+	}
+}
+
+// A PublicKey is a KEM encapsulation key (i.e. a public key) for a specific
+// [KEM].
+type PublicKey interface {
+	// KEM returns the KEM this key is for.
+	KEM() KEM
+
+	// Bytes returns the encoding of the public key. It implements
+	// SerializePublicKey from RFC 9180.
+	Bytes() []byte
+
+	encap() (sharedSecret, enc []byte, err error)
+}
+
+// A PrivateKey is a KEM decapsulation key (i.e. a private key) for a specific
+// [KEM].
+type PrivateKey interface {
+	// KEM returns the KEM this key is for.
+	KEM() KEM
+
+	// Bytes returns the encoding of the private key. It implements
+	// SerializePrivateKey from RFC 9180.
+	//
+	// Note that for DHKEM(X25519, HKDF-SHA256) the returned value is clamped,
+	// as required by RFC 9180, Section 7.1.2, so it might not match the input
+	// of NewPrivateKey.
+	Bytes() []byte
+
+	// PublicKey returns the corresponding public key.
+	PublicKey() PublicKey
+
+	decap(enc []byte) (sharedSecret []byte, err error)
+}
+
+// dhKEM implements the DHKEM of RFC 9180, Section 4.1.
+type dhKEM struct {
+	id      uint16
+	curve   ecdh.Curve
+	kdf     *hkdfKDF
+	nSecret uint16
+	nSk     uint16
+	nEnc    int
+}
+
+var (
+	dhKEMP256   = &dhKEM{id: 0x0010, curve: ecdh.P256(), kdf: hkdfSHA256, nSecret: 32, nSk: 32, nEnc: 65}
+	dhKEMP384   = &dhKEM{id: 0x0011, curve: ecdh.P384(), kdf: hkdfSHA384, nSecret: 48, nSk: 48, nEnc: 97}
+	dhKEMP521   = &dhKEM{id: 0x0012, curve: ecdh.P521(), kdf: hkdfSHA512, nSecret: 64, nSk: 66, nEnc: 133}
+	dhKEMX25519 = &dhKEM{id: 0x0020, curve: ecdh.X25519(), kdf: hkdfSHA256, nSecret: 32, nSk: 32, nEnc: 32}
+)
+
+// DHKEM returns the KEM implementing one of
+//
+//   - DHKEM(P-256, HKDF-SHA256)
+//   - DHKEM(P-384, HKDF-SHA384)
+//   - DHKEM(P-521, HKDF-SHA512)
+//   - DHKEM(X25519, HKDF-SHA256)
+//
+// depending on curve.
+func DHKEM(curve ecdh.Curve) KEM {
+	switch curve {
+	case ecdh.P256():
+		return dhKEMP256
+	case ecdh.P384():
+		return dhKEMP384
+	case ecdh.P521():
+		return dhKEMP521
+	case ecdh.X25519():
+		return dhKEMX25519
+	default:
+		// The set of ecdh.Curve implementations is closed, because the
+		// interface has unexported methods.
+		panic("hpke: unsupported curve")
+	}
+}
+
+func (kem *dhKEM) ID() uint16 {
+	return kem.id
+}
+
+func (kem *dhKEM) encSize() int {
+	return kem.nEnc
+}
+
+func (kem *dhKEM) suiteID() []byte {
+	return binary.BigEndian.AppendUint16([]byte("KEM"), kem.id)
+}
+
+// extractAndExpand implements ExtractAndExpand from RFC 9180, Section 4.1.
+func (kem *dhKEM) extractAndExpand(dhKey, kemContext []byte) ([]byte, error) {
+	suiteID := kem.suiteID()
+	eaePRK, err := kem.kdf.labeledExtract(suiteID, nil, "eae_prk", dhKey)
+	if err != nil {
+		return nil, err
+	}
+	return kem.kdf.labeledExpand(suiteID, eaePRK, "shared_secret", kemContext, kem.nSecret)
+}
+
+func (kem *dhKEM) GenerateKey() (PrivateKey, error) {
+	priv, err := kem.curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &dhKEMPrivateKey{kem, priv}, nil
+}
+
+func (kem *dhKEM) NewPublicKey(data []byte) (PublicKey, error) {
+	pub, err := kem.curve.NewPublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return &dhKEMPublicKey{kem, pub}, nil
+}
+
+func (kem *dhKEM) NewPrivateKey(data []byte) (PrivateKey, error) {
+	priv, err := kem.curve.NewPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return &dhKEMPrivateKey{kem, priv}, nil
+}
+
+// DeriveKeyPair implements DeriveKeyPair from RFC 9180, Section 7.1.3.
+func (kem *dhKEM) DeriveKeyPair(ikm []byte) (PrivateKey, error) {
+	suiteID := kem.suiteID()
+	dkpPRK, err := kem.kdf.labeledExtract(suiteID, nil, "dkp_prk", ikm)
+	if err != nil {
+		return nil, err
+	}
+	if kem == dhKEMX25519 {
+		sk, err := kem.kdf.labeledExpand(suiteID, dkpPRK, "sk", nil, kem.nSk)
+		if err != nil {
+			return nil, err
+		}
+		return kem.NewPrivateKey(sk)
+	}
+	for counter := 0; counter < 256; counter++ {
+		sk, err := kem.kdf.labeledExpand(suiteID, dkpPRK, "candidate", []byte{byte(counter)}, kem.nSk)
+		if err != nil {
+			return nil, err
+		}
+		if kem == dhKEMP521 {
+			sk[0] &= 0x01
+		}
+		if k, err := kem.NewPrivateKey(sk); err == nil {
+			return k, nil
+		}
+	}
+	return nil, errors.New("hpke: DeriveKeyPair failed")
+}
+
+type dhKEMPublicKey struct {
+	kem *dhKEM
+	pub *ecdh.PublicKey
+}
+
+func (pk *dhKEMPublicKey) KEM() KEM {
+	return pk.kem
+}
+
+func (pk *dhKEMPublicKey) Bytes() []byte {
+	return pk.pub.Bytes()
+}
+
+// testingOnlyGenerateKey is only used during testing, to provide
+// a fixed ephemeral key to use when checking the test vectors.
+var testingOnlyGenerateKey func() *ecdh.PrivateKey
+
+func (pk *dhKEMPublicKey) ephemeralKey() (*ecdh.PrivateKey, error) {
+	if testingOnlyGenerateKey != nil {
+		return testingOnlyGenerateKey(), nil
+	}
+	return pk.pub.Curve().GenerateKey(rand.Reader)
+}
+
+// encap implements Encap from RFC 9180, Section 4.1.
+func (pk *dhKEMPublicKey) encap() (sharedSecret, enc []byte, err error) {
+	privEph, err := pk.ephemeralKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	dh, err := privEph.ECDH(pk.pub)
+	if err != nil {
+		return nil, nil, err
+	}
+	enc = privEph.PublicKey().Bytes()
+
+	kemContext := append(enc[:len(enc):len(enc)], pk.pub.Bytes()...)
+	sharedSecret, err = pk.kem.extractAndExpand(dh, kemContext)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sharedSecret, enc, nil
+}
+
+// authEncap implements AuthEncap from RFC 9180, Section 4.1.
+func (pk *dhKEMPublicKey) authEncap(sk *dhKEMPrivateKey) (sharedSecret, enc []byte, err error) {
+	privEph, err := pk.ephemeralKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	dhE, err := privEph.ECDH(pk.pub)
+	if err != nil {
+		return nil, nil, err
+	}
+	dhS, err := sk.priv.ECDH(pk.pub)
+	if err != nil {
+		return nil, nil, err
+	}
+	enc = privEph.PublicKey().Bytes()
+
+	dh := append(dhE, dhS...)
+	kemContext := append(enc[:len(enc):len(enc)], pk.pub.Bytes()...)
+	kemContext = append(kemContext, sk.priv.PublicKey().Bytes()...)
+	sharedSecret, err = pk.kem.extractAndExpand(dh, kemContext)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sharedSecret, enc, nil
+}
+
+type dhKEMPrivateKey struct {
+	kem  *dhKEM
+	priv *ecdh.PrivateKey
+}
+
+func (k *dhKEMPrivateKey) KEM() KEM {
+	return k.kem
+}
+
+func (k *dhKEMPrivateKey) Bytes() []byte {
+	b := k.priv.Bytes()
+	if k.kem == dhKEMX25519 {
+		b[0] &= 248
+		b[31] &= 127
+		b[31] |= 64
+	}
+	return b
+}
+
+func (k *dhKEMPrivateKey) PublicKey() PublicKey {
+	return &dhKEMPublicKey{k.kem, k.priv.PublicKey()}
+}
+
+// decap implements Decap from RFC 9180, Section 4.1.
+func (k *dhKEMPrivateKey) decap(enc []byte) ([]byte, error) {
+	pubEph, err := k.kem.curve.NewPublicKey(enc)
+	if err != nil {
+		return nil, err
+	}
+	dh, err := k.priv.ECDH(pubEph)
+	if err != nil {
+		return nil, err
+	}
+	kemContext := append(enc[:len(enc):len(enc)], k.priv.PublicKey().Bytes()...)
+	return k.kem.extractAndExpand(dh, kemContext)
+}
+
+// authDecap implements AuthDecap from RFC 9180, Section 4.1.
+func (k *dhKEMPrivateKey) authDecap(enc []byte, pk *dhKEMPublicKey) ([]byte, error) {
+	pubEph, err := k.kem.curve.NewPublicKey(enc)
+	if err != nil {
+		return nil, err
+	}
+	dhE, err := k.priv.ECDH(pubEph)
+	if err != nil {
+		return nil, err
+	}
+	dhS, err := k.priv.ECDH(pk.pub)
+	if err != nil {
+		return nil, err
+	}
+	dh := append(dhE, dhS...)
+	kemContext := append(enc[:len(enc):len(enc)], k.priv.PublicKey().Bytes()...)
+	kemContext = append(kemContext, pk.pub.Bytes()...)
+	return k.kem.extractAndExpand(dh, kemContext)
+}
+
+// authKeys checks that pk and sk can be used together in the auth modes,
+// which are only supported by the DHKEM KEMs.
+func authKeys(pk PublicKey, sk PrivateKey) (*dhKEMPublicKey, *dhKEMPrivateKey, error) {
+	dhPK, ok1 := pk.(*dhKEMPublicKey)
+	dhSK, ok2 := sk.(*dhKEMPrivateKey)
+	if !ok1 || !ok2 {
+		return nil, nil, errors.New("hpke: KEM does not support the auth modes")
+	}
+	if dhPK.kem != dhSK.kem {
+		return nil, nil, errors.New("hpke: mismatched KEMs")
+	}
+	return dhPK, dhSK, nil
+}
@@ -0,0 +1,387 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"crypto/ecdh"
+	"crypto/mlkem"
+	"crypto/rand"
+	"crypto/sha3"
+	"encoding/binary"
+	"errors"
+)
+
+// This file implements the ML-KEM and hybrid ML-KEM KEMs specified in
+// draft-ietf-hpke-pq-03.
+
+type encapsulationKey interface {
+	Bytes() []byte
+	Encapsulate() (sharedKey, ciphertext []byte)
+}
+
+type decapsulationKey interface {
+	Bytes() []byte
+	Decapsulate(ciphertext []byte) (sharedKey []byte, err error)
+}
+
+// mlkemParams abstracts over the ML-KEM-768 and ML-KEM-1024 parameter sets.
+type mlkemParams struct {
+	ciphertextSize       int
+	encapsulationKeySize int
+	newEncapsulationKey  func([]byte) (encapsulationKey, error)
+	newDecapsulationKey  func(seed []byte) (decapsulationKey, encapsulationKey, error)
+}
+
+var mlkem768Params = &mlkemParams{
+	ciphertextSize:       mlkem.CiphertextSize768,
+	encapsulationKeySize: mlkem.EncapsulationKeySize768,
+	newEncapsulationKey: func(b []byte) (encapsulationKey, error) {
+		return mlkem.NewEncapsulationKey768(b)
+	},
+	newDecapsulationKey: func(seed []byte) (decapsulationKey, encapsulationKey, error) {
+		dk, err := mlkem.NewDecapsulationKey768(seed)
+		if err != nil {
+			return nil, nil, err
+		}
+		return dk, dk.EncapsulationKey(), nil
+	},
+}
+
+var mlkem1024Params = &mlkemParams{
+	ciphertextSize:       mlkem.CiphertextSize1024,
+	encapsulationKeySize: mlkem.EncapsulationKeySize1024,
+	newEncapsulationKey: func(b []byte) (encapsulationKey, error) {
+		return mlkem.NewEncapsulationKey1024(b)
+	},
+	newDecapsulationKey: func(seed []byte) (decapsulationKey, encapsulationKey, error) {
+		dk, err := mlkem.NewDecapsulationKey1024(seed)
+		if err != nil {
+			return nil, nil, err
+		}
+		return dk, dk.EncapsulationKey(), nil
+	},
+}
+
+// testingOnlyEncapsulate is only used during testing, to provide a fixed
+// ML-KEM encapsulation to use when checking the test vectors.
+var testingOnlyEncapsulate func(ek encapsulationKey) (sharedKey, ciphertext []byte)
+
+func encapsulate(ek encapsulationKey) (sharedKey, ciphertext []byte) {
+	if testingOnlyEncapsulate != nil {
+		return testingOnlyEncapsulate(ek)
+	}
+	return ek.Encapsulate()
+}
+
+// shakeLabeledDerive implements LabeledDerive with SHAKE256, which the
+// ML-KEM based KEMs use to derive key pairs.
+func shakeLabeledDerive(suiteID, ikm []byte, label string, context []byte, length uint16) []byte {
+	h := sha3.NewSHAKE256()
+	h.Write(ikm)
+	h.Write([]byte("HPKE-v1"))
+	h.Write(suiteID)
+	h.Write(binary.BigEndian.AppendUint16(nil, uint16(len(label))))
+	h.Write([]byte(label))
+	h.Write(binary.BigEndian.AppendUint16(nil, length))
+	h.Write(context)
+	out := make([]byte, length)
+	h.Read(out)
+	return out
+}
+
+type mlkemKEM struct {
+	id     uint16
+	params *mlkemParams
+}
+
+var (
+	mlkem768  = &mlkemKEM{id: 0x0041, params: mlkem768Params}
+	mlkem1024 = &mlkemKEM{id: 0x0042, params: mlkem1024Params}
+)
+
+// MLKEM768 returns the ML-KEM-768 KEM.
+func MLKEM768() KEM { return mlkem768 }
+
+// MLKEM1024 returns the ML-KEM-1024 KEM.
+func MLKEM1024() KEM { return mlkem1024 }
+
+func (kem *mlkemKEM) ID() uint16 {
+	return kem.id
+}
+
+func (kem *mlkemKEM) encSize() int {
+	return kem.params.ciphertextSize
+}
+
+func (kem *mlkemKEM) GenerateKey() (PrivateKey, error) {
+	seed := make([]byte, mlkem.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return kem.NewPrivateKey(seed)
+}
+
+func (kem *mlkemKEM) NewPublicKey(data []byte) (PublicKey, error) {
+	ek, err := kem.params.newEncapsulationKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return &mlkemPublicKey{kem, ek}, nil
+}
+
+// NewPrivateKey parses a private key in the 64-byte seed format.
+func (kem *mlkemKEM) NewPrivateKey(seed []byte) (PrivateKey, error) {
+	dk, ek, err := kem.params.newDecapsulationKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &mlkemPrivateKey{kem, dk, ek}, nil
+}
+
+func (kem *mlkemKEM) DeriveKeyPair(ikm []byte) (PrivateKey, error) {
+	suiteID := binary.BigEndian.AppendUint16([]byte("KEM"), kem.id)
+	return kem.NewPrivateKey(shakeLabeledDerive(suiteID, ikm, "DeriveKeyPair", nil, mlkem.SeedSize))
+}
+
+type mlkemPublicKey struct {
+	kem *mlkemKEM
+	ek  encapsulationKey
+}
+
+func (pk *mlkemPublicKey) KEM() KEM {
+	return pk.kem
+}
+
+func (pk *mlkemPublicKey) Bytes() []byte {
+	return pk.ek.Bytes()
+}
+
+func (pk *mlkemPublicKey) encap() (sharedSecret, enc []byte, err error) {
+	sharedSecret, enc = encapsulate(pk.ek)
+	return sharedSecret, enc, nil
+}
+
+type mlkemPrivateKey struct {
+	kem *mlkemKEM
+	dk  decapsulationKey
+	ek  encapsulationKey
+}
+
+func (k *mlkemPrivateKey) KEM() KEM {
+	return k.kem
+}
+
+func (k *mlkemPrivateKey) Bytes() []byte {
+	return k.dk.Bytes()
+}
+
+func (k *mlkemPrivateKey) PublicKey() PublicKey {
+	return &mlkemPublicKey{k.kem, k.ek}
+}
+
+func (k *mlkemPrivateKey) decap(enc []byte) ([]byte, error) {
+	return k.dk.Decapsulate(enc)
+}
+
+// hybridKEM is a KEM combining ML-KEM with an elliptic curve Diffie-Hellman
+// exchange, with the combiner of X-Wing.
+type hybridKEM struct {
+	id    uint16
+	label string
+	pq    *mlkemParams
+	curve ecdh.Curve
+
+	curveSeedSize  int
+	curvePointSize int
+}
+
+var (
+	mlkem768X25519 = &hybridKEM{
+		id: 0x647a,
+		label: /**/ `\./` +
+			/*   */ `/^\`,
+		pq:             mlkem768Params,
+		curve:          ecdh.X25519(),
+		curveSeedSize:  32,
+		curvePointSize: 32,
+	}
+	mlkem768P256 = &hybridKEM{
+		id:             0x0050,
+		label:          "MLKEM768-P256",
+		pq:             mlkem768Params,
+		curve:          ecdh.P256(),
+		curveSeedSize:  32,
+		curvePointSize: 65,
+	}
+	mlkem1024P384 = &hybridKEM{
+		id:             0x0051,
+		label:          "MLKEM1024-P384",
+		pq:             mlkem1024Params,
+		curve:          ecdh.P384(),
+		curveSeedSize:  48,
+		curvePointSize: 97,
+	}
+)
+
+// MLKEM768X25519 returns the MLKEM768-X25519 hybrid KEM, also known as
+// X-Wing. It is the recommended post-quantum KEM.
+func MLKEM768X25519() KEM { return mlkem768X25519 }
+
+// MLKEM768P256 returns the MLKEM768-P256 hybrid KEM.
+func MLKEM768P256() KEM { return mlkem768P256 }
+
+// MLKEM1024P384 returns the MLKEM1024-P384 hybrid KEM.
+func MLKEM1024P384() KEM { return mlkem1024P384 }
+
+func (kem *hybridKEM) ID() uint16 {
+	return kem.id
+}
+
+func (kem *hybridKEM) encSize() int {
+	return kem.pq.ciphertextSize + kem.curvePointSize
+}
+
+func (kem *hybridKEM) sharedSecret(ssPQ, ssT, ctT, ekT []byte) []byte {
+	h := sha3.New256()
+	h.Write(ssPQ)
+	h.Write(ssT)
+	h.Write(ctT)
+	h.Write(ekT)
+	h.Write([]byte(kem.label))
+	return h.Sum(nil)
+}
+
+func (kem *hybridKEM) GenerateKey() (PrivateKey, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return kem.NewPrivateKey(seed)
+}
+
+func (kem *hybridKEM) NewPublicKey(data []byte) (PublicKey, error) {
+	if len(data) != kem.pq.encapsulationKeySize+kem.curvePointSize {
+		return nil, errors.New("hpke: invalid public key size")
+	}
+	pq, err := kem.pq.newEncapsulationKey(data[:kem.pq.encapsulationKeySize])
+	if err != nil {
+		return nil, err
+	}
+	t, err := kem.curve.NewPublicKey(data[kem.pq.encapsulationKeySize:])
+	if err != nil {
+		return nil, err
+	}
+	return &hybridPublicKey{kem, pq, t}, nil
+}
+
+// NewPrivateKey parses a private key in the 32-byte seed format, and expands
+// it into the ML-KEM and elliptic curve private keys.
+func (kem *hybridKEM) NewPrivateKey(seed []byte) (PrivateKey, error) {
+	if len(seed) != 32 {
+		return nil, errors.New("hpke: invalid private key size")
+	}
+	s := sha3.NewSHAKE256()
+	s.Write(seed)
+
+	seedPQ := make([]byte, mlkem.SeedSize)
+	s.Read(seedPQ)
+	dk, ek, err := kem.pq.newDecapsulationKey(seedPQ)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejection sample the curve private key. For X25519 every candidate is
+	// valid, and for the NIST curves rejections are vanishingly rare.
+	seedT := make([]byte, kem.curveSeedSize)
+	for {
+		s.Read(seedT)
+		t, err := kem.curve.NewPrivateKey(seedT)
+		if err != nil {
+			continue
+		}
+		return &hybridPrivateKey{kem, append([]byte(nil), seed...), dk, ek, t}, nil
+	}
+}
+
+func (kem *hybridKEM) DeriveKeyPair(ikm []byte) (PrivateKey, error) {
+	suiteID := binary.BigEndian.AppendUint16([]byte("KEM"), kem.id)
+	return kem.NewPrivateKey(shakeLabeledDerive(suiteID, ikm, "DeriveKeyPair", nil, 32))
+}
+
+type hybridPublicKey struct {
+	kem *hybridKEM
+	pq  encapsulationKey
+	t   *ecdh.PublicKey
+}
+
+func (pk *hybridPublicKey) KEM() KEM {
+	return pk.kem
+}
+
+func (pk *hybridPublicKey) Bytes() []byte {
+	return append(pk.pq.Bytes(), pk.t.Bytes()...)
+}
+
+func (pk *hybridPublicKey) encap() (sharedSecret, enc []byte, err error) {
+	var skE *ecdh.PrivateKey
+	if testingOnlyGenerateKey != nil {
+		skE = testingOnlyGenerateKey()
+	} else {
+		skE, err = pk.kem.curve.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	ssT, err := skE.ECDH(pk.t)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctT := skE.PublicKey().Bytes()
+
+	ssPQ, ctPQ := encapsulate(pk.pq)
+
+	sharedSecret = pk.kem.sharedSecret(ssPQ, ssT, ctT, pk.t.Bytes())
+	return sharedSecret, append(ctPQ, ctT...), nil
+}
+
+type hybridPrivateKey struct {
+	kem  *hybridKEM
+	seed []byte
+	pq   decapsulationKey
+	pqEK encapsulationKey
+	t    *ecdh.PrivateKey
+}
+
+func (k *hybridPrivateKey) KEM() KEM {
+	return k.kem
+}
+
+func (k *hybridPrivateKey) Bytes() []byte {
+	return append([]byte(nil), k.seed...)
+}
+
+func (k *hybridPrivateKey) PublicKey() PublicKey {
+	return &hybridPublicKey{k.kem, k.pqEK, k.t.PublicKey()}
+}
+
+func (k *hybridPrivateKey) decap(enc []byte) ([]byte, error) {
+	if len(enc) != k.kem.encSize() {
+		return nil, errors.New("hpke: invalid encapsulated key size")
+	}
+	ctPQ, ctT := enc[:k.kem.pq.ciphertextSize], enc[k.kem.pq.ciphertextSize:]
+	ssPQ, err := k.pq.Decapsulate(ctPQ)
+	if err != nil {
+		return nil, err
+	}
+	pkE, err := k.kem.curve.NewPublicKey(ctT)
+	if err != nil {
+		return nil, err
+	}
+	ssT, err := k.t.ECDH(pkE)
+	if err != nil {
+		return nil, err
+	}
+	return k.kem.sharedSecret(ssPQ, ssT, ctT, k.t.PublicKey().Bytes()), nil
+}
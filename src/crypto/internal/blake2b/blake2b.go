@@ -0,0 +1,125 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blake2b implements the unkeyed BLAKE2b hash function defined by
+// RFC 7693, with digests of any size between 1 and 64 bytes.
+//
+// It is only intended for use by crypto/argon2, which builds its variable
+// length hash function H' on top of it.
+package blake2b
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// BlockSize is the block size of BLAKE2b in bytes.
+	BlockSize = 128
+	// Size is the hash size of BLAKE2b-512 in bytes.
+	Size = 64
+)
+
+var iv = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+// Digest is an unkeyed BLAKE2b hash state. It implements [hash.Hash].
+type Digest struct {
+	h      [8]uint64
+	c      [2]uint64
+	size   int
+	block  [BlockSize]byte
+	offset int
+}
+
+// New returns a new Digest computing the BLAKE2b checksum of the given size,
+// which must be between 1 and 64 bytes.
+func New(size int) (*Digest, error) {
+	if size < 1 || size > Size {
+		return nil, errors.New("blake2b: invalid hash size")
+	}
+	d := &Digest{size: size}
+	d.Reset()
+	return d, nil
+}
+
+// Sum512 returns the BLAKE2b-512 checksum of the data.
+func Sum512(data []byte) [Size]byte {
+	d := &Digest{size: Size}
+	d.Reset()
+	d.Write(data)
+	var sum [Size]byte
+	d.finalize(&sum)
+	return sum
+}
+
+func (d *Digest) BlockSize() int { return BlockSize }
+
+func (d *Digest) Size() int { return d.size }
+
+func (d *Digest) Reset() {
+	d.h = iv
+	d.h[0] ^= uint64(d.size) | (1 << 16) | (1 << 24)
+	d.offset, d.c[0], d.c[1] = 0, 0, 0
+}
+
+func (d *Digest) Write(p []byte) (n int, err error) {
+	n = len(p)
+
+	if d.offset > 0 {
+		remaining := BlockSize - d.offset
+		if n <= remaining {
+			d.offset += copy(d.block[d.offset:], p)
+			return
+		}
+		copy(d.block[d.offset:], p[:remaining])
+		hashBlocks(&d.h, &d.c, 0, d.block[:])
+		d.offset = 0
+		p = p[remaining:]
+	}
+
+	// The last block is always kept back, as it must be processed with the
+	// finalization flag set.
+	if length := len(p); length > BlockSize {
+		nn := length &^ (BlockSize - 1)
+		if length == nn {
+			nn -= BlockSize
+		}
+		hashBlocks(&d.h, &d.c, 0, p[:nn])
+		p = p[nn:]
+	}
+
+	if len(p) > 0 {
+		d.offset += copy(d.block[:], p)
+	}
+
+	return
+}
+
+func (d *Digest) Sum(b []byte) []byte {
+	var hash [Size]byte
+	d.finalize(&hash)
+	return append(b, hash[:d.size]...)
+}
+
+func (d *Digest) finalize(hash *[Size]byte) {
+	var block [BlockSize]byte
+	copy(block[:], d.block[:d.offset])
+	remaining := uint64(BlockSize - d.offset)
+
+	c := d.c
+	if c[0] < remaining {
+		c[1]--
+	}
+	c[0] -= remaining
+
+	h := d.h
+	hashBlocks(&h, &c, 0xFFFFFFFFFFFFFFFF, block[:])
+
+	for i, v := range h {
+		binary.LittleEndian.PutUint64(hash[8*i:], v)
+	}
+}
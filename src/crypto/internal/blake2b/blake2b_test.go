@@ -0,0 +1,60 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blake2b
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+// TestAccumulated hashes messages of every length up to 300 bytes and checks
+// a SHA-256 of all the digests, computed with an independent implementation.
+func TestAccumulated(t *testing.T) {
+	for _, tt := range []struct {
+		size int
+		want string
+	}{
+		{1, "72c6afcef8b4a7d0b0812f064c949a2971c6370656f7bdf40a4abd15d5f8f016"},
+		{20, "c3faa1683bd1e4407a3a57c648ca7f59f776584e9e7a68c98908557df1ec64a6"},
+		{32, "c7037e161d9043b26f6711c140eafa5c859c281f8064a07ecfbba78faf7b27ed"},
+		{48, "89ede152268e74a7b4d99223a75a817ab8b090a9a69af3272d18fd64c5ffc4ca"},
+		{64, "59413cb94bbd5115455cdc8fac57a69fbca770714bc269a407ce84f33ca4f091"},
+	} {
+		acc := sha256.New()
+		msg := make([]byte, 300)
+		for i := range msg {
+			msg[i] = byte(i % 251)
+		}
+		for n := 0; n < len(msg); n++ {
+			h, err := New(tt.size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Write in two uneven pieces to exercise the buffering.
+			h.Write(msg[:n/3])
+			h.Write(msg[n/3 : n])
+			sum := h.Sum(nil)
+			if tt.size == Size {
+				if s := Sum512(msg[:n]); !bytes.Equal(s[:], sum) {
+					t.Errorf("Sum512 and New(64) disagree for length %d", n)
+				}
+			}
+			acc.Write(sum)
+		}
+		if got := hex.EncodeToString(acc.Sum(nil)); got != tt.want {
+			t.Errorf("size %d: got %s, want %s", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestInvalidSize(t *testing.T) {
+	for _, size := range []int{-1, 0, Size + 1} {
+		if _, err := New(size); err == nil {
+			t.Errorf("New(%d) succeeded", size)
+		}
+	}
+}
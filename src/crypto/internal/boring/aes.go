@@ -67,7 +67,7 @@ type aesCipher struct {
 }
 
 type extraModes interface {
-	// Copied out of crypto/aes/modes.go.
+	// Copied out of crypto/internal/fips140/aes/modes.go.
 	NewCBCEncrypter(iv []byte) cipher.BlockMode
 	NewCBCDecrypter(iv []byte) cipher.BlockMode
 	NewCTR(iv []byte) cipher.Stream
@@ -0,0 +1,277 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edwards448 implements group logic for the untwisted Edwards curve
+//
+//	x^2 + y^2 = 1 - 39081*x^2*y^2
+//
+// over GF(2^448 - 2^224 - 1). This is the edwards448 curve of RFC 7748, also
+// known as Ed448-Goldilocks, and is the curve used by the Ed448 signature
+// scheme of RFC 8032.
+//
+// All operations are constant time.
+package edwards448
+
+import (
+	"crypto/internal/edwards448/field"
+	"crypto/subtle"
+	"errors"
+)
+
+// PointSize is the size of the encoding of a Point, as used by Ed448.
+const PointSize = 57
+
+// Point represents a point on the edwards448 curve.
+//
+// This type works similarly to math/big.Int, and all arguments and receivers
+// are allowed to alias.
+//
+// The zero value is NOT valid, and it may be used only as a receiver.
+type Point struct {
+	// Make the type not comparable (i.e. used with == or as a map key), as
+	// equivalent points can be represented by different Go values.
+	_ incomparable
+
+	// The point is internally represented in projective coordinates (X:Y:Z)
+	// where x = X/Z and y = Y/Z, per RFC 8032, Section 5.2.4.
+	x, y, z field.Element
+}
+
+type incomparable [0]func()
+
+func checkInitialized(points ...*Point) {
+	for _, p := range points {
+		if p.z == (field.Element{}) {
+			panic("edwards448: use of uninitialized Point")
+		}
+	}
+}
+
+// d is the curve constant -39081.
+var d = new(field.Element).Negate(new(field.Element).Mult32(new(field.Element).One(), 39081))
+
+// NewIdentityPoint returns a new Point set to the identity.
+func NewIdentityPoint() *Point {
+	p := &Point{}
+	p.x.Zero()
+	p.y.One()
+	p.z.One()
+	return p
+}
+
+// generator is the canonical generator of RFC 8032, Section 5.2.
+var generator, _ = new(Point).SetBytes([]byte{
+	0x14, 0xfa, 0x30, 0xf2, 0x5b, 0x79, 0x08, 0x98, 0xad, 0xc8, 0xd7, 0x4e,
+	0x2c, 0x13, 0xbd, 0xfd, 0xc4, 0x39, 0x7c, 0xe6, 0x1c, 0xff, 0xd3, 0x3a,
+	0xd7, 0xc2, 0xa0, 0x05, 0x1e, 0x9c, 0x78, 0x87, 0x40, 0x98, 0xa3, 0x6c,
+	0x73, 0x73, 0xea, 0x4b, 0x62, 0xc7, 0xc9, 0x56, 0x37, 0x20, 0x76, 0x88,
+	0x24, 0xbc, 0xb6, 0x6e, 0x71, 0x46, 0x3f, 0x69, 0x00,
+})
+
+// NewGeneratorPoint returns a new Point set to the canonical generator.
+func NewGeneratorPoint() *Point {
+	return new(Point).Set(generator)
+}
+
+// Set sets v = u, and returns v.
+func (v *Point) Set(u *Point) *Point {
+	*v = *u
+	return v
+}
+
+// Bytes returns the canonical 57-byte encoding of v, according to RFC 8032,
+// Section 5.2.2.
+func (v *Point) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var buf [PointSize]byte
+	return v.bytes(&buf)
+}
+
+func (v *Point) bytes(buf *[PointSize]byte) []byte {
+	checkInitialized(v)
+
+	var zInv, x, y field.Element
+	zInv.Invert(&v.z)       // zInv = 1 / Z
+	x.Multiply(&v.x, &zInv) // x = X / Z
+	y.Multiply(&v.y, &zInv) // y = Y / Z
+
+	out := buf[:0]
+	out = append(out, y.Bytes()...)
+	out = append(out, byte(x.IsNegative()<<7))
+	return out
+}
+
+// SetBytes sets v = x, where x is a 57-byte encoding of v. If x does not
+// represent a valid point on the curve, SetBytes returns nil and an error and
+// the receiver is unchanged. Otherwise, SetBytes returns v.
+//
+// Unlike edwards25519, non-canonical encodings are rejected, as required by
+// RFC 8032, Section 5.2.3.
+func (v *Point) SetBytes(x []byte) (*Point, error) {
+	if len(x) != PointSize {
+		return nil, errors.New("edwards448: invalid point encoding length")
+	}
+	if x[PointSize-1]&0x7f != 0 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+	y, err := new(field.Element).SetBytes(x[:PointSize-1])
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(y.Bytes(), x[:PointSize-1]) != 1 {
+		return nil, errors.New("edwards448: non-canonical point encoding")
+	}
+
+	// x² + y² = 1 + dx²y²
+	// x² - dx²y² = x²(1 - dy²) = 1 - y²
+	// x² = (y² - 1) / (dy² - 1)
+
+	// u = y² - 1
+	y2 := new(field.Element).Square(y)
+	u := new(field.Element).Subtract(y2, new(field.Element).One())
+
+	// w = dy² - 1
+	w := new(field.Element).Multiply(y2, d)
+	w.Subtract(w, new(field.Element).One())
+
+	// x = ±√(u/w)
+	xx, wasSquare := new(field.Element).SqrtRatio(u, w)
+	if wasSquare == 0 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+
+	// Select the negative square root if the sign bit is set.
+	sign := int(x[PointSize-1] >> 7)
+	if xx.Equal(new(field.Element)) == 1 && sign == 1 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+	xxNeg := new(field.Element).Negate(xx)
+	xx.Select(xxNeg, xx, sign^xx.IsNegative())
+
+	v.x.Set(xx)
+	v.y.Set(y)
+	v.z.One()
+	return v, nil
+}
+
+// Equal returns 1 if v is equivalent to u, and 0 otherwise.
+func (v *Point) Equal(u *Point) int {
+	checkInitialized(v, u)
+
+	var t1, t2, t3, t4 field.Element
+	t1.Multiply(&v.x, &u.z)
+	t2.Multiply(&u.x, &v.z)
+	t3.Multiply(&v.y, &u.z)
+	t4.Multiply(&u.y, &v.z)
+
+	return t1.Equal(&t2) & t3.Equal(&t4)
+}
+
+// Add sets v = p + q, and returns v.
+func (v *Point) Add(p, q *Point) *Point {
+	checkInitialized(p, q)
+
+	// RFC 8032, Section 5.2.4. These formulas are complete, since d is not a
+	// square, so they don't need to special-case the identity or doubling.
+	var a, b, c, dd, e, f, g, h, t field.Element
+	a.Multiply(&p.z, &q.z)
+	b.Square(&a)
+	c.Multiply(&p.x, &q.x)
+	dd.Multiply(&p.y, &q.y)
+	e.Multiply(d, e.Multiply(&c, &dd))
+	f.Subtract(&b, &e)
+	g.Add(&b, &e)
+	h.Multiply(h.Add(&p.x, &p.y), t.Add(&q.x, &q.y))
+
+	// X3 = A * F * (H - C - D)
+	h.Subtract(&h, &c)
+	h.Subtract(&h, &dd)
+	v.x.Multiply(t.Multiply(&a, &f), &h)
+	// Y3 = A * G * (D - C)
+	v.y.Multiply(t.Multiply(&a, &g), h.Subtract(&dd, &c))
+	// Z3 = F * G
+	v.z.Multiply(&f, &g)
+	return v
+}
+
+// Subtract sets v = p - q, and returns v.
+func (v *Point) Subtract(p, q *Point) *Point {
+	checkInitialized(p, q)
+	return v.Add(p, new(Point).Negate(q))
+}
+
+// Negate sets v = -p, and returns v.
+func (v *Point) Negate(p *Point) *Point {
+	checkInitialized(p)
+	v.x.Negate(&p.x)
+	v.y.Set(&p.y)
+	v.z.Set(&p.z)
+	return v
+}
+
+// double sets v = p + p, and returns v.
+func (v *Point) double(p *Point) *Point {
+	// RFC 8032, Section 5.2.4.
+	var b, c, dd, e, h, j field.Element
+	b.Square(b.Add(&p.x, &p.y))
+	c.Square(&p.x)
+	dd.Square(&p.y)
+	e.Add(&c, &dd)
+	h.Square(&p.z)
+	j.Subtract(&e, h.Add(&h, &h))
+
+	v.x.Multiply(b.Subtract(&b, &e), &j)
+	v.y.Multiply(&e, c.Subtract(&c, &dd))
+	v.z.Multiply(&e, &j)
+	return v
+}
+
+// Select sets v to a if cond == 1, and to b if cond == 0.
+func (v *Point) Select(a, b *Point, cond int) *Point {
+	v.x.Select(&a.x, &b.x, cond)
+	v.y.Select(&a.y, &b.y, cond)
+	v.z.Select(&a.z, &b.z, cond)
+	return v
+}
+
+// ScalarBaseMult sets v = x * B, where B is the canonical generator, and
+// returns v.
+func (v *Point) ScalarBaseMult(x *Scalar) *Point {
+	return v.ScalarMult(x, generator)
+}
+
+// ScalarMult sets v = x * q, and returns v.
+func (v *Point) ScalarMult(x *Scalar, q *Point) *Point {
+	checkInitialized(q)
+
+	// Build a table of the multiples 0 * q to 15 * q.
+	var table [16]Point
+	table[0].Set(NewIdentityPoint())
+	table[1].Set(q)
+	for i := 2; i < len(table); i++ {
+		table[i].Add(&table[i-1], q)
+	}
+
+	// Process the scalar four bits at a time, from the most significant, with
+	// a constant-time lookup of the corresponding multiple. The last byte of
+	// a reduced scalar is always zero.
+	s := x.Bytes()[:ScalarSize-1]
+	acc := NewIdentityPoint()
+	var t Point
+	for i := 2*len(s) - 1; i >= 0; i-- {
+		acc.double(acc)
+		acc.double(acc)
+		acc.double(acc)
+		acc.double(acc)
+
+		w := s[i/2] >> (4 * (i % 2)) & 0xf
+		t.Set(&table[0])
+		for j := 1; j < len(table); j++ {
+			t.Select(&table[j], &t, subtle.ConstantTimeByteEq(w, uint8(j)))
+		}
+		acc.Add(acc, &t)
+	}
+	return v.Set(acc)
+}
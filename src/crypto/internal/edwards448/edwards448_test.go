@@ -0,0 +1,156 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards448
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func decodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// scalarOrderBytes is l in little-endian order.
+var scalarOrderBytes = decodeHex("f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f00")
+
+func TestGenerator(t *testing.T) {
+	g := NewGeneratorPoint()
+	if g.Equal(NewIdentityPoint()) == 1 {
+		t.Fatal("generator is the identity")
+	}
+	// The generator has order l, so multiplying it by l - 1 yields -B.
+	lMinusOne := append([]byte{}, scalarOrderBytes...)
+	lMinusOne[0]--
+	s, err := NewScalar().SetCanonicalBytes(lMinusOne)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := new(Point).ScalarBaseMult(s)
+	if p.Add(p, g).Equal(NewIdentityPoint()) != 1 {
+		t.Error("(l - 1) * B + B is not the identity")
+	}
+}
+
+func TestScalarBaseMultVectors(t *testing.T) {
+	// Generated with an independent implementation of RFC 8032.
+	tests := []struct{ scalar, point string }{
+		{
+			"8bb7c647ec2fc33fbdc6331e870684f21257444b19c45428e2ba7c0043322cce7b9d699546e8b98c96986d58236e736d4009f3e7bcd5b82b00",
+			"c2e2e04f4db02a131ef509f24f79ddd4f3c9c88a24a539515b77faa70bfbba46d1a202ea6b656dce13194a4419bfb2ff3d61df5e73a36e4f80",
+		},
+		{
+			"d4e2091e4ccef9d4f4fae19ed2315631c164fcc7a6361aeeca22c5f65b4a1b82b130e358c99a0b50de081eb7369dd25a2420a45600550d3400",
+			"97b8847d8038ccc02cca5ef1c32272feefd717bba107c53ba1d2899a0e81858366d0bf503941e17f2954c6c2ccd06cee9eb9a00e3fcbd2f980",
+		},
+		{
+			"87da57f482960dceea963078ec9255e718fe544eaa585adc0f1b528dc98fe93c196f76f879f0685b5c3b9368dda572ce6b392d589ba3673100",
+			"b4c81c39bc1646a2753357a437daedf6c8b52d20dd77949e852a518e03295ba69d76682a58e545f2384f6130e4135a1636a8a73fb1c6b32e80",
+		},
+	}
+	for _, tt := range tests {
+		s, err := NewScalar().SetCanonicalBytes(decodeHex(tt.scalar))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := new(Point).ScalarBaseMult(s)
+		if got := hex.EncodeToString(p.Bytes()); got != tt.point {
+			t.Errorf("ScalarBaseMult(%s) = %s, want %s", tt.scalar, got, tt.point)
+		}
+
+		q, err := new(Point).SetBytes(p.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Equal(p) != 1 {
+			t.Errorf("SetBytes(Bytes()) round trip failed")
+		}
+	}
+}
+
+func TestAddDouble(t *testing.T) {
+	g := NewGeneratorPoint()
+	p := NewIdentityPoint()
+	for i := 1; i <= 20; i++ {
+		p.Add(p, g)
+		var b [ScalarSize]byte
+		b[0] = byte(i)
+		s, _ := NewScalar().SetCanonicalBytes(b[:])
+		if q := new(Point).ScalarBaseMult(s); q.Equal(p) != 1 {
+			t.Fatalf("%d * B doesn't match repeated addition", i)
+		}
+		if q := new(Point).double(p); q.Equal(new(Point).Add(p, p)) != 1 {
+			t.Fatalf("double doesn't match Add for %d * B", i)
+		}
+		if q := new(Point).Subtract(p, p); q.Equal(NewIdentityPoint()) != 1 {
+			t.Fatalf("P - P is not the identity for %d * B", i)
+		}
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	g := NewGeneratorPoint().Bytes()
+	for name, enc := range map[string][]byte{
+		"short":           g[:56],
+		"extra bits":      append(append([]byte{}, g[:56]...), 0x01),
+		"non-canonical y": append(bytes.Repeat([]byte{0xff}, 56), 0x00),
+		"not on curve":    append([]byte{0x02}, make([]byte, 56)...),
+		// y = 1 has x = 0, which can't have the sign bit set.
+		"negative zero": append(append([]byte{0x01}, make([]byte, 55)...), 0x80),
+	} {
+		if _, err := new(Point).SetBytes(enc); err == nil {
+			t.Errorf("%s: SetBytes accepted an invalid encoding", name)
+		}
+	}
+	identity := NewIdentityPoint().Bytes()
+	if p, err := new(Point).SetBytes(identity); err != nil || p.Equal(NewIdentityPoint()) != 1 {
+		t.Errorf("identity encoding round trip failed")
+	}
+}
+
+func TestScalar(t *testing.T) {
+	wide := decodeHex("a5eb97fd636f521edc995288cffe39cebfe46d9b09d26dbfc4872dda28f4bb0fc8d1b7bb4badefb7311d7bf9d08054c9e292820186d4fc48e2eb855a8e91d6fca3fdd8301902219b018dfccb399a29ef39663baf4b07be830e7f3002c2e07ffa3c2275b3526fc79a23d840e112aa0636402d")
+	want := "72ef68f2e4a4fe251d174147e28aecdeb722779c8b9df07eafa0b802dab7da3ebc5ff4ecb01b76994595124aef3e15884c4115aea711940300"
+	s, err := NewScalar().SetUniformBytes(wide)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(s.Bytes()); got != want {
+		t.Errorf("SetUniformBytes = %s, want %s", got, want)
+	}
+
+	if _, err := NewScalar().SetCanonicalBytes(scalarOrderBytes); err == nil {
+		t.Error("SetCanonicalBytes accepted l")
+	}
+	high := make([]byte, ScalarSize)
+	high[ScalarSize-1] = 1
+	if _, err := NewScalar().SetCanonicalBytes(high); err == nil {
+		t.Error("SetCanonicalBytes accepted a value with the last byte set")
+	}
+
+	// x * 1 + 0 = x
+	var oneBytes [ScalarSize]byte
+	oneBytes[0] = 1
+	one, _ := NewScalar().SetCanonicalBytes(oneBytes[:])
+	if r := NewScalar().MultiplyAdd(s, one, NewScalar()); r.Equal(s) != 1 {
+		t.Error("x * 1 + 0 != x")
+	}
+	// (l - 1) * (l - 1) + 0 = 1
+	lMinusOne := append([]byte{}, scalarOrderBytes...)
+	lMinusOne[0]--
+	m, _ := NewScalar().SetCanonicalBytes(lMinusOne)
+	if r := NewScalar().MultiplyAdd(m, m, NewScalar()); r.Equal(one) != 1 {
+		t.Error("(-1) * (-1) != 1")
+	}
+	// (l - 1) * 1 + 1 = 0
+	if r := NewScalar().MultiplyAdd(m, one, one); r.Equal(NewScalar()) != 1 {
+		t.Error("-1 + 1 != 0")
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package field implements arithmetic modulo 2^448 - 2^224 - 1.
+package field
+
+import (
+	"crypto/subtle"
+	"errors"
+	"internal/byteorder"
+	"math/bits"
+)
+
+// Element represents an element of the field GF(2^448 - 2^224 - 1). Note that
+// this is not a cryptographically secure group, and should only be used to
+// interact with edwards448.Point coordinates and X448.
+//
+// This type works similarly to math/big.Int, and all arguments and receivers
+// are allowed to alias.
+//
+// The zero value is a valid zero element.
+type Element struct {
+	// An element t represents the integer
+	//     t.l[0] + t.l[1]*2^56 + t.l[2]*2^112 + ... + t.l[7]*2^392
+	//
+	// Between operations, all limbs are expected to be lower than 2^57.
+	l [8]uint64
+}
+
+const maskLow56Bits uint64 = (1 << 56) - 1
+
+var feZero = &Element{}
+
+// Zero sets v = 0, and returns v.
+func (v *Element) Zero() *Element {
+	*v = *feZero
+	return v
+}
+
+var feOne = &Element{[8]uint64{1}}
+
+// One sets v = 1, and returns v.
+func (v *Element) One() *Element {
+	*v = *feOne
+	return v
+}
+
+// carryPropagate brings the limbs below 2^56 + 2^9, applying the reduction
+// identity 2^448 = 2^224 + 1 to the carry out of the top limb.
+func (v *Element) carryPropagate() *Element {
+	var c [8]uint64
+	for i := range v.l {
+		c[i] = v.l[i] >> 56
+		v.l[i] &= maskLow56Bits
+	}
+	for i := 1; i < 8; i++ {
+		v.l[i] += c[i-1]
+	}
+	v.l[0] += c[7]
+	v.l[4] += c[7]
+	return v
+}
+
+// reduce reduces v modulo 2^448 - 2^224 - 1 and returns it.
+func (v *Element) reduce() *Element {
+	v.carryPropagate()
+
+	// Propagate the carries sequentially, so that all limbs are lower than
+	// 2^56 and v < 2^448. The second round is only needed if folding the top
+	// carry of the first one made l[0] or l[4] overflow, and it can't carry
+	// out of the top limb again.
+	for round := 0; round < 2; round++ {
+		var c uint64
+		for i := range v.l {
+			v.l[i] += c
+			c = v.l[i] >> 56
+			v.l[i] &= maskLow56Bits
+		}
+		v.l[0] += c
+		v.l[4] += c
+	}
+
+	// If v >= p, then v + 2^224 + 1 >= 2^448, which generates a carry out of
+	// the top limb, and the low 448 bits are v - p.
+	var t [8]uint64
+	c := uint64(1)
+	for i := range t {
+		t[i] = v.l[i] + c
+		if i == 4 {
+			t[i]++
+		}
+		c = t[i] >> 56
+		t[i] &= maskLow56Bits
+	}
+	m := -c
+	for i := range v.l {
+		v.l[i] = (m & t[i]) | (^m & v.l[i])
+	}
+	return v
+}
+
+// Add sets v = a + b, and returns v.
+func (v *Element) Add(a, b *Element) *Element {
+	for i := range v.l {
+		v.l[i] = a.l[i] + b.l[i]
+	}
+	return v.carryPropagate()
+}
+
+// Subtract sets v = a - b, and returns v.
+func (v *Element) Subtract(a, b *Element) *Element {
+	// We first add 2 * p, to guarantee the subtraction won't underflow, and
+	// then subtract b (whose limbs are lower than 2^56 + 2^9).
+	for i := range v.l {
+		twoP := uint64(0x1fffffffffffffe)
+		if i == 4 {
+			twoP = 0x1fffffffffffffc
+		}
+		v.l[i] = (a.l[i] + twoP) - b.l[i]
+	}
+	return v.carryPropagate()
+}
+
+// Negate sets v = -a, and returns v.
+func (v *Element) Negate(a *Element) *Element {
+	return v.Subtract(feZero, a)
+}
+
+// Invert sets v = 1/z mod p, and returns v.
+//
+// If z == 0, Invert returns v = 0.
+func (v *Element) Invert(z *Element) *Element {
+	// Inversion is implemented as exponentiation with exponent p - 2, which is
+	// 4 * (p - 3) / 4 + 1.
+	var t Element
+	t.powPMinus3Over4(z)
+	t.Square(&t)
+	t.Square(&t)
+	return v.Multiply(&t, z)
+}
+
+// powPMinus3Over4 sets v = x^((p-3)/4), and returns v. (p-3)/4 is
+// 2^446 - 2^222 - 1.
+func (v *Element) powPMinus3Over4(x *Element) *Element {
+	var x2, x3, x6, x12, x24, x30, x48, x96, x192, x222, t Element
+
+	t.Square(x)
+	x2.Multiply(&t, x)                      // 2^2 - 1
+	x3.Multiply(t.Square(&x2), x)           // 2^3 - 1
+	x6.Multiply(t.squareN(&x3, 3), &x3)     // 2^6 - 1
+	x12.Multiply(t.squareN(&x6, 6), &x6)    // 2^12 - 1
+	x24.Multiply(t.squareN(&x12, 12), &x12) // 2^24 - 1
+	x30.Multiply(t.squareN(&x24, 6), &x6)   // 2^30 - 1
+	x48.Multiply(t.squareN(&x24, 24), &x24) // 2^48 - 1
+	x96.Multiply(t.squareN(&x48, 48), &x48) // 2^96 - 1
+	x192.Multiply(t.squareN(&x96, 96), &x96)
+	x222.Multiply(t.squareN(&x192, 30), &x30) // 2^222 - 1
+	t.Multiply(t.Square(&x222), x)            // 2^223 - 1
+	t.squareN(&t, 223)                        // 2^446 - 2^223
+	return v.Multiply(&t, &x222)              // 2^446 - 2^222 - 1
+}
+
+// squareN sets v = x^(2^n), and returns v.
+func (v *Element) squareN(x *Element, n int) *Element {
+	v.Square(x)
+	for i := 1; i < n; i++ {
+		v.Square(v)
+	}
+	return v
+}
+
+// Set sets v = a, and returns v.
+func (v *Element) Set(a *Element) *Element {
+	*v = *a
+	return v
+}
+
+// SetBytes sets v to x, where x is a 56-byte little-endian encoding. If x is
+// not of the right length, SetBytes returns nil and an error, and the
+// receiver is unchanged.
+//
+// Consistent with RFC 7748, non-canonical values (p through 2^448 - 1) are
+// accepted. Callers that need to reject them can compare the output of Bytes.
+func (v *Element) SetBytes(x []byte) (*Element, error) {
+	if len(x) != 56 {
+		return nil, errors.New("edwards448: invalid field element input size")
+	}
+	for i := range v.l {
+		var buf [8]byte
+		copy(buf[:7], x[7*i:])
+		v.l[i] = byteorder.LeUint64(buf[:])
+	}
+	return v, nil
+}
+
+// Bytes returns the canonical 56-byte little-endian encoding of v.
+func (v *Element) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var out [56]byte
+	return v.bytes(&out)
+}
+
+func (v *Element) bytes(out *[56]byte) []byte {
+	t := *v
+	t.reduce()
+
+	var buf [8]byte
+	for i, l := range t.l {
+		byteorder.LePutUint64(buf[:], l)
+		copy(out[7*i:], buf[:7])
+	}
+	return out[:]
+}
+
+// Equal returns 1 if v and u are equal, and 0 otherwise.
+func (v *Element) Equal(u *Element) int {
+	sa, sv := u.Bytes(), v.Bytes()
+	return subtle.ConstantTimeCompare(sa, sv)
+}
+
+// mask64Bits returns 0xffffffff if cond is 1, and 0 otherwise.
+func mask64Bits(cond int) uint64 { return ^(uint64(cond) - 1) }
+
+// Select sets v to a if cond == 1, and to b if cond == 0.
+func (v *Element) Select(a, b *Element, cond int) *Element {
+	m := mask64Bits(cond)
+	for i := range v.l {
+		v.l[i] = (m & a.l[i]) | (^m & b.l[i])
+	}
+	return v
+}
+
+// Swap swaps v and u if cond == 1 or leaves them unchanged if cond == 0.
+func (v *Element) Swap(u *Element, cond int) {
+	m := mask64Bits(cond)
+	for i := range v.l {
+		t := m & (v.l[i] ^ u.l[i])
+		v.l[i] ^= t
+		u.l[i] ^= t
+	}
+}
+
+// IsNegative returns 1 if v is negative, and 0 otherwise.
+//
+// Following RFC 8032, Section 5.2.2, an element is negative if the least
+// significant bit of its canonical encoding is set.
+func (v *Element) IsNegative() int {
+	return int(v.Bytes()[0] & 1)
+}
+
+// uint128 holds a 128-bit number as two 64-bit limbs, for use with the
+// bits.Mul64 and bits.Add64 intrinsics.
+type uint128 struct {
+	lo, hi uint64
+}
+
+// addMul64 returns v + a * b.
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+// add128 returns a + b.
+func add128(a, b uint128) uint128 {
+	lo, c := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, c)
+	return uint128{lo, hi}
+}
+
+// shiftRightBy56 returns a >> 56. a is assumed to be at most 120 bits.
+func shiftRightBy56(a uint128) uint64 {
+	return (a.hi << (64 - 56)) | (a.lo >> 56)
+}
+
+// Multiply sets v = x * y, and returns v.
+func (v *Element) Multiply(x, y *Element) *Element {
+	// Columnar multiplication into fifteen 128-bit columns, each the sum of at
+	// most eight products of limbs lower than 2^57.
+	var c [15]uint128
+	for i := range x.l {
+		for j := range y.l {
+			c[i+j] = addMul64(c[i+j], x.l[i], y.l[j])
+		}
+	}
+
+	// Fold the columns above 2^448 using 2^448 = 2^224 + 1, from the top down,
+	// so that columns 8 to 11 receive the contributions of columns 12 to 14
+	// before being folded themselves. Each column ends up with the equivalent
+	// of at most eighteen products, well below 2^128.
+	for k := 14; k >= 8; k-- {
+		c[k-8] = add128(c[k-8], c[k])
+		c[k-4] = add128(c[k-4], c[k])
+	}
+
+	var carry uint64
+	for i := range v.l {
+		t := add128(c[i], uint128{carry, 0})
+		v.l[i] = t.lo & maskLow56Bits
+		carry = shiftRightBy56(t)
+	}
+	v.l[0] += carry
+	v.l[4] += carry
+	return v.carryPropagate()
+}
+
+// Square sets v = x * x, and returns v.
+func (v *Element) Square(x *Element) *Element {
+	return v.Multiply(x, x)
+}
+
+// Mult32 sets v = x * y, and returns v.
+func (v *Element) Mult32(x *Element, y uint32) *Element {
+	var hi [8]uint64
+	for i := range x.l {
+		h, l := bits.Mul64(x.l[i], uint64(y))
+		hi[i] = (h << (64 - 56)) | (l >> 56)
+		v.l[i] = l & maskLow56Bits
+	}
+	for i := 1; i < 8; i++ {
+		v.l[i] += hi[i-1]
+	}
+	v.l[0] += hi[7]
+	v.l[4] += hi[7]
+	return v.carryPropagate()
+}
+
+// SqrtRatio sets r to a square root of the ratio of u and v.
+//
+// If u/v is square, SqrtRatio returns r and 1. If u/v is not square,
+// SqrtRatio returns an unspecified r and 0.
+func (r *Element) SqrtRatio(u, v *Element) (R *Element, wasSquare int) {
+	// Since p = 3 mod 4, the candidate root is
+	//
+	//     r = u^3 * v * (u^5 * v^3)^((p-3)/4)
+	//
+	// per RFC 8032, Section 5.2.3.
+	var u2, u3, u5, v3, t, rr Element
+	u2.Square(u)
+	u3.Multiply(&u2, u)
+	u5.Multiply(&u3, &u2)
+	v3.Multiply(t.Square(v), v)
+	t.Multiply(&u5, &v3)
+	t.powPMinus3Over4(&t)
+	rr.Multiply(&t, rr.Multiply(&u3, v))
+
+	// check = v * r^2
+	check := t.Multiply(v, t.Square(&rr))
+	wasSquare = check.Equal(u)
+
+	r.Set(&rr)
+	return r, wasSquare
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package field
+
+import (
+	"bytes"
+	"math/big"
+	mathrand "math/rand"
+	"testing"
+)
+
+// p = 2^448 - 2^224 - 1
+var p = new(big.Int).Sub(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 448),
+	new(big.Int).Lsh(big.NewInt(1), 224)), big.NewInt(1))
+
+func swapEndianness(b []byte) []byte {
+	for i := 0; i < len(b)/2; i++ {
+		b[i], b[len(b)-i-1] = b[len(b)-i-1], b[i]
+	}
+	return b
+}
+
+func toBig(v *Element) *big.Int {
+	return new(big.Int).SetBytes(swapEndianness(v.Bytes()))
+}
+
+// randomElement returns a random element, sometimes with unreduced limbs.
+func randomElement(r *mathrand.Rand) *Element {
+	v := new(Element)
+	switch r.Intn(4) {
+	case 0:
+		// A value close to p, or to 2^448.
+		b := make([]byte, 56)
+		for i := range b {
+			b[i] = 0xff
+		}
+		b[0] -= byte(r.Intn(4))
+		if r.Intn(2) == 0 {
+			b[28] = 0xfe
+		}
+		v.SetBytes(b)
+	case 1:
+		// Limbs up to 2^56 + 2^9.
+		for i := range v.l {
+			v.l[i] = r.Uint64()&maskLow56Bits + uint64(r.Intn(1<<9))
+		}
+	default:
+		b := make([]byte, 56)
+		r.Read(b)
+		v.SetBytes(b)
+	}
+	return v
+}
+
+func checkReduced(t *testing.T, v *Element) {
+	t.Helper()
+	if b := toBig(v); b.Cmp(p) >= 0 {
+		t.Fatalf("non-canonical encoding: %x", b)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	r := mathrand.New(mathrand.NewSource(448))
+	for i := 0; i < 2000; i++ {
+		a, b := randomElement(r), randomElement(r)
+		// Compute the limb values directly, as Bytes reduces.
+		limbs := func(v *Element) *big.Int {
+			n := new(big.Int)
+			for i := len(v.l) - 1; i >= 0; i-- {
+				n.Lsh(n, 56)
+				n.Add(n, new(big.Int).SetUint64(v.l[i]))
+			}
+			return n
+		}
+		A, B := limbs(a), limbs(b)
+
+		check := func(name string, got *Element, want *big.Int) {
+			t.Helper()
+			checkReduced(t, got)
+			want.Mod(want, p)
+			if toBig(got).Cmp(want) != 0 {
+				t.Fatalf("%s(%x, %x) = %x, want %x", name, A, B, toBig(got), want)
+			}
+		}
+		check("Add", new(Element).Add(a, b), new(big.Int).Add(A, B))
+		check("Subtract", new(Element).Subtract(a, b), new(big.Int).Sub(A, B))
+		check("Negate", new(Element).Negate(a), new(big.Int).Neg(A))
+		check("Multiply", new(Element).Multiply(a, b), new(big.Int).Mul(A, B))
+		check("Square", new(Element).Square(a), new(big.Int).Mul(A, A))
+		check("Mult32", new(Element).Mult32(a, 39081), new(big.Int).Mul(A, big.NewInt(39081)))
+		check("Invert", new(Element).Invert(a), new(big.Int).Exp(A, new(big.Int).Sub(p, big.NewInt(2)), p))
+
+		// Aliasing.
+		c := *a
+		c.Multiply(&c, &c)
+		check("Multiply (aliased)", &c, new(big.Int).Mul(A, A))
+	}
+}
+
+func TestSetBytesRoundTrip(t *testing.T) {
+	r := mathrand.New(mathrand.NewSource(448))
+	for i := 0; i < 100; i++ {
+		b := make([]byte, 56)
+		r.Read(b)
+		b[55] &= 0x7f
+		v, err := new(Element).SetBytes(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(v.Bytes(), b) {
+			t.Fatalf("SetBytes(%x).Bytes() = %x", b, v.Bytes())
+		}
+	}
+
+	// p and p+1 are non-canonical encodings of 0 and 1.
+	pBytes := swapEndianness(p.FillBytes(make([]byte, 56)))
+	v, _ := new(Element).SetBytes(pBytes)
+	if v.Equal(feZero) != 1 {
+		t.Errorf("SetBytes(p) != 0")
+	}
+	pBytes = swapEndianness(new(big.Int).Add(p, big.NewInt(1)).FillBytes(make([]byte, 56)))
+	v, _ = new(Element).SetBytes(pBytes)
+	if v.Equal(feOne) != 1 {
+		t.Errorf("SetBytes(p+1) != 1")
+	}
+
+	if _, err := new(Element).SetBytes(make([]byte, 57)); err == nil {
+		t.Errorf("SetBytes accepted a 57-byte input")
+	}
+}
+
+func TestSqrtRatio(t *testing.T) {
+	r := mathrand.New(mathrand.NewSource(448))
+	var squares, nonSquares int
+	for i := 0; i < 200; i++ {
+		u, v := randomElement(r), randomElement(r)
+		if v.Equal(feZero) == 1 {
+			continue
+		}
+		root, wasSquare := new(Element).SqrtRatio(u, v)
+
+		// u/v is a square iff (u/v)^((p-1)/2) is 0 or 1.
+		ratio := toBig(new(Element).Multiply(u, new(Element).Invert(v)))
+		exp := new(big.Int).Rsh(p, 1)
+		isSquare := new(big.Int).Exp(ratio, exp, p).Cmp(big.NewInt(1)) <= 0
+		if (wasSquare == 1) != isSquare {
+			t.Fatalf("SqrtRatio(%x, %x) wasSquare = %d, want %v", toBig(u), toBig(v), wasSquare, isSquare)
+		}
+		if wasSquare == 1 {
+			squares++
+			got := new(Element).Multiply(v, new(Element).Square(root))
+			if got.Equal(u) != 1 {
+				t.Fatalf("SqrtRatio(%x, %x) = %x, not a root", toBig(u), toBig(v), toBig(root))
+			}
+		} else {
+			nonSquares++
+		}
+	}
+	if squares == 0 || nonSquares == 0 {
+		t.Errorf("got %d squares and %d non-squares", squares, nonSquares)
+	}
+}
+
+func TestSelectSwap(t *testing.T) {
+	r := mathrand.New(mathrand.NewSource(448))
+	a, b := randomElement(r), randomElement(r)
+	if new(Element).Select(a, b, 1).Equal(a) != 1 || new(Element).Select(a, b, 0).Equal(b) != 1 {
+		t.Errorf("Select returned the wrong element")
+	}
+	c, d := *a, *b
+	c.Swap(&d, 0)
+	if c != *a || d != *b {
+		t.Errorf("Swap with cond 0 changed the elements")
+	}
+	c.Swap(&d, 1)
+	if c != *b || d != *a {
+		t.Errorf("Swap with cond 1 didn't swap the elements")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards448
+
+import (
+	"crypto/internal/fips140/bigmod"
+	"errors"
+	"math/big"
+)
+
+// A Scalar is an integer modulo
+//
+//	l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885
+//
+// which is the prime order of the edwards448 group.
+//
+// This type works similarly to math/big.Int, and all arguments and
+// receivers are allowed to alias.
+//
+// The zero value is a valid zero element.
+type Scalar struct {
+	// s is the canonical little-endian encoding of the scalar. Arithmetic is
+	// performed in constant time by crypto/internal/fips140/bigmod.
+	s [ScalarSize]byte
+}
+
+// ScalarSize is the size of the encoding of a Scalar, as used by Ed448.
+const ScalarSize = 57
+
+var (
+	scalarOrder   *big.Int
+	scalarModulus *bigmod.Modulus
+	// scalarTwo440 and scalarTwo880 are 2^440 and 2^880 modulo l.
+	scalarTwo440, scalarTwo880 *bigmod.Nat
+)
+
+func init() {
+	c, _ := new(big.Int).SetString("13818066809895115352007386748515426880336692474882178609894547503885", 10)
+	scalarOrder = new(big.Int).Lsh(big.NewInt(1), 446)
+	scalarOrder.Sub(scalarOrder, c)
+
+	var err error
+	scalarModulus, err = bigmod.NewModulus(scalarOrder.Bytes())
+	if err != nil {
+		panic("edwards448: internal error: " + err.Error())
+	}
+	natFromBig := func(x *big.Int) *bigmod.Nat {
+		n, err := bigmod.NewNat().SetBytes(x.Bytes(), scalarModulus)
+		if err != nil {
+			panic("edwards448: internal error: " + err.Error())
+		}
+		return n
+	}
+	two := big.NewInt(2)
+	scalarTwo440 = natFromBig(new(big.Int).Exp(two, big.NewInt(440), scalarOrder))
+	scalarTwo880 = natFromBig(new(big.Int).Exp(two, big.NewInt(880), scalarOrder))
+}
+
+// NewScalar returns a new zero Scalar.
+func NewScalar() *Scalar {
+	return &Scalar{}
+}
+
+// nat returns s as a bigmod.Nat.
+func (s *Scalar) nat() *bigmod.Nat {
+	// The last byte of the encoding is always zero, and l fits in 56 bytes.
+	var be [ScalarSize - 1]byte
+	for i := range be {
+		be[i] = s.s[len(be)-1-i]
+	}
+	n, err := bigmod.NewNat().SetBytes(be[:], scalarModulus)
+	if err != nil {
+		panic("edwards448: internal error: scalar is not reduced")
+	}
+	return n
+}
+
+// setNat sets s = n, where n is reduced modulo l.
+func (s *Scalar) setNat(n *bigmod.Nat) *Scalar {
+	be := n.Bytes(scalarModulus)
+	s.s = [ScalarSize]byte{}
+	for i, b := range be {
+		s.s[len(be)-1-i] = b
+	}
+	return s
+}
+
+// MultiplyAdd sets s = x * y + z mod l, and returns s.
+func (s *Scalar) MultiplyAdd(x, y, z *Scalar) *Scalar {
+	n := x.nat()
+	n.Mul(y.nat(), scalarModulus)
+	n.Add(z.nat(), scalarModulus)
+	return s.setNat(n)
+}
+
+// Set sets s = x, and returns s.
+func (s *Scalar) Set(x *Scalar) *Scalar {
+	*s = *x
+	return s
+}
+
+// SetUniformBytes sets s = x mod l, where x is a 114-byte little-endian
+// integer. If x is not of the right length, SetUniformBytes returns nil and an
+// error, and the receiver is unchanged.
+//
+// SetUniformBytes can be used to set s to a uniformly distributed value given
+// 114 uniformly distributed random bytes, such as the SHAKE256 outputs of
+// Ed448.
+func (s *Scalar) SetUniformBytes(x []byte) (*Scalar, error) {
+	if len(x) != 114 {
+		return nil, errors.New("edwards448: invalid SetUniformBytes input length")
+	}
+	return s.setWideBytes(x), nil
+}
+
+// setWideBytes sets s = x mod l, where x is a little-endian integer of at most
+// 114 bytes.
+func (s *Scalar) setWideBytes(x []byte) *Scalar {
+	// As in edwards25519, we interpret x as the sum of three values shorter
+	// than l, and reduce it with two multiplications and two additions.
+	//
+	//    x = a + b * 2^440 + c * 2^880  mod l
+	//
+	var buf [114]byte
+	copy(buf[:], x)
+	n := shortNat(buf[:55])
+	n.Add(shortNat(buf[55:110]).Mul(scalarTwo440, scalarModulus), scalarModulus)
+	n.Add(shortNat(buf[110:]).Mul(scalarTwo880, scalarModulus), scalarModulus)
+	return s.setNat(n)
+}
+
+// shortNat returns x as a bigmod.Nat, where x is a little-endian integer of at
+// most 55 bytes, and therefore lower than l.
+func shortNat(x []byte) *bigmod.Nat {
+	var be [55]byte
+	for i, b := range x {
+		be[len(be)-1-i] = b
+	}
+	n, err := bigmod.NewNat().SetBytes(be[:], scalarModulus)
+	if err != nil {
+		panic("edwards448: internal error: " + err.Error())
+	}
+	return n
+}
+
+// SetCanonicalBytes sets s = x, where x is a 57-byte little-endian encoding of
+// s, and returns s. If x is not a canonical encoding of s, SetCanonicalBytes
+// returns nil and an error, and the receiver is unchanged.
+func (s *Scalar) SetCanonicalBytes(x []byte) (*Scalar, error) {
+	if len(x) != ScalarSize {
+		return nil, errors.New("invalid scalar length")
+	}
+	var be [ScalarSize - 1]byte
+	for i := range be {
+		be[i] = x[len(be)-1-i]
+	}
+	if _, err := bigmod.NewNat().SetBytes(be[:], scalarModulus); err != nil || x[ScalarSize-1] != 0 {
+		return nil, errors.New("invalid scalar encoding")
+	}
+	copy(s.s[:], x)
+	return s, nil
+}
+
+// SetBytesWithClamping applies the buffer pruning described in RFC 8032,
+// Section 5.2.5 (also known as clamping) and sets s to the result. The input
+// must be 57 bytes, and it is not modified. If x is not of the right length,
+// SetBytesWithClamping returns nil and an error, and the receiver is unchanged.
+//
+// As with edwards25519, the reduction modulo l discards the cofactor-clearing
+// properties of clamping, which is fine for points on the prime order subgroup.
+func (s *Scalar) SetBytesWithClamping(x []byte) (*Scalar, error) {
+	if len(x) != ScalarSize {
+		return nil, errors.New("edwards448: invalid SetBytesWithClamping input length")
+	}
+	var buf [ScalarSize]byte
+	copy(buf[:], x)
+	buf[0] &= 252
+	buf[55] |= 128
+	buf[56] = 0
+	return s.setWideBytes(buf[:]), nil
+}
+
+// Bytes returns the canonical 57-byte little-endian encoding of s.
+func (s *Scalar) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var encoded [ScalarSize]byte
+	return s.bytes(&encoded)
+}
+
+func (s *Scalar) bytes(out *[ScalarSize]byte) []byte {
+	*out = s.s
+	return out[:]
+}
+
+// Equal returns 1 if s and t are equal, and 0 otherwise.
+func (s *Scalar) Equal(t *Scalar) int {
+	var diff byte
+	for i := range s.s {
+		diff |= s.s[i] ^ t.s[i]
+	}
+	return int((uint32(diff) - 1) >> 31)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes
+
+import (
+	"crypto/internal/fips140"
+	"errors"
+)
+
+func init() {
+	fips140.CAST("AES-128", func() error {
+		// FIPS 197, Appendix C.1.
+		key := []byte{
+			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+			0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+		}
+		plaintext := []byte{
+			0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+			0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+		}
+		ciphertext := []byte{
+			0x69, 0xc4, 0xe0, 0xd8, 0x6a, 0x7b, 0x04, 0x30,
+			0xd8, 0xcd, 0xb7, 0x80, 0x70, 0xb4, 0xc5, 0x5a,
+		}
+		b, err := New(key)
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, BlockSize)
+		b.Encrypt(buf, plaintext)
+		if string(buf) != string(ciphertext) {
+			return errors.New("unexpected encryption result")
+		}
+		b.Decrypt(buf, ciphertext)
+		if string(buf) != string(plaintext) {
+			return errors.New("unexpected decryption result")
+		}
+		return nil
+	})
+}
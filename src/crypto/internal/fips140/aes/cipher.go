@@ -7,7 +7,6 @@ package aes
 import (
 	"crypto/cipher"
 	"crypto/internal/alias"
-	"crypto/internal/boring"
 	"strconv"
 )
 
@@ -27,11 +26,11 @@ func (k KeySizeError) Error() string {
 	return "crypto/aes: invalid key size " + strconv.Itoa(int(k))
 }
 
-// NewCipher creates and returns a new [cipher.Block].
+// New creates and returns a new [cipher.Block].
 // The key argument should be the AES key,
 // either 16, 24, or 32 bytes to select
 // AES-128, AES-192, or AES-256.
-func NewCipher(key []byte) (cipher.Block, error) {
+func New(key []byte) (cipher.Block, error) {
 	k := len(key)
 	switch k {
 	default:
@@ -39,9 +38,6 @@ func NewCipher(key []byte) (cipher.Block, error) {
 	case 16, 24, 32:
 		break
 	}
-	if boring.Enabled {
-		return boring.NewAESCipher(key)
-	}
 	return newCipher(key)
 }
 
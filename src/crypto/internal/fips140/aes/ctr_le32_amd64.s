@@ -0,0 +1,189 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// le32One increments the first 32 bits of a counter block,
+// as a little-endian integer.
+DATA le32One<>+0x00(SB)/8, $0x0000000000000001
+DATA le32One<>+0x08(SB)/8, $0x0000000000000000
+GLOBL le32One<>(SB), (NOPTR+RODATA), $16
+
+#define CTR X9
+#define ONE X10
+#define KEY X8
+
+// ROUND8 applies one AES round with the key at off(AX) to X0-X7.
+#define ROUND8(off) \
+	MOVUPS off(AX), KEY; \
+	AESENC KEY, X0; \
+	AESENC KEY, X1; \
+	AESENC KEY, X2; \
+	AESENC KEY, X3; \
+	AESENC KEY, X4; \
+	AESENC KEY, X5; \
+	AESENC KEY, X6; \
+	AESENC KEY, X7
+
+// LASTROUND8 applies the last AES round with the key at off(AX) to X0-X7.
+#define LASTROUND8(off) \
+	MOVUPS off(AX), KEY; \
+	AESENCLAST KEY, X0; \
+	AESENCLAST KEY, X1; \
+	AESENCLAST KEY, X2; \
+	AESENCLAST KEY, X3; \
+	AESENCLAST KEY, X4; \
+	AESENCLAST KEY, X5; \
+	AESENCLAST KEY, X6; \
+	AESENCLAST KEY, X7
+
+// func ctrLE32Asm(nr int, xk *uint32, dst, src *byte, blocks int, ctr *[16]byte)
+TEXT ·ctrLE32Asm(SB),NOSPLIT,$0
+	MOVQ nr+0(FP), CX
+	MOVQ dst+16(FP), DX
+	MOVQ src+24(FP), BX
+	MOVQ blocks+32(FP), SI
+	MOVQ ctr+40(FP), DI
+	MOVOU (DI), CTR
+	MOVOU le32One<>(SB), ONE
+
+	// Encrypt eight counter blocks at a time.
+loop8:
+	CMPQ SI, $8
+	JB loop1
+	MOVOU CTR, X0
+	PADDD ONE, CTR
+	MOVOU CTR, X1
+	PADDD ONE, CTR
+	MOVOU CTR, X2
+	PADDD ONE, CTR
+	MOVOU CTR, X3
+	PADDD ONE, CTR
+	MOVOU CTR, X4
+	PADDD ONE, CTR
+	MOVOU CTR, X5
+	PADDD ONE, CTR
+	MOVOU CTR, X6
+	PADDD ONE, CTR
+	MOVOU CTR, X7
+	PADDD ONE, CTR
+	MOVQ xk+8(FP), AX
+	MOVUPS 0(AX), KEY
+	PXOR KEY, X0
+	PXOR KEY, X1
+	PXOR KEY, X2
+	PXOR KEY, X3
+	PXOR KEY, X4
+	PXOR KEY, X5
+	PXOR KEY, X6
+	PXOR KEY, X7
+	ADDQ $16, AX
+	CMPQ CX, $12
+	JE enc8_192
+	JB enc8_128
+	ROUND8(0)
+	ROUND8(16)
+	ADDQ $32, AX
+enc8_192:
+	ROUND8(0)
+	ROUND8(16)
+	ADDQ $32, AX
+enc8_128:
+	ROUND8(0)
+	ROUND8(16)
+	ROUND8(32)
+	ROUND8(48)
+	ROUND8(64)
+	ROUND8(80)
+	ROUND8(96)
+	ROUND8(112)
+	ROUND8(128)
+	LASTROUND8(144)
+	MOVOU 0(BX), KEY
+	PXOR KEY, X0
+	MOVOU X0, 0(DX)
+	MOVOU 16(BX), KEY
+	PXOR KEY, X1
+	MOVOU X1, 16(DX)
+	MOVOU 32(BX), KEY
+	PXOR KEY, X2
+	MOVOU X2, 32(DX)
+	MOVOU 48(BX), KEY
+	PXOR KEY, X3
+	MOVOU X3, 48(DX)
+	MOVOU 64(BX), KEY
+	PXOR KEY, X4
+	MOVOU X4, 64(DX)
+	MOVOU 80(BX), KEY
+	PXOR KEY, X5
+	MOVOU X5, 80(DX)
+	MOVOU 96(BX), KEY
+	PXOR KEY, X6
+	MOVOU X6, 96(DX)
+	MOVOU 112(BX), KEY
+	PXOR KEY, X7
+	MOVOU X7, 112(DX)
+	ADDQ $128, BX
+	ADDQ $128, DX
+	SUBQ $8, SI
+	JMP loop8
+
+	// Then the remaining blocks one at a time.
+loop1:
+	TESTQ SI, SI
+	JZ done
+	MOVOU CTR, X0
+	PADDD ONE, CTR
+	MOVQ xk+8(FP), AX
+	MOVUPS 0(AX), KEY
+	PXOR KEY, X0
+	ADDQ $16, AX
+	CMPQ CX, $12
+	JE enc1_192
+	JB enc1_128
+	MOVUPS 0(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 16(AX), KEY
+	AESENC KEY, X0
+	ADDQ $32, AX
+enc1_192:
+	MOVUPS 0(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 16(AX), KEY
+	AESENC KEY, X0
+	ADDQ $32, AX
+enc1_128:
+	MOVUPS 0(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 16(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 32(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 48(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 64(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 80(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 96(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 112(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 128(AX), KEY
+	AESENC KEY, X0
+	MOVUPS 144(AX), KEY
+	AESENCLAST KEY, X0
+	MOVOU 0(BX), KEY
+	PXOR KEY, X0
+	MOVOU X0, 0(DX)
+	ADDQ $16, BX
+	ADDQ $16, DX
+	DECQ SI
+	JMP loop1
+
+done:
+	MOVOU CTR, (DI)
+	RET
@@ -0,0 +1,347 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// func ctrLE32Asm(nr int, xk *uint32, dst, src *byte, blocks int, ctr *[16]byte)
+TEXT ·ctrLE32Asm(SB),NOSPLIT,$0
+	MOVD	nr+0(FP), R9
+	MOVD	xk+8(FP), R10
+	MOVD	dst+16(FP), R11
+	MOVD	src+24(FP), R12
+	MOVD	blocks+32(FP), R13
+	MOVD	ctr+40(FP), R14
+
+	VLD1	(R14), [V8.B16]
+	// V9 increments the first 32 bits of a counter block,
+	// as a little-endian integer.
+	VEOR	V9.B16, V9.B16, V9.B16
+	MOVD	$1, R0
+	VMOV	R0, V9.S[0]
+
+	// Load the round keys into V16-V30, like encryptBlockAsm.
+	CMP	$12, R9
+	BLT	load128
+	BEQ	load192
+	VLD1.P	32(R10), [V16.B16, V17.B16]
+load192:
+	VLD1.P	32(R10), [V18.B16, V19.B16]
+load128:
+	VLD1.P	64(R10), [V20.B16, V21.B16, V22.B16, V23.B16]
+	VLD1.P	64(R10), [V24.B16, V25.B16, V26.B16, V27.B16]
+	VLD1.P	48(R10), [V28.B16, V29.B16, V30.B16]
+
+	// Encrypt eight counter blocks at a time.
+loop8:
+	CMP	$8, R13
+	BLT	loop1
+	VMOV	V8.B16, V0.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V1.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V2.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V3.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V4.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V5.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V6.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	VMOV	V8.B16, V7.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	CMP	$12, R9
+	BLT	enc8_128
+	BEQ	enc8_192
+	AESE	V16.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V16.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V16.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V16.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V16.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V16.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V16.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V16.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V17.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V17.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V17.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V17.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V17.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V17.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V17.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V17.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+enc8_192:
+	AESE	V18.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V18.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V18.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V18.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V18.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V18.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V18.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V18.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V19.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V19.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V19.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V19.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V19.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V19.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V19.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V19.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+enc8_128:
+	AESE	V20.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V20.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V20.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V20.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V20.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V20.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V20.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V20.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V21.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V21.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V21.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V21.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V21.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V21.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V21.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V21.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V22.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V22.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V22.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V22.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V22.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V22.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V22.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V22.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V23.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V23.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V23.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V23.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V23.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V23.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V23.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V23.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V24.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V24.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V24.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V24.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V24.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V24.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V24.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V24.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V25.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V25.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V25.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V25.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V25.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V25.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V25.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V25.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V26.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V26.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V26.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V26.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V26.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V26.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V26.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V26.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V27.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V27.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V27.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V27.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V27.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V27.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V27.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V27.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V28.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V28.B16, V1.B16
+	AESMC	V1.B16, V1.B16
+	AESE	V28.B16, V2.B16
+	AESMC	V2.B16, V2.B16
+	AESE	V28.B16, V3.B16
+	AESMC	V3.B16, V3.B16
+	AESE	V28.B16, V4.B16
+	AESMC	V4.B16, V4.B16
+	AESE	V28.B16, V5.B16
+	AESMC	V5.B16, V5.B16
+	AESE	V28.B16, V6.B16
+	AESMC	V6.B16, V6.B16
+	AESE	V28.B16, V7.B16
+	AESMC	V7.B16, V7.B16
+	AESE	V29.B16, V0.B16
+	AESE	V29.B16, V1.B16
+	AESE	V29.B16, V2.B16
+	AESE	V29.B16, V3.B16
+	AESE	V29.B16, V4.B16
+	AESE	V29.B16, V5.B16
+	AESE	V29.B16, V6.B16
+	AESE	V29.B16, V7.B16
+	VEOR	V0.B16, V30.B16, V0.B16
+	VEOR	V1.B16, V30.B16, V1.B16
+	VEOR	V2.B16, V30.B16, V2.B16
+	VEOR	V3.B16, V30.B16, V3.B16
+	VEOR	V4.B16, V30.B16, V4.B16
+	VEOR	V5.B16, V30.B16, V5.B16
+	VEOR	V6.B16, V30.B16, V6.B16
+	VEOR	V7.B16, V30.B16, V7.B16
+	VLD1.P	64(R12), [V10.B16, V11.B16, V12.B16, V13.B16]
+	VEOR	V10.B16, V0.B16, V0.B16
+	VEOR	V11.B16, V1.B16, V1.B16
+	VEOR	V12.B16, V2.B16, V2.B16
+	VEOR	V13.B16, V3.B16, V3.B16
+	VST1.P	[V0.B16, V1.B16, V2.B16, V3.B16], 64(R11)
+	VLD1.P	64(R12), [V10.B16, V11.B16, V12.B16, V13.B16]
+	VEOR	V10.B16, V4.B16, V4.B16
+	VEOR	V11.B16, V5.B16, V5.B16
+	VEOR	V12.B16, V6.B16, V6.B16
+	VEOR	V13.B16, V7.B16, V7.B16
+	VST1.P	[V4.B16, V5.B16, V6.B16, V7.B16], 64(R11)
+	SUB	$8, R13
+	B	loop8
+
+	// Then the remaining blocks one at a time.
+loop1:
+	CBZ	R13, done
+	VMOV	V8.B16, V0.B16
+	VADD	V8.S4, V9.S4, V8.S4
+	CMP	$12, R9
+	BLT	enc1_128
+	BEQ	enc1_192
+	AESE	V16.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V17.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+enc1_192:
+	AESE	V18.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V19.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+enc1_128:
+	AESE	V20.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V21.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V22.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V23.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V24.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V25.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V26.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V27.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V28.B16, V0.B16
+	AESMC	V0.B16, V0.B16
+	AESE	V29.B16, V0.B16
+	VEOR	V0.B16, V30.B16, V0.B16
+	VLD1.P	16(R12), [V10.B16]
+	VEOR	V10.B16, V0.B16, V0.B16
+	VST1.P	[V0.B16], 16(R11)
+	SUB	$1, R13
+	B	loop1
+
+done:
+	VST1	[V8.B16], (R14)
+	RET
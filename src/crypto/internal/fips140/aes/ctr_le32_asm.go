@@ -0,0 +1,37 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (amd64 || arm64) && !purego
+
+package aes
+
+import "crypto/internal/alias"
+
+// defined in ctr_le32_*.s
+
+//go:noescape
+func ctrLE32Asm(nr int, xk *uint32, dst, src *byte, blocks int, ctr *[BlockSize]byte)
+
+// XORKeyStreamLE32 XORs src with the AES counter mode key stream into dst,
+// and advances ctr past the counter blocks it used. Unlike in
+// [crypto/cipher.NewCTR], only the first four bytes of the counter block
+// are incremented, as a little-endian integer, as AES-GCM-SIV requires
+// (RFC 8452, Section 4). len(src) must be a multiple of BlockSize.
+//
+// It is called by crypto/aes.NewGCMSIV via an interface, like NewGCM.
+func (c *aesCipherAsm) XORKeyStreamLE32(dst, src []byte, ctr *[BlockSize]byte) {
+	if len(src)%BlockSize != 0 {
+		panic("crypto/aes: input not full blocks")
+	}
+	if len(dst) < len(src) {
+		panic("crypto/aes: output smaller than input")
+	}
+	if alias.InexactOverlap(dst[:len(src)], src) {
+		panic("crypto/aes: invalid buffer overlap")
+	}
+	if len(src) == 0 {
+		return
+	}
+	ctrLE32Asm(int(c.l)/4-1, &c.enc[0], &dst[0], &src[0], len(src)/BlockSize, ctr)
+}
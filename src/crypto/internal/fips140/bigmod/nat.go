@@ -7,7 +7,6 @@ package bigmod
 import (
 	"errors"
 	"internal/byteorder"
-	"math/big"
 	"math/bits"
 )
 
@@ -99,19 +98,6 @@ func (x *Nat) set(y *Nat) *Nat {
 	return x
 }
 
-// setBig assigns x = n, optionally resizing n to the appropriate size.
-//
-// The announced length of x is set based on the actual bit size of the input,
-// ignoring leading zeroes.
-func (x *Nat) setBig(n *big.Int) *Nat {
-	limbs := n.Bits()
-	x.reset(len(limbs))
-	for i := range limbs {
-		x.limbs[i] = uint(limbs[i])
-	}
-	return x
-}
-
 // Bytes returns x as a zero-extended big-endian byte slice. The size of the
 // slice will match the size of m.
 //
@@ -177,6 +163,15 @@ func bigEndianUint(buf []byte) uint {
 
 func (x *Nat) setBytes(b []byte, m *Modulus) error {
 	x.resetFor(m)
+	if !x.fillBytes(b) {
+		return errors.New("input overflows the modulus size")
+	}
+	return nil
+}
+
+// fillBytes sets the limbs of x, which must be zero, to the big-endian bytes
+// b, and reports whether b fit in them.
+func (x *Nat) fillBytes(b []byte) bool {
 	i, k := len(b), 0
 	for k < len(x.limbs) && i >= _S {
 		x.limbs[k] = bigEndianUint(b[i-_S : i])
@@ -187,10 +182,7 @@ func (x *Nat) setBytes(b []byte, m *Modulus) error {
 		x.limbs[k] |= uint(b[i-1]) << s
 		i--
 	}
-	if i > 0 {
-		return errors.New("input overflows the modulus size")
-	}
-	return nil
+	return i == 0
 }
 
 // Equal returns 1 if x == y, and 0 otherwise.
@@ -369,18 +361,22 @@ func minusInverseModW(x uint) uint {
 	return -y
 }
 
-// NewModulusFromBig creates a new Modulus from a [big.Int].
+// NewModulus creates a new Modulus from a slice of big-endian bytes.
 //
-// The Int must be odd. The number of significant bits (and nothing else) is
-// leaked through timing side-channels.
-func NewModulusFromBig(n *big.Int) (*Modulus, error) {
-	if b := n.Bits(); len(b) == 0 {
+// The value must be odd. Leading zero bytes are ignored. The number of
+// significant bits (and nothing else) is leaked through timing side-channels.
+func NewModulus(b []byte) (*Modulus, error) {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	if len(b) == 0 {
 		return nil, errors.New("modulus must be >= 0")
-	} else if b[0]&1 != 1 {
+	} else if b[len(b)-1]&1 != 1 {
 		return nil, errors.New("modulus must be odd")
 	}
 	m := &Modulus{}
-	m.nat = NewNat().setBig(n)
+	m.nat = NewNat().reset((len(b) + _S - 1) / _S)
+	m.nat.fillBytes(b)
 	m.leading = _W - bitLen(m.nat.limbs[len(m.nat.limbs)-1])
 	m.m0inv = minusInverseModW(m.nat.limbs[0])
 	m.rr = rr(m)
@@ -785,3 +781,168 @@ func (out *Nat) ExpShortVarTime(x *Nat, e uint, m *Modulus) *Nat {
 	}
 	return out.montgomeryReduction(m)
 }
+
+// IsOne returns 1 if x == 1, and 0 otherwise.
+func (x *Nat) IsOne() choice {
+	// Eliminate bounds checks in the loop.
+	size := len(x.limbs)
+	xLimbs := x.limbs[:size]
+
+	if len(xLimbs) == 0 {
+		return no
+	}
+
+	one := ctEq(xLimbs[0], 1)
+	for i := 1; i < size; i++ {
+		one &= ctEq(xLimbs[i], 0)
+	}
+	return one
+}
+
+// IsOdd returns 1 if x is odd, and 0 otherwise.
+func (x *Nat) IsOdd() choice {
+	if len(x.limbs) == 0 {
+		return no
+	}
+	return choice(x.limbs[0] & 1)
+}
+
+// InverseVarTime calculates x = a⁻¹ mod m and returns (x, true) if a is
+// invertible. Otherwise, InverseVarTime returns (x, false) and x is not
+// modified.
+//
+// a must be reduced modulo m, but doesn't need to have the same size. The
+// output will be resized to the size of m and overwritten.
+func (x *Nat) InverseVarTime(a *Nat, m *Modulus) (*Nat, bool) {
+	u, A, err := extendedGCD(a, m.nat)
+	if err != nil {
+		return x, false
+	}
+	if u.IsOne() == no {
+		return x, false
+	}
+	return x.set(A), true
+}
+
+// extendedGCD computes u and A such that u = GCD(a, m) = A*a - B*m.
+//
+// u will have the size of the larger of a and m, and A will have the size of m.
+//
+// It is an error if either a or m is zero, or if they are both even.
+func extendedGCD(a, m *Nat) (u, A *Nat, err error) {
+	// This is the extended binary GCD algorithm described in the Handbook of
+	// Applied Cryptography, Algorithm 14.61, adapted by BoringSSL to bound
+	// coefficients and avoid negative numbers. For more details and proof of
+	// correctness, see https://github.com/mit-plv/fiat-crypto/pull/333/files.
+	//
+	// Following the proof linked in the PR above, the changes are:
+	//
+	// 1. Negate [B] and [C] so they are positive. The invariant now involves a
+	//    subtraction.
+	// 2. If step 2 (both [x] and [y] are even) runs, abort immediately. This
+	//    case needs to be handled by the caller.
+	// 3. Subtract copies of [x] and [y] as needed in step 6 (both [u] and [v]
+	//    are odd) so coefficients stay in bounds.
+	// 4. Replace the [u >= v] check with [u > v]. This changes the end
+	//    condition to [v = 0] rather than [u = 0]. This saves an extra
+	//    subtraction due to which coefficients were negated.
+	// 5. Rename x and y to a and n, to capture that one is a modulus.
+	// 6. Rearrange steps 4 through 6 slightly. Merge the loops in steps 4 and
+	//    5 into the main loop (step 7's goto), and move step 6 to the start of
+	//    the loop iteration, ensuring each loop iteration halves at least one
+	//    value.
+	//
+	// Note this algorithm does not handle either input being zero.
+
+	if a.IsZero() == yes || m.IsZero() == yes {
+		return nil, nil, errors.New("extendedGCD: a or m is zero")
+	}
+	if a.IsOdd() == no && m.IsOdd() == no {
+		return nil, nil, errors.New("extendedGCD: both a and m are even")
+	}
+
+	size := max(len(a.limbs), len(m.limbs))
+	u = NewNat().set(a).expand(size)
+	v := NewNat().set(m).expand(size)
+
+	A = NewNat().reset(len(m.limbs))
+	A.limbs[0] = 1
+	B := NewNat().reset(len(a.limbs))
+	C := NewNat().reset(len(m.limbs))
+	D := NewNat().reset(len(a.limbs))
+	D.limbs[0] = 1
+
+	// Before and after each loop iteration, the following hold:
+	//
+	//   u = A*a - B*m
+	//   v = D*m - C*a
+	//   0 < u <= a
+	//   0 <= v <= m
+	//   0 <= A < m
+	//   0 <= B <= a
+	//   0 <= C < m
+	//   0 <= D <= a
+	//
+	// After each loop iteration, u and v only get smaller, and at least one of
+	// them shrinks by at least a factor of two.
+	for {
+		// If both u and v are odd, subtract the smaller from the larger.
+		// If u = v, we need to subtract from v to hit the modified exit condition.
+		if u.IsOdd() == yes && v.IsOdd() == yes {
+			if v.cmpGeq(u) == no {
+				u.sub(v)
+				A.Add(C, &Modulus{nat: m})
+				B.Add(D, &Modulus{nat: a})
+			} else {
+				v.sub(u)
+				C.Add(A, &Modulus{nat: m})
+				D.Add(B, &Modulus{nat: a})
+			}
+		}
+
+		// Exactly one of u and v is now even.
+		if u.IsOdd() == v.IsOdd() {
+			panic("bigmod: internal error: u and v are not in the expected state")
+		}
+
+		// Halve the even one and adjust the corresponding coefficient.
+		if u.IsOdd() == no {
+			rshift1(u, 0)
+			if A.IsOdd() == yes || B.IsOdd() == yes {
+				rshift1(A, A.add(m))
+				rshift1(B, B.add(a))
+			} else {
+				rshift1(A, 0)
+				rshift1(B, 0)
+			}
+		} else { // v.IsOdd() == no
+			rshift1(v, 0)
+			if C.IsOdd() == yes || D.IsOdd() == yes {
+				rshift1(C, C.add(m))
+				rshift1(D, D.add(a))
+			} else {
+				rshift1(C, 0)
+				rshift1(D, 0)
+			}
+		}
+
+		if v.IsZero() == yes {
+			return u, A, nil
+		}
+	}
+}
+
+// rshift1 sets a = a >> 1, shifting carry into the most significant bit.
+func rshift1(a *Nat, carry uint) {
+	size := len(a.limbs)
+	aLimbs := a.limbs[:size]
+
+	for i := range size {
+		aLimbs[i] >>= 1
+		if i+1 < size {
+			aLimbs[i] |= aLimbs[i+1] << (_W - 1)
+		} else {
+			aLimbs[i] |= carry << (_W - 1)
+		}
+	}
+}
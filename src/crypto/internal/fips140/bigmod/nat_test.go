@@ -70,7 +70,7 @@ func TestMontgomeryRoundtrip(t *testing.T) {
 		one.limbs[0] = 1
 		aPlusOne := new(big.Int).SetBytes(natBytes(a))
 		aPlusOne.Add(aPlusOne, big.NewInt(1))
-		m, _ := NewModulusFromBig(aPlusOne)
+		m, _ := NewModulus(aPlusOne.Bytes())
 		monty := new(Nat).set(a)
 		monty.montgomeryRepresentation(m)
 		aAgain := new(Nat).set(monty)
@@ -310,6 +310,36 @@ func TestExpShort(t *testing.T) {
 	}
 }
 
+func TestInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 100; i++ {
+		n := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), 1024))
+		n.SetBit(n, 0, 1)
+		a := new(big.Int).Rand(r, n)
+		if i%10 == 0 {
+			// Make sure some inputs are not invertible.
+			n.Mul(n, big.NewInt(3))
+			a.Mul(a, big.NewInt(3))
+		}
+		m, err := NewModulus(n.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := NewNat().SetBytes(a.Bytes(), m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := new(big.Int).ModInverse(a, n)
+		got, ok := NewNat().InverseVarTime(x, m)
+		if ok != (want != nil) {
+			t.Fatalf("InverseVarTime(%v, %v) ok = %v, want %v", a, n, ok, want != nil)
+		}
+		if ok && new(big.Int).SetBytes(got.Bytes(m)).Cmp(want) != 0 {
+			t.Errorf("InverseVarTime(%v, %v) = %x, want %v", a, n, got.Bytes(m), want)
+		}
+	}
+}
+
 // TestMulReductions tests that Mul reduces results equal or slightly greater
 // than the modulus. Some Montgomery algorithms don't and need extra care to
 // return correct results. See https://go.dev/issue/13907.
@@ -319,7 +349,7 @@ func TestMulReductions(t *testing.T) {
 	b, _ := new(big.Int).SetString("180692823610368451951102211649591374573781973061758082626801", 10)
 	n := new(big.Int).Mul(a, b)
 
-	N, _ := NewModulusFromBig(n)
+	N, _ := NewModulus(n.Bytes())
 	A := NewNat().setBig(a).ExpandFor(N)
 	B := NewNat().setBig(b).ExpandFor(N)
 
@@ -328,7 +358,7 @@ func TestMulReductions(t *testing.T) {
 	}
 
 	i := new(big.Int).ModInverse(a, b)
-	N, _ = NewModulusFromBig(b)
+	N, _ = NewModulus(b.Bytes())
 	A = NewNat().setBig(a).ExpandFor(N)
 	I := NewNat().setBig(i).ExpandFor(N)
 	one := NewNat().setBig(big.NewInt(1)).ExpandFor(N)
@@ -349,17 +379,26 @@ func natFromBytes(b []byte) *Nat {
 }
 
 func modulusFromBytes(b []byte) *Modulus {
-	bb := new(big.Int).SetBytes(b)
-	m, _ := NewModulusFromBig(bb)
+	m, _ := NewModulus(b)
 	return m
 }
 
+// setBig assigns x = n, resizing x to the size of n.
+func (x *Nat) setBig(n *big.Int) *Nat {
+	limbs := n.Bits()
+	x.reset(len(limbs))
+	for i := range limbs {
+		x.limbs[i] = uint(limbs[i])
+	}
+	return x
+}
+
 // maxModulus returns the biggest modulus that can fit in n limbs.
 func maxModulus(n uint) *Modulus {
 	b := big.NewInt(1)
 	b.Lsh(b, n*_W)
 	b.Sub(b, big.NewInt(1))
-	m, _ := NewModulusFromBig(b)
+	m, _ := NewModulus(b.Bytes())
 	return m
 }
 
@@ -465,16 +504,16 @@ func BenchmarkExp(b *testing.B) {
 	}
 }
 
-func TestNewModFromBigZero(t *testing.T) {
+func TestNewModulusZero(t *testing.T) {
 	expected := "modulus must be >= 0"
-	_, err := NewModulusFromBig(big.NewInt(0))
+	_, err := NewModulus([]byte{0})
 	if err == nil || err.Error() != expected {
-		t.Errorf("NewModulusFromBig(0) got %q, want %q", err, expected)
+		t.Errorf("NewModulus(0) got %q, want %q", err, expected)
 	}
 
 	expected = "modulus must be odd"
-	_, err = NewModulusFromBig(big.NewInt(2))
+	_, err = NewModulus([]byte{2})
 	if err == nil || err.Error() != expected {
-		t.Errorf("NewModulusFromBig(2) got %q, want %q", err, expected)
+		t.Errorf("NewModulus(2) got %q, want %q", err, expected)
 	}
 }
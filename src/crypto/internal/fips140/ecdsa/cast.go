@@ -0,0 +1,82 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/internal/fips140"
+	"crypto/internal/fips140/sha256"
+	"errors"
+	"hash"
+)
+
+func init() {
+	fips140.CAST("ECDSA P-256 SHA2-256 sign and verify", func() error {
+		// RFC 6979, Appendix A.2.5, with SHA-256 and message "sample".
+		d := []byte{
+			0xc9, 0xaf, 0xa9, 0xd8, 0x45, 0xba, 0x75, 0x16,
+			0x6b, 0x5c, 0x21, 0x57, 0x67, 0xb1, 0xd6, 0x93,
+			0x4e, 0x50, 0xc3, 0xdb, 0x36, 0xe8, 0x9b, 0x12,
+			0x7b, 0x8a, 0x62, 0x2b, 0x12, 0x0f, 0x67, 0x21,
+		}
+		q := []byte{
+			0x04,
+			0x60, 0xfe, 0xd4, 0xba, 0x25, 0x5a, 0x9d, 0x31,
+			0xc9, 0x61, 0xeb, 0x74, 0xc6, 0x35, 0x6d, 0x68,
+			0xc0, 0x49, 0xb8, 0x92, 0x3b, 0x61, 0xfa, 0x6c,
+			0xe6, 0x69, 0x62, 0x2e, 0x60, 0xf2, 0x9f, 0xb6,
+			0x79, 0x03, 0xfe, 0x10, 0x08, 0xb8, 0xbc, 0x99,
+			0xa4, 0x1a, 0xe9, 0xe9, 0x56, 0x28, 0xbc, 0x64,
+			0xf2, 0xf1, 0xb2, 0x0c, 0x2d, 0x7e, 0x9f, 0x51,
+			0x77, 0xa3, 0xc2, 0x94, 0xd4, 0x46, 0x22, 0x99,
+		}
+		r := []byte{
+			0xef, 0xd4, 0x8b, 0x2a, 0xac, 0xb6, 0xa8, 0xfd,
+			0x11, 0x40, 0xdd, 0x9c, 0xd4, 0x5e, 0x81, 0xd6,
+			0x9d, 0x2c, 0x87, 0x7b, 0x56, 0xaa, 0xf9, 0x91,
+			0xc3, 0x4d, 0x0e, 0xa8, 0x4e, 0xaf, 0x37, 0x16,
+		}
+		s := []byte{
+			0xf7, 0xcb, 0x1c, 0x94, 0x2d, 0x65, 0x7c, 0x41,
+			0xd4, 0x36, 0xc7, 0xa1, 0xb6, 0xe2, 0x9f, 0x65,
+			0xf3, 0xe9, 0x00, 0xdb, 0xb9, 0xaf, 0xf4, 0x06,
+			0x4d, 0xc4, 0xab, 0x2f, 0x84, 0x3a, 0xcd, 0xa8,
+		}
+		c := P256()
+		priv, err := NewPrivateKey(c, d, q)
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		h.Write([]byte("sample"))
+		hash := h.Sum(nil)
+		sig, err := SignDeterministic(c, newSHA256, priv, hash)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sig.R, r) || !bytes.Equal(sig.S, s) {
+			return errors.New("unexpected signature")
+		}
+		return Verify(c, priv.PublicKey(), hash, sig)
+	})
+}
+
+func newSHA256() hash.Hash { return sha256.New() }
+
+// pairwiseTest checks that priv can produce a signature that verifies with
+// its public key, as required for every new key by FIPS 140-3.
+func pairwiseTest[P Point[P]](c *Curve[P], priv *PrivateKey) error {
+	h := sha256.New()
+	h.Write([]byte("ECDSA PCT"))
+	hash := h.Sum(nil)
+	sig, err := SignDeterministic(c, newSHA256, priv, hash)
+	if err != nil {
+		return err
+	}
+	if err := Verify(c, priv.PublicKey(), hash, sig); err != nil {
+		return errors.New("ecdsa: pairwise consistency test failed")
+	}
+	return nil
+}
@@ -0,0 +1,602 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ecdsa implements the Elliptic Curve Digital Signature Algorithm, as
+// defined in FIPS 186-4 and SEC 1, Version 2.0, over the NIST curves P-224,
+// P-256, P-384, and P-521.
+//
+// Keys and signatures are handled as byte slices; crypto/ecdsa converts them
+// to and from its math/big based types.
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/internal/fips140"
+	"crypto/internal/fips140/aes"
+	"crypto/internal/fips140/bigmod"
+	"crypto/internal/fips140/hmac"
+	"crypto/internal/fips140/nistec"
+	"crypto/internal/fips140/sha512"
+	"errors"
+	"hash"
+	"io"
+	"sync"
+)
+
+// PrivateKey and PublicKey are not generic, so that they can be stored without
+// instantiating them with a point type. They are tied to one of the curves
+// below by their curve field.
+
+// PrivateKey is an ECDSA private key.
+type PrivateKey struct {
+	pub PublicKey
+	d   []byte // the scalar, as long as the curve order
+}
+
+// Bytes returns the private scalar as a big-endian byte slice as long as the
+// order of the curve.
+func (priv *PrivateKey) Bytes() []byte {
+	return priv.d
+}
+
+// PublicKey returns the public key corresponding to priv.
+func (priv *PrivateKey) PublicKey() *PublicKey {
+	return &priv.pub
+}
+
+// PublicKey is an ECDSA public key.
+type PublicKey struct {
+	curve curveID
+	q     []byte // the uncompressed point encoding
+}
+
+// Bytes returns the uncompressed encoding of the public point.
+func (pub *PublicKey) Bytes() []byte {
+	return pub.q
+}
+
+type curveID string
+
+const (
+	p224 curveID = "P-224"
+	p256 curveID = "P-256"
+	p384 curveID = "P-384"
+	p521 curveID = "P-521"
+)
+
+// Curve holds the parameters of one of the NIST curves.
+type Curve[P Point[P]] struct {
+	curve    curveID
+	newPoint func() P
+	N        *bigmod.Modulus
+	nMinus2  []byte
+}
+
+// Point is a generic constraint for the nistec Point types.
+type Point[P any] interface {
+	*nistec.P224Point | *nistec.P256Point | *nistec.P384Point | *nistec.P521Point
+	Bytes() []byte
+	BytesX() ([]byte, error)
+	SetBytes([]byte) (P, error)
+	Add(P, P) P
+	ScalarMult(P, []byte) (P, error)
+	ScalarBaseMult([]byte) (P, error)
+}
+
+func precomputeParams[P Point[P]](c *Curve[P], order []byte) {
+	var err error
+	c.N, err = bigmod.NewModulus(order)
+	if err != nil {
+		panic(err)
+	}
+	two, _ := bigmod.NewNat().SetBytes([]byte{2}, c.N)
+	c.nMinus2 = bigmod.NewNat().ExpandFor(c.N).Sub(two, c.N).Bytes(c.N)
+}
+
+var _p224 *Curve[*nistec.P224Point]
+var p224Once sync.Once
+
+// P224 returns the parameters of the NIST P-224 curve.
+func P224() *Curve[*nistec.P224Point] {
+	p224Once.Do(func() {
+		_p224 = &Curve[*nistec.P224Point]{curve: p224, newPoint: nistec.NewP224Point}
+		precomputeParams(_p224, p224Order)
+	})
+	return _p224
+}
+
+var p224Order = []byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x16, 0xa2,
+	0xe0, 0xb8, 0xf0, 0x3e, 0x13, 0xdd, 0x29, 0x45,
+	0x5c, 0x5c, 0x2a, 0x3d,
+}
+
+var _p256 *Curve[*nistec.P256Point]
+var p256Once sync.Once
+
+// P256 returns the parameters of the NIST P-256 curve.
+func P256() *Curve[*nistec.P256Point] {
+	p256Once.Do(func() {
+		_p256 = &Curve[*nistec.P256Point]{curve: p256, newPoint: nistec.NewP256Point}
+		precomputeParams(_p256, p256Order)
+	})
+	return _p256
+}
+
+var p256Order = []byte{
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xbc, 0xe6, 0xfa, 0xad, 0xa7, 0x17, 0x9e, 0x84,
+	0xf3, 0xb9, 0xca, 0xc2, 0xfc, 0x63, 0x25, 0x51,
+}
+
+var _p384 *Curve[*nistec.P384Point]
+var p384Once sync.Once
+
+// P384 returns the parameters of the NIST P-384 curve.
+func P384() *Curve[*nistec.P384Point] {
+	p384Once.Do(func() {
+		_p384 = &Curve[*nistec.P384Point]{curve: p384, newPoint: nistec.NewP384Point}
+		precomputeParams(_p384, p384Order)
+	})
+	return _p384
+}
+
+var p384Order = []byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xc7, 0x63, 0x4d, 0x81, 0xf4, 0x37, 0x2d, 0xdf,
+	0x58, 0x1a, 0x0d, 0xb2, 0x48, 0xb0, 0xa7, 0x7a,
+	0xec, 0xec, 0x19, 0x6a, 0xcc, 0xc5, 0x29, 0x73,
+}
+
+var _p521 *Curve[*nistec.P521Point]
+var p521Once sync.Once
+
+// P521 returns the parameters of the NIST P-521 curve.
+func P521() *Curve[*nistec.P521Point] {
+	p521Once.Do(func() {
+		_p521 = &Curve[*nistec.P521Point]{curve: p521, newPoint: nistec.NewP521Point}
+		precomputeParams(_p521, p521Order)
+	})
+	return _p521
+}
+
+var p521Order = []byte{0x01, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfa,
+	0x51, 0x86, 0x87, 0x83, 0xbf, 0x2f, 0x96, 0x6b,
+	0x7f, 0xcc, 0x01, 0x48, 0xf7, 0x09, 0xa5, 0xd0,
+	0x3b, 0xb5, 0xc9, 0xb8, 0x89, 0x9c, 0x47, 0xae,
+	0xbb, 0x6f, 0xb7, 0x1e, 0x91, 0x38, 0x64, 0x09,
+}
+
+// NewPrivateKey checks that D is in [1, N-1] and that Q is a valid point, and
+// returns the corresponding private key. D is the big-endian encoding of the
+// private scalar, as long as the curve order, and Q is the uncompressed
+// encoding of the public point.
+//
+// NewPrivateKey doesn't check that Q matches D.
+func NewPrivateKey[P Point[P]](c *Curve[P], D, Q []byte) (*PrivateKey, error) {
+	pub, err := NewPublicKey(c, Q)
+	if err != nil {
+		return nil, err
+	}
+	if len(D) != c.N.Size() {
+		return nil, errors.New("ecdsa: invalid private key length")
+	}
+	d, err := bigmod.NewNat().SetBytes(D, c.N)
+	if err != nil {
+		return nil, err
+	}
+	if d.IsZero() == 1 {
+		return nil, errors.New("ecdsa: private key is zero")
+	}
+	return &PrivateKey{pub: *pub, d: d.Bytes(c.N)}, nil
+}
+
+// NewPublicKey checks that Q is the uncompressed encoding of a point on the
+// curve, other than the point at infinity, and returns the corresponding
+// public key.
+func NewPublicKey[P Point[P]](c *Curve[P], Q []byte) (*PublicKey, error) {
+	if len(Q) < 1 || Q[0] != 4 {
+		return nil, errors.New("ecdsa: invalid public key encoding")
+	}
+	// SetBytes checks that Q is on the curve and that its coordinates are
+	// reduced modulo p.
+	if _, err := c.newPoint().SetBytes(Q); err != nil {
+		return nil, err
+	}
+	return &PublicKey{curve: c.curve, q: bytes.Clone(Q)}, nil
+}
+
+// GenerateKey generates a new private key for the curve c, using rand as the
+// source of randomness.
+func GenerateKey[P Point[P]](c *Curve[P], rand io.Reader) (*PrivateKey, error) {
+	k, Q, err := randomPoint(c, rand)
+	if err != nil {
+		return nil, err
+	}
+	priv := &PrivateKey{
+		pub: PublicKey{curve: c.curve, q: Q.Bytes()},
+		d:   k.Bytes(c.N),
+	}
+	if err := fips140.PCT("ECDSA PCT", func() error { return pairwiseTest(c, priv) }); err != nil {
+		// This can happen only if the implementation is broken.
+		return nil, err
+	}
+	return priv, nil
+}
+
+// randomPoint returns a random scalar and the corresponding point using the
+// procedure given in FIPS 186-4, Appendix B.5.2 (rejection sampling).
+func randomPoint[P Point[P]](c *Curve[P], rand io.Reader) (k *bigmod.Nat, p P, err error) {
+	k, err = randomScalar(c, rand)
+	if err != nil {
+		return nil, p, err
+	}
+	p, err = c.newPoint().ScalarBaseMult(k.Bytes(c.N))
+	return k, p, err
+}
+
+// randomScalar returns a random scalar in [1, N-1], as in randomPoint.
+func randomScalar[P Point[P]](c *Curve[P], rand io.Reader) (*bigmod.Nat, error) {
+	k := bigmod.NewNat()
+	for {
+		b := make([]byte, c.N.Size())
+		if _, err := io.ReadFull(rand, b); err != nil {
+			return nil, err
+		}
+
+		// Mask off any excess bits to increase the chance of hitting a value in
+		// (0, N). These are the most dangerous lines in the package and maybe in
+		// the library: a single bit of bias in the selection of nonces would likely
+		// lead to key recovery, but no tests would fail. Look but DO NOT TOUCH.
+		if excess := len(b)*8 - c.N.BitLen(); excess > 0 {
+			// Just to be safe, assert that this only happens for the one curve that
+			// doesn't have a round number of bits.
+			if c.curve != p521 {
+				panic("ecdsa: internal error: unexpectedly masking off bits")
+			}
+			b[0] >>= excess
+		}
+
+		// FIPS 186-4 makes us check k <= N - 2 and then add one.
+		// Checking 0 < k <= N - 1 is strictly equivalent.
+		// None of this matters anyway because the chance of selecting
+		// zero is cryptographically negligible.
+		if _, err := k.SetBytes(b, c.N); err == nil && k.IsZero() == 0 {
+			return k, nil
+		}
+
+		if testingOnlyRejectionSamplingLooped != nil {
+			testingOnlyRejectionSamplingLooped()
+		}
+	}
+}
+
+// testingOnlyRejectionSamplingLooped is called when rejection sampling in
+// randomScalar or deterministicPoint rejects a candidate for being higher than
+// the modulus.
+var testingOnlyRejectionSamplingLooped func()
+
+// errNoAsm is returned by signAsm and verifyAsm when the assembly
+// implementation is not available.
+var errNoAsm = errors.New("no assembly implementation available")
+
+// Signature is an ECDSA signature, where R and S are big-endian byte slices
+// as long as the curve order.
+type Signature struct {
+	R, S []byte
+}
+
+// Sign signs hash (which should be the result of hashing a larger message)
+// using the private key priv. If the hash is longer than the bit-length of the
+// curve order, the hash will be truncated to that length.
+//
+// The nonce is derived from a CSPRNG that mixes entropy from rand with the
+// private key and the hash, so that a failure of rand doesn't leak the key.
+func Sign[P Point[P]](c *Curve[P], priv *PrivateKey, rand io.Reader, hash []byte) (*Signature, error) {
+	if priv.pub.curve != c.curve {
+		return nil, errors.New("ecdsa: private key does not match curve")
+	}
+	csprng, err := mixedCSPRNG(rand, priv.d, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig, err := signAsm(c, priv, csprng, hash); err != errNoAsm {
+		return sig, err
+	}
+
+	k, R, err := randomPoint(c, csprng)
+	if err != nil {
+		return nil, err
+	}
+	return signWithNonce(c, priv, k, R, hash)
+}
+
+// signWithNonce computes the signature of hash with the nonce k, where R is kG.
+func signWithNonce[P Point[P]](c *Curve[P], priv *PrivateKey, k *bigmod.Nat, R P, hash []byte) (*Signature, error) {
+	// SEC 1, Version 2.0, Section 4.1.3
+
+	// kInv = k⁻¹
+	kInv := bigmod.NewNat()
+	inverse(c, kInv, k)
+
+	Rx, err := R.BytesX()
+	if err != nil {
+		return nil, err
+	}
+	r, err := bigmod.NewNat().SetOverflowingBytes(Rx, c.N)
+	if err != nil {
+		return nil, err
+	}
+
+	// The spec wants us to retry here, but the chance of hitting this condition
+	// on a large prime-order group like the NIST curves we support is
+	// cryptographically negligible. If we hit it, something is awfully wrong.
+	if r.IsZero() == 1 {
+		return nil, errors.New("ecdsa: internal error: r is zero")
+	}
+
+	e := bigmod.NewNat()
+	hashToNat(c, e, hash)
+
+	s, err := bigmod.NewNat().SetBytes(priv.d, c.N)
+	if err != nil {
+		return nil, err
+	}
+	s.Mul(r, c.N)
+	s.Add(e, c.N)
+	s.Mul(kInv, c.N)
+
+	// Again, the chance of this happening is cryptographically negligible.
+	if s.IsZero() == 1 {
+		return nil, errors.New("ecdsa: internal error: s is zero")
+	}
+
+	return &Signature{r.Bytes(c.N), s.Bytes(c.N)}, nil
+}
+
+// SignDeterministic signs hash with priv using a nonce derived from the
+// private key and hash as specified in RFC 6979, with HMAC_DRBG instantiated
+// with h. The caller must check that hash is as long as the output of h.
+//
+// Deterministic signatures always use the generic implementation, to ensure
+// they don't depend on the platform.
+func SignDeterministic[P Point[P]](c *Curve[P], h func() hash.Hash, priv *PrivateKey, hash []byte) (*Signature, error) {
+	if priv.pub.curve != c.curve {
+		return nil, errors.New("ecdsa: private key does not match curve")
+	}
+	k, R, err := deterministicPoint(c, h, priv, hash)
+	if err != nil {
+		return nil, err
+	}
+	return signWithNonce(c, priv, k, R, hash)
+}
+
+// deterministicPoint returns the nonce and the corresponding point for a
+// deterministic signature of hash, generated as specified in RFC 6979,
+// Section 3.2.
+func deterministicPoint[P Point[P]](c *Curve[P], h func() hash.Hash, priv *PrivateKey, hash []byte) (k *bigmod.Nat, p P, err error) {
+	// int2octets(x) and bits2octets(h1), both exactly N.Size() bytes long.
+	e := bigmod.NewNat()
+	hashToNat(c, e, hash)
+	xBytes, h1Bytes := priv.d, e.Bytes(c.N)
+
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(h, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+
+	// Steps b. through g., the HMAC_DRBG instantiation.
+	size := h().Size()
+	V := bytes.Repeat([]byte{0x01}, size)
+	K := make([]byte, size)
+	K = mac(K, V, []byte{0x00}, xBytes, h1Bytes)
+	V = mac(K, V)
+	K = mac(K, V, []byte{0x01}, xBytes, h1Bytes)
+	V = mac(K, V)
+
+	// Step h., generating candidates until one is in [1, N-1].
+	k = bigmod.NewNat()
+	for {
+		var T []byte
+		for len(T) < c.N.Size() {
+			V = mac(K, V)
+			T = append(T, V...)
+		}
+		if _, err := k.SetBytes(leftmostBits(c, T), c.N); err == nil && k.IsZero() == 0 {
+			break
+		}
+
+		if testingOnlyRejectionSamplingLooped != nil {
+			testingOnlyRejectionSamplingLooped()
+		}
+		K = mac(K, V, []byte{0x00})
+		V = mac(K, V)
+	}
+
+	p, err = c.newPoint().ScalarBaseMult(k.Bytes(c.N))
+	return k, p, err
+}
+
+// inverse sets kInv to the inverse of k modulo the order of the curve.
+func inverse[P Point[P]](c *Curve[P], kInv, k *bigmod.Nat) {
+	if c.curve == p256 {
+		kBytes, err := nistec.P256OrdInverse(k.Bytes(c.N))
+		// Some platforms don't implement P256OrdInverse, and always return an error.
+		if err == nil {
+			_, err := kInv.SetBytes(kBytes, c.N)
+			if err != nil {
+				panic("ecdsa: internal error: P256OrdInverse produced an invalid value")
+			}
+			return
+		}
+	}
+
+	// Calculate the inverse of s in GF(N) using Fermat's method
+	// (exponentiation modulo P - 2, per Euler's theorem)
+	kInv.Exp(k, c.nMinus2, c.N)
+}
+
+// hashToNat sets e to the left-most bits of hash, according to
+// SEC 1, Section 4.1.3, point 5 and Section 4.1.4, point 3.
+func hashToNat[P Point[P]](c *Curve[P], e *bigmod.Nat, hash []byte) {
+	// ECDSA asks us to take the left-most log2(N) bits of hash, and use them as
+	// an integer modulo N. This is the absolute worst of all worlds: we still
+	// have to reduce, because the result might still overflow N, but to take
+	// the left-most bits for P-521 we have to do a right shift.
+	_, err := e.SetOverflowingBytes(leftmostBits(c, hash), c.N)
+	if err != nil {
+		panic("ecdsa: internal error: truncated hash is too long")
+	}
+}
+
+// leftmostBits returns the left-most log2(N) bits of b, if b is longer than
+// that, as a big-endian integer. This is bits2int in RFC 6979.
+func leftmostBits[P Point[P]](c *Curve[P], b []byte) []byte {
+	if size := c.N.Size(); len(b) >= size {
+		b = b[:size]
+		if excess := len(b)*8 - c.N.BitLen(); excess > 0 {
+			b = bytes.Clone(b)
+			for i := len(b) - 1; i >= 0; i-- {
+				b[i] >>= excess
+				if i > 0 {
+					b[i] |= b[i-1] << (8 - excess)
+				}
+			}
+		}
+	}
+	return b
+}
+
+// mixedCSPRNG returns a CSPRNG that mixes entropy from rand with the message
+// and the private key d, to protect the key in case rand fails. This is
+// equivalent in security to RFC 6979 deterministic nonce generation, but still
+// produces randomized signatures.
+func mixedCSPRNG(rand io.Reader, d, hash []byte) (io.Reader, error) {
+	// This implementation derives the nonce from an AES-CTR CSPRNG keyed by:
+	//
+	//    SHA2-512(d || entropy || hash)[:32]
+	//
+	// The CSPRNG key is indifferentiable from a random oracle as shown in
+	// [Coron], the AES-CTR stream is indifferentiable from a random oracle
+	// under standard cryptographic assumptions (see [Larsson] for examples).
+	//
+	// [Coron]: https://cs.nyu.edu/~dodis/ps/merkle.pdf
+	// [Larsson]: https://web.archive.org/web/20040719170906/https://www.nada.kth.se/kurser/kth/2D1441/semteo03/lecturenotes/assump.pdf
+
+	// Get 256 bits of entropy from rand.
+	entropy := make([]byte, 32)
+	if _, err := io.ReadFull(rand, entropy); err != nil {
+		return nil, err
+	}
+
+	// Initialize an SHA-512 hash context; digest...
+	md := sha512.New()
+	md.Write(d)             // the private key,
+	md.Write(entropy)       // the entropy,
+	md.Write(hash)          // and the input hash;
+	key := md.Sum(nil)[:32] // and compute ChopMD-256(SHA-512),
+	// which is an indifferentiable MAC.
+
+	// Create an AES-CTR instance to use as a CSPRNG.
+	block, err := aes.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a CSPRNG that xors a stream of zeros with
+	// the output of the AES-CTR instance.
+	const aesIV = "IV for ECDSA CTR"
+	return &cipher.StreamReader{
+		R: zeroReader,
+		S: cipher.NewCTR(block, []byte(aesIV)),
+	}, nil
+}
+
+type zr struct{}
+
+var zeroReader = zr{}
+
+// Read replaces the contents of dst with zeros. It is safe for concurrent use.
+func (zr) Read(dst []byte) (n int, err error) {
+	clear(dst)
+	return len(dst), nil
+}
+
+// Verify verifies the signature sig of hash using the public key pub.
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func Verify[P Point[P]](c *Curve[P], pub *PublicKey, hash []byte, sig *Signature) error {
+	if pub.curve != c.curve {
+		return errors.New("ecdsa: public key does not match curve")
+	}
+
+	if err := verifyAsm(c, pub, hash, sig); err != errNoAsm {
+		return err
+	}
+
+	// SEC 1, Version 2.0, Section 4.1.4
+
+	Q, err := c.newPoint().SetBytes(pub.q)
+	if err != nil {
+		return err
+	}
+
+	r, err := bigmod.NewNat().SetBytes(sig.R, c.N)
+	if err != nil || r.IsZero() == 1 {
+		return errInvalidSignature
+	}
+	s, err := bigmod.NewNat().SetBytes(sig.S, c.N)
+	if err != nil || s.IsZero() == 1 {
+		return errInvalidSignature
+	}
+
+	e := bigmod.NewNat()
+	hashToNat(c, e, hash)
+
+	// w = s⁻¹
+	w := bigmod.NewNat()
+	inverse(c, w, s)
+
+	// p₁ = [e * s⁻¹]G
+	p1, err := c.newPoint().ScalarBaseMult(e.Mul(w, c.N).Bytes(c.N))
+	if err != nil {
+		return err
+	}
+	// p₂ = [r * s⁻¹]Q
+	p2, err := Q.ScalarMult(Q, w.Mul(r, c.N).Bytes(c.N))
+	if err != nil {
+		return err
+	}
+	// BytesX returns an error for the point at infinity.
+	Rx, err := p1.Add(p1, p2).BytesX()
+	if err != nil {
+		return err
+	}
+
+	v, err := bigmod.NewNat().SetOverflowingBytes(Rx, c.N)
+	if err != nil {
+		return err
+	}
+
+	if v.Equal(r) != 1 {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+var errInvalidSignature = errors.New("ecdsa: invalid signature")
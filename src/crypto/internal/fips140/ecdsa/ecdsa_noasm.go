@@ -8,10 +8,10 @@ package ecdsa
 
 import "io"
 
-func verifyAsm(pub *PublicKey, hash []byte, sig []byte) error {
+func verifyAsm[P Point[P]](c *Curve[P], pub *PublicKey, hash []byte, sig *Signature) error {
 	return errNoAsm
 }
 
-func signAsm(priv *PrivateKey, csprng io.Reader, hash []byte) (sig []byte, err error) {
+func signAsm[P Point[P]](c *Curve[P], priv *PrivateKey, csprng io.Reader, hash []byte) (*Signature, error) {
 	return nil, errNoAsm
 }
@@ -7,11 +7,11 @@
 package ecdsa
 
 import (
-	"crypto/elliptic"
+	"bytes"
+	"crypto/internal/fips140/bigmod"
 	"errors"
 	"internal/cpu"
 	"io"
-	"math/big"
 )
 
 // kdsa invokes the "compute digital signature authentication"
@@ -33,54 +33,43 @@ var testingDisableKDSA bool
 // Then, based on the curve name, a function code and a block size will be assigned.
 // If KDSA instruction is not available or if the curve is not supported, canUseKDSA
 // will set ok to false.
-func canUseKDSA(c elliptic.Curve) (functionCode uint64, blockSize int, ok bool) {
+func canUseKDSA(c curveID) (functionCode uint64, blockSize int, ok bool) {
 	if testingDisableKDSA {
 		return 0, 0, false
 	}
 	if !cpu.S390X.HasECDSA {
 		return 0, 0, false
 	}
-	switch c.Params().Name {
-	case "P-256":
+	switch c {
+	case p256:
 		return 1, 32, true
-	case "P-384":
+	case p384:
 		return 2, 48, true
-	case "P-521":
+	case p521:
 		return 3, 80, true
 	}
 	return 0, 0, false // A mismatch
 }
 
-func hashToBytes(dst, hash []byte, c elliptic.Curve) {
-	l := len(dst)
-	if n := c.Params().N.BitLen(); n == l*8 {
-		// allocation free path for curves with a length that is a whole number of bytes
-		if len(hash) >= l {
-			// truncate hash
-			copy(dst, hash[:l])
-			return
-		}
-		// pad hash with leading zeros
-		p := l - len(hash)
-		for i := 0; i < p; i++ {
-			dst[i] = 0
-		}
-		copy(dst[p:], hash)
-		return
+// appendBlock appends b, right-aligned in a block of blockSize bytes, to p.
+func appendBlock(p []byte, blockSize int, b []byte) []byte {
+	if len(b) > blockSize {
+		panic("ecdsa: internal error: appendBlock input larger than block")
 	}
-	// TODO(mundaym): avoid hashToInt call here
-	hashToInt(hash, c).FillBytes(dst)
+	padding := blockSize - len(b)
+	p = append(p, make([]byte, padding)...)
+	return append(p, b...)
 }
 
-func signAsm(priv *PrivateKey, csprng io.Reader, hash []byte) (sig []byte, err error) {
-	c := priv.Curve
-	functionCode, blockSize, ok := canUseKDSA(c)
+func signAsm[P Point[P]](c *Curve[P], priv *PrivateKey, csprng io.Reader, hash []byte) (*Signature, error) {
+	functionCode, blockSize, ok := canUseKDSA(c.curve)
 	if !ok {
 		return nil, errNoAsm
 	}
+	e := bigmod.NewNat()
+	hashToNat(c, e, hash)
 	for {
-		var k *big.Int
-		k, err = randFieldElement(c, csprng)
+		k, err := randomScalar(c, csprng)
 		if err != nil {
 			return nil, err
 		}
@@ -109,18 +98,21 @@ func signAsm(priv *PrivateKey, csprng io.Reader, hash []byte) (sig []byte, err e
 		// Copy content into the parameter block. In the sign case,
 		// we copy hashed message, private key and random number into
 		// the parameter block.
-		hashToBytes(params[2*blockSize:3*blockSize], hash, c)
-		priv.D.FillBytes(params[3*blockSize : 4*blockSize])
-		k.FillBytes(params[4*blockSize : 5*blockSize])
+		p := params[:2*blockSize]
+		p = appendBlock(p, blockSize, e.Bytes(c.N))
+		p = appendBlock(p, blockSize, priv.d)
+		p = appendBlock(p, blockSize, k.Bytes(c.N))
 		// Convert verify function code into a sign function code by adding 8.
 		// We also need to set the 'deterministic' bit in the function code, by
 		// adding 128, in order to stop the instruction using its own random number
 		// generator in addition to the random number we supply.
 		switch kdsa(functionCode+136, &params) {
 		case 0: // success
-			return encodeSignature(params[:blockSize], params[blockSize:2*blockSize])
+			r := bytes.Clone(params[blockSize-c.N.Size() : blockSize])
+			s := bytes.Clone(params[2*blockSize-c.N.Size() : 2*blockSize])
+			return &Signature{R: r, S: s}, nil
 		case 1: // error
-			return nil, errZeroParam
+			return nil, errors.New("zero parameter")
 		case 2: // retry
 			continue
 		}
@@ -128,21 +120,20 @@ func signAsm(priv *PrivateKey, csprng io.Reader, hash []byte) (sig []byte, err e
 	}
 }
 
-func verifyAsm(pub *PublicKey, hash []byte, sig []byte) error {
-	c := pub.Curve
-	functionCode, blockSize, ok := canUseKDSA(c)
+func verifyAsm[P Point[P]](c *Curve[P], pub *PublicKey, hash []byte, sig *Signature) error {
+	functionCode, blockSize, ok := canUseKDSA(c.curve)
 	if !ok {
 		return errNoAsm
 	}
 
-	r, s, err := parseSignature(sig)
-	if err != nil {
-		return err
-	}
+	r, s := sig.R, sig.S
 	if len(r) > blockSize || len(s) > blockSize {
-		return errors.New("invalid signature")
+		return errInvalidSignature
 	}
 
+	e := bigmod.NewNat()
+	hashToNat(c, e, hash)
+
 	// The parameter block looks like the following for verify:
 	// 	+---------------------+
 	// 	|   Signature(R)      |
@@ -167,13 +158,15 @@ func verifyAsm(pub *PublicKey, hash []byte, sig []byte) error {
 	// Copy content into the parameter block. In the verify case,
 	// we copy signature (r), signature(s), hashed message, public key x component,
 	// and public key y component into the parameter block.
-	copy(params[0*blockSize+blockSize-len(r):], r)
-	copy(params[1*blockSize+blockSize-len(s):], s)
-	hashToBytes(params[2*blockSize:3*blockSize], hash, c)
-	pub.X.FillBytes(params[3*blockSize : 4*blockSize])
-	pub.Y.FillBytes(params[4*blockSize : 5*blockSize])
+	byteLen := (len(pub.q) - 1) / 2
+	p := params[:0]
+	p = appendBlock(p, blockSize, r)
+	p = appendBlock(p, blockSize, s)
+	p = appendBlock(p, blockSize, e.Bytes(c.N))
+	p = appendBlock(p, blockSize, pub.q[1:1+byteLen])
+	p = appendBlock(p, blockSize, pub.q[1+byteLen:])
 	if kdsa(functionCode, &params) != 0 {
-		return errors.New("invalid signature")
+		return errInvalidSignature
 	}
 	return nil
 }
@@ -0,0 +1,20 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s390x && !purego
+
+package ecdsa
+
+import (
+	"testing"
+)
+
+func TestNoAsm(t *testing.T) {
+	testingDisableKDSA = true
+	defer func() { testingDisableKDSA = false }()
+
+	t.Run("P-256", func(t *testing.T) { testSignAndVerify(t, P256()) })
+	t.Run("P-384", func(t *testing.T) { testSignAndVerify(t, P384()) })
+	t.Run("P-521", func(t *testing.T) { testSignAndVerify(t, P521()) })
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/internal/fips140/bigmod"
+	"crypto/internal/fips140/sha256"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"testing"
+)
+
+func TestRandomPoint(t *testing.T) {
+	t.Run("P-224", func(t *testing.T) { testRandomPoint(t, P224()) })
+	t.Run("P-256", func(t *testing.T) { testRandomPoint(t, P256()) })
+	t.Run("P-384", func(t *testing.T) { testRandomPoint(t, P384()) })
+	t.Run("P-521", func(t *testing.T) { testRandomPoint(t, P521()) })
+}
+
+func testRandomPoint[P Point[P]](t *testing.T, c *Curve[P]) {
+	t.Cleanup(func() { testingOnlyRejectionSamplingLooped = nil })
+	var loopCount int
+	testingOnlyRejectionSamplingLooped = func() { loopCount++ }
+
+	// A sequence of all ones will generate 2^N-1, which should be rejected.
+	// (Unless, for example, we are masking too many bits.)
+	r := io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0xff}, 100)), rand.Reader)
+	if k, p, err := randomPoint(c, r); err != nil {
+		t.Fatal(err)
+	} else if k.IsZero() == 1 {
+		t.Error("k is zero")
+	} else if p.Bytes()[0] != 4 {
+		t.Error("p is infinity")
+	}
+	if loopCount == 0 {
+		t.Error("overflow was not rejected")
+	}
+	loopCount = 0
+
+	// A sequence of all zeroes will generate zero, which should be rejected.
+	r = io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0}, 100)), rand.Reader)
+	if k, p, err := randomPoint(c, r); err != nil {
+		t.Fatal(err)
+	} else if k.IsZero() == 1 {
+		t.Error("k is zero")
+	} else if p.Bytes()[0] != 4 {
+		t.Error("p is infinity")
+	}
+	if loopCount == 0 {
+		t.Error("zero was not rejected")
+	}
+	loopCount = 0
+
+	// P-256 has a 2⁻³² chance or randomly hitting a rejection. For P-224 it's
+	// 2⁻¹¹², for P-384 it's 2⁻¹⁹⁴, and for P-521 it's 2⁻²⁶², so if we hit in
+	// tests, something is horribly wrong. (For example, we are masking the
+	// wrong bits.)
+	if c.curve == p256 {
+		return
+	}
+	if k, p, err := randomPoint(c, rand.Reader); err != nil {
+		t.Fatal(err)
+	} else if k.IsZero() == 1 {
+		t.Error("k is zero")
+	} else if p.Bytes()[0] != 4 {
+		t.Error("p is infinity")
+	}
+	if loopCount > 0 {
+		t.Error("unexpected rejection")
+	}
+}
+
+func TestHashToNat(t *testing.T) {
+	t.Run("P-224", func(t *testing.T) { testHashToNat(t, P224()) })
+	t.Run("P-256", func(t *testing.T) { testHashToNat(t, P256()) })
+	t.Run("P-384", func(t *testing.T) { testHashToNat(t, P384()) })
+	t.Run("P-521", func(t *testing.T) { testHashToNat(t, P521()) })
+}
+
+func testHashToNat[P Point[P]](t *testing.T, c *Curve[P]) {
+	for l := 0; l < 600; l++ {
+		h := bytes.Repeat([]byte{0xff}, l)
+		hashToNat(c, bigmod.NewNat(), h)
+	}
+}
+
+func TestDeterministicRejection(t *testing.T) {
+	// RFC 6979, Appendix A.2.5, and a message chosen to make the first
+	// candidate nonce exceed the order of the curve, exercising step h.3 of
+	// Section 3.2.
+	d := fromHex("C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721")
+	q := fromHex("04" +
+		"60FED4BA255A9D31C961EB74C6356D68C049B8923B61FA6CE669622E60F29FB6" +
+		"7903FE1008B8BC99A41AE9E95628BC64F2F1B20C2D7E9F5177A3C294D4462299")
+	tests := []struct {
+		msg   string
+		r, s  string
+		loops int
+	}{
+		{"sample",
+			"EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716",
+			"F7CB1C942D657C41D436C7A1B6E29F65F3E900DBB9AFF4064DC4AB2F843ACDA8", 0},
+		{"wv[vnX",
+			"EFD9073B652E76DA1B5A019C0E4A2E3FA529B035A6ABB91EF67F0ED7A1F21234",
+			"3DB4706C9D9F4A4FE13BB5E08EF0FAB53A57DBAB2061C83A35FA411C68D2BA33", 1},
+	}
+	t.Cleanup(func() { testingOnlyRejectionSamplingLooped = nil })
+	var loopCount int
+	testingOnlyRejectionSamplingLooped = func() { loopCount++ }
+
+	c := P256()
+	priv, err := NewPrivateKey(c, d, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		h := sha256.New()
+		h.Write([]byte(tt.msg))
+		loopCount = 0
+		sig, err := SignDeterministic(c, newSHA256, priv, h.Sum(nil))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.msg, err)
+		}
+		if !bytes.Equal(sig.R, fromHex(tt.r)) || !bytes.Equal(sig.S, fromHex(tt.s)) {
+			t.Errorf("%s: got signature %x, %x, want %s, %s", tt.msg, sig.R, sig.S, tt.r, tt.s)
+		}
+		if loopCount != tt.loops {
+			t.Errorf("%s: nonce generation looped %d times, want %d", tt.msg, loopCount, tt.loops)
+		}
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	t.Run("P-224", func(t *testing.T) { testSignAndVerify(t, P224()) })
+	t.Run("P-256", func(t *testing.T) { testSignAndVerify(t, P256()) })
+	t.Run("P-384", func(t *testing.T) { testSignAndVerify(t, P384()) })
+	t.Run("P-521", func(t *testing.T) { testSignAndVerify(t, P521()) })
+}
+
+func testSignAndVerify[P Point[P]](t *testing.T, c *Curve[P]) {
+	priv, err := GenerateKey(c, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pairwiseTest(c, priv); err != nil {
+		t.Fatal(err)
+	}
+
+	hash := []byte("testing")
+	sig, err := Sign(c, priv, rand.Reader, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(c, priv.PublicKey(), hash, sig); err != nil {
+		t.Errorf("Verify failed: %v", err)
+	}
+	if err := Verify(c, priv.PublicKey(), []byte("Testing"), sig); err == nil {
+		t.Errorf("Verify succeeded on the wrong hash")
+	}
+	sig.S[len(sig.S)-1] ^= 1
+	if err := Verify(c, priv.PublicKey(), hash, sig); err == nil {
+		t.Errorf("Verify succeeded on a modified signature")
+	}
+}
+
+func fromHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
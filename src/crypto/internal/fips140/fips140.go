@@ -0,0 +1,96 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fips140 is the root of the Go Cryptographic Module, the set of
+// packages under crypto/internal/fips140 that implement FIPS 140-3 approved
+// algorithms for the standard library. The module currently covers SHA-256,
+// SHA-512, HMAC, HKDF, AES (including AES-GCM), ECDSA over the NIST curves,
+// RSA (PKCS #1 v1.5, PSS and OAEP), and the TLS 1.2 and TLS 1.3 key
+// derivation functions, along with the bigmod and nistec arithmetic they are
+// built on.
+//
+// RSA key generation is not part of the module: crypto/rsa generates the
+// primes with math/big, and then imports the key into the module, which runs
+// its pairwise consistency test. The TLS 1.0 and 1.1 pseudo-random function
+// and non-approved algorithms built on top of the module, like AES-GCM-SIV in
+// crypto/aes, are also outside of it.
+//
+// The packages of the module import only each other, a handful of low-level
+// packages of the standard library, crypto/subtle for its constant-time
+// helpers, and crypto/cipher for the Block, AEAD, BlockMode and Stream
+// interfaces that the AES implementation satisfies. They never directly
+// import the public packages that implement algorithms, like crypto/aes or
+// crypto/sha256, which are built on top of them; go/build's deps_test.go
+// enforces this. Each package of the module
+// runs the Cryptographic Algorithm Self-Tests (CASTs) of its algorithms at
+// init when FIPS 140-3 mode is enabled.
+//
+// FIPS 140-3 mode is selected with the fips140 GODEBUG setting:
+//
+//   - fips140=off (the default) disables the self-tests;
+//   - fips140=on runs the self-tests;
+//   - fips140=only also makes the use of non-approved algorithms and
+//     parameters fail, see crypto/internal/fips140only;
+//   - fips140=debug is like fips140=on, and also reports each self-test
+//     on standard error.
+//
+// The setting is read once at program start-up, and can't be changed later.
+//
+// This package doesn't implement the integrity self-test of the module code,
+// which requires linker support, and running the module doesn't by itself
+// amount to using a CMVP-validated module.
+package fips140
+
+import (
+	"errors"
+	"internal/godebug"
+	"internal/goexperiment"
+)
+
+// Enabled reports whether FIPS 140-3 mode is enabled, with fips140=on,
+// fips140=only or fips140=debug.
+var Enabled bool
+
+// debug reports whether fips140=debug is set.
+var debug bool
+
+func init() {
+	switch v := godebug.New("fips140").Value(); v {
+	case "on", "only":
+		Enabled = true
+	case "debug":
+		Enabled = true
+		debug = true
+	case "off", "":
+	default:
+		panic("fips140: unknown GODEBUG setting fips140=" + v)
+	}
+	if Enabled {
+		if err := Supported(); err != nil {
+			panic("fips140: " + err.Error())
+		}
+	}
+}
+
+// Supported returns an error if FIPS 140-3 mode can't be enabled in this
+// program.
+func Supported() error {
+	// BoringCrypto replaces the module with its own, separately validated,
+	// implementations, so the self-tests of the module wouldn't cover the
+	// code that actually runs.
+	if goexperiment.BoringCrypto {
+		return errors.New("FIPS 140-3 mode is incompatible with GOEXPERIMENT=boringcrypto")
+	}
+	return nil
+}
+
+// Name returns the name of the module.
+func Name() string {
+	return "Go Cryptographic Module"
+}
+
+// Version returns the version of the module.
+func Version() string {
+	return "latest"
+}
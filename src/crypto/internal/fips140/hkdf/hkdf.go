@@ -0,0 +1,50 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hkdf implements the HMAC-based Extract-and-Expand Key Derivation
+// Function (HKDF) as defined in RFC 5869 and NIST SP 800-56C.
+package hkdf
+
+import (
+	"crypto/internal/fips140/hmac"
+	"hash"
+)
+
+// Extract generates a pseudorandom key from secret and salt. If salt is nil,
+// a string of zeroes of the size of the hash output is used instead.
+func Extract(h func() hash.Hash, secret, salt []byte) []byte {
+	if salt == nil {
+		salt = make([]byte, h().Size())
+	}
+	extractor := hmac.New(h, salt)
+	extractor.Write(secret)
+	return extractor.Sum(nil)
+}
+
+// Expand derives keyLen bytes of keying material from pseudorandomKey and
+// info. keyLen must be at most 255 times the size of the hash output, which
+// the caller is responsible for checking.
+func Expand(h func() hash.Hash, pseudorandomKey []byte, info string, keyLen int) []byte {
+	out := make([]byte, 0, keyLen)
+	expander := hmac.New(h, pseudorandomKey)
+	var buf []byte
+	for counter := byte(1); len(out) < keyLen; counter++ {
+		if counter > 1 {
+			expander.Reset()
+		}
+		expander.Write(buf)
+		expander.Write([]byte(info))
+		expander.Write([]byte{counter})
+		buf = expander.Sum(buf[:0])
+		remain := keyLen - len(out)
+		out = append(out, buf[:min(remain, len(buf))]...)
+	}
+	return out
+}
+
+// Key runs Extract and then Expand.
+func Key(h func() hash.Hash, secret, salt []byte, info string, keyLen int) []byte {
+	prk := Extract(h, secret, salt)
+	return Expand(h, prk, info, keyLen)
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmac
+
+import (
+	"crypto/internal/fips140"
+	"crypto/internal/fips140/sha256"
+	"errors"
+	"hash"
+)
+
+func init() {
+	fips140.CAST("HMAC-SHA2-256", func() error {
+		// RFC 4231, Test Case 2.
+		key := []byte("Jefe")
+		input := []byte("what do ya want for nothing?")
+		want := []byte{
+			0x5b, 0xdc, 0xc1, 0x46, 0xbf, 0x60, 0x75, 0x4e,
+			0x6a, 0x04, 0x24, 0x26, 0x08, 0x95, 0x75, 0xc7,
+			0x5a, 0x00, 0x3f, 0x08, 0x9d, 0x27, 0x39, 0x83,
+			0x9d, 0xec, 0x58, 0xb9, 0x64, 0xec, 0x38, 0x43,
+		}
+		h := New(func() hash.Hash { return sha256.New() }, key)
+		h.Write(input)
+		if got := h.Sum(nil); string(got) != string(want) {
+			return errors.New("unexpected result")
+		}
+		return nil
+	})
+}
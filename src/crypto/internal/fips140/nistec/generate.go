@@ -152,7 +152,7 @@ const tmplNISTEC = `// Copyright 2022 The Go Authors. All rights reserved.
 package nistec
 
 import (
-	"crypto/internal/nistec/fiat"
+	"crypto/internal/fips140/nistec/fiat"
 	"crypto/subtle"
 	"errors"
 	"sync"
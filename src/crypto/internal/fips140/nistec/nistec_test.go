@@ -7,7 +7,7 @@ package nistec_test
 import (
 	"bytes"
 	"crypto/elliptic"
-	"crypto/internal/nistec"
+	"crypto/internal/fips140/nistec"
 	"fmt"
 	"internal/testenv"
 	"math/big"
@@ -7,7 +7,7 @@
 package nistec
 
 import (
-	"crypto/internal/nistec/fiat"
+	"crypto/internal/fips140/nistec/fiat"
 	"crypto/subtle"
 	"errors"
 	"sync"
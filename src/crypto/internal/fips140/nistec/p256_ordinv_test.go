@@ -9,7 +9,7 @@ package nistec_test
 import (
 	"bytes"
 	"crypto/elliptic"
-	"crypto/internal/nistec"
+	"crypto/internal/fips140/nistec"
 	"math/big"
 	"testing"
 )
@@ -0,0 +1,244 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"bytes"
+	"crypto/internal/fips140"
+	"crypto/internal/fips140/sha256"
+	"errors"
+)
+
+func init() {
+	fips140.CAST("RSASSA-PKCS-v1.5 2048-bit sign and verify", func() error {
+		n := []byte{
+			0xb5, 0xd5, 0xc9, 0xd5, 0xd2, 0x3d, 0x9b, 0x4f,
+			0xa7, 0xe8, 0x36, 0xcd, 0xdb, 0x83, 0x8f, 0xef,
+			0xf1, 0xfa, 0x89, 0x06, 0x6d, 0x26, 0x4f, 0x60,
+			0x38, 0x74, 0x7b, 0x5a, 0x37, 0x0b, 0xea, 0xa4,
+			0x0d, 0x3e, 0xe3, 0xc8, 0x1b, 0x2e, 0x36, 0x0d,
+			0xe5, 0x43, 0x10, 0x26, 0xc8, 0xcf, 0xf7, 0xa2,
+			0x1c, 0xe6, 0x5a, 0xfb, 0x7c, 0x45, 0x01, 0xc3,
+			0xbc, 0xb2, 0x82, 0xfc, 0xd2, 0x64, 0x0b, 0x05,
+			0xca, 0x5a, 0x86, 0x6f, 0xdc, 0x24, 0x80, 0x28,
+			0x8c, 0x43, 0xba, 0x30, 0x86, 0xbb, 0x07, 0xda,
+			0x70, 0xfe, 0x9b, 0x2f, 0x09, 0xe6, 0x07, 0x2d,
+			0x86, 0xdd, 0x0b, 0xcb, 0x03, 0x3b, 0xb4, 0xa2,
+			0xe2, 0x09, 0xdf, 0xe7, 0x06, 0xe3, 0xe1, 0x96,
+			0x1f, 0xc1, 0x23, 0x07, 0xf3, 0xb7, 0xce, 0x24,
+			0xb7, 0x49, 0xc4, 0x69, 0x81, 0xc7, 0xcc, 0xab,
+			0x30, 0x2d, 0x75, 0xf4, 0xe6, 0xb4, 0xf4, 0x4a,
+			0xc4, 0x54, 0xfc, 0x8b, 0x8a, 0x56, 0x00, 0x3f,
+			0x34, 0x8e, 0xf3, 0x20, 0x3e, 0xe8, 0xd5, 0x0b,
+			0xa4, 0x1e, 0x86, 0xee, 0xd0, 0x9a, 0xae, 0x94,
+			0xcc, 0x8d, 0xf5, 0x90, 0x2e, 0xee, 0xaa, 0xe6,
+			0x2e, 0x23, 0xaa, 0x61, 0x02, 0x98, 0xb9, 0xe4,
+			0xb6, 0x7d, 0xc5, 0x5e, 0xb0, 0x96, 0x3b, 0x21,
+			0xc7, 0x4f, 0x9e, 0x74, 0xca, 0xd6, 0x25, 0xe1,
+			0x2f, 0x5b, 0x55, 0x67, 0x7a, 0xbc, 0x8a, 0x14,
+			0xa6, 0x71, 0x30, 0xfc, 0xe1, 0x40, 0xd2, 0x5f,
+			0xfb, 0x76, 0x4a, 0xc0, 0x65, 0xd0, 0xd8, 0x27,
+			0x05, 0x06, 0x43, 0xa2, 0x96, 0x67, 0xce, 0xdf,
+			0x2b, 0xef, 0x79, 0xe6, 0x3a, 0x42, 0xdd, 0xf3,
+			0x70, 0x31, 0xde, 0x91, 0xbb, 0xa2, 0x06, 0x92,
+			0x2b, 0x43, 0xb2, 0xb7, 0x95, 0x63, 0xda, 0xfc,
+			0xff, 0xa3, 0xc3, 0xad, 0x37, 0x7b, 0x20, 0x02,
+			0x2a, 0xfa, 0xea, 0xe1, 0x5d, 0xa3, 0x8b, 0x91,
+		}
+		d := []byte{
+			0x35, 0xf7, 0xb4, 0xc6, 0xd4, 0x76, 0x5a, 0x71,
+			0x42, 0xd9, 0x65, 0xe2, 0xa5, 0x43, 0x1f, 0xe6,
+			0x4c, 0x40, 0x5a, 0x88, 0xe0, 0xbe, 0x5d, 0x12,
+			0xa6, 0xce, 0xd3, 0x89, 0x8c, 0x0a, 0xea, 0x62,
+			0x34, 0x58, 0x35, 0x0c, 0xc0, 0x1c, 0x70, 0x08,
+			0xa1, 0xc1, 0xcc, 0xba, 0x66, 0x21, 0x6b, 0x52,
+			0xdf, 0x16, 0xf3, 0x6b, 0xee, 0x8f, 0x20, 0x9d,
+			0xf2, 0x94, 0x5c, 0xd2, 0x22, 0xb3, 0x74, 0x9e,
+			0x0c, 0x57, 0xdf, 0xcc, 0xb5, 0x5e, 0xd3, 0xf6,
+			0xb4, 0xe2, 0xa9, 0x11, 0x1b, 0xfb, 0xc8, 0xb1,
+			0xe9, 0x95, 0x77, 0x83, 0x77, 0x81, 0xa1, 0x21,
+			0xbf, 0x04, 0x3e, 0x2a, 0xcb, 0xd3, 0xdd, 0xa1,
+			0xf6, 0x4a, 0x8d, 0xfc, 0xa8, 0x8b, 0xdb, 0xf9,
+			0x4d, 0x85, 0x6a, 0x1b, 0x43, 0x17, 0x8b, 0x84,
+			0x54, 0x2d, 0xfd, 0xb0, 0x7e, 0xc7, 0x49, 0x14,
+			0x89, 0xe1, 0x04, 0x40, 0x62, 0x74, 0xd4, 0x88,
+			0x18, 0x38, 0xeb, 0x5c, 0xd5, 0xd7, 0xa5, 0x94,
+			0xec, 0xd7, 0x13, 0xc6, 0x3d, 0xe7, 0xf1, 0x6b,
+			0x46, 0x19, 0x0a, 0xab, 0x42, 0x27, 0x6f, 0x56,
+			0x10, 0xac, 0xf5, 0xc7, 0x46, 0xe0, 0x64, 0x34,
+			0xad, 0x3f, 0x2f, 0x46, 0xd8, 0x8f, 0x79, 0x2a,
+			0xc9, 0xcf, 0x44, 0x06, 0x43, 0xd1, 0x11, 0x6a,
+			0xc1, 0xfe, 0xc0, 0x6e, 0xe6, 0xf9, 0xce, 0x9f,
+			0xab, 0x1f, 0x54, 0x7d, 0x2f, 0x2c, 0xfc, 0x8d,
+			0xfc, 0x25, 0x92, 0x11, 0xa0, 0xf6, 0xcf, 0xb1,
+			0xab, 0x3a, 0xd7, 0x2e, 0x4e, 0x16, 0xfc, 0x60,
+			0xd3, 0xeb, 0xae, 0x80, 0xa6, 0xdd, 0x17, 0x7b,
+			0x51, 0x3c, 0xc1, 0x18, 0x57, 0xbf, 0x9f, 0x88,
+			0xd5, 0x2a, 0xae, 0x71, 0x24, 0x87, 0x63, 0x41,
+			0xf9, 0x17, 0x68, 0x78, 0xfb, 0x30, 0xb9, 0x87,
+			0xee, 0x0d, 0x38, 0xf4, 0x91, 0x24, 0x74, 0x9c,
+			0x8f, 0xf9, 0xa8, 0xfc, 0xbf, 0xa8, 0x00, 0x4d,
+		}
+		p := []byte{
+			0xd8, 0x83, 0x18, 0x75, 0x8c, 0x40, 0x0f, 0x73,
+			0x6f, 0x34, 0x13, 0xed, 0x29, 0x78, 0xdd, 0x2b,
+			0x95, 0xac, 0x5b, 0xe2, 0xa3, 0x9a, 0x93, 0x2a,
+			0xf6, 0x9b, 0xd2, 0xa7, 0x4f, 0xdc, 0xef, 0x18,
+			0x04, 0xc1, 0x97, 0xf9, 0x5a, 0xf5, 0xbf, 0x86,
+			0x55, 0xd5, 0xf5, 0x4b, 0xff, 0xff, 0x77, 0x7f,
+			0x97, 0xf7, 0xe7, 0xbd, 0x65, 0x7a, 0x9f, 0x47,
+			0xea, 0xc6, 0xca, 0xdf, 0x9a, 0x58, 0x8a, 0xec,
+			0x08, 0x7f, 0x53, 0xc0, 0xcc, 0xbc, 0xf8, 0x8c,
+			0xd6, 0xb0, 0xa5, 0xfe, 0x0e, 0x56, 0x59, 0xe4,
+			0x67, 0xed, 0xd9, 0x98, 0x76, 0x49, 0xe1, 0xf1,
+			0x03, 0x15, 0x99, 0x12, 0x3e, 0x80, 0x47, 0x39,
+			0x4f, 0x09, 0x4c, 0xf3, 0x86, 0xa7, 0xcc, 0xeb,
+			0xa9, 0x7b, 0x6b, 0x39, 0x75, 0xc5, 0x3b, 0x39,
+			0x54, 0x82, 0xa9, 0x7d, 0xb1, 0x47, 0x60, 0x4b,
+			0x32, 0x61, 0xc0, 0xb4, 0x81, 0x68, 0xdd, 0xbf,
+		}
+		q := []byte{
+			0xd6, 0xff, 0xa1, 0xc1, 0x9c, 0xe5, 0x80, 0x64,
+			0x22, 0x2b, 0x13, 0x3f, 0x89, 0xdc, 0x0e, 0xbb,
+			0xc6, 0xf3, 0x23, 0x79, 0xd3, 0x20, 0x47, 0xab,
+			0x10, 0x17, 0x8f, 0xa1, 0xc0, 0x9a, 0xf3, 0xb6,
+			0xa9, 0xae, 0x59, 0x07, 0x25, 0x34, 0x44, 0xd8,
+			0x4a, 0x70, 0x46, 0x3d, 0xba, 0xb1, 0x41, 0x38,
+			0x81, 0xf3, 0x02, 0xdb, 0xe3, 0x03, 0x16, 0x57,
+			0x04, 0x72, 0x02, 0xd4, 0xcb, 0xa2, 0x0e, 0x41,
+			0x48, 0xfe, 0x9d, 0x58, 0xe3, 0xc5, 0xe6, 0x0e,
+			0xf6, 0x70, 0x09, 0x88, 0x7b, 0x2e, 0x63, 0x77,
+			0x5f, 0x8f, 0x48, 0x03, 0x65, 0x2d, 0x70, 0x27,
+			0xf0, 0xf7, 0xa5, 0x08, 0xfc, 0x67, 0xcc, 0x6e,
+			0xa7, 0x4d, 0xfa, 0x73, 0x2b, 0x81, 0xeb, 0xb7,
+			0xbe, 0x5f, 0x31, 0x34, 0x9b, 0xeb, 0x29, 0x1b,
+			0x57, 0x5b, 0x13, 0xde, 0xf9, 0xd6, 0x3a, 0x64,
+			0x08, 0xbe, 0x87, 0xb5, 0x01, 0x55, 0x8a, 0xaf,
+		}
+		dP := []byte{
+			0xcc, 0xba, 0x59, 0x9c, 0x7d, 0x3b, 0xed, 0xe0,
+			0x47, 0x5c, 0xc5, 0x22, 0xd2, 0xbe, 0x58, 0xd4,
+			0x67, 0xb9, 0x0d, 0x41, 0xa7, 0x55, 0xf8, 0xa6,
+			0xfd, 0x7d, 0xa6, 0x99, 0x52, 0xa9, 0x24, 0xe4,
+			0x5f, 0x4a, 0xf7, 0xfb, 0x6f, 0x89, 0x44, 0xcb,
+			0x2f, 0xcb, 0x1b, 0x21, 0xfc, 0x35, 0x82, 0xb7,
+			0x81, 0xe9, 0x86, 0x95, 0xce, 0xa9, 0x3e, 0x87,
+			0xf0, 0x76, 0x7d, 0x4b, 0x26, 0x55, 0x67, 0x37,
+			0x7d, 0x69, 0x78, 0xb6, 0xac, 0x62, 0x5b, 0xdc,
+			0x40, 0xfd, 0xd4, 0x58, 0x01, 0x12, 0xa1, 0xf9,
+			0x9a, 0x54, 0x8c, 0x16, 0xe0, 0x73, 0x48, 0x54,
+			0x6f, 0x88, 0x32, 0xaf, 0xcf, 0xad, 0xd8, 0xab,
+			0x56, 0x13, 0x03, 0x52, 0x9b, 0x17, 0x7c, 0x6b,
+			0xb2, 0x5c, 0xe3, 0xac, 0xf7, 0xb1, 0xc2, 0x28,
+			0x99, 0xc2, 0xdb, 0xa5, 0xe0, 0xc6, 0x0f, 0x45,
+			0xcb, 0xa4, 0x27, 0x66, 0x07, 0xa0, 0x33, 0x5d,
+		}
+		dQ := []byte{
+			0xb0, 0xfb, 0xbc, 0x6f, 0x82, 0x35, 0x96, 0x8c,
+			0x57, 0x68, 0x38, 0x2f, 0x1c, 0xd0, 0x2e, 0xbc,
+			0xbb, 0x18, 0x99, 0x82, 0x86, 0x9e, 0xd3, 0x00,
+			0x69, 0x7d, 0xcb, 0xb9, 0xee, 0xb0, 0x5f, 0xa1,
+			0x65, 0x6a, 0xe5, 0x54, 0xb1, 0x76, 0xa6, 0x99,
+			0x9a, 0x25, 0x74, 0x5d, 0x0a, 0x5b, 0x32, 0x02,
+			0x4f, 0x4e, 0xf9, 0x72, 0x83, 0x1c, 0x9e, 0x7e,
+			0x41, 0x65, 0x8d, 0x19, 0x19, 0x0c, 0x2d, 0x49,
+			0xe6, 0xc9, 0xda, 0x43, 0x3b, 0x35, 0x44, 0x9d,
+			0xda, 0x95, 0xfd, 0xf3, 0x00, 0xdb, 0xfd, 0xfb,
+			0x5d, 0x89, 0xb8, 0x51, 0x3b, 0x2f, 0xbd, 0x5c,
+			0xd3, 0xcb, 0x38, 0x6a, 0xfe, 0x44, 0x80, 0x10,
+			0x32, 0xdf, 0x49, 0x25, 0x17, 0x92, 0x9a, 0xb5,
+			0x26, 0x1c, 0x7c, 0x87, 0xff, 0x36, 0x05, 0x1f,
+			0xc1, 0xb8, 0x72, 0xd2, 0x21, 0xbe, 0x0d, 0x51,
+			0x1d, 0xb5, 0xa2, 0x47, 0x09, 0x73, 0x1e, 0x77,
+		}
+		qInv := []byte{
+			0x42, 0xf6, 0x98, 0xbe, 0x65, 0x16, 0xba, 0xdc,
+			0xb6, 0x80, 0x57, 0xb1, 0x1b, 0x77, 0x36, 0x99,
+			0x37, 0x5d, 0x82, 0xb6, 0xb7, 0x5d, 0xf9, 0x3e,
+			0xb2, 0xc7, 0x12, 0x0e, 0x46, 0x2e, 0x38, 0xd9,
+			0x99, 0xfb, 0xcc, 0x36, 0xed, 0xad, 0xfa, 0x7e,
+			0x27, 0x9c, 0x42, 0xb7, 0xa6, 0x85, 0x2f, 0x43,
+			0xef, 0xef, 0x13, 0xc3, 0xa9, 0x9f, 0x8b, 0xaf,
+			0x16, 0x08, 0xf3, 0x97, 0x29, 0x42, 0xa2, 0xfc,
+			0xbc, 0x15, 0x34, 0x14, 0x77, 0x5c, 0xd1, 0xc9,
+			0x4e, 0xbc, 0x2e, 0x9e, 0x20, 0xb2, 0xec, 0x84,
+			0x4d, 0x77, 0x9c, 0x9a, 0xf0, 0x3b, 0x5e, 0x77,
+			0x60, 0x28, 0xb8, 0x8c, 0x20, 0xb2, 0xc9, 0x7f,
+			0x1f, 0x24, 0x44, 0xd1, 0x0a, 0x7f, 0x6e, 0x14,
+			0xfa, 0xd6, 0x9c, 0x94, 0x5a, 0xd5, 0x85, 0x7b,
+			0x2e, 0x14, 0xe4, 0x67, 0x04, 0x86, 0x12, 0xdb,
+			0xef, 0x54, 0xb9, 0x6c, 0x58, 0xb4, 0xf0, 0x4c,
+		}
+		want := []byte{
+			0x64, 0xb2, 0xea, 0x63, 0xd5, 0x62, 0xdb, 0x69,
+			0x33, 0xf3, 0x5c, 0x0e, 0x5b, 0xbb, 0xf9, 0x0b,
+			0x23, 0xfc, 0x05, 0x59, 0x26, 0x6c, 0x79, 0x39,
+			0x08, 0xce, 0xbf, 0xd2, 0x39, 0x92, 0x07, 0x1f,
+			0xcd, 0x7f, 0xb8, 0xb0, 0xe0, 0x25, 0xc7, 0xfa,
+			0x0c, 0x66, 0xbe, 0x26, 0xa8, 0xa1, 0xc6, 0xab,
+			0xe4, 0x45, 0xcd, 0x77, 0xae, 0x79, 0x92, 0x30,
+			0x1d, 0x05, 0x9e, 0x15, 0xd2, 0x7c, 0x91, 0xfb,
+			0x75, 0x25, 0x75, 0x68, 0xf2, 0xe1, 0x67, 0x6c,
+			0x9d, 0x13, 0xe2, 0x9b, 0xe0, 0x62, 0x3a, 0x43,
+			0x31, 0x5c, 0x87, 0xe0, 0x10, 0x6b, 0xa2, 0xba,
+			0x9c, 0xc4, 0xa2, 0x41, 0xc5, 0xf7, 0xa8, 0xb4,
+			0x02, 0x06, 0x59, 0x99, 0xff, 0x4b, 0xd5, 0xfc,
+			0xcd, 0x56, 0xb7, 0xf0, 0xb9, 0x8a, 0xd0, 0x5f,
+			0xd8, 0x4f, 0xae, 0x1c, 0x1a, 0x13, 0x06, 0x08,
+			0x0a, 0x8b, 0xc4, 0x97, 0xc8, 0x32, 0x3b, 0x91,
+			0x14, 0xdf, 0x93, 0x2b, 0x51, 0xff, 0xec, 0x4a,
+			0x9b, 0x6b, 0x51, 0x06, 0x30, 0xf0, 0xeb, 0x6c,
+			0x3d, 0xc3, 0xf1, 0xa6, 0x22, 0xdb, 0xf9, 0x06,
+			0x65, 0x56, 0xfc, 0x69, 0xa2, 0x25, 0xee, 0x26,
+			0x67, 0x9f, 0x20, 0x05, 0x22, 0x9f, 0x2b, 0x34,
+			0x5b, 0x2c, 0xd5, 0xb7, 0xaa, 0x44, 0x1c, 0xd9,
+			0xd1, 0x18, 0x99, 0xa9, 0x81, 0xea, 0x2a, 0x27,
+			0xdc, 0xf4, 0x10, 0x2c, 0x3c, 0xa0, 0x6b, 0xa1,
+			0xcd, 0xc7, 0x46, 0xcc, 0xd5, 0x20, 0x9f, 0x42,
+			0x86, 0x6e, 0x6e, 0x9e, 0x2e, 0xd9, 0x60, 0x69,
+			0x61, 0x2d, 0x6d, 0xa7, 0x58, 0x58, 0x9e, 0x33,
+			0x0d, 0xdf, 0x05, 0xeb, 0x8e, 0xbd, 0xa0, 0xda,
+			0x2b, 0xba, 0x69, 0xab, 0x35, 0x02, 0x2e, 0x0c,
+			0xb0, 0xaa, 0x70, 0x68, 0xee, 0x83, 0x8b, 0xce,
+			0xda, 0x01, 0xf8, 0xf3, 0xfc, 0x9f, 0x1a, 0xe3,
+			0x4f, 0x78, 0xed, 0x62, 0xe2, 0xa6, 0x65, 0x00,
+		}
+		priv, err := NewPrivateKey(n, 65537, d, p, q, dP, dQ, qInv)
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		h.Write([]byte("sample"))
+		hash := h.Sum(nil)
+		sig, err := SignPKCS1v15(priv, "SHA-256", hash)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sig, want) {
+			return errors.New("unexpected signature")
+		}
+		return VerifyPKCS1v15(priv.PublicKey(), "SHA-256", hash, sig)
+	})
+}
+
+// pairwiseTest checks that priv can produce a signature that verifies with
+// its public key, as required for every new key by FIPS 140-3. It uses the raw
+// RSASP1 and RSAVP1 primitives, so that it works with keys of any size.
+func pairwiseTest(priv *PrivateKey) error {
+	m := make([]byte, priv.pub.Size())
+	m[len(m)-1] = 2
+	sig, err := decrypt(priv, m, noCheck)
+	if err != nil {
+		return err
+	}
+	m1, err := encrypt(&priv.pub, sig)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(m, m1) {
+		return errors.New("crypto/rsa: pairwise consistency test failed")
+	}
+	return nil
+}
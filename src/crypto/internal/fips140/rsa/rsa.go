@@ -0,0 +1,243 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rsa implements the RSA primitives and the PKCS #1 v1.5, PSS and OAEP
+// schemes, as specified in RFC 8017 and FIPS 186-5.
+//
+// Key generation, which needs prime generation and arbitrary-precision
+// arithmetic, is implemented by crypto/rsa, which imports the resulting keys
+// with [NewPrivateKey]. Importing a key runs the pairwise consistency test
+// required by FIPS 140-3.
+package rsa
+
+import (
+	"crypto/internal/fips140"
+	"crypto/internal/fips140/bigmod"
+	"errors"
+)
+
+// PublicKey is an RSA public key.
+type PublicKey struct {
+	N *bigmod.Modulus
+	E int
+}
+
+// Size returns the modulus size in bytes. Raw signatures and ciphertexts
+// for or by this public key will have the same size.
+func (pub *PublicKey) Size() int {
+	return (pub.N.BitLen() + 7) / 8
+}
+
+// PrivateKey is an RSA private key.
+type PrivateKey struct {
+	pub PublicKey
+	d   *bigmod.Nat // d < N
+
+	// The following values are not set for multi-prime keys, which are
+	// imported with NewPrivateKeyWithoutCRT and use d directly.
+	p, q *bigmod.Modulus // p × q = N
+	// dP and dQ are used as exponents, so they are stored as big-endian byte
+	// slices to be passed to [bigmod.Nat.Exp].
+	dP, dQ []byte      // d mod (p - 1), d mod (q - 1)
+	qInv   *bigmod.Nat // q⁻¹ mod p
+}
+
+// PublicKey returns the public key corresponding to priv.
+func (priv *PrivateKey) PublicKey() *PublicKey {
+	return &priv.pub
+}
+
+// NewPrivateKey imports a two-prime RSA private key, including its Chinese
+// Remainder Theorem values, and runs its pairwise consistency test.
+//
+// All values are big-endian byte slices, and may have leading zeroes.
+func NewPrivateKey(N []byte, e int, d, P, Q, dP, dQ, qInv []byte) (*PrivateKey, error) {
+	n, err := bigmod.NewModulus(N)
+	if err != nil {
+		return nil, err
+	}
+	p, err := bigmod.NewModulus(P)
+	if err != nil {
+		return nil, err
+	}
+	q, err := bigmod.NewModulus(Q)
+	if err != nil {
+		return nil, err
+	}
+	dN, err := bigmod.NewNat().SetBytes(d, n)
+	if err != nil {
+		return nil, err
+	}
+	qInvP, err := bigmod.NewNat().SetBytes(qInv, p)
+	if err != nil {
+		return nil, errors.New("crypto/rsa: invalid CRT coefficient")
+	}
+	priv := &PrivateKey{
+		pub: PublicKey{N: n, E: e},
+		d:   dN,
+		p:   p, q: q,
+		dP: dP, dQ: dQ,
+		qInv: qInvP,
+	}
+	if err := checkPrivateKey(priv); err != nil {
+		return nil, err
+	}
+	return priv, nil
+}
+
+// NewPrivateKeyWithoutCRT imports an RSA private key with only its private
+// exponent, and runs its pairwise consistency test. It's meant for
+// multi-prime keys, and performs private key operations more slowly than
+// keys imported with [NewPrivateKey].
+func NewPrivateKeyWithoutCRT(N []byte, e int, d []byte) (*PrivateKey, error) {
+	n, err := bigmod.NewModulus(N)
+	if err != nil {
+		return nil, err
+	}
+	dN, err := bigmod.NewNat().SetBytes(d, n)
+	if err != nil {
+		return nil, err
+	}
+	priv := &PrivateKey{
+		pub: PublicKey{N: n, E: e},
+		d:   dN,
+	}
+	if err := checkPrivateKey(priv); err != nil {
+		return nil, err
+	}
+	return priv, nil
+}
+
+// checkPrivateKey checks the consistency of the values of priv, and runs its
+// pairwise consistency test.
+func checkPrivateKey(priv *PrivateKey) error {
+	if err := checkPublicKey(&priv.pub); err != nil {
+		return err
+	}
+
+	if priv.p != nil {
+		N, p, q := priv.pub.N, priv.p, priv.q
+
+		// Check that p × q ≡ 0 mod N, with p < N and q < N, which implies
+		// p × q = N.
+		pN, err := bigmod.NewNat().SetBytes(p.Nat().Bytes(p), N)
+		if err != nil {
+			return errors.New("crypto/rsa: invalid prime")
+		}
+		qN, err := bigmod.NewNat().SetBytes(q.Nat().Bytes(q), N)
+		if err != nil {
+			return errors.New("crypto/rsa: invalid prime")
+		}
+		if pN.Mul(qN, N).IsZero() != 1 {
+			return errors.New("crypto/rsa: invalid modulus")
+		}
+
+		// Check that qInv × q ≡ 1 mod p.
+		qP := bigmod.NewNat().Mod(q.Nat(), p)
+		if qP.Mul(priv.qInv, p).IsOne() != 1 {
+			return errors.New("crypto/rsa: invalid CRT coefficient")
+		}
+	}
+
+	return fips140.PCT("RSA PCT", func() error { return pairwiseTest(priv) })
+}
+
+func checkPublicKey(pub *PublicKey) error {
+	if pub.N == nil {
+		return errors.New("crypto/rsa: missing public modulus")
+	}
+	if pub.E < 2 {
+		return errors.New("crypto/rsa: public exponent too small")
+	}
+	// The exponent is required to fit in 32 bits, so that the behavior
+	// doesn't depend on the size of int.
+	if pub.E > 1<<31-1 {
+		return errors.New("crypto/rsa: public exponent too large")
+	}
+	return nil
+}
+
+var (
+	// ErrMessageTooLong is returned when attempting to encrypt or sign a
+	// message which is too large for the size of the key, or when the PSS
+	// salt doesn't fit.
+	ErrMessageTooLong = errors.New("crypto/rsa: message too long for RSA key size")
+
+	// ErrDecryption represents a failure to decrypt a message.
+	ErrDecryption = errors.New("crypto/rsa: decryption error")
+
+	// ErrVerification represents a failure to verify a signature.
+	ErrVerification = errors.New("crypto/rsa: verification error")
+)
+
+// Encrypt performs the raw RSA public key operation, RSAEP or RSAVP1.
+func Encrypt(pub *PublicKey, plaintext []byte) ([]byte, error) {
+	if err := checkPublicKey(pub); err != nil {
+		return nil, err
+	}
+	return encrypt(pub, plaintext)
+}
+
+func encrypt(pub *PublicKey, plaintext []byte) ([]byte, error) {
+	m, err := bigmod.NewNat().SetBytes(plaintext, pub.N)
+	if err != nil {
+		return nil, err
+	}
+	return bigmod.NewNat().ExpShortVarTime(m, uint(pub.E), pub.N).Bytes(pub.N), nil
+}
+
+const withCheck = true
+const noCheck = false
+
+// DecryptWithoutCheck performs the raw RSA private key operation, RSADP.
+func DecryptWithoutCheck(priv *PrivateKey, ciphertext []byte) ([]byte, error) {
+	return decrypt(priv, ciphertext, noCheck)
+}
+
+// DecryptWithCheck performs the raw RSA private key operation, RSASP1, and
+// checks the result against the public key to defend against faults in the
+// CRT computation.
+func DecryptWithCheck(priv *PrivateKey, ciphertext []byte) ([]byte, error) {
+	return decrypt(priv, ciphertext, withCheck)
+}
+
+// decrypt performs an RSA decryption of ciphertext. If check is true, m^e is
+// calculated and compared with ciphertext, in order to defend against errors
+// in the CRT computation.
+func decrypt(priv *PrivateKey, ciphertext []byte, check bool) ([]byte, error) {
+	N := priv.pub.N
+	c, err := bigmod.NewNat().SetBytes(ciphertext, N)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+
+	var m *bigmod.Nat
+	if priv.p == nil {
+		m = bigmod.NewNat().Exp(c, priv.d.Bytes(N), N)
+	} else {
+		P, Q := priv.p, priv.q
+		t0 := bigmod.NewNat()
+		// m = c ^ Dp mod p
+		m = bigmod.NewNat().Exp(t0.Mod(c, P), priv.dP, P)
+		// m2 = c ^ Dq mod q
+		m2 := bigmod.NewNat().Exp(t0.Mod(c, Q), priv.dQ, Q)
+		// m = m - m2 mod p
+		m.Sub(t0.Mod(m2, P), P)
+		// m = m * Qinv mod p
+		m.Mul(priv.qInv, P)
+		// m = m * q mod N
+		m.ExpandFor(N).Mul(t0.Mod(Q.Nat(), N), N)
+		// m = m + m2 mod N
+		m.Add(m2.ExpandFor(N), N)
+	}
+
+	if check {
+		c1 := bigmod.NewNat().ExpShortVarTime(m, uint(priv.pub.E), N)
+		if c1.Equal(c) != 1 {
+			return nil, ErrDecryption
+		}
+	}
+
+	return m.Bytes(N), nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha256
+
+import (
+	"crypto/internal/fips140"
+	"errors"
+)
+
+func init() {
+	fips140.CAST("SHA2-256", func() error {
+		input := []byte("abc")
+		want := []byte{
+			0xba, 0x78, 0x16, 0xbf, 0x8f, 0x01, 0xcf, 0xea,
+			0x41, 0x41, 0x40, 0xde, 0x5d, 0xae, 0x22, 0x23,
+			0xb0, 0x03, 0x61, 0xa3, 0x96, 0x17, 0x7a, 0x9c,
+			0xb4, 0x10, 0xff, 0x61, 0xf2, 0x00, 0x15, 0xad,
+		}
+		h := New()
+		h.Write(input)
+		if got := h.Sum(nil); string(got) != string(want) {
+			return errors.New("unexpected result")
+		}
+		return nil
+	})
+}
@@ -8,7 +8,7 @@ package sha512
 
 import "internal/cpu"
 
-func block(dig *digest, p []byte) {
+func block(dig *Digest, p []byte) {
 	if cpu.ARM64.HasSHA512 {
 		blockAsm(dig, p)
 		return
@@ -17,4 +17,4 @@ func block(dig *digest, p []byte) {
 }
 
 //go:noescape
-func blockAsm(dig *digest, p []byte)
+func blockAsm(dig *Digest, p []byte)
@@ -0,0 +1,64 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tls12 implements the TLS 1.2 pseudo-random function and master
+// secret derivation, as defined in RFC 5246 and RFC 7627, and allowed by
+// SP 800-135, Revision 1, Section 4.2.2.
+package tls12
+
+import (
+	"crypto/internal/fips140/hmac"
+	"hash"
+)
+
+// PRF implements the TLS 1.2 pseudo-random function, as defined in RFC 5246,
+// Section 5.
+func PRF(hash func() hash.Hash, secret []byte, label string, seed []byte, keyLen int) []byte {
+	labelAndSeed := make([]byte, len(label)+len(seed))
+	copy(labelAndSeed, label)
+	copy(labelAndSeed[len(label):], seed)
+
+	result := make([]byte, keyLen)
+	pHash(hash, result, secret, labelAndSeed)
+	return result
+}
+
+// pHash implements the P_hash function, as defined in RFC 5246, Section 5.
+func pHash(hash func() hash.Hash, result, secret, seed []byte) {
+	h := hmac.New(hash, secret)
+	h.Write(seed)
+	a := h.Sum(nil)
+
+	for len(result) > 0 {
+		h.Reset()
+		h.Write(a)
+		h.Write(seed)
+		b := h.Sum(nil)
+		n := copy(result, b)
+		result = result[n:]
+
+		h.Reset()
+		h.Write(a)
+		a = h.Sum(nil)
+	}
+}
+
+const masterSecretLength = 48
+const masterSecretLabel = "master secret"
+const extendedMasterSecretLabel = "extended master secret"
+
+// MasterSecret derives the master secret from the pre-master secret and the
+// client and server randoms, as defined in RFC 5246, Section 8.1.
+func MasterSecret(hash func() hash.Hash, preMasterSecret, clientRandom, serverRandom []byte) []byte {
+	seed := make([]byte, 0, len(clientRandom)+len(serverRandom))
+	seed = append(seed, clientRandom...)
+	seed = append(seed, serverRandom...)
+	return PRF(hash, preMasterSecret, masterSecretLabel, seed, masterSecretLength)
+}
+
+// ExtendedMasterSecret derives the extended master secret from the
+// pre-master secret and the session hash, as defined in RFC 7627.
+func ExtendedMasterSecret(hash func() hash.Hash, preMasterSecret, transcript []byte) []byte {
+	return PRF(hash, preMasterSecret, extendedMasterSecretLabel, transcript, masterSecretLength)
+}
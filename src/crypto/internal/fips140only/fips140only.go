@@ -0,0 +1,31 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fips140only implements the approved-algorithm enforcement of
+// fips140=only mode, in which the use of cryptography that is not approved
+// for FIPS 140-3 makes the crypto packages return an error or panic.
+package fips140only
+
+import (
+	"crypto/internal/fips140/sha256"
+	"crypto/internal/fips140/sha512"
+	"crypto/sha3"
+	"hash"
+	"internal/godebug"
+)
+
+// Enabled reports whether FIPS 140-only mode is enabled, in which non-approved
+// cryptography returns an error or panics.
+var Enabled = godebug.New("fips140").Value() == "only"
+
+// ApprovedHash reports whether h is one of the approved hash functions,
+// SHA-2 or SHA-3, as implemented by the standard library.
+func ApprovedHash(h hash.Hash) bool {
+	switch h.(type) {
+	case *sha256.Digest, *sha512.Digest, *sha3.SHA3:
+		return true
+	default:
+		return false
+	}
+}
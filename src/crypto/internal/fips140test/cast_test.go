@@ -0,0 +1,137 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fips140test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/internal/fips140"
+	"crypto/rand"
+	"crypto/rsa"
+	"internal/testenv"
+	"os"
+	"strings"
+	"testing"
+
+	// Import packages that define CASTs to test them.
+	_ "crypto/internal/fips140/aes"
+	_ "crypto/internal/fips140/ecdsa"
+	_ "crypto/internal/fips140/hkdf"
+	_ "crypto/internal/fips140/hmac"
+	_ "crypto/internal/fips140/rsa"
+	_ "crypto/internal/fips140/sha256"
+	_ "crypto/internal/fips140/sha512"
+	_ "crypto/internal/fips140/tls12"
+	_ "crypto/internal/fips140/tls13"
+)
+
+var allCASTs = []string{
+	"AES-128",
+	"ECDSA P-256 SHA2-256 sign and verify",
+	"HKDF-SHA2-256",
+	"HMAC-SHA2-256",
+	"RSASSA-PKCS-v1.5 2048-bit sign and verify",
+	"SHA2-256",
+	"SHA2-512",
+	"TLSv1.2-SHA2-256",
+	"TLSv1.3-SHA2-256",
+}
+
+var allPCTs = []string{
+	"ECDSA PCT",
+	"RSA PCT",
+}
+
+// runChild runs the test named test in a child process, with the given
+// GODEBUG setting, and returns its combined output.
+func runChild(t *testing.T, test, godebug string) (string, error) {
+	t.Helper()
+	cmd := testenv.CleanCmdEnv(testenv.Command(t, os.Args[0], "-test.run=^"+test+"$", "-test.v", "-test.count=1"))
+	cmd.Env = append(cmd.Env, "GODEBUG="+godebug)
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func TestCASTs(t *testing.T) {
+	testenv.MustHaveExec(t)
+
+	out, err := runChild(t, "TestPCTChild", "fips140=debug")
+	if err != nil {
+		t.Fatalf("fips140=debug failed: %v\n%s", err, out)
+	}
+	for _, name := range allCASTs {
+		if !strings.Contains(out, "FIPS 140-3 self-test passed: "+name+"\n") {
+			t.Errorf("CAST %q did not run\n%s", name, out)
+		}
+	}
+	if !strings.Contains(out, "--- PASS: TestPCTChild") {
+		t.Errorf("TestPCTChild did not run\n%s", out)
+	}
+}
+
+func TestCASTFailures(t *testing.T) {
+	testenv.MustHaveExec(t)
+
+	for _, name := range allCASTs {
+		t.Run(name, func(t *testing.T) {
+			out, err := runChild(t, "TestPCTChild", "fips140=on,failfipscast="+name)
+			if err == nil {
+				t.Fatalf("simulated CAST failure did not abort the program\n%s", out)
+			}
+			if !strings.Contains(out, "FIPS 140-3 self-test failed: "+name+": ") {
+				t.Errorf("unexpected output\n%s", out)
+			}
+		})
+	}
+}
+
+func TestPCTFailures(t *testing.T) {
+	testenv.MustHaveExec(t)
+
+	for _, name := range allPCTs {
+		t.Run(name, func(t *testing.T) {
+			out, err := runChild(t, "TestPCTChild", "fips140=on,failfipscast="+name)
+			if err == nil {
+				t.Fatalf("simulated PCT failure did not fail key generation\n%s", out)
+			}
+			if !strings.Contains(out, "simulated PCT failure") {
+				t.Errorf("unexpected output\n%s", out)
+			}
+		})
+	}
+}
+
+func TestPCTChild(t *testing.T) {
+	if !fips140.Enabled {
+		t.Skip("FIPS 140-3 mode not enabled")
+	}
+	if _, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		t.Error(err)
+	}
+	if _, err := rsa.GenerateKey(rand.Reader, 2048); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInvalidNames(t *testing.T) {
+	for _, name := range []string{"a,b", "a#b", "a=b", "a:b"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("CAST(%q) did not panic", name)
+				}
+			}()
+			fips140.CAST(name, func() error { return nil })
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("PCT(%q) did not panic", name)
+				}
+			}()
+			fips140.PCT(name, func() error { return nil })
+		}()
+	}
+}
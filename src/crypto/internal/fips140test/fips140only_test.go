@@ -0,0 +1,90 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fips140test
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/des"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/internal/fips140only"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rc4"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"internal/testenv"
+	"strings"
+	"testing"
+)
+
+func TestFIPS140Only(t *testing.T) {
+	testenv.MustHaveExec(t)
+
+	out, err := runChild(t, "TestFIPS140OnlyChild", "fips140=only")
+	if err != nil {
+		t.Fatalf("fips140=only failed: %v\n%s", err, out)
+	}
+	if !strings.Contains(out, "--- PASS: TestFIPS140OnlyChild") {
+		t.Errorf("TestFIPS140OnlyChild did not run\n%s", out)
+	}
+}
+
+func TestFIPS140OnlyChild(t *testing.T) {
+	if !fips140only.Enabled {
+		t.Skip("FIPS 140-only mode not enabled")
+	}
+
+	// Approved algorithms keep working.
+	if _, err := aes.NewCipher(make([]byte, 16)); err != nil {
+		t.Errorf("AES: %v", err)
+	}
+	if _, err := hkdf.Key(sha256.New, make([]byte, 32), nil, "", 32); err != nil {
+		t.Errorf("HKDF-SHA2-256: %v", err)
+	}
+	hmac.New(sha256.New, make([]byte, 32)).Sum(nil)
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("RSA 2048: %v", err)
+	}
+	hashed := sha256.Sum256([]byte("message"))
+	sig, err := rsa.SignPSS(rand.Reader, priv, crypto.SHA256, hashed[:], nil)
+	if err != nil {
+		t.Errorf("RSA-PSS: %v", err)
+	} else if err := rsa.VerifyPSS(&priv.PublicKey, crypto.SHA256, hashed[:], sig, nil); err != nil {
+		t.Errorf("RSA-PSS verification: %v", err)
+	}
+
+	// Non-approved algorithms and parameters fail.
+	if _, err := md5.New().Write([]byte("x")); err == nil {
+		t.Error("MD5 Write succeeded")
+	}
+	if _, err := sha1.New().Write([]byte("x")); err == nil {
+		t.Error("SHA-1 Write succeeded")
+	}
+	if _, err := rc4.NewCipher(make([]byte, 16)); err == nil {
+		t.Error("RC4 succeeded")
+	}
+	if _, err := des.NewTripleDESCipher(make([]byte, 24)); err == nil {
+		t.Error("TripleDES succeeded")
+	}
+	if _, err := aes.NewGCMSIV(make([]byte, 16)); err == nil {
+		t.Error("AES-GCM-SIV succeeded")
+	}
+	if _, err := hkdf.Key(sha1.New, make([]byte, 32), nil, "", 32); err == nil {
+		t.Error("HKDF-SHA1 succeeded")
+	}
+	if _, err := rsa.GenerateKey(rand.Reader, 1024); err == nil {
+		t.Error("RSA 1024 key generation succeeded")
+	}
+	if _, err := rsa.SignPKCS1v15(nil, priv, crypto.SHA1, make([]byte, 20)); err == nil {
+		t.Error("RSA PKCS#1 v1.5 with SHA-1 succeeded")
+	}
+	if _, err := rsa.EncryptPKCS1v15(rand.Reader, &priv.PublicKey, []byte("key")); err == nil {
+		t.Error("RSA PKCS#1 v1.5 encryption succeeded")
+	}
+}
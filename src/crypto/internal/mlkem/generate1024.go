@@ -0,0 +1,111 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// This program generates mlkem1024.go from mlkem768.go, by renaming the
+// identifiers that are specific to the ML-KEM-768 parameter set.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+var replacements = map[string]string{
+	"k": "k1024",
+
+	"CiphertextSize768":       "CiphertextSize1024",
+	"EncapsulationKeySize768": "EncapsulationKeySize1024",
+	"decapsulationKeySize768": "decapsulationKeySize1024",
+
+	"encryptionKey": "encryptionKey1024",
+	"decryptionKey": "decryptionKey1024",
+
+	"generateKey":    "generateKey1024",
+	"newKeyFromSeed": "newKeyFromSeed1024",
+	"kemKeyGen":      "kemKeyGen1024",
+	"kemEncaps":      "kemEncaps1024",
+	"parseEK":        "parseEK1024",
+	"pkeEncrypt":     "pkeEncrypt1024",
+	"kemDecaps":      "kemDecaps1024",
+	"pkeDecrypt":     "pkeDecrypt1024",
+
+	"encodingSize4":             "encodingSize5",
+	"encodingSize10":            "encodingSize11",
+	"ringCompressAndEncode4":    "ringCompressAndEncode5",
+	"ringCompressAndEncode10":   "ringCompressAndEncode11",
+	"ringDecodeAndDecompress4":  "ringDecodeAndDecompress5",
+	"ringDecodeAndDecompress10": "ringDecodeAndDecompress11",
+}
+
+func main() {
+	inputFile := flag.String("input", "", "")
+	outputFile := flag.String("output", "", "")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, *inputFile, nil, parser.SkipObjectResolution|parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Drop the header, the package documentation, and the comments that
+	// precede the imports, which only belong in mlkem768.go.
+	f.Doc = nil
+	var importsEnd token.Pos
+	for _, decl := range f.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			importsEnd = d.End()
+		}
+	}
+	var comments []*ast.CommentGroup
+	for _, c := range f.Comments {
+		if c.Pos() < importsEnd {
+			continue
+		}
+		for _, c := range c.List {
+			c.Text = strings.ReplaceAll(c.Text, "768", "1024")
+		}
+		comments = append(comments, c)
+	}
+	f.Comments = comments
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.ValueSpec:
+			// Change the module dimension from 3 to 4.
+			if len(x.Names) == 1 && x.Names[0].Name == "k" {
+				x.Values[0].(*ast.BasicLit).Value = "4"
+			}
+		case *ast.Ident:
+			if replacement, ok := replacements[x.Name]; ok {
+				x.Name = replacement
+			} else if strings.HasSuffix(x.Name, "768") {
+				x.Name = strings.TrimSuffix(x.Name, "768") + "1024"
+			}
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by generate1024.go. DO NOT EDIT.\n\n")
+	if err := format.Node(&buf, fset, f); err != nil {
+		log.Fatal(err)
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*outputFile, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
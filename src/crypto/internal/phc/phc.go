@@ -0,0 +1,166 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phc implements the PHC string format used to store password hashes,
+// as specified at https://github.com/P-H-C/phc-string-format.
+//
+// A PHC string has the form
+//
+//	$<id>[$v=<version>]$<param>=<value>(,<param>=<value>)*$<salt>$<hash>
+//
+// where salt and hash are encoded with unpadded standard base64. The format
+// allows the parameters, salt and hash to be omitted, but the password hashing
+// packages always need all of them, so this package requires them too, and
+// only supports parameters with decimal integer values.
+package phc
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Hash is a parsed PHC string.
+type Hash struct {
+	ID         string
+	HasVersion bool
+	Version    uint32
+	Params     []Param
+	Salt       []byte
+	Hash       []byte
+}
+
+// Param is a named integer parameter of a PHC string.
+type Param struct {
+	Name  string
+	Value uint32
+}
+
+var b64 = base64.RawStdEncoding.Strict()
+
+var errInvalid = errors.New("invalid PHC string")
+
+// Parse parses a PHC string. The returned error does not carry a package
+// prefix, as it's meant to be wrapped by the caller.
+func Parse(s string) (*Hash, error) {
+	fields := strings.Split(s, "$")
+	if len(fields) < 5 || fields[0] != "" {
+		return nil, errInvalid
+	}
+	fields = fields[1:]
+
+	h := &Hash{ID: fields[0]}
+	if !validName(h.ID, 32) {
+		return nil, errInvalid
+	}
+	fields = fields[1:]
+
+	if v, ok := strings.CutPrefix(fields[0], "v="); ok {
+		version, err := parseDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		h.HasVersion, h.Version = true, version
+		fields = fields[1:]
+	}
+	if len(fields) != 3 {
+		return nil, errInvalid
+	}
+
+	for _, p := range strings.Split(fields[0], ",") {
+		name, value, ok := strings.Cut(p, "=")
+		if !ok || !validName(name, 32) {
+			return nil, errInvalid
+		}
+		for _, q := range h.Params {
+			if q.Name == name {
+				return nil, errInvalid
+			}
+		}
+		v, err := parseDecimal(value)
+		if err != nil {
+			return nil, err
+		}
+		h.Params = append(h.Params, Param{Name: name, Value: v})
+	}
+
+	var err error
+	if h.Salt, err = b64.DecodeString(fields[1]); err != nil || len(h.Salt) == 0 {
+		return nil, errInvalid
+	}
+	if h.Hash, err = b64.DecodeString(fields[2]); err != nil || len(h.Hash) == 0 {
+		return nil, errInvalid
+	}
+	return h, nil
+}
+
+// Uint32Params returns the values of the parameters with the given names. It
+// returns an error unless the hash has exactly those parameters, in order.
+func (h *Hash) Uint32Params(names ...string) ([]uint32, error) {
+	if len(h.Params) != len(names) {
+		return nil, errors.New("unexpected PHC parameters")
+	}
+	values := make([]uint32, len(names))
+	for i, p := range h.Params {
+		if p.Name != names[i] {
+			return nil, errors.New("unexpected PHC parameter " + strconv.Quote(p.Name))
+		}
+		values[i] = p.Value
+	}
+	return values, nil
+}
+
+// String returns the PHC string encoding of h.
+func (h *Hash) String() string {
+	var b strings.Builder
+	b.WriteString("$")
+	b.WriteString(h.ID)
+	if h.HasVersion {
+		b.WriteString("$v=")
+		b.WriteString(strconv.FormatUint(uint64(h.Version), 10))
+	}
+	for i, p := range h.Params {
+		if i == 0 {
+			b.WriteString("$")
+		} else {
+			b.WriteString(",")
+		}
+		b.WriteString(p.Name)
+		b.WriteString("=")
+		b.WriteString(strconv.FormatUint(uint64(p.Value), 10))
+	}
+	b.WriteString("$")
+	b.WriteString(b64.EncodeToString(h.Salt))
+	b.WriteString("$")
+	b.WriteString(b64.EncodeToString(h.Hash))
+	return b.String()
+}
+
+// validName reports whether s is a valid function or parameter name: a
+// non-empty sequence of at most max characters in [a-z0-9-].
+func validName(s string, max int) bool {
+	if len(s) == 0 || len(s) > max {
+		return false
+	}
+	for _, c := range []byte(s) {
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDecimal parses a canonical decimal encoding of a 32-bit unsigned
+// integer, without sign or leading zeroes.
+func parseDecimal(s string) (uint32, error) {
+	if len(s) == 0 || len(s) > 1 && s[0] == '0' || s[0] == '+' || s[0] == '-' {
+		return 0, errInvalid
+	}
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, errInvalid
+	}
+	return uint32(v), nil
+}
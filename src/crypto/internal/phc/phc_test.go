@@ -0,0 +1,74 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$scrypt$ln=4,r=2,p=3$AAECAwQFBgcICQoLDA0ODw$Jss6lugVDCI3uav334KGIxxsmhJLo642",
+		"$x-1$a=0$AA$AA",
+	} {
+		h, err := Parse(s)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", s, err)
+			continue
+		}
+		if got := h.String(); got != s {
+			t.Errorf("Parse(%q).String() = %q", s, got)
+		}
+	}
+
+	h, err := Parse("$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$AQID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ID != "argon2id" || !h.HasVersion || h.Version != 19 ||
+		!bytes.Equal(h.Salt, []byte("somesalt")) || !bytes.Equal(h.Hash, []byte{1, 2, 3}) {
+		t.Errorf("unexpected parse result %+v", h)
+	}
+	if v, err := h.Uint32Params("m", "t", "p"); err != nil || v[0] != 65536 || v[1] != 2 || v[2] != 1 {
+		t.Errorf("Uint32Params = %v, %v", v, err)
+	}
+	if _, err := h.Uint32Params("m", "p", "t"); err == nil {
+		t.Error("Uint32Params accepted parameters out of order")
+	}
+	if _, err := h.Uint32Params("m", "t"); err == nil {
+		t.Error("Uint32Params accepted extra parameters")
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"$",
+		"$$a=1$AA$AA",
+		"$A$a=1$AA$AA",
+		"$a$$AA$AA",
+		"$a$b$AA$AA",
+		"$a$b=$AA$AA",
+		"$a$b=x$AA$AA",
+		"$a$b=01$AA$AA",
+		"$a$b=+1$AA$AA",
+		"$a$b=4294967296$AA$AA",
+		"$a$b=1,b=2$AA$AA",
+		"$a$v=1$v=1$b=1$AA$AA",
+		"$a$b=1$$AA",
+		"$a$b=1$AA$",
+		"$a$b=1$AA==$AA",
+		"$a$b=1$AB$AA",
+		"$a$b=1$AA",
+		"$a$b=1$AA$AA$AA",
+		"a$b=1$AA$AA",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) succeeded", s)
+		}
+	}
+}
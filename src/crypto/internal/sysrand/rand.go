@@ -0,0 +1,52 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sysrand provides cryptographically secure random bytes from the
+// operating system. It backs crypto/rand.Reader, and can be used by packages
+// that crypto/rand depends on, such as crypto/cipher.
+package sysrand
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+var firstUse atomic.Bool
+
+func warnBlocked() {
+	println("crypto/rand: blocked for 60 seconds waiting to read random data from the kernel")
+}
+
+// Read fills b with cryptographically secure random bytes from the operating
+// system. It returns an error if and only if b could not be filled entirely.
+//
+// Read is not affected by BoringCrypto, and should only be used by packages
+// that can't use crypto/rand.Reader.
+func Read(b []byte) error {
+	if firstUse.CompareAndSwap(false, true) {
+		// First use of randomness. Start timer to warn about
+		// being blocked on entropy not being available.
+		t := time.AfterFunc(time.Minute, warnBlocked)
+		defer t.Stop()
+	}
+	return read(b)
+}
+
+// batched returns a function that calls f to populate a []byte by chunking it
+// into subslices of, at most, readMax bytes.
+func batched(f func([]byte) error, readMax int) func([]byte) error {
+	return func(out []byte) error {
+		for len(out) > 0 {
+			read := len(out)
+			if read > readMax {
+				read = readMax
+			}
+			if err := f(out[:read]); err != nil {
+				return err
+			}
+			out = out[read:]
+		}
+		return nil
+	}
+}
@@ -4,7 +4,7 @@
 
 //go:build js && wasm
 
-package rand
+package sysrand
 
 import "syscall/js"
 
@@ -15,23 +15,17 @@ const maxGetRandomRead = 64 << 10
 var batchedGetRandom func([]byte) error
 
 func init() {
-	Reader = &reader{}
 	batchedGetRandom = batched(getRandom, maxGetRandomRead)
 }
 
 var jsCrypto = js.Global().Get("crypto")
 var uint8Array = js.Global().Get("Uint8Array")
 
-// reader implements a pseudorandom generator
+// read implements a pseudorandom generator
 // using JavaScript crypto.getRandomValues method.
 // See https://developer.mozilla.org/en-US/docs/Web/API/Crypto/getRandomValues.
-type reader struct{}
-
-func (r *reader) Read(b []byte) (int, error) {
-	if err := batchedGetRandom(b); err != nil {
-		return 0, err
-	}
-	return len(b), nil
+func read(b []byte) error {
+	return batchedGetRandom(b)
 }
 
 func getRandom(b []byte) error {
@@ -0,0 +1,69 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Plan9 cryptographically secure pseudorandom number
+// generator.
+
+package sysrand
+
+import (
+	"internal/byteorder"
+	"internal/chacha8rand"
+	"io"
+	"os"
+	"sync"
+)
+
+const randomDevice = "/dev/random"
+
+// This is a pseudorandom generator that seeds itself by reading from
+// /dev/random. The read function always returns the full amount asked for, or
+// else it returns an error. crypto/aes can't be used here, as it depends on
+// this package through crypto/cipher, so the generator is ChaCha8 with fast
+// key erasure, as used by the runtime.
+
+var (
+	mu      sync.Mutex
+	seeded  sync.Once
+	seedErr error
+	state   chacha8rand.State
+)
+
+func read(b []byte) error {
+	seeded.Do(func() {
+		entropy, err := os.Open(randomDevice)
+		if err != nil {
+			seedErr = err
+			return
+		}
+		defer entropy.Close()
+		var seed [32]byte
+		if _, err := io.ReadFull(entropy, seed[:]); err != nil {
+			seedErr = err
+			return
+		}
+		state.Init(seed)
+	})
+	if seedErr != nil {
+		return seedErr
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for len(b) > 0 {
+		x, ok := state.Next()
+		if !ok {
+			state.Refill()
+			continue
+		}
+		var buf [8]byte
+		byteorder.BePutUint64(buf[:], x)
+		n := copy(b, buf[:])
+		b = b[n:]
+	}
+	state.Reseed()
+
+	return nil
+}
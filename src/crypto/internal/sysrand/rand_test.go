@@ -0,0 +1,31 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sysrand
+
+import (
+	"bytes"
+	"compress/flate"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	// Note that TestRead in crypto/rand covers the larger reads.
+	b := make([]byte, 1e5)
+	if err := Read(b); err != nil {
+		t.Fatal(err)
+	}
+
+	var z bytes.Buffer
+	f, _ := flate.NewWriter(&z, 5)
+	f.Write(b)
+	f.Close()
+	if z.Len() < len(b)*99/100 {
+		t.Fatalf("Compressed %d -> %d", len(b), z.Len())
+	}
+
+	if err := Read(nil); err != nil {
+		t.Fatalf("Read(nil) = %v", err)
+	}
+}
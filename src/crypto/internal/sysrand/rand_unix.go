@@ -0,0 +1,68 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+// Unix cryptographically secure pseudorandom number
+// generator.
+
+package sysrand
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+const urandomDevice = "/dev/urandom"
+
+// altGetRandom if non-nil specifies an OS-specific function to get
+// urandom-style randomness.
+var altGetRandom func([]byte) (err error)
+
+// urandom is urandomDevice, opened on first use if altGetRandom is not
+// available or fails.
+var (
+	urandomMu     sync.Mutex
+	urandom       io.Reader
+	urandomOpened atomic.Bool
+)
+
+func read(b []byte) error {
+	if altGetRandom != nil && altGetRandom(b) == nil {
+		return nil
+	}
+	if !urandomOpened.Load() {
+		urandomMu.Lock()
+		if !urandomOpened.Load() {
+			f, err := os.Open(urandomDevice)
+			if err != nil {
+				urandomMu.Unlock()
+				return err
+			}
+			urandom = hideAgainReader{f}
+			urandomOpened.Store(true)
+		}
+		urandomMu.Unlock()
+	}
+	_, err := io.ReadFull(urandom, b)
+	return err
+}
+
+// hideAgainReader masks EAGAIN reads from /dev/urandom.
+// See golang.org/issue/9205
+type hideAgainReader struct {
+	r io.Reader
+}
+
+func (hr hideAgainReader) Read(p []byte) (n int, err error) {
+	n, err = hr.r.Read(p)
+	if errors.Is(err, syscall.EAGAIN) {
+		err = nil
+	}
+	return
+}
@@ -5,19 +5,12 @@
 // Windows cryptographically secure pseudorandom number
 // generator.
 
-package rand
+package sysrand
 
 import (
 	"internal/syscall/windows"
 )
 
-func init() { Reader = &rngReader{} }
-
-type rngReader struct{}
-
-func (r *rngReader) Read(b []byte) (int, error) {
-	if err := windows.ProcessPrng(b); err != nil {
-		return 0, err
-	}
-	return len(b), nil
+func read(b []byte) error {
+	return windows.ProcessPrng(b)
 }
@@ -0,0 +1,328 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"bytes"
+	"crypto/internal/mlkem"
+	"crypto/rand"
+	"crypto/sha3"
+	"encoding/hex"
+	"testing"
+)
+
+type encapsulationKey interface {
+	Bytes() []byte
+	Encapsulate() ([]byte, []byte)
+}
+
+type decapsulationKey[E encapsulationKey] interface {
+	Bytes() []byte
+	Decapsulate([]byte) ([]byte, error)
+	EncapsulationKey() E
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Run("768", func(t *testing.T) {
+		testRoundTrip(t, GenerateKey768, NewEncapsulationKey768, NewDecapsulationKey768)
+	})
+	t.Run("1024", func(t *testing.T) {
+		testRoundTrip(t, GenerateKey1024, NewEncapsulationKey1024, NewDecapsulationKey1024)
+	})
+}
+
+func testRoundTrip[E encapsulationKey, D decapsulationKey[E]](
+	t *testing.T, generateKey func() (D, error),
+	newEncapsulationKey func([]byte) (E, error),
+	newDecapsulationKey func([]byte) (D, error)) {
+	dk, err := generateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek := dk.EncapsulationKey()
+	Ke, c := ek.Encapsulate()
+	Kd, err := dk.Decapsulate(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(Ke, Kd) {
+		t.Fail()
+	}
+
+	ek1, err := newEncapsulationKey(ek.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ek.Bytes(), ek1.Bytes()) {
+		t.Fail()
+	}
+	dk1, err := newDecapsulationKey(dk.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dk.Bytes(), dk1.Bytes()) {
+		t.Fail()
+	}
+	Kd1, err := dk1.Decapsulate(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(Ke, Kd1) {
+		t.Fail()
+	}
+
+	dk2, err := generateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(dk.EncapsulationKey().Bytes(), dk2.EncapsulationKey().Bytes()) {
+		t.Fail()
+	}
+	if bytes.Equal(dk.Bytes(), dk2.Bytes()) {
+		t.Fail()
+	}
+
+	Ke1, c1 := ek.Encapsulate()
+	if bytes.Equal(c, c1) {
+		t.Fail()
+	}
+	if bytes.Equal(Ke, Ke1) {
+		t.Fail()
+	}
+}
+
+func TestBadLengths(t *testing.T) {
+	t.Run("768", func(t *testing.T) {
+		testBadLengths(t, GenerateKey768, NewEncapsulationKey768, NewDecapsulationKey768)
+	})
+	t.Run("1024", func(t *testing.T) {
+		testBadLengths(t, GenerateKey1024, NewEncapsulationKey1024, NewDecapsulationKey1024)
+	})
+}
+
+func testBadLengths[E encapsulationKey, D decapsulationKey[E]](
+	t *testing.T, generateKey func() (D, error),
+	newEncapsulationKey func([]byte) (E, error),
+	newDecapsulationKey func([]byte) (D, error)) {
+	dk, err := generateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dkBytes := dk.Bytes()
+	ek := dk.EncapsulationKey()
+	ekBytes := dk.EncapsulationKey().Bytes()
+	_, c := ek.Encapsulate()
+
+	for i := 0; i < len(dkBytes)-1; i++ {
+		if _, err := newDecapsulationKey(dkBytes[:i]); err == nil {
+			t.Errorf("expected error for dk length %d", i)
+		}
+	}
+	dkLong := dkBytes
+	for i := 0; i < 100; i++ {
+		dkLong = append(dkLong, 0)
+		if _, err := newDecapsulationKey(dkLong); err == nil {
+			t.Errorf("expected error for dk length %d", len(dkLong))
+		}
+	}
+
+	for i := 0; i < len(ekBytes)-1; i++ {
+		if _, err := newEncapsulationKey(ekBytes[:i]); err == nil {
+			t.Errorf("expected error for ek length %d", i)
+		}
+	}
+	ekLong := ekBytes
+	for i := 0; i < 100; i++ {
+		ekLong = append(ekLong, 0)
+		if _, err := newEncapsulationKey(ekLong); err == nil {
+			t.Errorf("expected error for ek length %d", len(ekLong))
+		}
+	}
+
+	for i := 0; i < len(c)-1; i++ {
+		if _, err := dk.Decapsulate(c[:i]); err == nil {
+			t.Errorf("expected error for c length %d", i)
+		}
+	}
+	cLong := c
+	for i := 0; i < 100; i++ {
+		cLong = append(cLong, 0)
+		if _, err := dk.Decapsulate(cLong); err == nil {
+			t.Errorf("expected error for c length %d", len(cLong))
+		}
+	}
+}
+
+func TestUnreducedEncapsulationKey(t *testing.T) {
+	dk, err := GenerateKey768()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek := dk.EncapsulationKey().Bytes()
+	// Replace the first coefficient of t with q, which is not reduced.
+	ek[0] = 0x01
+	ek[1] = ek[1]&0xf0 | 0x0d
+	if _, err := NewEncapsulationKey768(ek); err == nil {
+		t.Error("expected error for unreduced encapsulation key")
+	}
+}
+
+func TestExpandedKey(t *testing.T) {
+	dk, err := mlkem.GenerateKey768()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := mlkem.TestingOnlyExpandedBytes768(dk)
+	dk1, err := mlkem.TestingOnlyNewDecapsulationKey768(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(mlkem.TestingOnlyExpandedBytes768(dk1), b) {
+		t.Error("expanded key did not round-trip")
+	}
+	K, c := dk.EncapsulationKey().Encapsulate()
+	if K1, err := dk1.Decapsulate(c); err != nil || !bytes.Equal(K, K1) {
+		t.Errorf("Decapsulate = %x, %v; want %x", K1, err, K)
+	}
+
+	// The check of H(ek) of FIPS 203, Section 7.3.
+	b[len(b)-64] ^= 1
+	if _, err := mlkem.TestingOnlyNewDecapsulationKey768(b); err == nil {
+		t.Error("expected error for inconsistent H(ek)")
+	}
+}
+
+type internalEncapsulationKey interface {
+	Bytes() []byte
+	EncapsulateInternal(m *[32]byte) (sharedKey, ciphertext []byte)
+}
+
+type internalDecapsulationKey[E internalEncapsulationKey] interface {
+	Decapsulate([]byte) ([]byte, error)
+	EncapsulationKey() E
+}
+
+// TestAccumulated accumulates 10k vectors and checks the hash of the result, to
+// avoid checking in 150MB of test vectors. The expected values were computed by
+// an independent implementation of FIPS 203, which also reproduces the values
+// of the pq-crystals reference implementation for the initial public draft of
+// FIPS 203, which differs only in the derivation of ρ and σ.
+func TestAccumulated(t *testing.T) {
+	t.Run("768", func(t *testing.T) {
+		n, expected := 10000, "f959d18d3d1180121433bf0e05f11e7908cf9d03edc150b2b07cb90bef5bc1c1"
+		if testing.Short() {
+			n, expected = 100, "8d65b902f28edc683cebee2872962fd165a4d197c9e24ec74caa4470270df0b7"
+		}
+		testAccumulated(t, mlkem.GenerateKeyInternal768, mlkem.TestingOnlyExpandedBytes768,
+			CiphertextSize768, n, expected)
+	})
+	t.Run("1024", func(t *testing.T) {
+		n, expected := 10000, "e3bf82b013307b2e9d47dde791ff6dfc82e694e6382404abdb948b908b75bad5"
+		if testing.Short() {
+			n, expected = 100, "c3ffe9ebecfa479c142656cbfbc6417efa05b77e994fe538eef4daed166363df"
+		}
+		testAccumulated(t, mlkem.GenerateKeyInternal1024, mlkem.TestingOnlyExpandedBytes1024,
+			CiphertextSize1024, n, expected)
+	})
+}
+
+func testAccumulated[E internalEncapsulationKey, D internalDecapsulationKey[E]](
+	t *testing.T, generateKey func(d, z *[32]byte) D, expandedBytes func(D) []byte,
+	ciphertextSize, n int, expected string) {
+	s := sha3.NewSHAKE128()
+	o := sha3.NewSHAKE128()
+	var d, z, msg [32]byte
+	ct1 := make([]byte, ciphertextSize)
+
+	for i := 0; i < n; i++ {
+		s.Read(d[:])
+		s.Read(z[:])
+		dk := generateKey(&d, &z)
+		ek := dk.EncapsulationKey()
+		o.Write(ek.Bytes())
+		o.Write(expandedBytes(dk))
+
+		s.Read(msg[:])
+		k, ct := ek.EncapsulateInternal(&msg)
+		o.Write(ct)
+		o.Write(k)
+
+		kk, err := dk.Decapsulate(ct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(kk, k) {
+			t.Errorf("k: got %x, expected %x", kk, k)
+		}
+
+		s.Read(ct1)
+		k1, err := dk.Decapsulate(ct1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o.Write(k1)
+	}
+
+	sum := make([]byte, 32)
+	o.Read(sum)
+	if got := hex.EncodeToString(sum); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+var sink byte
+
+func BenchmarkKeyGen(b *testing.B) {
+	var d, z [32]byte
+	rand.Read(d[:])
+	rand.Read(z[:])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dk := mlkem.GenerateKeyInternal768(&d, &z)
+		sink ^= dk.EncapsulationKey().Bytes()[0]
+	}
+}
+
+func BenchmarkParseEncapsulationKey(b *testing.B) {
+	var d, z [32]byte
+	rand.Read(d[:])
+	rand.Read(z[:])
+	dk := mlkem.GenerateKeyInternal768(&d, &z)
+	ek := dk.EncapsulationKey().Bytes()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := mlkem.NewEncapsulationKey768(ek)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncaps(b *testing.B) {
+	var d, z, m [32]byte
+	rand.Read(d[:])
+	rand.Read(z[:])
+	rand.Read(m[:])
+	dk := mlkem.GenerateKeyInternal768(&d, &z)
+	ek := dk.EncapsulationKey()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		K, c := ek.EncapsulateInternal(&m)
+		sink ^= c[0] ^ K[0]
+	}
+}
+
+func BenchmarkDecaps(b *testing.B) {
+	dk, err := GenerateKey768()
+	if err != nil {
+		b.Fatal(err)
+	}
+	_, c := dk.EncapsulationKey().Encapsulate()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		K, _ := dk.Decapsulate(c)
+		sink ^= K[0]
+	}
+}
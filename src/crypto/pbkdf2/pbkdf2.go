@@ -0,0 +1,86 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pbkdf2 implements the key derivation function PBKDF2 as defined in
+// [RFC 8018] (PKCS #5 v2.1).
+//
+// A key derivation function is useful when encrypting data based on a password
+// or any other not-fully-random data. It uses a pseudorandom function to derive
+// a secure encryption key based on the password.
+//
+// [RFC 8018]: https://datatracker.ietf.org/doc/html/rfc8018
+package pbkdf2
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+)
+
+// Key derives a key from the password, salt and iteration count, returning a
+// []byte of length keyLength that can be used as cryptographic key. The key is
+// derived based on the method described as PBKDF2 with the HMAC variant using
+// the supplied hash function.
+//
+// For example, to use a HMAC-SHA-1 based PBKDF2 key derivation function, you
+// can get a derived key for e.g. AES-256 (which needs a 32-byte key) by
+// doing:
+//
+//	dk, err := pbkdf2.Key(sha1.New, "some password", salt, 4096, 32)
+//
+// Remember to get a good random salt. At least 8 bytes is recommended by the
+// RFC.
+//
+// Using a higher iteration count will increase the cost of an exhaustive
+// search but will also make derivation proportionally slower.
+//
+// Key returns an error if iter is less than one, or if keyLength is not
+// positive or exceeds the maximum of (2³² - 1) times the output size of the
+// hash.
+func Key[Hash hash.Hash](h func() Hash, password string, salt []byte, iter, keyLength int) ([]byte, error) {
+	if iter < 1 {
+		return nil, errors.New("pbkdf2: iteration count must be at least 1")
+	}
+	if keyLength <= 0 {
+		return nil, errors.New("pbkdf2: key length must be positive")
+	}
+
+	prf := hmac.New(func() hash.Hash { return h() }, []byte(password))
+	hashLen := prf.Size()
+	numBlocks := (keyLength + hashLen - 1) / hashLen
+	if uint64(numBlocks) > 1<<32-1 {
+		return nil, errors.New("pbkdf2: key length too long")
+	}
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	U := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		// N.B.: || means concatenation, ^ means XOR
+		// for each block T_i = U_1 ^ U_2 ^ ... ^ U_iter
+		// U_1 = PRF(password, salt || uint(i))
+		prf.Reset()
+		prf.Write(salt)
+		buf[0] = byte(block >> 24)
+		buf[1] = byte(block >> 16)
+		buf[2] = byte(block >> 8)
+		buf[3] = byte(block)
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		T := dk[len(dk)-hashLen:]
+		copy(U, T)
+
+		// U_n = PRF(password, U_(n-1))
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(U)
+			U = U[:0]
+			U = prf.Sum(U)
+			for x := range U {
+				T[x] ^= U[x]
+			}
+		}
+	}
+	return dk[:keyLength], nil
+}
@@ -6,7 +6,11 @@
 // random number generator.
 package rand
 
-import "io"
+import (
+	"crypto/internal/boring"
+	"crypto/internal/sysrand"
+	"io"
+)
 
 // Reader is a global, shared instance of a cryptographically
 // secure random number generator.
@@ -21,26 +25,26 @@ import "io"
 //   - On wasip1/wasm, Reader uses random_get from wasi_snapshot_preview1.
 var Reader io.Reader
 
+func init() {
+	if boring.Enabled {
+		Reader = boring.RandReader
+		return
+	}
+	Reader = &reader{}
+}
+
+type reader struct{}
+
+func (r *reader) Read(b []byte) (n int, err error) {
+	boring.Unreachable()
+	if err := sysrand.Read(b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
 // Read is a helper function that calls Reader.Read using io.ReadFull.
 // On return, n == len(b) if and only if err == nil.
 func Read(b []byte) (n int, err error) {
 	return io.ReadFull(Reader, b)
 }
-
-// batched returns a function that calls f to populate a []byte by chunking it
-// into subslices of, at most, readMax bytes.
-func batched(f func([]byte) error, readMax int) func([]byte) error {
-	return func(out []byte) error {
-		for len(out) > 0 {
-			read := len(out)
-			if read > readMax {
-				read = readMax
-			}
-			if err := f(out[:read]); err != nil {
-				return err
-			}
-			out = out[read:]
-		}
-		return nil
-	}
-}
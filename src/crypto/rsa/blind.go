@@ -0,0 +1,283 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+// This file implements the RSA blind signature protocol of RFC 9474.
+
+import (
+	"crypto"
+	"crypto/internal/boring"
+	"crypto/internal/fips140/bigmod"
+	"crypto/internal/fips140/rsa"
+	"crypto/sha512"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// BlindVariant is one of the RSABSSA variants defined in RFC 9474, Section 5.
+//
+// A blind signature protocol involves a client, which holds a message, and a
+// signer, which holds a [PrivateKey]. The client prepares the message with
+// [BlindVariant.Prepare], blinds it with [BlindVariant.Blind], and sends the
+// blinded message to the signer. The signer signs it with [BlindSign] without
+// learning the message, and the client turns the blind signature into a
+// regular RSASSA-PSS signature of the prepared message with
+// [BlindVariant.Finalize]. Anyone can then check the signature with
+// [BlindVariant.Verify], without being able to link it to the signing
+// operation.
+type BlindVariant int
+
+const (
+	// BlindSHA384PSSRandomized is RSABSSA-SHA384-PSS-Randomized, which uses
+	// a 48-byte PSS salt and a random message prefix. It is the recommended
+	// variant.
+	BlindSHA384PSSRandomized BlindVariant = iota + 1
+	// BlindSHA384PSSZeroRandomized is RSABSSA-SHA384-PSSZERO-Randomized,
+	// which uses an empty PSS salt and a random message prefix.
+	BlindSHA384PSSZeroRandomized
+	// BlindSHA384PSSDeterministic is RSABSSA-SHA384-PSS-Deterministic, which
+	// uses a 48-byte PSS salt and no message prefix. It must only be used
+	// with messages that have enough entropy on their own.
+	BlindSHA384PSSDeterministic
+	// BlindSHA384PSSZeroDeterministic is RSABSSA-SHA384-PSSZERO-Deterministic,
+	// which uses an empty PSS salt and no message prefix. Signatures of this
+	// variant are deterministic, and it must only be used with messages that
+	// have enough entropy on their own.
+	BlindSHA384PSSZeroDeterministic
+)
+
+// blindPrefixSize is the size of the random message prefix of the randomized
+// variants, per RFC 9474, Section 4.1.
+const blindPrefixSize = 32
+
+func (v BlindVariant) String() string {
+	switch v {
+	case BlindSHA384PSSRandomized:
+		return "RSABSSA-SHA384-PSS-Randomized"
+	case BlindSHA384PSSZeroRandomized:
+		return "RSABSSA-SHA384-PSSZERO-Randomized"
+	case BlindSHA384PSSDeterministic:
+		return "RSABSSA-SHA384-PSS-Deterministic"
+	case BlindSHA384PSSZeroDeterministic:
+		return "RSABSSA-SHA384-PSSZERO-Deterministic"
+	}
+	return "BlindVariant(" + strconv.Itoa(int(v)) + ")"
+}
+
+func (v BlindVariant) valid() bool {
+	return v >= BlindSHA384PSSRandomized && v <= BlindSHA384PSSZeroDeterministic
+}
+
+func (v BlindVariant) saltLength() int {
+	if v == BlindSHA384PSSRandomized || v == BlindSHA384PSSDeterministic {
+		return sha512.Size384
+	}
+	return 0
+}
+
+func (v BlindVariant) randomized() bool {
+	return v == BlindSHA384PSSRandomized || v == BlindSHA384PSSZeroRandomized
+}
+
+var errInvalidBlindVariant = errors.New("crypto/rsa: invalid blind signature variant")
+
+// errBlindBoring is returned by all blind signature operations when
+// BoringCrypto is enabled, since it doesn't implement them.
+var errBlindBoring = errors.New("crypto/rsa: blind signatures are not supported in BoringCrypto mode")
+
+// Prepare returns the message to be blinded, signed and verified in place of
+// msg, as specified in RFC 9474, Section 4.1.
+//
+// For the randomized variants, it prepends 32 random bytes read from rand to
+// msg. For the deterministic variants, it returns a copy of msg and rand is
+// not used. The returned message must be passed to [BlindVariant.Blind],
+// [BlindVariant.Finalize] and [BlindVariant.Verify].
+func (v BlindVariant) Prepare(rand io.Reader, msg []byte) ([]byte, error) {
+	if !v.valid() {
+		return nil, errInvalidBlindVariant
+	}
+	if !v.randomized() {
+		return append([]byte{}, msg...), nil
+	}
+	prepared := make([]byte, blindPrefixSize, blindPrefixSize+len(msg))
+	if _, err := io.ReadFull(rand, prepared); err != nil {
+		return nil, err
+	}
+	return append(prepared, msg...), nil
+}
+
+// Blind blinds the prepared message msg for signing by the holder of the
+// private key corresponding to pub, as specified in RFC 9474, Section 4.2.
+//
+// It returns the blinded message, which must be sent to the signer, and the
+// inverse of the blinding factor, which must be kept secret and passed to
+// [BlindVariant.Finalize] along with the blind signature. Both are as long as
+// the modulus.
+//
+// Random bytes are read from rand for the PSS salt and for the blinding
+// factor. Most applications should use [crypto/rand.Reader] as rand.
+func (v BlindVariant) Blind(rand io.Reader, pub *PublicKey, msg []byte) (blindedMsg, inv []byte, err error) {
+	if !v.valid() {
+		return nil, nil, errInvalidBlindVariant
+	}
+	if boring.Enabled {
+		return nil, nil, errBlindBoring
+	}
+	if err := checkPub(pub); err != nil {
+		return nil, nil, err
+	}
+
+	salt := make([]byte, v.saltLength())
+	if _, err := io.ReadFull(rand, salt); err != nil {
+		return nil, nil, err
+	}
+	digest := sha512.Sum384(msg)
+	em, err := rsa.EMSAPSSEncode(digest[:], pub.N.BitLen()-1, salt, sha512.New384())
+	if err != nil {
+		return nil, nil, fipsError(err)
+	}
+
+	N, err := bigmod.NewModulus(pub.N.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	// The encoded message is shorter than the modulus, so it's always reduced.
+	m, err := bigmod.NewNat().SetBytes(em, N)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := bigmod.NewNat().InverseVarTime(m, N); !ok {
+		return nil, nil, errors.New("crypto/rsa: invalid blind signature input")
+	}
+
+	r, err := randomNat(rand, N)
+	if err != nil {
+		return nil, nil, err
+	}
+	// InverseVarTime leaks r, which would leak the message to the signer, so
+	// invert r * b for a random b instead, and then multiply the result by b.
+	b, err := randomNat(rand, N)
+	if err != nil {
+		return nil, nil, err
+	}
+	rb := bigmod.NewNat().Mod(r, N).Mul(b, N)
+	rInv, ok := bigmod.NewNat().InverseVarTime(rb, N)
+	if !ok {
+		return nil, nil, errors.New("crypto/rsa: blinding error")
+	}
+	rInv.Mul(b, N)
+
+	// z = m * r^e mod n
+	z := bigmod.NewNat().ExpShortVarTime(r, uint(pub.E), N)
+	z.Mul(m, N)
+
+	return z.Bytes(N), rInv.Bytes(N), nil
+}
+
+// randomNat returns a uniformly random value in [1, N), reading from rand.
+func randomNat(rand io.Reader, N *bigmod.Modulus) (*bigmod.Nat, error) {
+	b := make([]byte, N.Size())
+	for {
+		if _, err := io.ReadFull(rand, b); err != nil {
+			return nil, err
+		}
+		// Clear the bits above the size of the modulus, so that each attempt
+		// succeeds with probability at least one half.
+		if excess := len(b)*8 - N.BitLen(); excess > 0 {
+			b[0] &= 0xff >> excess
+		}
+		x, err := bigmod.NewNat().SetBytes(b, N)
+		if err != nil || x.IsZero() == 1 {
+			continue
+		}
+		return x, nil
+	}
+}
+
+// BlindSign signs the blinded message blindedMsg with priv, as specified in
+// RFC 9474, Section 4.3, and returns the blind signature. The signature is
+// computed with the raw RSA private key operation, and the signer learns
+// nothing about the message that was blinded, so the use of blind signatures
+// must be limited to keys that are not used for anything else.
+//
+// The blind signature is the same for all variants, and it must be returned to
+// the client, which obtains the final signature with [BlindVariant.Finalize].
+func BlindSign(priv *PrivateKey, blindedMsg []byte) ([]byte, error) {
+	if boring.Enabled {
+		return nil, errBlindBoring
+	}
+	if err := checkPub(&priv.PublicKey); err != nil {
+		return nil, err
+	}
+	if len(blindedMsg) != priv.Size() {
+		return nil, errors.New("crypto/rsa: invalid blinded message length")
+	}
+	// decrypt checks that the blinded message is lower than the modulus, and
+	// that the signature verifies, per steps 1 through 5.
+	return decrypt(priv, blindedMsg, withCheck)
+}
+
+// Finalize computes the signature of the prepared message msg from the blind
+// signature blindSig returned by [BlindSign] and the inverse of the blinding
+// factor returned by [BlindVariant.Blind], as specified in RFC 9474,
+// Section 4.4. The signature is verified before it's returned.
+func (v BlindVariant) Finalize(pub *PublicKey, msg, blindSig, inv []byte) ([]byte, error) {
+	if !v.valid() {
+		return nil, errInvalidBlindVariant
+	}
+	if boring.Enabled {
+		return nil, errBlindBoring
+	}
+	if err := checkPub(pub); err != nil {
+		return nil, err
+	}
+	if len(blindSig) != pub.Size() || len(inv) != pub.Size() {
+		return nil, errors.New("crypto/rsa: invalid blind signature length")
+	}
+
+	N, err := bigmod.NewModulus(pub.N.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	z, err := bigmod.NewNat().SetBytes(blindSig, N)
+	if err != nil {
+		return nil, ErrVerification
+	}
+	rInv, err := bigmod.NewNat().SetBytes(inv, N)
+	if err != nil {
+		return nil, errors.New("crypto/rsa: invalid blinding factor inverse")
+	}
+	sig := z.Mul(rInv, N).Bytes(N)
+
+	if err := v.Verify(pub, msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// Verify reports whether sig is a valid signature of the prepared message
+// msg by pub. A valid signature is indicated by returning a nil error.
+//
+// Signatures produced by the blind signature protocol are regular RSASSA-PSS
+// signatures with SHA-384 and the salt length of the variant, so this is
+// equivalent to [VerifyPSS] of the SHA-384 digest of msg, except that the salt
+// length is always checked.
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func (v BlindVariant) Verify(pub *PublicKey, msg, sig []byte) error {
+	if !v.valid() {
+		return errInvalidBlindVariant
+	}
+	if boring.Enabled {
+		return errBlindBoring
+	}
+	if err := checkPub(pub); err != nil {
+		return err
+	}
+	digest := sha512.Sum384(msg)
+	return verifyPSS(pub, crypto.SHA384, digest[:], sig, v.saltLength())
+}
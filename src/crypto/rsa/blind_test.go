@@ -0,0 +1,251 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rsa_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/internal/boring"
+	"crypto/rand"
+	. "crypto/rsa"
+	"crypto/sha512"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+)
+
+// rsabssa-vectors.json contains test vectors for the four variants of RFC 9474
+// with a 2048-bit key and the message of RFC 9474, Appendix A. They were
+// generated with an independent implementation, and the final signatures were
+// checked with the RSA-PSS verification of OpenSSL.
+type blindTestVectors struct {
+	N, E, D, P, Q string
+	Msg           string
+	Vectors       []struct {
+		Name        string
+		MsgPrefix   string `json:"msg_prefix"`
+		PreparedMsg string `json:"prepared_msg"`
+		Salt        string
+		Inv         string
+		EncodedMsg  string `json:"encoded_msg"`
+		BlindedMsg  string `json:"blinded_msg"`
+		BlindSig    string `json:"blind_sig"`
+		Sig         string
+	}
+}
+
+var blindVariants = []BlindVariant{
+	BlindSHA384PSSRandomized,
+	BlindSHA384PSSZeroRandomized,
+	BlindSHA384PSSDeterministic,
+	BlindSHA384PSSZeroDeterministic,
+}
+
+func blindSaltLength(v BlindVariant) int {
+	switch v {
+	case BlindSHA384PSSRandomized, BlindSHA384PSSDeterministic:
+		return sha512.Size384
+	}
+	return 0
+}
+
+func fromHexBig(t *testing.T, s string) *big.Int {
+	t.Helper()
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		t.Fatalf("invalid hex integer %q", s)
+	}
+	return n
+}
+
+func TestBlindVectors(t *testing.T) {
+	if boring.Enabled {
+		t.Skip("blind signatures are not supported in BoringCrypto mode")
+	}
+	data, err := os.ReadFile("testdata/rsabssa-vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tv blindTestVectors
+	if err := json.Unmarshal(data, &tv); err != nil {
+		t.Fatal(err)
+	}
+
+	priv := &PrivateKey{
+		PublicKey: PublicKey{
+			N: fromHexBig(t, tv.N),
+			E: int(fromHexBig(t, tv.E).Int64()),
+		},
+		D:      fromHexBig(t, tv.D),
+		Primes: []*big.Int{fromHexBig(t, tv.P), fromHexBig(t, tv.Q)},
+	}
+	if err := priv.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	priv.Precompute()
+	pub := &priv.PublicKey
+	msg := fromHex(tv.Msg)
+
+	variants := make(map[string]BlindVariant)
+	for _, v := range blindVariants {
+		variants[v.String()] = v
+	}
+
+	for _, vec := range tv.Vectors {
+		t.Run(vec.Name, func(t *testing.T) {
+			v, ok := variants[vec.Name]
+			if !ok {
+				t.Fatalf("unknown variant %q", vec.Name)
+			}
+
+			prepared, err := v.Prepare(bytes.NewReader(fromHex(vec.MsgPrefix)), msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fromHex(vec.PreparedMsg); !bytes.Equal(prepared, want) {
+				t.Errorf("Prepare = %x, want %x", prepared, want)
+			}
+
+			// The reader supplies the salt, then the blinding factor r, which
+			// is the inverse of inv, and then the extra factor used to hide r
+			// from the variable-time inversion, which doesn't affect the result.
+			inv := fromHex(vec.Inv)
+			r := new(big.Int).ModInverse(new(big.Int).SetBytes(inv), pub.N)
+			rnd := new(bytes.Buffer)
+			rnd.Write(fromHex(vec.Salt))
+			rnd.Write(r.FillBytes(make([]byte, pub.Size())))
+			rnd.Write(big.NewInt(42).FillBytes(make([]byte, pub.Size())))
+
+			blindedMsg, gotInv, err := v.Blind(rnd, pub, prepared)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fromHex(vec.BlindedMsg); !bytes.Equal(blindedMsg, want) {
+				t.Errorf("Blind message = %x, want %x", blindedMsg, want)
+			}
+			if !bytes.Equal(gotInv, inv) {
+				t.Errorf("Blind inverse = %x, want %x", gotInv, inv)
+			}
+
+			blindSig, err := BlindSign(priv, blindedMsg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fromHex(vec.BlindSig); !bytes.Equal(blindSig, want) {
+				t.Errorf("BlindSign = %x, want %x", blindSig, want)
+			}
+
+			sig, err := v.Finalize(pub, prepared, blindSig, gotInv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fromHex(vec.Sig); !bytes.Equal(sig, want) {
+				t.Errorf("Finalize = %x, want %x", sig, want)
+			}
+
+			if err := v.Verify(pub, prepared, sig); err != nil {
+				t.Errorf("Verify failed: %v", err)
+			}
+			digest := sha512.Sum384(prepared)
+			opts := &PSSOptions{SaltLength: len(fromHex(vec.Salt))}
+			if opts.SaltLength == 0 {
+				opts.SaltLength = PSSSaltLengthAuto
+			}
+			if err := VerifyPSS(pub, crypto.SHA384, digest[:], sig, opts); err != nil {
+				t.Errorf("VerifyPSS failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestBlindRoundTrip(t *testing.T) {
+	if boring.Enabled {
+		t.Skip("blind signatures are not supported in BoringCrypto mode")
+	}
+	priv := test2048Key
+	pub := &priv.PublicKey
+	msg := []byte("hello, world")
+
+	for _, v := range blindVariants {
+		t.Run(v.String(), func(t *testing.T) {
+			prepared, err := v.Prepare(rand.Reader, msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blindedMsg, inv, err := v.Blind(rand.Reader, pub, prepared)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blindSig, err := BlindSign(priv, blindedMsg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := v.Finalize(pub, prepared, blindSig, inv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := v.Verify(pub, prepared, sig); err != nil {
+				t.Errorf("Verify failed: %v", err)
+			}
+
+			if err := v.Verify(pub, append(prepared, 0), sig); err == nil {
+				t.Errorf("Verify succeeded for a different message")
+			}
+			if _, err := v.Finalize(pub, append(prepared, 0), blindSig, inv); err == nil {
+				t.Errorf("Finalize succeeded for a different message")
+			}
+			if _, err := BlindSign(priv, blindedMsg[1:]); err == nil {
+				t.Errorf("BlindSign succeeded for a short blinded message")
+			}
+
+			// The salt length of the variant is enforced on verification.
+			for _, other := range blindVariants {
+				if blindSaltLength(other) == blindSaltLength(v) {
+					continue
+				}
+				if err := other.Verify(pub, prepared, sig); err == nil {
+					t.Errorf("%v verified a %v signature", other, v)
+				}
+			}
+		})
+	}
+}
+
+func TestBlindPrepare(t *testing.T) {
+	msg := []byte("hello, world")
+	for _, v := range blindVariants {
+		prepared, err := v.Prepare(rand.Reader, msg)
+		if err != nil {
+			t.Fatalf("%v: %v", v, err)
+		}
+		switch v {
+		case BlindSHA384PSSRandomized, BlindSHA384PSSZeroRandomized:
+			if len(prepared) != 32+len(msg) || !bytes.Equal(prepared[32:], msg) {
+				t.Errorf("%v: Prepare = %x, want a 32-byte prefix", v, prepared)
+			}
+		default:
+			if !bytes.Equal(prepared, msg) {
+				t.Errorf("%v: Prepare = %x, want %x", v, prepared, msg)
+			}
+		}
+	}
+}
+
+func TestBlindInvalidVariant(t *testing.T) {
+	var v BlindVariant
+	if _, err := v.Prepare(rand.Reader, nil); err == nil {
+		t.Error("Prepare succeeded with an invalid variant")
+	}
+	if _, _, err := v.Blind(rand.Reader, &test2048Key.PublicKey, nil); err == nil {
+		t.Error("Blind succeeded with an invalid variant")
+	}
+	if err := v.Verify(&test2048Key.PublicKey, nil, nil); err == nil {
+		t.Error("Verify succeeded with an invalid variant")
+	}
+	if got, want := v.String(), "BlindVariant(0)"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
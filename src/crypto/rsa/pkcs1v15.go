@@ -5,9 +5,10 @@
 package rsa
 
 import (
-	"bytes"
 	"crypto"
 	"crypto/internal/boring"
+	"crypto/internal/fips140/rsa"
+	"crypto/internal/fips140only"
 	"crypto/internal/randutil"
 	"crypto/subtle"
 	"errors"
@@ -40,6 +41,9 @@ type PKCS1v15DecryptOptions struct {
 // WARNING: use of this function to encrypt plaintexts other than
 // session keys is dangerous. Use RSA OAEP in new protocols.
 func EncryptPKCS1v15(random io.Reader, pub *PublicKey, msg []byte) ([]byte, error) {
+	if fips140only.Enabled {
+		return nil, errPKCS1v15EncryptionFIPS
+	}
 	randutil.MaybeReadByte(random)
 
 	if err := checkPub(pub); err != nil {
@@ -91,6 +95,9 @@ func EncryptPKCS1v15(random io.Reader, pub *PublicKey, msg []byte) ([]byte, erro
 // forge signatures as if they had the private key. See
 // DecryptPKCS1v15SessionKey for a way of solving this problem.
 func DecryptPKCS1v15(random io.Reader, priv *PrivateKey, ciphertext []byte) ([]byte, error) {
+	if fips140only.Enabled {
+		return nil, errPKCS1v15EncryptionFIPS
+	}
 	if err := checkPub(&priv.PublicKey); err != nil {
 		return nil, err
 	}
@@ -152,6 +159,9 @@ func DecryptPKCS1v15(random io.Reader, priv *PrivateKey, ciphertext []byte) ([]b
 //   - [1] RFC 3218, Preventing the Million Message Attack on CMS,
 //     https://www.rfc-editor.org/rfc/rfc3218.html
 func DecryptPKCS1v15SessionKey(random io.Reader, priv *PrivateKey, ciphertext []byte, key []byte) error {
+	if fips140only.Enabled {
+		return errPKCS1v15EncryptionFIPS
+	}
 	if err := checkPub(&priv.PublicKey); err != nil {
 		return err
 	}
@@ -252,27 +262,6 @@ func nonZeroRandomBytes(s []byte, random io.Reader) (err error) {
 	return
 }
 
-// These are ASN1 DER structures:
-//
-//	DigestInfo ::= SEQUENCE {
-//	  digestAlgorithm AlgorithmIdentifier,
-//	  digest OCTET STRING
-//	}
-//
-// For performance, we don't use the generic ASN1 encoder. Rather, we
-// precompute a prefix of the digest value that makes a valid ASN1 DER string
-// with the correct contents.
-var hashPrefixes = map[crypto.Hash][]byte{
-	crypto.MD5:       {0x30, 0x20, 0x30, 0x0c, 0x06, 0x08, 0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x02, 0x05, 0x05, 0x00, 0x04, 0x10},
-	crypto.SHA1:      {0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
-	crypto.SHA224:    {0x30, 0x2d, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x04, 0x05, 0x00, 0x04, 0x1c},
-	crypto.SHA256:    {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
-	crypto.SHA384:    {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
-	crypto.SHA512:    {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
-	crypto.MD5SHA1:   {}, // A special TLS case which doesn't use an ASN1 prefix.
-	crypto.RIPEMD160: {0x30, 0x20, 0x30, 0x08, 0x06, 0x06, 0x28, 0xcf, 0x06, 0x03, 0x00, 0x31, 0x04, 0x14},
-}
-
 // SignPKCS1v15 calculates the signature of hashed using
 // RSASSA-PKCS1-V1_5-SIGN from RSA PKCS #1 v1.5.  Note that hashed must
 // be the result of hashing the input message using the given hash
@@ -286,52 +275,36 @@ var hashPrefixes = map[crypto.Hash][]byte{
 // messages to signatures and identify the signed messages. As ever,
 // signatures provide authenticity, not confidentiality.
 func SignPKCS1v15(random io.Reader, priv *PrivateKey, hash crypto.Hash, hashed []byte) ([]byte, error) {
-	// pkcs1v15ConstructEM is called before boring.SignRSAPKCS1v15 to return
-	// consistent errors, including ErrMessageTooLong.
-	em, err := pkcs1v15ConstructEM(&priv.PublicKey, hash, hashed)
-	if err != nil {
+	if err := checkFIPS140OnlyPrivateKey(priv); err != nil {
 		return nil, err
 	}
-
-	if boring.Enabled {
-		bkey, err := boringPrivateKey(priv)
-		if err != nil {
-			return nil, err
-		}
-		return boring.SignRSAPKCS1v15(bkey, hash, hashed)
+	if err := checkFIPS140OnlyHash(hash); err != nil {
+		return nil, err
 	}
 
-	return decrypt(priv, em, withCheck)
-}
-
-func pkcs1v15ConstructEM(pub *PublicKey, hash crypto.Hash, hashed []byte) ([]byte, error) {
 	// Special case: crypto.Hash(0) is used to indicate that the data is
 	// signed directly.
-	var prefix []byte
+	var hashName string
 	if hash != 0 {
 		if len(hashed) != hash.Size() {
 			return nil, errors.New("crypto/rsa: input must be hashed message")
 		}
-		var ok bool
-		prefix, ok = hashPrefixes[hash]
-		if !ok {
-			return nil, errors.New("crypto/rsa: unsupported hash function")
-		}
+		hashName = hash.String()
 	}
 
-	// EM = 0x00 || 0x01 || PS || 0x00 || T
-	k := pub.Size()
-	if k < len(prefix)+len(hashed)+2+8+1 {
-		return nil, ErrMessageTooLong
+	if boring.Enabled {
+		bkey, err := boringPrivateKey(priv)
+		if err != nil {
+			return nil, err
+		}
+		return boring.SignRSAPKCS1v15(bkey, hash, hashed)
 	}
-	em := make([]byte, k)
-	em[1] = 1
-	for i := 2; i < k-len(prefix)-len(hashed)-1; i++ {
-		em[i] = 0xff
+
+	k, err := fipsPrivateKey(priv)
+	if err != nil {
+		return nil, err
 	}
-	copy(em[k-len(prefix)-len(hashed):], prefix)
-	copy(em[k-len(hashed):], hashed)
-	return em, nil
+	return fipsError2(rsa.SignPKCS1v15(k, hashName, hashed))
 }
 
 // VerifyPKCS1v15 verifies an RSA PKCS #1 v1.5 signature.
@@ -343,6 +316,13 @@ func pkcs1v15ConstructEM(pub *PublicKey, hash crypto.Hash, hashed []byte) ([]byt
 // The inputs are not considered confidential, and may leak through timing side
 // channels, or if an attacker has control of part of the inputs.
 func VerifyPKCS1v15(pub *PublicKey, hash crypto.Hash, hashed []byte, sig []byte) error {
+	if err := checkFIPS140OnlyPublicKey(pub); err != nil {
+		return err
+	}
+	if err := checkFIPS140OnlyHash(hash); err != nil {
+		return err
+	}
+
 	if boring.Enabled {
 		bkey, err := boringPublicKey(pub)
 		if err != nil {
@@ -354,25 +334,13 @@ func VerifyPKCS1v15(pub *PublicKey, hash crypto.Hash, hashed []byte, sig []byte)
 		return nil
 	}
 
-	// RFC 8017 Section 8.2.2: If the length of the signature S is not k
-	// octets (where k is the length in octets of the RSA modulus n), output
-	// "invalid signature" and stop.
-	if pub.Size() != len(sig) {
-		return ErrVerification
+	var hashName string
+	if hash != 0 {
+		hashName = hash.String()
 	}
-
-	em, err := encrypt(pub, sig)
+	k, err := fipsPublicKey(pub)
 	if err != nil {
 		return ErrVerification
 	}
-
-	expected, err := pkcs1v15ConstructEM(pub, hash, hashed)
-	if err != nil {
-		return ErrVerification
-	}
-	if !bytes.Equal(em, expected) {
-		return ErrVerification
-	}
-
-	return nil
+	return fipsError(rsa.VerifyPKCS1v15(k, hashName, hashed, sig))
 }
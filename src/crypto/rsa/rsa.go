@@ -26,9 +26,11 @@ package rsa
 
 import (
 	"crypto"
-	"crypto/internal/bigmod"
 	"crypto/internal/boring"
 	"crypto/internal/boring/bbig"
+	"crypto/internal/fips140/bigmod"
+	"crypto/internal/fips140/rsa"
+	"crypto/internal/fips140only"
 	"crypto/internal/randutil"
 	"crypto/rand"
 	"crypto/subtle"
@@ -108,6 +110,54 @@ func checkPub(pub *PublicKey) error {
 	return nil
 }
 
+var errPKCS1v15EncryptionFIPS = errors.New("crypto/rsa: use of PKCS#1 v1.5 encryption is not allowed in FIPS 140-only mode")
+
+// checkFIPS140OnlyPublicKey returns an error if pub can't be used in FIPS
+// 140-only mode, per SP 800-131A Rev. 2 and FIPS 186-5, Section 5.1.
+func checkFIPS140OnlyPublicKey(pub *PublicKey) error {
+	if !fips140only.Enabled {
+		return nil
+	}
+	if pub.N == nil {
+		return errPublicModulus
+	}
+	if pub.N.BitLen() < 2048 {
+		return errors.New("crypto/rsa: use of keys smaller than 2048 bits is not allowed in FIPS 140-only mode")
+	}
+	if pub.N.BitLen()%2 == 1 {
+		return errors.New("crypto/rsa: use of keys with odd size is not allowed in FIPS 140-only mode")
+	}
+	if pub.E <= 1<<16 {
+		return errors.New("crypto/rsa: use of public exponent <= 2¹⁶ is not allowed in FIPS 140-only mode")
+	}
+	if pub.E&1 == 0 {
+		return errors.New("crypto/rsa: use of even public exponent is not allowed in FIPS 140-only mode")
+	}
+	return nil
+}
+
+// checkFIPS140OnlyPrivateKey is like checkFIPS140OnlyPublicKey, and also
+// rejects multi-prime keys.
+func checkFIPS140OnlyPrivateKey(priv *PrivateKey) error {
+	if err := checkFIPS140OnlyPublicKey(&priv.PublicKey); err != nil {
+		return err
+	}
+	if fips140only.Enabled && len(priv.Primes) != 2 {
+		return errors.New("crypto/rsa: use of multi-prime keys is not allowed in FIPS 140-only mode")
+	}
+	return nil
+}
+
+// checkFIPS140OnlyHash returns an error if h is not an approved hash function
+// and FIPS 140-only mode is enabled. Signing pre-hashed data directly, with h
+// set to zero, is not approved.
+func checkFIPS140OnlyHash(h crypto.Hash) error {
+	if fips140only.Enabled && (!h.Available() || !fips140only.ApprovedHash(h.New())) {
+		return errors.New("crypto/rsa: use of hash functions other than SHA-2 or SHA-3 is not allowed in FIPS 140-only mode")
+	}
+	return nil
+}
+
 // A PrivateKey represents an RSA key
 type PrivateKey struct {
 	PublicKey            // public part.
@@ -218,7 +268,7 @@ type PrecomputedValues struct {
 	// complexity.
 	CRTValues []CRTValue
 
-	n, p, q *bigmod.Modulus // moduli for CRT with Montgomery precomputed constants
+	fips *rsa.PrivateKey // the key imported into the FIPS 140-3 module
 }
 
 // CRTValue contains the precomputed Chinese remainder theorem values.
@@ -297,6 +347,16 @@ func GenerateKey(random io.Reader, bits int) (*PrivateKey, error) {
 func GenerateMultiPrimeKey(random io.Reader, nprimes int, bits int) (*PrivateKey, error) {
 	randutil.MaybeReadByte(random)
 
+	if fips140only.Enabled && bits < 2048 {
+		return nil, errors.New("crypto/rsa: use of keys smaller than 2048 bits is not allowed in FIPS 140-only mode")
+	}
+	if fips140only.Enabled && bits%2 == 1 {
+		return nil, errors.New("crypto/rsa: use of keys with odd size is not allowed in FIPS 140-only mode")
+	}
+	if fips140only.Enabled && nprimes != 2 {
+		return nil, errors.New("crypto/rsa: use of multi-prime keys is not allowed in FIPS 140-only mode")
+	}
+
 	if boring.Enabled && random == boring.RandReader && nprimes == 2 &&
 		(bits == 2048 || bits == 3072 || bits == 4096) {
 		bN, bE, bD, bP, bQ, bDp, bDq, bQinv, err := boring.GenerateKeyRSA(bits)
@@ -316,19 +376,6 @@ func GenerateMultiPrimeKey(random io.Reader, nprimes int, bits int) (*PrivateKey
 			return nil, errors.New("crypto/rsa: generated key exponent too large")
 		}
 
-		mn, err := bigmod.NewModulusFromBig(N)
-		if err != nil {
-			return nil, err
-		}
-		mp, err := bigmod.NewModulusFromBig(P)
-		if err != nil {
-			return nil, err
-		}
-		mq, err := bigmod.NewModulusFromBig(Q)
-		if err != nil {
-			return nil, err
-		}
-
 		key := &PrivateKey{
 			PublicKey: PublicKey{
 				N: N,
@@ -341,11 +388,12 @@ func GenerateMultiPrimeKey(random io.Reader, nprimes int, bits int) (*PrivateKey
 				Dq:        Dq,
 				Qinv:      Qinv,
 				CRTValues: make([]CRTValue, 0), // non-nil, to match Precompute
-				n:         mn,
-				p:         mp,
-				q:         mq,
 			},
 		}
+		key.Precomputed.fips, err = newFIPSPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
 		return key, nil
 	}
 
@@ -434,43 +482,17 @@ NextSetOfPrimes:
 		}
 	}
 
+	// Importing the key into the FIPS 140-3 module runs its pairwise
+	// consistency test.
 	priv.Precompute()
-	return priv, nil
-}
-
-// incCounter increments a four byte, big-endian counter.
-func incCounter(c *[4]byte) {
-	if c[3]++; c[3] != 0 {
-		return
-	}
-	if c[2]++; c[2] != 0 {
-		return
-	}
-	if c[1]++; c[1] != 0 {
-		return
-	}
-	c[0]++
-}
-
-// mgf1XOR XORs the bytes in out with a mask generated using the MGF1 function
-// specified in PKCS #1 v2.1.
-func mgf1XOR(out []byte, hash hash.Hash, seed []byte) {
-	var counter [4]byte
-	var digest []byte
-
-	done := 0
-	for done < len(out) {
-		hash.Write(seed)
-		hash.Write(counter[0:4])
-		digest = hash.Sum(digest[:0])
-		hash.Reset()
-
-		for i := 0; i < len(digest) && done < len(out); i++ {
-			out[done] ^= digest[i]
-			done++
+	if priv.Precomputed.fips == nil {
+		k, err := newFIPSPrivateKey(priv)
+		if err != nil {
+			return nil, err
 		}
-		incCounter(&counter)
+		priv.Precomputed.fips = k
 	}
+	return priv, nil
 }
 
 // ErrMessageTooLong is returned when attempting to encrypt or sign a message
@@ -481,17 +503,11 @@ var ErrMessageTooLong = errors.New("crypto/rsa: message too long for RSA key siz
 func encrypt(pub *PublicKey, plaintext []byte) ([]byte, error) {
 	boring.Unreachable()
 
-	N, err := bigmod.NewModulusFromBig(pub.N)
+	k, err := fipsPublicKey(pub)
 	if err != nil {
 		return nil, err
 	}
-	m, err := bigmod.NewNat().SetBytes(plaintext, N)
-	if err != nil {
-		return nil, err
-	}
-	e := uint(pub.E)
-
-	return bigmod.NewNat().ExpShortVarTime(m, e, N).Bytes(N), nil
+	return fipsError2(rsa.Encrypt(k, plaintext))
 }
 
 // EncryptOAEP encrypts the given message with RSA-OAEP.
@@ -522,6 +538,12 @@ func EncryptOAEP(hash hash.Hash, random io.Reader, pub *PublicKey, msg []byte, l
 	if err := checkPub(pub); err != nil {
 		return nil, err
 	}
+	if err := checkFIPS140OnlyPublicKey(pub); err != nil {
+		return nil, err
+	}
+	if fips140only.Enabled && !fips140only.ApprovedHash(hash) {
+		return nil, errors.New("crypto/rsa: use of hash functions other than SHA-2 or SHA-3 is not allowed in FIPS 140-only mode")
+	}
 	hash.Reset()
 	k := pub.Size()
 	if len(msg) > k-2*hash.Size()-2 {
@@ -537,36 +559,11 @@ func EncryptOAEP(hash hash.Hash, random io.Reader, pub *PublicKey, msg []byte, l
 	}
 	boring.UnreachableExceptTests()
 
-	hash.Write(label)
-	lHash := hash.Sum(nil)
-	hash.Reset()
-
-	em := make([]byte, k)
-	seed := em[1 : 1+hash.Size()]
-	db := em[1+hash.Size():]
-
-	copy(db[0:hash.Size()], lHash)
-	db[len(db)-len(msg)-1] = 1
-	copy(db[len(db)-len(msg):], msg)
-
-	_, err := io.ReadFull(random, seed)
+	fk, err := fipsPublicKey(pub)
 	if err != nil {
 		return nil, err
 	}
-
-	mgf1XOR(db, hash, seed)
-	mgf1XOR(seed, hash, db)
-
-	if boring.Enabled {
-		var bkey *boring.PublicKeyRSA
-		bkey, err = boringPublicKey(pub)
-		if err != nil {
-			return nil, err
-		}
-		return boring.EncryptRSANoPadding(bkey, em)
-	}
-
-	return encrypt(pub, em)
+	return fipsError2(rsa.EncryptOAEP(hash, hash, random, fk, msg, label))
 }
 
 // ErrDecryption represents a failure to decrypt a message.
@@ -577,57 +574,89 @@ var ErrDecryption = errors.New("crypto/rsa: decryption error")
 // It is deliberately vague to avoid adaptive attacks.
 var ErrVerification = errors.New("crypto/rsa: verification error")
 
+// fipsError maps the errors of the FIPS 140-3 module to the ones exposed by
+// this package.
+func fipsError(err error) error {
+	switch err {
+	case rsa.ErrDecryption:
+		return ErrDecryption
+	case rsa.ErrVerification:
+		return ErrVerification
+	case rsa.ErrMessageTooLong:
+		return ErrMessageTooLong
+	}
+	return err
+}
+
+func fipsError2[T any](x T, err error) (T, error) {
+	return x, fipsError(err)
+}
+
+// fipsPublicKey converts pub for use with the FIPS 140-3 module.
+func fipsPublicKey(pub *PublicKey) (*rsa.PublicKey, error) {
+	N, err := bigmod.NewModulus(pub.N.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{N: N, E: pub.E}, nil
+}
+
+// fipsPrivateKey returns priv imported into the FIPS 140-3 module, which is
+// cached by Precompute.
+func fipsPrivateKey(priv *PrivateKey) (*rsa.PrivateKey, error) {
+	if priv.Precomputed.fips != nil {
+		return priv.Precomputed.fips, nil
+	}
+	return newFIPSPrivateKey(priv)
+}
+
+// newFIPSPrivateKey imports priv into the FIPS 140-3 module, which runs its
+// pairwise consistency test. Keys with two primes use the CRT values if they
+// were computed by Precompute.
+func newFIPSPrivateKey(priv *PrivateKey) (*rsa.PrivateKey, error) {
+	N, D := priv.N.Bytes(), priv.D.Bytes()
+	pre := &priv.Precomputed
+	if len(priv.Primes) != 2 || pre.Dp == nil || pre.Dq == nil || pre.Qinv == nil {
+		return rsa.NewPrivateKeyWithoutCRT(N, priv.E, D)
+	}
+	return rsa.NewPrivateKey(N, priv.E, D, priv.Primes[0].Bytes(), priv.Primes[1].Bytes(),
+		pre.Dp.Bytes(), pre.Dq.Bytes(), pre.Qinv.Bytes())
+}
+
 // Precompute performs some calculations that speed up private key operations
 // in the future.
 func (priv *PrivateKey) Precompute() {
-	if priv.Precomputed.n == nil && len(priv.Primes) == 2 {
-		// Precomputed values _should_ always be valid, but if they aren't
-		// just return. We could also panic.
-		var err error
-		priv.Precomputed.n, err = bigmod.NewModulusFromBig(priv.N)
-		if err != nil {
-			return
-		}
-		priv.Precomputed.p, err = bigmod.NewModulusFromBig(priv.Primes[0])
-		if err != nil {
-			// Unset previous values, so we either have everything or nothing
-			priv.Precomputed.n = nil
-			return
-		}
-		priv.Precomputed.q, err = bigmod.NewModulusFromBig(priv.Primes[1])
-		if err != nil {
-			// Unset previous values, so we either have everything or nothing
-			priv.Precomputed.n, priv.Precomputed.p = nil, nil
-			return
-		}
-	}
-
 	// Fill in the backwards-compatibility *big.Int values.
-	if priv.Precomputed.Dp != nil {
-		return
-	}
+	if priv.Precomputed.Dp == nil {
+		priv.Precomputed.Dp = new(big.Int).Sub(priv.Primes[0], bigOne)
+		priv.Precomputed.Dp.Mod(priv.D, priv.Precomputed.Dp)
 
-	priv.Precomputed.Dp = new(big.Int).Sub(priv.Primes[0], bigOne)
-	priv.Precomputed.Dp.Mod(priv.D, priv.Precomputed.Dp)
+		priv.Precomputed.Dq = new(big.Int).Sub(priv.Primes[1], bigOne)
+		priv.Precomputed.Dq.Mod(priv.D, priv.Precomputed.Dq)
 
-	priv.Precomputed.Dq = new(big.Int).Sub(priv.Primes[1], bigOne)
-	priv.Precomputed.Dq.Mod(priv.D, priv.Precomputed.Dq)
+		priv.Precomputed.Qinv = new(big.Int).ModInverse(priv.Primes[1], priv.Primes[0])
 
-	priv.Precomputed.Qinv = new(big.Int).ModInverse(priv.Primes[1], priv.Primes[0])
+		r := new(big.Int).Mul(priv.Primes[0], priv.Primes[1])
+		priv.Precomputed.CRTValues = make([]CRTValue, len(priv.Primes)-2)
+		for i := 2; i < len(priv.Primes); i++ {
+			prime := priv.Primes[i]
+			values := &priv.Precomputed.CRTValues[i-2]
 
-	r := new(big.Int).Mul(priv.Primes[0], priv.Primes[1])
-	priv.Precomputed.CRTValues = make([]CRTValue, len(priv.Primes)-2)
-	for i := 2; i < len(priv.Primes); i++ {
-		prime := priv.Primes[i]
-		values := &priv.Precomputed.CRTValues[i-2]
+			values.Exp = new(big.Int).Sub(prime, bigOne)
+			values.Exp.Mod(priv.D, values.Exp)
 
-		values.Exp = new(big.Int).Sub(prime, bigOne)
-		values.Exp.Mod(priv.D, values.Exp)
+			values.R = new(big.Int).Set(r)
+			values.Coeff = new(big.Int).ModInverse(r, prime)
 
-		values.R = new(big.Int).Set(r)
-		values.Coeff = new(big.Int).ModInverse(r, prime)
+			r.Mul(r, prime)
+		}
+	}
 
-		r.Mul(r, prime)
+	if priv.Precomputed.fips == nil {
+		// Precomputed values _should_ always be valid, but if they aren't
+		// leave the key unset, so that private key operations return the
+		// error. We could also panic.
+		priv.Precomputed.fips, _ = newFIPSPrivateKey(priv)
 	}
 }
 
@@ -642,56 +671,14 @@ func decrypt(priv *PrivateKey, ciphertext []byte, check bool) ([]byte, error) {
 		boring.Unreachable()
 	}
 
-	var (
-		err  error
-		m, c *bigmod.Nat
-		N    *bigmod.Modulus
-		t0   = bigmod.NewNat()
-	)
-	if priv.Precomputed.n == nil {
-		N, err = bigmod.NewModulusFromBig(priv.N)
-		if err != nil {
-			return nil, ErrDecryption
-		}
-		c, err = bigmod.NewNat().SetBytes(ciphertext, N)
-		if err != nil {
-			return nil, ErrDecryption
-		}
-		m = bigmod.NewNat().Exp(c, priv.D.Bytes(), N)
-	} else {
-		N = priv.Precomputed.n
-		P, Q := priv.Precomputed.p, priv.Precomputed.q
-		Qinv, err := bigmod.NewNat().SetBytes(priv.Precomputed.Qinv.Bytes(), P)
-		if err != nil {
-			return nil, ErrDecryption
-		}
-		c, err = bigmod.NewNat().SetBytes(ciphertext, N)
-		if err != nil {
-			return nil, ErrDecryption
-		}
-
-		// m = c ^ Dp mod p
-		m = bigmod.NewNat().Exp(t0.Mod(c, P), priv.Precomputed.Dp.Bytes(), P)
-		// m2 = c ^ Dq mod q
-		m2 := bigmod.NewNat().Exp(t0.Mod(c, Q), priv.Precomputed.Dq.Bytes(), Q)
-		// m = m - m2 mod p
-		m.Sub(t0.Mod(m2, P), P)
-		// m = m * Qinv mod p
-		m.Mul(Qinv, P)
-		// m = m * q mod N
-		m.ExpandFor(N).Mul(t0.Mod(Q.Nat(), N), N)
-		// m = m + m2 mod N
-		m.Add(m2.ExpandFor(N), N)
+	k, err := fipsPrivateKey(priv)
+	if err != nil {
+		return nil, err
 	}
-
 	if check {
-		c1 := bigmod.NewNat().ExpShortVarTime(m, uint(priv.E), N)
-		if c1.Equal(c) != 1 {
-			return nil, ErrDecryption
-		}
+		return fipsError2(rsa.DecryptWithCheck(k, ciphertext))
 	}
-
-	return m.Bytes(N), nil
+	return fipsError2(rsa.DecryptWithoutCheck(k, ciphertext))
 }
 
 // DecryptOAEP decrypts ciphertext using RSA-OAEP.
@@ -712,6 +699,12 @@ func decryptOAEP(hash, mgfHash hash.Hash, random io.Reader, priv *PrivateKey, ci
 	if err := checkPub(&priv.PublicKey); err != nil {
 		return nil, err
 	}
+	if err := checkFIPS140OnlyPrivateKey(priv); err != nil {
+		return nil, err
+	}
+	if fips140only.Enabled && (!fips140only.ApprovedHash(hash) || !fips140only.ApprovedHash(mgfHash)) {
+		return nil, errors.New("crypto/rsa: use of hash functions other than SHA-2 or SHA-3 is not allowed in FIPS 140-only mode")
+	}
 	k := priv.Size()
 	if len(ciphertext) > k ||
 		k < hash.Size()*2+2 {
@@ -730,51 +723,9 @@ func decryptOAEP(hash, mgfHash hash.Hash, random io.Reader, priv *PrivateKey, ci
 		return out, nil
 	}
 
-	em, err := decrypt(priv, ciphertext, noCheck)
+	fk, err := fipsPrivateKey(priv)
 	if err != nil {
 		return nil, err
 	}
-
-	hash.Write(label)
-	lHash := hash.Sum(nil)
-	hash.Reset()
-
-	firstByteIsZero := subtle.ConstantTimeByteEq(em[0], 0)
-
-	seed := em[1 : hash.Size()+1]
-	db := em[hash.Size()+1:]
-
-	mgf1XOR(seed, mgfHash, db)
-	mgf1XOR(db, mgfHash, seed)
-
-	lHash2 := db[0:hash.Size()]
-
-	// We have to validate the plaintext in constant time in order to avoid
-	// attacks like: J. Manger. A Chosen Ciphertext Attack on RSA Optimal
-	// Asymmetric Encryption Padding (OAEP) as Standardized in PKCS #1
-	// v2.0. In J. Kilian, editor, Advances in Cryptology.
-	lHash2Good := subtle.ConstantTimeCompare(lHash, lHash2)
-
-	// The remainder of the plaintext must be zero or more 0x00, followed
-	// by 0x01, followed by the message.
-	//   lookingForIndex: 1 iff we are still looking for the 0x01
-	//   index: the offset of the first 0x01 byte
-	//   invalid: 1 iff we saw a non-zero byte before the 0x01.
-	var lookingForIndex, index, invalid int
-	lookingForIndex = 1
-	rest := db[hash.Size():]
-
-	for i := 0; i < len(rest); i++ {
-		equals0 := subtle.ConstantTimeByteEq(rest[i], 0)
-		equals1 := subtle.ConstantTimeByteEq(rest[i], 1)
-		index = subtle.ConstantTimeSelect(lookingForIndex&equals1, i, index)
-		lookingForIndex = subtle.ConstantTimeSelect(equals1, 0, lookingForIndex)
-		invalid = subtle.ConstantTimeSelect(lookingForIndex&^equals0, 1, invalid)
-	}
-
-	if firstByteIsZero&lHash2Good&^invalid&^lookingForIndex != 1 {
-		return nil, ErrDecryption
-	}
-
-	return rest[index+1:], nil
+	return fipsError2(rsa.DecryptOAEP(hash, mgfHash, fk, ciphertext, label))
 }
@@ -5,6 +5,4 @@
 package rsa
 
 var NonZeroRandomBytes = nonZeroRandomBytes
-var EMSAPSSEncode = emsaPSSEncode
-var EMSAPSSVerify = emsaPSSVerify
 var InvalidSaltLenErr = invalidSaltLenErr
@@ -0,0 +1,159 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scrypt
+
+import (
+	"crypto/internal/phc"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"math/bits"
+)
+
+// Params are the scrypt parameters used by [GenerateFromPassword].
+type Params struct {
+	// N is the CPU/memory cost parameter. It must be a power of two greater
+	// than 1.
+	N int
+	// R is the block size parameter, and P is the parallelization parameter.
+	// They must be positive and satisfy R * P < 2³⁰.
+	R, P int
+	// SaltLength is the length in bytes of the random salt. It must be at
+	// least 8, and 16 is recommended.
+	SaltLength int
+	// KeyLength is the length in bytes of the hash. It must be at least 16.
+	KeyLength int
+}
+
+// defaultParams are the recommended parameters for interactive logins.
+var defaultParams = Params{
+	N:          1 << 15,
+	R:          8,
+	P:          1,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+// ErrMismatchedHashAndPassword is returned from [CompareHashAndPassword] when
+// a password and hash do not match.
+var ErrMismatchedHashAndPassword = errors.New("scrypt: hashedPassword is not the hash of the given password")
+
+func (p *Params) check() error {
+	switch {
+	case p.N <= 1 || p.N&(p.N-1) != 0:
+		return errors.New("scrypt: N must be > 1 and a power of 2")
+	case p.R < 1 || p.P < 1:
+		return errors.New("scrypt: r and p must be positive")
+	case uint64(p.R)*uint64(p.P) >= 1<<30:
+		return errors.New("scrypt: parameters are too large")
+	case p.SaltLength < 8:
+		return errors.New("scrypt: salt must be at least 8 bytes")
+	case p.KeyLength < 16:
+		return errors.New("scrypt: key length must be at least 16 bytes")
+	}
+	return nil
+}
+
+// GenerateFromPassword returns the scrypt hash of the password with a new
+// random salt, encoded as a PHC string along with the parameters.
+//
+// If params is nil, N=32768, r=8 and p=1 are used, with a 16-byte salt and a
+// 32-byte hash.
+func GenerateFromPassword(password []byte, params *Params) (string, error) {
+	if params == nil {
+		params = &defaultParams
+	}
+	if err := params.check(); err != nil {
+		return "", err
+	}
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	dk, err := Key(password, salt, params.N, params.R, params.P, params.KeyLength)
+	if err != nil {
+		return "", err
+	}
+	h := &phc.Hash{
+		ID: "scrypt",
+		Params: []phc.Param{
+			{Name: "ln", Value: uint32(bits.TrailingZeros(uint(params.N)))},
+			{Name: "r", Value: uint32(params.R)},
+			{Name: "p", Value: uint32(params.P)},
+		},
+		Salt: salt,
+		Hash: dk,
+	}
+	return h.String(), nil
+}
+
+// CompareHashAndPassword compares a PHC-encoded scrypt hash with a possible
+// plaintext equivalent. It returns nil on success, [ErrMismatchedHashAndPassword]
+// if the password does not match, or another error if the hash can't be parsed.
+//
+// Verifying a hash requires as much memory and time as computing it, so the
+// hash must come from a trusted source.
+func CompareHashAndPassword(hashedPassword string, password []byte) error {
+	params, h, err := parseHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+	other, err := Key(password, h.Salt, params.N, params.R, params.P, params.KeyLength)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(h.Hash, other) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+// NeedsRehash reports whether the PHC-encoded scrypt hash was computed with
+// something other than exactly the given parameters, in which case the
+// application should compute a new hash with [GenerateFromPassword] the next
+// time it verifies the password successfully.
+//
+// If params is nil, the defaults of [GenerateFromPassword] are used.
+func NeedsRehash(hashedPassword string, params *Params) (bool, error) {
+	if params == nil {
+		params = &defaultParams
+	}
+	p, _, err := parseHash(hashedPassword)
+	if err != nil {
+		return false, err
+	}
+	return *p != *params, nil
+}
+
+func parseHash(s string) (*Params, *phc.Hash, error) {
+	h, err := phc.Parse(s)
+	if err != nil {
+		return nil, nil, errors.New("scrypt: " + err.Error())
+	}
+	if h.ID != "scrypt" {
+		return nil, nil, errors.New("scrypt: unsupported algorithm " + h.ID)
+	}
+	if h.HasVersion {
+		return nil, nil, errors.New("scrypt: unsupported version")
+	}
+	v, err := h.Uint32Params("ln", "r", "p")
+	if err != nil {
+		return nil, nil, errors.New("scrypt: " + err.Error())
+	}
+	if v[0] >= bits.UintSize-2 || v[1] >= 1<<30 || v[2] >= 1<<30 {
+		return nil, nil, errors.New("scrypt: parameters are too large")
+	}
+	params := &Params{
+		N:          1 << v[0],
+		R:          int(v[1]),
+		P:          int(v[2]),
+		SaltLength: len(h.Salt),
+		KeyLength:  len(h.Hash),
+	}
+	if err := params.check(); err != nil {
+		return nil, nil, err
+	}
+	return params, h, nil
+}
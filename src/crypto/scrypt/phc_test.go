@@ -0,0 +1,123 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scrypt
+
+import (
+	"strings"
+	"testing"
+)
+
+// Computed with an independent implementation.
+var phcVectors = []struct {
+	password, hash string
+}{
+	{"password", "$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc"},
+	{"hunter2", "$scrypt$ln=4,r=2,p=3$AAECAwQFBgcICQoLDA0ODw$Jss6lugVDCI3uav334KGIxxsmhJLo642"},
+}
+
+func TestCompareHashAndPassword(t *testing.T) {
+	for _, v := range phcVectors {
+		if err := CompareHashAndPassword(v.hash, []byte(v.password)); err != nil {
+			t.Errorf("%s: %v", v.hash, err)
+		}
+		if err := CompareHashAndPassword(v.hash, []byte(v.password+"x")); err != ErrMismatchedHashAndPassword {
+			t.Errorf("%s: wrong password: got %v, want ErrMismatchedHashAndPassword", v.hash, err)
+		}
+	}
+}
+
+func TestGenerateFromPassword(t *testing.T) {
+	params := &Params{N: 16, R: 4, P: 2, SaltLength: 16, KeyLength: 24}
+	h, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(h, "$scrypt$ln=4,r=4,p=2$") {
+		t.Errorf("unexpected encoding %q", h)
+	}
+	if err := CompareHashAndPassword(h, []byte("hunter2")); err != nil {
+		t.Error(err)
+	}
+	if err := CompareHashAndPassword(h, []byte("hunter3")); err != ErrMismatchedHashAndPassword {
+		t.Errorf("wrong password: got %v, want ErrMismatchedHashAndPassword", err)
+	}
+	h2, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h == h2 {
+		t.Error("two hashes of the same password are equal")
+	}
+
+	for _, p := range []Params{
+		{N: 1, R: 8, P: 1, SaltLength: 16, KeyLength: 32},
+		{N: 24, R: 8, P: 1, SaltLength: 16, KeyLength: 32},
+		{N: 16, R: 0, P: 1, SaltLength: 16, KeyLength: 32},
+		{N: 16, R: 8, P: 0, SaltLength: 16, KeyLength: 32},
+		{N: 16, R: 1 << 15, P: 1 << 15, SaltLength: 16, KeyLength: 32},
+		{N: 16, R: 8, P: 1, SaltLength: 7, KeyLength: 32},
+		{N: 16, R: 8, P: 1, SaltLength: 16, KeyLength: 15},
+	} {
+		if _, err := GenerateFromPassword([]byte("hunter2"), &p); err == nil {
+			t.Errorf("GenerateFromPassword succeeded with %+v", p)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	params := &Params{N: 1024, R: 8, P: 1, SaltLength: 8, KeyLength: 32}
+	for _, tt := range []struct {
+		hash string
+		want bool
+	}{
+		{"$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc", false},
+		{"$scrypt$ln=4,r=2,p=3$AAECAwQFBgcICQoLDA0ODw$Jss6lugVDCI3uav334KGIxxsmhJLo642", true},
+	} {
+		got, err := NeedsRehash(tt.hash, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("NeedsRehash(%q) = %v, want %v", tt.hash, got, tt.want)
+		}
+	}
+
+	h, err := GenerateFromPassword([]byte("hunter2"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := NeedsRehash(h, params); err != nil || got {
+		t.Errorf("NeedsRehash of a fresh hash = %v, %v", got, err)
+	}
+	if got, err := NeedsRehash(h, nil); err != nil || !got {
+		t.Errorf("NeedsRehash with default parameters = %v, %v", got, err)
+	}
+}
+
+func TestInvalidHash(t *testing.T) {
+	for _, h := range []string{
+		"",
+		"scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt2$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$v=1$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$r=8,ln=10,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=10,r=8$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=0,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=70,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=10,r=0,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=10,r=8,p=-1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=10,r=65536,p=65536$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc",
+		"$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEPRk+qarG40BYOh1xe9tMAc=",
+		"$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ$wdXoWEig5T693O7BJbufEP",
+		"$scrypt$ln=10,r=8,p=1$c29tZXNhbHQ",
+	} {
+		if err := CompareHashAndPassword(h, []byte("password")); err == nil || err == ErrMismatchedHashAndPassword {
+			t.Errorf("CompareHashAndPassword(%q) = %v, want parsing error", h, err)
+		}
+		if _, err := NeedsRehash(h, nil); err == nil {
+			t.Errorf("NeedsRehash(%q) succeeded", h)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha3_test
+
+import (
+	"crypto/sha3"
+	"fmt"
+)
+
+func ExampleSum256() {
+	sum := sha3.Sum256([]byte("hello world\n"))
+	fmt.Printf("%x\n", sum)
+	// Output: a8009a7a528d87778c356da3a55d964719e818666a04e4f960c9e2439e35f138
+}
+
+func ExampleNewSHAKE256() {
+	h := sha3.NewSHAKE256()
+	h.Write([]byte("hello world\n"))
+	out := make([]byte, 16)
+	h.Read(out)
+	fmt.Printf("%x\n", out)
+	// Output: 4b7b2eafa0af610fce30bc6fdcdc44ad
+}
@@ -0,0 +1,310 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sha3 implements the SHA-3 hash algorithms and the SHAKE extendable
+// output functions defined in FIPS 202, and the cSHAKE functions defined in
+// NIST SP 800-185.
+package sha3
+
+import (
+	"crypto"
+	"errors"
+	"hash"
+	"internal/byteorder"
+)
+
+func init() {
+	crypto.RegisterHash(crypto.SHA3_224, func() hash.Hash { return New224() })
+	crypto.RegisterHash(crypto.SHA3_256, func() hash.Hash { return New256() })
+	crypto.RegisterHash(crypto.SHA3_384, func() hash.Hash { return New384() })
+	crypto.RegisterHash(crypto.SHA3_512, func() hash.Hash { return New512() })
+}
+
+// Domain separation bytes, which hold the suffix bits that FIPS 202 and
+// SP 800-185 append to the message, followed by the first bit of the
+// padding. Bits are numbered from the least significant up.
+const (
+	dsbyteSHA3   = 0b00000110
+	dsbyteSHAKE  = 0b00011111
+	dsbyteCSHAKE = 0b00000100
+)
+
+const (
+	// maxRate is the largest rate, that of SHAKE128.
+	maxRate = rateK256
+
+	// The rates, in bytes, of the sponges for each security level.
+	rateK256  = (1600 - 256) / 8
+	rateK448  = (1600 - 448) / 8
+	rateK512  = (1600 - 512) / 8
+	rateK768  = (1600 - 768) / 8
+	rateK1024 = (1600 - 1024) / 8
+)
+
+// A spongeDirection is whether data is flowing into the sponge or out of it.
+type spongeDirection uint8
+
+const (
+	spongeAbsorbing spongeDirection = iota
+	spongeSqueezing
+)
+
+// A state is a Keccak sponge, with a buffer for the input or output
+// that does not fill a whole block.
+type state struct {
+	a      [25]uint64 // the Keccak-f[1600] state
+	rate   int        // the number of bytes of the state that are input or output
+	dsbyte byte       // the domain separation byte
+
+	// storage[i:n] is the buffer. When absorbing, it holds the input
+	// that is not yet in a, and i is zero; when squeezing, it holds
+	// the output that was not read yet.
+	i, n    int
+	storage [maxRate]byte
+
+	direction spongeDirection
+}
+
+func (d *state) reset() {
+	d.a = [25]uint64{}
+	d.i, d.n = 0, 0
+	d.direction = spongeAbsorbing
+}
+
+// xorIn xors a block of input into the state.
+func (d *state) xorIn(buf []byte) {
+	for i := 0; len(buf) >= 8; i++ {
+		d.a[i] ^= byteorder.LeUint64(buf)
+		buf = buf[8:]
+	}
+}
+
+// copyOut copies a block of output from the state into the buffer.
+func (d *state) copyOut() {
+	b := d.storage[:d.rate]
+	for i := 0; len(b) >= 8; i++ {
+		byteorder.LePutUint64(b, d.a[i])
+		b = b[8:]
+	}
+}
+
+// write absorbs more data into the sponge.
+func (d *state) write(p []byte) {
+	if d.direction != spongeAbsorbing {
+		panic("sha3: Write after Read")
+	}
+	for len(p) > 0 {
+		if d.n == 0 && len(p) >= d.rate {
+			// Absorb a whole block straight from p.
+			d.xorIn(p[:d.rate])
+			p = p[d.rate:]
+			keccakF1600(&d.a)
+			continue
+		}
+		c := copy(d.storage[d.n:d.rate], p)
+		d.n += c
+		p = p[c:]
+		if d.n == d.rate {
+			d.xorIn(d.storage[:d.rate])
+			d.n = 0
+			keccakF1600(&d.a)
+		}
+	}
+}
+
+// padAndPermute appends the domain separation bits and the padding
+// of FIPS 202, Section 5.1, to the buffered input, absorbs it, and
+// turns the sponge around to squeeze out the first block.
+func (d *state) padAndPermute() {
+	// There is always room for at least one byte, since a full buffer
+	// is absorbed at once. dsbyte includes the first bit of the padding.
+	d.storage[d.n] = d.dsbyte
+	clear(d.storage[d.n+1 : d.rate])
+	// The last bit of the padding is the most significant bit of the
+	// last byte of the block.
+	d.storage[d.rate-1] ^= 0x80
+	d.xorIn(d.storage[:d.rate])
+	keccakF1600(&d.a)
+	d.direction = spongeSqueezing
+	d.copyOut()
+	d.i, d.n = 0, d.rate
+}
+
+// read squeezes any number of bytes out of the sponge.
+func (d *state) read(out []byte) {
+	if d.direction == spongeAbsorbing {
+		d.padAndPermute()
+	}
+	for len(out) > 0 {
+		if d.i == d.n {
+			keccakF1600(&d.a)
+			d.copyOut()
+			d.i = 0
+		}
+		c := copy(out, d.storage[d.i:d.n])
+		d.i += c
+		out = out[c:]
+	}
+}
+
+const (
+	magic         = "sha\x08"
+	marshaledSize = len(magic) + 1 + 1 + 25*8 + maxRate + 1 + 1 + 1
+)
+
+func (d *state) appendBinary(b []byte) []byte {
+	b = append(b, magic...)
+	b = append(b, d.dsbyte, byte(d.rate))
+	for _, v := range d.a {
+		b = byteorder.LeAppendUint64(b, v)
+	}
+	b = append(b, d.storage[:]...)
+	b = append(b, byte(d.i), byte(d.n), byte(d.direction))
+	return b
+}
+
+// unmarshalBinary restores a state saved by appendBinary. The saved
+// state must be of the same function as d. It returns the rest of b.
+func (d *state) unmarshalBinary(b []byte) ([]byte, error) {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return nil, errors.New("crypto/sha3: invalid hash state identifier")
+	}
+	if len(b) < marshaledSize {
+		return nil, errors.New("crypto/sha3: invalid hash state size")
+	}
+	b = b[len(magic):]
+	if b[0] != d.dsbyte || int(b[1]) != d.rate {
+		return nil, errors.New("crypto/sha3: invalid hash state function")
+	}
+	b = b[2:]
+	var a [25]uint64
+	for i := range a {
+		a[i] = byteorder.LeUint64(b)
+		b = b[8:]
+	}
+	var storage [maxRate]byte
+	b = b[copy(storage[:], b):]
+	i, n, direction := int(b[0]), int(b[1]), spongeDirection(b[2])
+	b = b[3:]
+	switch {
+	case direction == spongeAbsorbing && i == 0 && n < d.rate:
+	case direction == spongeSqueezing && i <= d.rate && n == d.rate:
+	default:
+		return nil, errors.New("crypto/sha3: invalid hash state")
+	}
+	d.a, d.storage = a, storage
+	d.i, d.n, d.direction = i, n, direction
+	return b, nil
+}
+
+// SHA3 is an instance of a SHA-3 hash. It implements [hash.Hash],
+// [encoding.BinaryMarshaler], and [encoding.BinaryUnmarshaler].
+type SHA3 struct {
+	s state
+}
+
+// New224 returns a new SHA3 hash computing the SHA3-224 checksum.
+func New224() *SHA3 {
+	return &SHA3{state{rate: rateK448, dsbyte: dsbyteSHA3}}
+}
+
+// New256 returns a new SHA3 hash computing the SHA3-256 checksum.
+func New256() *SHA3 {
+	return &SHA3{state{rate: rateK512, dsbyte: dsbyteSHA3}}
+}
+
+// New384 returns a new SHA3 hash computing the SHA3-384 checksum.
+func New384() *SHA3 {
+	return &SHA3{state{rate: rateK768, dsbyte: dsbyteSHA3}}
+}
+
+// New512 returns a new SHA3 hash computing the SHA3-512 checksum.
+func New512() *SHA3 {
+	return &SHA3{state{rate: rateK1024, dsbyte: dsbyteSHA3}}
+}
+
+// Write absorbs more data into the hash's state.
+func (s *SHA3) Write(p []byte) (n int, err error) {
+	s.s.write(p)
+	return len(p), nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (s *SHA3) Sum(b []byte) []byte {
+	d := s.s
+	var out [64]byte
+	d.read(out[:s.Size()])
+	return append(b, out[:s.Size()]...)
+}
+
+// Reset resets the hash to its initial state.
+func (s *SHA3) Reset() {
+	s.s.reset()
+}
+
+// Size returns the number of bytes Sum will produce.
+func (s *SHA3) Size() int {
+	// The capacity of the sponge is twice the output size.
+	return (200 - s.s.rate) / 2
+}
+
+// BlockSize returns the hash's rate.
+func (s *SHA3) BlockSize() int {
+	return s.s.rate
+}
+
+// MarshalBinary returns the state of the hash.
+func (s *SHA3) MarshalBinary() ([]byte, error) {
+	return s.s.appendBinary(make([]byte, 0, marshaledSize)), nil
+}
+
+// UnmarshalBinary restores a state returned by MarshalBinary.
+// It must be the state of a hash of the same size as s.
+func (s *SHA3) UnmarshalBinary(data []byte) error {
+	b, err := s.s.unmarshalBinary(data)
+	if err != nil {
+		return err
+	}
+	if len(b) != 0 {
+		return errors.New("crypto/sha3: invalid hash state size")
+	}
+	return nil
+}
+
+// Sum224 returns the SHA3-224 hash of data.
+func Sum224(data []byte) [28]byte {
+	var out [28]byte
+	h := New224()
+	h.Write(data)
+	h.s.read(out[:])
+	return out
+}
+
+// Sum256 returns the SHA3-256 hash of data.
+func Sum256(data []byte) [32]byte {
+	var out [32]byte
+	h := New256()
+	h.Write(data)
+	h.s.read(out[:])
+	return out
+}
+
+// Sum384 returns the SHA3-384 hash of data.
+func Sum384(data []byte) [48]byte {
+	var out [48]byte
+	h := New384()
+	h.Write(data)
+	h.s.read(out[:])
+	return out
+}
+
+// Sum512 returns the SHA3-512 hash of data.
+func Sum512(data []byte) [64]byte {
+	var out [64]byte
+	h := New512()
+	h.Write(data)
+	h.s.read(out[:])
+	return out
+}
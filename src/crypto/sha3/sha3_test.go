@@ -0,0 +1,329 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha3
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/internal/cryptotest"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+)
+
+var hashes = []struct {
+	name string
+	new  func() *SHA3
+	sum  func([]byte) []byte
+}{
+	{"SHA3-224", New224, func(b []byte) []byte { s := Sum224(b); return s[:] }},
+	{"SHA3-256", New256, func(b []byte) []byte { s := Sum256(b); return s[:] }},
+	{"SHA3-384", New384, func(b []byte) []byte { s := Sum384(b); return s[:] }},
+	{"SHA3-512", New512, func(b []byte) []byte { s := Sum512(b); return s[:] }},
+}
+
+var golden = map[string][]struct {
+	in, out string
+}{
+	"SHA3-224": {
+		{"", "6b4e03423667dbb73b6e15454f0eb1abd4597f9a1b078e3f5b5a6bc7"},
+		{"abc", "e642824c3f8cf24ad09234ee7d3c766fc9a3a5168d0c94ad73b46fdf"},
+		{strings.Repeat("a", 200), "455e0ccfc6010738ed93a793dffd79aff36debbd1a7eb6621bd6c722"},
+	},
+	"SHA3-256": {
+		{"", "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a"},
+		{"abc", "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"},
+		{strings.Repeat("a", 200), "cce34485baf2bf2aca99b94833892a4f52896d3d153f7b840cc4f9fe695f1387"},
+	},
+	"SHA3-384": {
+		{"", "0c63a75b845e4f7d01107d852e4c2485c51a50aaaa94fc61995e71bbee983a2ac3713831264adb47fb6bd1e058d5f004"},
+		{"abc", "ec01498288516fc926459f58e2c6ad8df9b473cb0fc08c2596da7cf0e49be4b298d88cea927ac7f539f1edf228376d25"},
+		{strings.Repeat("a", 200), "f97756776c1874724c94a8008f7f155553b4bf00fbf8fbeac246624ad59c258a3c0977d9f2543d7cbd75b9ac8fdc0d40"},
+	},
+	"SHA3-512": {
+		{"", "a69f73cca23a9ac5c8b567dc185a756e97c982164fe25859e0d1dcc1475c80a615b2123af1f5f94c11e3e9402c3ac558f500199d95b6d3e301758586281dcd26"},
+		{"abc", "b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0"},
+		{strings.Repeat("a", 200), "eae6c85c6904f11075de9f9d5e1064371d000510fa3d2d79d40cf9be34892fb01859d0a0234e138bcb0ad5c84f6c0dca226a414b0c9a2897cb695f5185fe36ec"},
+	},
+}
+
+func TestGolden(t *testing.T) {
+	for _, h := range hashes {
+		for _, g := range golden[h.name] {
+			if got := hex.EncodeToString(h.sum([]byte(g.in))); got != g.out {
+				t.Errorf("%s Sum(%q) = %s, want %s", h.name, g.in, got, g.out)
+			}
+			d := h.new()
+			for i := 0; i < 3; i++ {
+				if i < 2 {
+					d.Write([]byte(g.in))
+				} else {
+					d.Write([]byte(g.in[:len(g.in)/2]))
+					d.Sum(nil)
+					d.Write([]byte(g.in[len(g.in)/2:]))
+				}
+				if got := hex.EncodeToString(d.Sum(nil)); got != g.out {
+					t.Errorf("%s[%d](%q) = %s, want %s", h.name, i, g.in, got, g.out)
+				}
+				d.Reset()
+			}
+		}
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	for _, tt := range []struct {
+		h    crypto.Hash
+		name string
+	}{
+		{crypto.SHA3_224, "SHA3-224"},
+		{crypto.SHA3_256, "SHA3-256"},
+		{crypto.SHA3_384, "SHA3-384"},
+		{crypto.SHA3_512, "SHA3-512"},
+	} {
+		if !tt.h.Available() {
+			t.Errorf("%v is not available", tt.h)
+			continue
+		}
+		h := tt.h.New()
+		if h.Size() != tt.h.Size() {
+			t.Errorf("%v: Size() = %d, want %d", tt.h, h.Size(), tt.h.Size())
+		}
+		h.Write([]byte("abc"))
+		if got, want := hex.EncodeToString(h.Sum(nil)), golden[tt.name][1].out; got != want {
+			t.Errorf("%v: got %s, want %s", tt.h, got, want)
+		}
+	}
+}
+
+func TestSHAKE(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func() *SHAKE
+		in   string
+		n    int
+		out  string // the last bytes of the output
+	}{
+		{"SHAKE128", NewSHAKE128, "", 32, "7f9c2ba4e88f827d616045507605853ed73b8093f6efbc88eb1a6eacfa66ef26"},
+		{"SHAKE256", NewSHAKE256, "", 64, "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be"},
+		{"SHAKE128", NewSHAKE128, "abc", 300, "6bdb2e06a3eed543a38919b57ecbec737f4086be"},
+		{"SHAKE256", NewSHAKE256, "abc", 300, "66caa7d8ddcbec7da52b42215c11d5f8ee57f341"},
+		// NIST SP 800-185 cSHAKE samples.
+		{"cSHAKE128", func() *SHAKE { return NewCSHAKE128(nil, []byte("Email Signature")) },
+			"\x00\x01\x02\x03", 32, "c1c36925b6409a04f1b504fcbca9d82b4017277cb5ed2b2065fc1d3814d5aaf5"},
+		{"cSHAKE256", func() *SHAKE { return NewCSHAKE256(nil, []byte("Email Signature")) },
+			"\x00\x01\x02\x03", 64, "d008828e2b80ac9d2218ffee1d070c48b8e4c87bff32c9699d5b6896eee0edd164020e2be0560858d9c00c037e34a96937c561a74c412bb4c746469527281c8c"},
+	}
+	for _, tt := range tests {
+		h := tt.new()
+		for i := 0; i < 2; i++ {
+			h.Write([]byte(tt.in))
+			// Read the output in pieces of varying sizes.
+			out := make([]byte, tt.n)
+			for b, size := out, 1; len(b) > 0; size++ {
+				n := min(size, len(b))
+				h.Read(b[:n])
+				b = b[n:]
+			}
+			if got := hex.EncodeToString(out); !strings.HasSuffix(got, tt.out) {
+				t.Errorf("%s(%q)[%d] = %s, want suffix %s", tt.name, tt.in, i, got, tt.out)
+			}
+			h.Reset()
+		}
+	}
+
+	if got := hex.EncodeToString(SumSHAKE128(nil, 32)); got != tests[0].out {
+		t.Errorf("SumSHAKE128 = %s, want %s", got, tests[0].out)
+	}
+	if got := hex.EncodeToString(SumSHAKE256(nil, 64)); got != tests[1].out {
+		t.Errorf("SumSHAKE256 = %s, want %s", got, tests[1].out)
+	}
+	if got := hex.EncodeToString(SumSHAKE128([]byte("abc"), 300)); !strings.HasSuffix(got, tests[2].out) {
+		t.Errorf("SumSHAKE128 = %s, want suffix %s", got, tests[2].out)
+	}
+}
+
+func TestCSHAKEEmpty(t *testing.T) {
+	for _, tt := range []struct {
+		cshake, shake *SHAKE
+	}{
+		{NewCSHAKE128(nil, nil), NewSHAKE128()},
+		{NewCSHAKE256(nil, nil), NewSHAKE256()},
+	} {
+		tt.cshake.Write([]byte("abc"))
+		tt.shake.Write([]byte("abc"))
+		a, b := make([]byte, 100), make([]byte, 100)
+		tt.cshake.Read(a)
+		tt.shake.Read(b)
+		if !bytes.Equal(a, b) {
+			t.Errorf("cSHAKE with empty N and S = %x, want %x", a, b)
+		}
+	}
+}
+
+func TestWriteAfterRead(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Write after Read did not panic")
+		}
+	}()
+	h := NewSHAKE128()
+	h.Read(make([]byte, 1))
+	h.Write([]byte("x"))
+}
+
+func TestMarshalSHA3(t *testing.T) {
+	msg := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 10))
+	for _, h := range hashes {
+		for _, split := range []int{0, 1, 71, 72, 143, 144, len(msg)} {
+			want := h.sum(msg)
+
+			d := h.new()
+			d.Write(msg[:split])
+			state, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			d2 := h.new()
+			if err := d2.UnmarshalBinary(state); err != nil {
+				t.Fatalf("%s: %v", h.name, err)
+			}
+			d2.Write(msg[split:])
+			if got := d2.Sum(nil); !bytes.Equal(got, want) {
+				t.Errorf("%s split at %d: got %x, want %x", h.name, split, got, want)
+			}
+		}
+	}
+
+	state, _ := New256().MarshalBinary()
+	if err := New512().UnmarshalBinary(state); err == nil {
+		t.Error("SHA3-256 state was accepted by SHA3-512")
+	}
+	if err := NewSHAKE256().UnmarshalBinary(state); err == nil {
+		t.Error("SHA3-256 state was accepted by SHAKE256")
+	}
+	if err := New256().UnmarshalBinary(state[:len(state)-1]); err == nil {
+		t.Error("short state was accepted")
+	}
+	if err := New256().UnmarshalBinary(append(state, 0)); err == nil {
+		t.Error("long state was accepted")
+	}
+}
+
+func TestMarshalSHAKE(t *testing.T) {
+	msg := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 10))
+	for _, newSHAKE := range []func() *SHAKE{
+		NewSHAKE128,
+		NewSHAKE256,
+		func() *SHAKE { return NewCSHAKE128([]byte("N"), []byte("S")) },
+		func() *SHAKE { return NewCSHAKE256(nil, []byte("Email Signature")) },
+	} {
+		want := make([]byte, 500)
+		h := newSHAKE()
+		h.Write(msg)
+		h.Read(want)
+
+		// Save the state while absorbing.
+		h = newSHAKE()
+		h.Write(msg[:100])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		h2 := newSHAKE()
+		if err := h2.UnmarshalBinary(state); err != nil {
+			t.Fatal(err)
+		}
+		h2.Write(msg[100:])
+		got := make([]byte, 500)
+		h2.Read(got)
+		if !bytes.Equal(got, want) {
+			t.Errorf("absorbing: got %x, want %x", got, want)
+		}
+
+		// Save the state while squeezing, and after a reset.
+		h = newSHAKE()
+		h.Write(msg)
+		h.Read(got[:250])
+		state, _ = h.MarshalBinary()
+		h2 = newSHAKE()
+		if err := h2.UnmarshalBinary(state); err != nil {
+			t.Fatal(err)
+		}
+		h2.Read(got[250:])
+		if !bytes.Equal(got, want) {
+			t.Errorf("squeezing: got %x, want %x", got, want)
+		}
+		h2.Reset()
+		h2.Write(msg)
+		h2.Read(got)
+		if !bytes.Equal(got, want) {
+			t.Errorf("after Reset: got %x, want %x", got, want)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	for _, h := range hashes {
+		t.Run(h.name, func(t *testing.T) {
+			cryptotest.TestHash(t, func() hash.Hash { return h.new() })
+		})
+	}
+}
+
+func TestAllocations(t *testing.T) {
+	in := []byte("hello, world!")
+	out := make([]byte, 0, 64)
+	h := New256()
+	s := NewSHAKE256()
+	n := int(testing.AllocsPerRun(10, func() {
+		h.Reset()
+		h.Write(in)
+		out = h.Sum(out[:0])
+		s.Reset()
+		s.Write(in)
+		s.Read(out[:64])
+		Sum512(in)
+		SumSHAKE128(in, 32)
+	}))
+	if n > 0 {
+		t.Errorf("allocs = %d, want 0", n)
+	}
+}
+
+var buf = make([]byte, 8192)
+
+func benchmarkSize(b *testing.B, h hash.Hash, size int) {
+	b.SetBytes(int64(size))
+	sum := make([]byte, 0, h.Size())
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(buf[:size])
+		sum = h.Sum(sum[:0])
+	}
+}
+
+func BenchmarkHash8Bytes(b *testing.B) {
+	benchmarkSize(b, New256(), 8)
+}
+
+func BenchmarkHash1K(b *testing.B) {
+	benchmarkSize(b, New256(), 1024)
+}
+
+func BenchmarkHash8K(b *testing.B) {
+	benchmarkSize(b, New256(), 8192)
+}
+
+func BenchmarkSHAKE128(b *testing.B) {
+	b.SetBytes(int64(len(buf)))
+	h := NewSHAKE128()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(buf[:32])
+		h.Read(buf)
+	}
+}
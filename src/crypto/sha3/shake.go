@@ -0,0 +1,193 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha3
+
+import (
+	"errors"
+	"internal/byteorder"
+)
+
+// SHAKE is an instance of a SHAKE or cSHAKE extendable output function.
+// It implements [io.Writer] and [io.Reader], [encoding.BinaryMarshaler],
+// and [encoding.BinaryUnmarshaler].
+type SHAKE struct {
+	s state
+
+	// initBlock is the encoding of the function name N and the
+	// customization string S of cSHAKE, which is absorbed first,
+	// as specified in SP 800-185, Section 3.3. It is empty for SHAKE.
+	initBlock []byte
+}
+
+// NewSHAKE128 returns a new SHAKE instance computing the SHAKE128 XOF.
+// Its generic security strength is 128 bits against all attacks if at
+// least 32 bytes of its output are used.
+func NewSHAKE128() *SHAKE {
+	return &SHAKE{s: state{rate: rateK256, dsbyte: dsbyteSHAKE}}
+}
+
+// NewSHAKE256 returns a new SHAKE instance computing the SHAKE256 XOF.
+// Its generic security strength is 256 bits against all attacks if at
+// least 64 bytes of its output are used.
+func NewSHAKE256() *SHAKE {
+	return &SHAKE{s: state{rate: rateK512, dsbyte: dsbyteSHAKE}}
+}
+
+// NewCSHAKE128 returns a new SHAKE instance computing the cSHAKE128 XOF.
+//
+// N is used to define functions based on cSHAKE, and can be empty when
+// plain cSHAKE is desired. S is a customization byte string used for domain
+// separation. When N and S are both empty, this is equivalent to NewSHAKE128.
+func NewCSHAKE128(N, S []byte) *SHAKE {
+	if len(N) == 0 && len(S) == 0 {
+		return NewSHAKE128()
+	}
+	return newCSHAKE(N, S, rateK256)
+}
+
+// NewCSHAKE256 returns a new SHAKE instance computing the cSHAKE256 XOF.
+//
+// N is used to define functions based on cSHAKE, and can be empty when
+// plain cSHAKE is desired. S is a customization byte string used for domain
+// separation. When N and S are both empty, this is equivalent to NewSHAKE256.
+func NewCSHAKE256(N, S []byte) *SHAKE {
+	if len(N) == 0 && len(S) == 0 {
+		return NewSHAKE256()
+	}
+	return newCSHAKE(N, S, rateK512)
+}
+
+func newCSHAKE(N, S []byte, rate int) *SHAKE {
+	s := &SHAKE{s: state{rate: rate, dsbyte: dsbyteCSHAKE}}
+	b := make([]byte, 0, 9+9+len(N)+9+len(S))
+	b = appendLeftEncode(b, uint64(len(N))*8)
+	b = append(b, N...)
+	b = appendLeftEncode(b, uint64(len(S))*8)
+	b = append(b, S...)
+	s.initBlock = b
+	s.absorbInitBlock()
+	return s
+}
+
+// absorbInitBlock absorbs bytepad(initBlock, rate), where initBlock
+// is already encode_string(N) || encode_string(S).
+func (s *SHAKE) absorbInitBlock() {
+	if s.initBlock == nil {
+		return
+	}
+	var b [9]byte
+	s.s.write(appendLeftEncode(b[:0], uint64(s.s.rate)))
+	s.s.write(s.initBlock)
+	if n := s.s.n; n != 0 {
+		var zero [maxRate]byte
+		s.s.write(zero[:s.s.rate-n])
+	}
+}
+
+// appendLeftEncode appends the left_encode of x, as specified in
+// SP 800-185, Section 2.3.1: its big-endian encoding, with no leading
+// zeroes but at least one byte, prefixed by its length.
+func appendLeftEncode(b []byte, x uint64) []byte {
+	var enc [9]byte
+	byteorder.BePutUint64(enc[1:], x)
+	i := 1
+	for i < 8 && enc[i] == 0 {
+		i++
+	}
+	enc[i-1] = byte(9 - i)
+	return append(b, enc[i-1:]...)
+}
+
+// Write absorbs more data into the XOF's state.
+//
+// It panics if any output has already been read.
+func (s *SHAKE) Write(p []byte) (n int, err error) {
+	s.s.write(p)
+	return len(p), nil
+}
+
+// Read squeezes more output from the XOF.
+//
+// Any call to Write after a call to Read will panic.
+func (s *SHAKE) Read(p []byte) (n int, err error) {
+	s.s.read(p)
+	return len(p), nil
+}
+
+// Reset resets the XOF to its initial state.
+func (s *SHAKE) Reset() {
+	s.s.reset()
+	s.absorbInitBlock()
+}
+
+// BlockSize returns the rate of the XOF.
+func (s *SHAKE) BlockSize() int {
+	return s.s.rate
+}
+
+// MarshalBinary returns the state of the XOF.
+func (s *SHAKE) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize+len(s.initBlock))
+	b = s.s.appendBinary(b)
+	return append(b, s.initBlock...), nil
+}
+
+// UnmarshalBinary restores a state returned by MarshalBinary.
+// It must be the state of the same function as s, but cSHAKE
+// states may be of any function name and customization string.
+func (s *SHAKE) UnmarshalBinary(data []byte) error {
+	b, err := s.s.unmarshalBinary(data)
+	if err != nil {
+		return err
+	}
+	if s.s.dsbyte != dsbyteCSHAKE {
+		if len(b) != 0 {
+			return errors.New("crypto/sha3: invalid hash state size")
+		}
+		return nil
+	}
+	s.initBlock = append([]byte(nil), b...)
+	return nil
+}
+
+// SumSHAKE128 applies the SHAKE128 extendable output function to data and
+// returns an output of the given length in bytes.
+func SumSHAKE128(data []byte, length int) []byte {
+	// Outline the allocation for up to 256 bits of output to the caller's stack.
+	out := make([]byte, 32)
+	return sumSHAKE128(out, data, length)
+}
+
+func sumSHAKE128(out, data []byte, length int) []byte {
+	if len(out) < length {
+		out = make([]byte, length)
+	} else {
+		out = out[:length]
+	}
+	h := NewSHAKE128()
+	h.Write(data)
+	h.Read(out)
+	return out
+}
+
+// SumSHAKE256 applies the SHAKE256 extendable output function to data and
+// returns an output of the given length in bytes.
+func SumSHAKE256(data []byte, length int) []byte {
+	// Outline the allocation for up to 512 bits of output to the caller's stack.
+	out := make([]byte, 64)
+	return sumSHAKE256(out, data, length)
+}
+
+func sumSHAKE256(out, data []byte, length int) []byte {
+	if len(out) < length {
+		out = make([]byte, length)
+	} else {
+		out = out[:length]
+	}
+	h := NewSHAKE256()
+	h.Write(data)
+	h.Read(out)
+	return out
+}
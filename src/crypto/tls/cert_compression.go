@@ -0,0 +1,157 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"compress/brotli"
+	"compress/zlib"
+	"compress/zstd"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+)
+
+// This file implements certificate compression, as specified in RFC 8879.
+
+// supportedCertCompression reports whether alg is implemented.
+func supportedCertCompression(alg CertificateCompressionAlgorithm) bool {
+	switch alg {
+	case CertificateCompressionZlib, CertificateCompressionBrotli, CertificateCompressionZstd:
+		return true
+	}
+	return false
+}
+
+// certCompressionAlgorithms returns the algorithms of CertificateCompression
+// that are implemented, in preference order.
+func (c *Config) certCompressionAlgorithms() []CertificateCompressionAlgorithm {
+	var algs []CertificateCompressionAlgorithm
+	for _, alg := range c.CertificateCompression {
+		if supportedCertCompression(alg) && !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
+// A certCompressor is a compressor that can be reused with Reset.
+type certCompressor interface {
+	io.WriteCloser
+	Reset(io.Writer)
+}
+
+var certCompressors = map[CertificateCompressionAlgorithm]*sync.Pool{
+	CertificateCompressionZlib: {
+		New: func() any { return zlib.NewWriter(nil) },
+	},
+	CertificateCompressionBrotli: {
+		New: func() any { return brotli.NewWriter(nil) },
+	},
+	CertificateCompressionZstd: {
+		New: func() any { return zstd.NewWriter(nil) },
+	},
+}
+
+// compressCertificateMsg returns the message to send in place of certMsg.
+// If the peer supports one of the configured algorithms, that is a
+// CompressedCertificate message using the first of them. Otherwise,
+// it's certMsg itself.
+func (c *Conn) compressCertificateMsg(certMsg *certificateMsgTLS13, peerAlgs []CertificateCompressionAlgorithm) (handshakeMessage, error) {
+	algs := c.config.certCompressionAlgorithms()
+	i := slices.IndexFunc(algs, func(alg CertificateCompressionAlgorithm) bool {
+		return slices.Contains(peerAlgs, alg)
+	})
+	if i < 0 {
+		return certMsg, nil
+	}
+	alg := algs[i]
+
+	data, err := certMsg.marshal()
+	if err != nil {
+		return nil, err
+	}
+	// The compressed message doesn't include the handshake message header.
+	data = data[4:]
+
+	var buf bytes.Buffer
+	pool := certCompressors[alg]
+	w := pool.Get().(certCompressor)
+	defer pool.Put(w)
+	w.Reset(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &compressedCertificateMsg{
+		algorithm:          alg,
+		uncompressedLength: uint32(len(data)),
+		compressed:         buf.Bytes(),
+	}, nil
+}
+
+// decompressCertificateMsg returns the Certificate message carried by msg,
+// which must use one of the algorithms in offered.
+func (c *Conn) decompressCertificateMsg(msg *compressedCertificateMsg, offered []CertificateCompressionAlgorithm) (*certificateMsgTLS13, error) {
+	if !slices.Contains(offered, msg.algorithm) {
+		c.sendAlert(alertIllegalParameter)
+		return nil, fmt.Errorf("tls: received certificate compressed with unexpected algorithm %v", msg.algorithm)
+	}
+	// The length is checked against the limit for uncompressed messages
+	// before decompressing, so that the output is bounded.
+	if msg.uncompressedLength > maxHandshakeCertificateMsg {
+		c.sendAlert(alertBadCertificate)
+		return nil, fmt.Errorf("tls: compressed certificate of length %d bytes exceeds maximum of %d bytes", msg.uncompressedLength, maxHandshakeCertificateMsg)
+	}
+
+	var r io.Reader
+	compressed := bytes.NewReader(msg.compressed)
+	switch msg.algorithm {
+	case CertificateCompressionZlib:
+		zr, err := zlib.NewReader(compressed)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return nil, errors.New("tls: failed to decompress certificate: " + err.Error())
+		}
+		r = zr
+	case CertificateCompressionBrotli:
+		r = brotli.NewReader(compressed)
+	case CertificateCompressionZstd:
+		r = zstd.NewReader(compressed)
+	default:
+		// offered only contains supported algorithms.
+		return nil, c.sendAlert(alertInternalError)
+	}
+
+	// Decompress into a full Certificate message.
+	n := int(msg.uncompressedLength)
+	data := make([]byte, 4+n)
+	data[0] = typeCertificate
+	data[1] = byte(n >> 16)
+	data[2] = byte(n >> 8)
+	data[3] = byte(n)
+	if _, err := io.ReadFull(r, data[4:]); err != nil {
+		c.sendAlert(alertBadCertificate)
+		return nil, errors.New("tls: failed to decompress certificate: " + err.Error())
+	}
+	// The stream must end, with a valid checksum if any, at the announced
+	// length.
+	if _, err := io.ReadFull(r, make([]byte, 1)); err != io.EOF {
+		c.sendAlert(alertBadCertificate)
+		return nil, errors.New("tls: invalid compressed certificate length")
+	}
+
+	certMsg := new(certificateMsgTLS13)
+	if !certMsg.unmarshal(data) {
+		c.sendAlert(alertDecodeError)
+		return nil, errors.New("tls: failed to parse decompressed certificate message")
+	}
+	return certMsg, nil
+}
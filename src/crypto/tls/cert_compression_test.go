@@ -0,0 +1,262 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"net"
+	"testing"
+)
+
+var certCompressionAlgorithms = []CertificateCompressionAlgorithm{
+	CertificateCompressionZlib,
+	CertificateCompressionBrotli,
+	CertificateCompressionZstd,
+}
+
+// byteCountingConn wraps a net.Conn and counts the bytes written to it.
+type byteCountingConn struct {
+	net.Conn
+	n int
+}
+
+func (c *byteCountingConn) Write(data []byte) (int, error) {
+	c.n += len(data)
+	return c.Conn.Write(data)
+}
+
+// countingHandshake runs a handshake and returns the number of bytes written
+// by the client and by the server.
+func countingHandshake(t *testing.T, clientConfig, serverConfig *Config) (clientBytes, serverBytes int, err error) {
+	c, s := localPipe(t)
+	cc, sc := &byteCountingConn{Conn: c}, &byteCountingConn{Conn: s}
+	errChan := make(chan error, 1)
+	go func() {
+		cli := Client(cc, clientConfig)
+		err := cli.Handshake()
+		if err != nil {
+			err = errors.New("client: " + err.Error())
+		} else if n := len(cli.ConnectionState().PeerCertificates); n != len(serverConfig.Certificates[0].Certificate) {
+			err = errors.New("client: unexpected number of peer certificates")
+		}
+		c.Close()
+		errChan <- err
+	}()
+	srv := Server(sc, serverConfig)
+	err = srv.Handshake()
+	if err != nil {
+		err = errors.New("server: " + err.Error())
+	} else if serverConfig.ClientAuth != NoClientCert {
+		if n := len(srv.ConnectionState().PeerCertificates); n != len(clientConfig.Certificates[0].Certificate) {
+			err = errors.New("server: unexpected number of peer certificates")
+		}
+	}
+	s.Close()
+	err = errors.Join(err, <-errChan)
+	return cc.n, sc.n, err
+}
+
+// certCompressionConfigs returns client and server configs that use
+// TLS 1.3 and a long certificate chain, which compresses well, and
+// that require a client certificate.
+func certCompressionConfigs() (clientConfig, serverConfig *Config) {
+	chain := []Certificate{{
+		Certificate: [][]byte{testRSACertificate},
+		PrivateKey:  testRSAPrivateKey,
+	}}
+	for range 8 {
+		chain[0].Certificate = append(chain[0].Certificate, testRSACertificateIssuer)
+	}
+	clientConfig = testConfig.Clone()
+	clientConfig.MinVersion = VersionTLS13
+	clientConfig.Certificates = chain
+	serverConfig = testConfig.Clone()
+	serverConfig.MinVersion = VersionTLS13
+	serverConfig.Certificates = chain
+	serverConfig.ClientAuth = RequireAnyClientCert
+	return clientConfig, serverConfig
+}
+
+func TestCertificateCompression(t *testing.T) {
+	clientConfig, serverConfig := certCompressionConfigs()
+	clientBase, serverBase, err := countingHandshake(t, clientConfig, serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alg := range certCompressionAlgorithms {
+		t.Run(alg.String(), func(t *testing.T) {
+			clientConfig, serverConfig := certCompressionConfigs()
+			clientConfig.CertificateCompression = []CertificateCompressionAlgorithm{alg}
+			serverConfig.CertificateCompression = []CertificateCompressionAlgorithm{alg}
+			clientBytes, serverBytes, err := countingHandshake(t, clientConfig, serverConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if serverBytes > serverBase/2 {
+				t.Errorf("server sent %d bytes, want at most half of the %d bytes without compression", serverBytes, serverBase)
+			}
+			if clientBytes > clientBase/2 {
+				t.Errorf("client sent %d bytes, want at most half of the %d bytes without compression", clientBytes, clientBase)
+			}
+		})
+	}
+
+	// Compression is only used if both sides support a common algorithm.
+	tests := []struct {
+		name           string
+		client, server []CertificateCompressionAlgorithm
+	}{
+		{"client only", certCompressionAlgorithms, nil},
+		{"server only", nil, certCompressionAlgorithms},
+		{"no common algorithm", []CertificateCompressionAlgorithm{CertificateCompressionZlib}, []CertificateCompressionAlgorithm{CertificateCompressionZstd}},
+		{"unsupported algorithm", []CertificateCompressionAlgorithm{0x4242}, []CertificateCompressionAlgorithm{0x4242}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientConfig, serverConfig := certCompressionConfigs()
+			clientConfig.CertificateCompression = tt.client
+			serverConfig.CertificateCompression = tt.server
+			clientBytes, serverBytes, err := countingHandshake(t, clientConfig, serverConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if serverBytes < serverBase*9/10 || clientBytes < clientBase*9/10 {
+				t.Errorf("sent %d and %d bytes, want about %d and %d bytes without compression", clientBytes, serverBytes, clientBase, serverBase)
+			}
+		})
+	}
+
+	// Certificate compression doesn't apply to TLS 1.2.
+	t.Run("TLS 1.2", func(t *testing.T) {
+		clientConfig, serverConfig := certCompressionConfigs()
+		clientConfig.MinVersion, clientConfig.MaxVersion = VersionTLS12, VersionTLS12
+		serverConfig.MinVersion = VersionTLS12
+		clientConfig.CertificateCompression = certCompressionAlgorithms
+		serverConfig.CertificateCompression = certCompressionAlgorithms
+		if _, _, err := countingHandshake(t, clientConfig, serverConfig); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestCertificateCompressionPreference(t *testing.T) {
+	c := Client(&discardConn{}, &Config{
+		CertificateCompression: []CertificateCompressionAlgorithm{
+			0x4242, CertificateCompressionZstd, CertificateCompressionBrotli,
+		},
+	})
+	certMsg := &certificateMsgTLS13{certificate: Certificate{Certificate: [][]byte{testRSACertificate}}}
+	msg, err := c.compressCertificateMsg(certMsg, []CertificateCompressionAlgorithm{
+		CertificateCompressionZlib, CertificateCompressionBrotli, CertificateCompressionZstd,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := msg.(*compressedCertificateMsg)
+	if !ok {
+		t.Fatalf("got %T, want *compressedCertificateMsg", msg)
+	}
+	if m.algorithm != CertificateCompressionZstd {
+		t.Errorf("algorithm = %v, want %v", m.algorithm, CertificateCompressionZstd)
+	}
+}
+
+// alertRecordingConn wraps a net.Conn, discards all writes, and records
+// the last alert written.
+type alertRecordingConn struct {
+	net.Conn
+	alert alert
+}
+
+func (c *alertRecordingConn) Write(data []byte) (int, error) {
+	if len(data) == recordHeaderLen+2 && recordType(data[0]) == recordTypeAlert {
+		c.alert = alert(data[recordHeaderLen+1])
+	}
+	return len(data), nil
+}
+
+func TestCertificateDecompressionErrors(t *testing.T) {
+	certMsg := &certificateMsgTLS13{certificate: Certificate{Certificate: [][]byte{testRSACertificate}}}
+	config := &Config{CertificateCompression: []CertificateCompressionAlgorithm{CertificateCompressionZlib}}
+	c := Client(&discardConn{}, config)
+	msg, err := c.compressCertificateMsg(certMsg, config.CertificateCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := msg.(*compressedCertificateMsg)
+
+	var notCertificate bytes.Buffer
+	w := zlib.NewWriter(&notCertificate)
+	w.Write([]byte("not a certificate message"))
+	w.Close()
+
+	corrupt := bytes.Clone(valid.compressed)
+	corrupt[len(corrupt)/2] ^= 0xff
+
+	tests := []struct {
+		name  string
+		msg   compressedCertificateMsg
+		alert alert
+	}{
+		{"unexpected algorithm", compressedCertificateMsg{
+			algorithm:          CertificateCompressionBrotli,
+			uncompressedLength: valid.uncompressedLength,
+			compressed:         valid.compressed,
+		}, alertIllegalParameter},
+		{"too long", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: maxHandshakeCertificateMsg + 1,
+			compressed:         valid.compressed,
+		}, alertBadCertificate},
+		{"length too large", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: valid.uncompressedLength + 1,
+			compressed:         valid.compressed,
+		}, alertBadCertificate},
+		{"length too small", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: valid.uncompressedLength - 1,
+			compressed:         valid.compressed,
+		}, alertBadCertificate},
+		{"corrupt", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: valid.uncompressedLength,
+			compressed:         corrupt,
+		}, alertBadCertificate},
+		{"truncated", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: valid.uncompressedLength,
+			compressed:         valid.compressed[:len(valid.compressed)-4],
+		}, alertBadCertificate},
+		{"not a certificate", compressedCertificateMsg{
+			algorithm:          CertificateCompressionZlib,
+			uncompressedLength: uint32(len("not a certificate message")),
+			compressed:         notCertificate.Bytes(),
+		}, alertDecodeError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &alertRecordingConn{}
+			c := Client(conn, config)
+			if _, err := c.decompressCertificateMsg(&tt.msg, config.CertificateCompression); err == nil {
+				t.Fatal("decompression succeeded")
+			}
+			if conn.alert != tt.alert {
+				t.Errorf("sent alert %v, want %v", conn.alert, tt.alert)
+			}
+		})
+	}
+
+	got, err := c.decompressCertificateMsg(valid, config.CertificateCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.certificate.Certificate[0], testRSACertificate) {
+		t.Error("decompressed certificate mismatch")
+	}
+}
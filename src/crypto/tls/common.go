@@ -81,22 +81,23 @@ const (
 
 // TLS handshake message types.
 const (
-	typeHelloRequest        uint8 = 0
-	typeClientHello         uint8 = 1
-	typeServerHello         uint8 = 2
-	typeNewSessionTicket    uint8 = 4
-	typeEndOfEarlyData      uint8 = 5
-	typeEncryptedExtensions uint8 = 8
-	typeCertificate         uint8 = 11
-	typeServerKeyExchange   uint8 = 12
-	typeCertificateRequest  uint8 = 13
-	typeServerHelloDone     uint8 = 14
-	typeCertificateVerify   uint8 = 15
-	typeClientKeyExchange   uint8 = 16
-	typeFinished            uint8 = 20
-	typeCertificateStatus   uint8 = 22
-	typeKeyUpdate           uint8 = 24
-	typeMessageHash         uint8 = 254 // synthetic message
+	typeHelloRequest          uint8 = 0
+	typeClientHello           uint8 = 1
+	typeServerHello           uint8 = 2
+	typeNewSessionTicket      uint8 = 4
+	typeEndOfEarlyData        uint8 = 5
+	typeEncryptedExtensions   uint8 = 8
+	typeCertificate           uint8 = 11
+	typeServerKeyExchange     uint8 = 12
+	typeCertificateRequest    uint8 = 13
+	typeServerHelloDone       uint8 = 14
+	typeCertificateVerify     uint8 = 15
+	typeClientKeyExchange     uint8 = 16
+	typeFinished              uint8 = 20
+	typeCertificateStatus     uint8 = 22
+	typeKeyUpdate             uint8 = 24
+	typeCompressedCertificate uint8 = 25
+	typeMessageHash           uint8 = 254 // synthetic message
 )
 
 // TLS compression types.
@@ -114,6 +115,7 @@ const (
 	extensionALPN                    uint16 = 16
 	extensionSCT                     uint16 = 18
 	extensionExtendedMasterSecret    uint16 = 23
+	extensionCompressCertificate     uint16 = 27
 	extensionSessionTicket           uint16 = 35
 	extensionPreSharedKey            uint16 = 41
 	extensionEarlyData               uint16 = 42
@@ -149,10 +151,11 @@ const (
 	CurveP521 CurveID = 25
 	X25519    CurveID = 29
 
-	// Experimental codepoint for X25519Kyber768Draft00, specified in
-	// draft-tls-westerbaan-xyber768d00-03. Not exported, as support might be
-	// removed in the future.
-	x25519Kyber768Draft00 CurveID = 0x6399 // X25519Kyber768Draft00
+	// Hybrid post-quantum key exchanges, combining ML-KEM-768 with X25519 or
+	// P-256 as specified in draft-ietf-tls-ecdhe-mlkem. They are only
+	// supported in TLS 1.3.
+	X25519MLKEM768    CurveID = 4588
+	SecP256r1MLKEM768 CurveID = 4587
 )
 
 // TLS 1.3 Key Share. See RFC 8446, Section 4.2.8.
@@ -284,25 +287,31 @@ type ConnectionState struct {
 	// response provided by the peer for the leaf certificate, if any.
 	OCSPResponse []byte
 
+	// VerifiedOCSPResponse is the parsed OCSPResponse, if it is current and
+	// correctly signed for the leaf of the first verified chain. It is nil
+	// if VerifiedChains is empty.
+	VerifiedOCSPResponse *x509.OCSPResponse
+
 	// TLSUnique contains the "tls-unique" channel binding value (see RFC 5929,
 	// Section 3). This value will be nil for TLS 1.3 connections and for
 	// resumed connections that don't support Extended Master Secret (RFC 7627).
 	TLSUnique []byte
 
 	// ECHAccepted indicates if Encrypted Client Hello was offered by the client
-	// and accepted by the server. Currently, ECH is supported only on the
-	// client side.
+	// and accepted by the server.
 	ECHAccepted bool
 
+	// CurveID is the key exchange mechanism used for the connection. The name
+	// refers to elliptic curves for legacy reasons, see [CurveID]. If a legacy
+	// RSA key exchange is used, or if a TLS 1.0–1.2 session is resumed without
+	// a new key exchange, this value is zero.
+	CurveID CurveID
+
 	// ekm is a closure exposed via ExportKeyingMaterial.
 	ekm func(label string, context []byte, length int) ([]byte, error)
 
 	// testingOnlyDidHRR is true if a HelloRetryRequest was sent/received.
 	testingOnlyDidHRR bool
-
-	// testingOnlyCurveID is the selected CurveID, or zero if an RSA exchanges
-	// is performed.
-	testingOnlyCurveID CurveID
 }
 
 // ExportKeyingMaterial returns length bytes of exported key material in a new
@@ -376,7 +385,7 @@ type ClientSessionCache interface {
 	Put(sessionKey string, cs *ClientSessionState)
 }
 
-//go:generate stringer -linecomment -type=SignatureScheme,CurveID,ClientAuthType -output=common_string.go
+//go:generate stringer -linecomment -type=SignatureScheme,CurveID,ClientAuthType,CertificateCompressionAlgorithm -output=common_string.go
 
 // SignatureScheme identifies a signature algorithm supported by TLS. See
 // RFC 8446, Section 4.2.3.
@@ -406,6 +415,16 @@ const (
 	ECDSAWithSHA1 SignatureScheme = 0x0203
 )
 
+// CertificateCompressionAlgorithm identifies a certificate compression
+// algorithm. See RFC 8879, Section 3.
+type CertificateCompressionAlgorithm uint16
+
+const (
+	CertificateCompressionZlib   CertificateCompressionAlgorithm = 1
+	CertificateCompressionBrotli CertificateCompressionAlgorithm = 2
+	CertificateCompressionZstd   CertificateCompressionAlgorithm = 3
+)
+
 // ClientHelloInfo contains information from a ClientHello message in order to
 // guide application logic in the GetCertificate and GetConfigForClient callbacks.
 type ClientHelloInfo struct {
@@ -641,6 +660,20 @@ type Config struct {
 	// If RootCAs is nil, TLS uses the host's root CA set.
 	RootCAs *x509.CertPool
 
+	// RevocationOptions, if not nil, enables checking the revocation status
+	// of the peer's certificate chain with OCSP and CRLs, as part of the
+	// verification against RootCAs or ClientCAs. The OCSP response stapled by
+	// the peer, if any, is used in place of RevocationOptions.OCSPStaple. If
+	// a certificate is revoked, the handshake fails with a
+	// certificate_revoked alert.
+	RevocationOptions *x509.RevocationOptions
+
+	// CTPolicy, if not nil, is the Certificate Transparency policy that the
+	// server certificate must satisfy, with SCTs embedded in the certificate,
+	// provided in the TLS handshake, or in the stapled OCSP response. It is
+	// only used by clients, and only if InsecureSkipVerify is false.
+	CTPolicy *x509.CTPolicy
+
 	// NextProtos is a list of supported application level protocols, in
 	// order of preference. If both peers support ALPN, the selected
 	// protocol will be one from this list, and the connection will fail
@@ -761,11 +794,33 @@ type Config struct {
 	// be used. The client will use the first preference as the type for
 	// its key share in TLS 1.3. This may change in the future.
 	//
-	// From Go 1.23, the default includes the X25519Kyber768Draft00 hybrid
-	// post-quantum key exchange. To disable it, set CurvePreferences explicitly
-	// or use the GODEBUG=tlskyber=0 environment variable.
+	// The default includes the X25519MLKEM768 hybrid post-quantum key
+	// exchange. To disable it, set CurvePreferences explicitly or use the
+	// GODEBUG=tlsmlkem=0 environment variable. The older GODEBUG=tlskyber=0
+	// setting has the same effect. SecP256r1MLKEM768 is supported but not
+	// enabled by default.
+	//
+	// If the first preference is a hybrid key exchange, the client also sends
+	// a key share for its ECDH component, if that is in CurvePreferences.
 	CurvePreferences []CurveID
 
+	// CertificateCompression contains the certificate compression algorithms
+	// supported by this side of the connection, in preference order, as
+	// specified in RFC 8879. Compression only applies to TLS 1.3 connections.
+	// CertificateCompressionZlib, CertificateCompressionBrotli and
+	// CertificateCompressionZstd are implemented, using compress/zlib,
+	// compress/brotli and compress/zstd. Other values are ignored.
+	//
+	// Clients advertise these algorithms to servers, and servers advertise them
+	// in TLS 1.3 certificate requests. The peer may then send its certificate
+	// chain compressed with any of them. When the peer advertises algorithms,
+	// the certificate chain is compressed with the first one in this list that
+	// the peer also supports.
+	//
+	// If empty, certificates are never compressed, and compressed certificates
+	// are not accepted.
+	CertificateCompression []CertificateCompressionAlgorithm
+
 	// DynamicRecordSizingDisabled disables adaptive sizing of TLS records.
 	// When true, the largest possible TLS record size is always used. When
 	// false, the size of TLS records may be adjusted in an attempt to
@@ -787,7 +842,7 @@ type Config struct {
 	// EncryptedClientHelloConfigList is a serialized ECHConfigList. If
 	// provided, clients will attempt to connect to servers using Encrypted
 	// Client Hello (ECH) using one of the provided ECHConfigs. Servers
-	// currently ignore this field.
+	// ignore this field, see EncryptedClientHelloKeys instead.
 	//
 	// If the list contains no valid ECH configs, the handshake will fail
 	// and return an error.
@@ -819,6 +874,25 @@ type Config struct {
 	// when ECH is rejected, even if set, and InsecureSkipVerify is ignored.
 	EncryptedClientHelloRejectionVerify func(ConnectionState) error
 
+	// EncryptedClientHelloKeys are the ECH keys to use when a client
+	// attempts ECH. Clients ignore this field.
+	//
+	// The server tries each key whose config matches the config ID and
+	// cipher suite selected by the client, in order, so keys can be rotated
+	// by adding the new key and keeping the old one until clients stop using
+	// it. If ECH is accepted, the rest of the handshake is performed with the
+	// inner ClientHello, including GetConfigForClient and GetCertificate.
+	//
+	// If a client attempts ECH, but it is rejected by the server, the server
+	// will send a list of configs to retry based on the set of
+	// EncryptedClientHelloKeys which have the SendAsRetry field set, if the
+	// negotiated version is TLS 1.3.
+	//
+	// Keys are looked up in the Config passed to Server, not in the one
+	// returned by GetConfigForClient, because ECH processing has to happen
+	// before the ClientHello can be inspected.
+	EncryptedClientHelloKeys []EncryptedClientHelloKey
+
 	// mutex protects sessionTicketKeys and autoSessionTicketKeys.
 	mutex sync.RWMutex
 	// sessionTicketKeys contains zero or more ticket keys. If set, it means
@@ -842,6 +916,23 @@ const (
 	ticketKeyRotation = 24 * time.Hour
 )
 
+// EncryptedClientHelloKey holds a private key that is associated
+// with a specific ECH config known to a client.
+type EncryptedClientHelloKey struct {
+	// Config should be a marshalled ECHConfig associated with PrivateKey. This
+	// must match the config provided to clients byte-for-byte. The config
+	// must use a KEM, KDF and AEAD supported by crypto/hpke, except for the
+	// export-only AEAD.
+	Config []byte
+	// PrivateKey should be a marshalled private key for the KEM of Config,
+	// in the format accepted by the NewPrivateKey method of [crypto/hpke.KEM].
+	PrivateKey []byte
+	// SendAsRetry indicates if Config should be sent as part of the list of
+	// retry configs when ECH is requested by the client but rejected by the
+	// server.
+	SendAsRetry bool
+}
+
 // ticketKey is the internal representation of a session ticket key.
 type ticketKey struct {
 	aesKey  [16]byte
@@ -888,6 +979,8 @@ func (c *Config) Clone() *Config {
 		VerifyPeerCertificate:               c.VerifyPeerCertificate,
 		VerifyConnection:                    c.VerifyConnection,
 		RootCAs:                             c.RootCAs,
+		RevocationOptions:                   c.RevocationOptions,
+		CTPolicy:                            c.CTPolicy,
 		NextProtos:                          c.NextProtos,
 		ServerName:                          c.ServerName,
 		ClientAuth:                          c.ClientAuth,
@@ -903,11 +996,13 @@ func (c *Config) Clone() *Config {
 		MinVersion:                          c.MinVersion,
 		MaxVersion:                          c.MaxVersion,
 		CurvePreferences:                    c.CurvePreferences,
+		CertificateCompression:              c.CertificateCompression,
 		DynamicRecordSizingDisabled:         c.DynamicRecordSizingDisabled,
 		Renegotiation:                       c.Renegotiation,
 		KeyLogWriter:                        c.KeyLogWriter,
 		EncryptedClientHelloConfigList:      c.EncryptedClientHelloConfigList,
 		EncryptedClientHelloRejectionVerify: c.EncryptedClientHelloRejectionVerify,
+		EncryptedClientHelloKeys:            c.EncryptedClientHelloKeys,
 		sessionTicketKeys:                   c.sessionTicketKeys,
 		autoSessionTicketKeys:               c.autoSessionTicketKeys,
 	}
@@ -1148,7 +1243,7 @@ func (c *Config) curvePreferences(version uint16) []CurveID {
 	}
 	if version < VersionTLS13 {
 		return slices.DeleteFunc(curvePreferences, func(c CurveID) bool {
-			return c == x25519Kyber768Draft00
+			return isTLS13OnlyKeyExchange(c)
 		})
 	}
 	return curvePreferences
@@ -60,6 +60,9 @@ type Conn struct {
 	// verifiedChains contains the certificate chains that we built, as
 	// opposed to the ones presented by the server.
 	verifiedChains [][]*x509.Certificate
+	// verifiedOCSP is the parsed ocspResponse, if it is valid for the leaf
+	// of verifiedChains[0]. It is only set on the client side.
+	verifiedOCSP *x509.OCSPResponse
 	// serverName contains the server name indicated by the client, if any.
 	serverName string
 	// secureRenegotiation is true if the server echoed the secure
@@ -1095,7 +1098,7 @@ func (c *Conn) readHandshake(transcript transcriptHash) (any, error) {
 	// hasVers indicates we're past the first message, forcing someone trying to
 	// make us just allocate a large buffer to at least do the initial part of
 	// the handshake first.
-	if c.haveVers && data[0] == typeCertificate {
+	if c.haveVers && (data[0] == typeCertificate || data[0] == typeCompressedCertificate) {
 		// Since certificate messages are likely to be the only messages that
 		// can be larger than maxHandshake, we use a special limit for just
 		// those messages.
@@ -1135,6 +1138,11 @@ func (c *Conn) unmarshalHandshakeMessage(data []byte, transcript transcriptHash)
 		} else {
 			m = new(certificateMsg)
 		}
+	case typeCompressedCertificate:
+		if c.vers != VersionTLS13 {
+			return nil, c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
+		}
+		m = new(compressedCertificateMsg)
 	case typeCertificateRequest:
 		if c.vers == VersionTLS13 {
 			m = new(certificateRequestMsgTLS13)
@@ -1615,6 +1623,49 @@ func (c *Conn) ConnectionState() ConnectionState {
 	return c.connectionStateLocked()
 }
 
+// verifiedOCSPResponse parses the stapled OCSP response and checks that it is
+// current and signed for the leaf of the first verified chain. Clients call it
+// once the server's chain is known and store the result in c.verifiedOCSP.
+func (c *Conn) verifiedOCSPResponse() *x509.OCSPResponse {
+	if len(c.ocspResponse) == 0 || len(c.verifiedChains) == 0 || len(c.verifiedChains[0]) < 2 {
+		return nil
+	}
+	chain := c.verifiedChains[0]
+	resp, err := x509.ParseOCSPResponse(c.ocspResponse, chain[0], chain[1])
+	if err != nil {
+		return nil
+	}
+	now := c.config.time()
+	if now.Before(resp.ThisUpdate) || !resp.NextUpdate.IsZero() && !now.Before(resp.NextUpdate) {
+		return nil
+	}
+	if resp.Certificate != nil && (now.Before(resp.Certificate.NotBefore) || now.After(resp.Certificate.NotAfter)) {
+		return nil
+	}
+	return resp
+}
+
+// revocationOptions returns the revocation options to verify the peer's
+// certificate chain with, or nil if revocation checking is disabled.
+func (c *Conn) revocationOptions(ocspStaple []byte) *x509.RevocationOptions {
+	if c.config.RevocationOptions == nil {
+		return nil
+	}
+	opts := *c.config.RevocationOptions
+	opts.OCSPStaple = ocspStaple
+	return &opts
+}
+
+// verificationErrorAlert returns the alert to send when the verification of
+// the peer's certificate chain failed with err.
+func verificationErrorAlert(err error) alert {
+	var errCertificateInvalid x509.CertificateInvalidError
+	if errors.As(err, &errCertificateInvalid) && errCertificateInvalid.Reason == x509.Revoked {
+		return alertCertificateRevoked
+	}
+	return alertBadCertificate
+}
+
 var tlsunsafeekm = godebug.New("tlsunsafeekm")
 
 func (c *Conn) connectionStateLocked() ConnectionState {
@@ -1624,8 +1675,7 @@ func (c *Conn) connectionStateLocked() ConnectionState {
 	state.NegotiatedProtocol = c.clientProtocol
 	state.DidResume = c.didResume
 	state.testingOnlyDidHRR = c.didHRR
-	// c.curveID is not set on TLS 1.0–1.2 resumptions. Fix that before exposing it.
-	state.testingOnlyCurveID = c.curveID
+	state.CurveID = c.curveID
 	state.NegotiatedProtocolIsMutual = true
 	state.ServerName = c.serverName
 	state.CipherSuite = c.cipherSuite
@@ -1633,6 +1683,7 @@ func (c *Conn) connectionStateLocked() ConnectionState {
 	state.VerifiedChains = c.verifiedChains
 	state.SignedCertificateTimestamps = c.scts
 	state.OCSPResponse = c.ocspResponse
+	state.VerifiedOCSPResponse = c.verifiedOCSP
 	if (!c.didResume || c.extMasterSecret) && c.vers != VersionTLS13 {
 		if c.clientFinishedIsFirst {
 			state.TLSUnique = c.clientFinished[:]
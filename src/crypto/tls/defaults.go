@@ -13,14 +13,18 @@ import (
 // Defaults are collected in this file to allow distributions to more easily patch
 // them to apply local policies.
 
+var tlsmlkem = godebug.New("tlsmlkem")
+
+// tlskyber disabled the X25519Kyber768Draft00 key exchange that
+// X25519MLKEM768 replaced, so programs setting it still opt out of the
+// post-quantum default.
 var tlskyber = godebug.New("tlskyber")
 
 func defaultCurvePreferences() []CurveID {
-	if tlskyber.Value() == "0" {
+	if tlsmlkem.Value() == "0" || tlskyber.Value() == "0" {
 		return []CurveID{X25519, CurveP256, CurveP384, CurveP521}
 	}
-	// For now, x25519Kyber768Draft00 must always be followed by X25519.
-	return []CurveID{x25519Kyber768Draft00, X25519, CurveP256, CurveP384, CurveP521}
+	return []CurveID{X25519MLKEM768, X25519, CurveP256, CurveP384, CurveP521}
 }
 
 // defaultSupportedSignatureAlgorithms contains the signature and hash algorithms that
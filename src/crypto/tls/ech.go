@@ -5,8 +5,11 @@
 package tls
 
 import (
-	"crypto/internal/hpke"
+	"bytes"
+	"crypto/hpke"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 
 	"golang.org/x/crypto/cryptobyte"
@@ -40,6 +43,77 @@ type echConfig struct {
 
 var errMalformedECHConfig = errors.New("tls: malformed ECHConfigList")
 
+// parseECHConfig parses a single ECHConfig. If the config has a version we
+// don't support, skip is true and the rest of ec is not populated.
+func parseECHConfig(enc []byte) (skip bool, ec echConfig, err error) {
+	s := cryptobyte.String(enc)
+	ec.raw = []byte(enc)
+	if !s.ReadUint16(&ec.Version) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	if !s.ReadUint16(&ec.Length) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	if len(ec.raw) < int(ec.Length)+4 {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	ec.raw = ec.raw[:ec.Length+4]
+	if ec.Version != extensionEncryptedClientHello {
+		s.Skip(int(ec.Length))
+		return true, echConfig{}, nil
+	}
+	if !s.ReadUint8(&ec.ConfigID) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	if !s.ReadUint16(&ec.KemID) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	if !s.ReadUint16LengthPrefixed((*cryptobyte.String)(&ec.PublicKey)) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	var cipherSuites cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&cipherSuites) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	for !cipherSuites.Empty() {
+		var c echCipher
+		if !cipherSuites.ReadUint16(&c.KDFID) {
+			return false, echConfig{}, errMalformedECHConfig
+		}
+		if !cipherSuites.ReadUint16(&c.AEADID) {
+			return false, echConfig{}, errMalformedECHConfig
+		}
+		ec.SymmetricCipherSuite = append(ec.SymmetricCipherSuite, c)
+	}
+	if !s.ReadUint8(&ec.MaxNameLength) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	var publicName cryptobyte.String
+	if !s.ReadUint8LengthPrefixed(&publicName) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	ec.PublicName = publicName
+	var extensions cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&extensions) {
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	for !extensions.Empty() {
+		var e echExtension
+		if !extensions.ReadUint16(&e.Type) {
+			return false, echConfig{}, errMalformedECHConfig
+		}
+		if !extensions.ReadUint16LengthPrefixed((*cryptobyte.String)(&e.Data)) {
+			return false, echConfig{}, errMalformedECHConfig
+		}
+		ec.Extensions = append(ec.Extensions, e)
+	}
+	if len(ec.raw) != len(enc)-len(s) {
+		// The contents didn't match the length field.
+		return false, echConfig{}, errMalformedECHConfig
+	}
+	return false, ec, nil
+}
+
 // parseECHConfigList parses a draft-ietf-tls-esni-18 ECHConfigList, returning a
 // slice of parsed ECHConfigs, in the same order they were parsed, or an error
 // if the list is malformed.
@@ -55,84 +129,30 @@ func parseECHConfigList(data []byte) ([]echConfig, error) {
 	}
 	var configs []echConfig
 	for len(s) > 0 {
-		var ec echConfig
-		ec.raw = []byte(s)
-		if !s.ReadUint16(&ec.Version) {
-			return nil, errMalformedECHConfig
-		}
-		if !s.ReadUint16(&ec.Length) {
-			return nil, errMalformedECHConfig
-		}
-		if len(ec.raw) < int(ec.Length)+4 {
+		if len(s) < 4 {
 			return nil, errMalformedECHConfig
 		}
-		ec.raw = ec.raw[:ec.Length+4]
-		if ec.Version != extensionEncryptedClientHello {
-			s.Skip(int(ec.Length))
-			continue
+		configLen := uint16(s[2])<<8 | uint16(s[3])
+		skip, ec, err := parseECHConfig(s)
+		if err != nil {
+			return nil, err
 		}
-		if !s.ReadUint8(&ec.ConfigID) {
-			return nil, errMalformedECHConfig
+		s = s[configLen+4:]
+		if !skip {
+			configs = append(configs, ec)
 		}
-		if !s.ReadUint16(&ec.KemID) {
-			return nil, errMalformedECHConfig
-		}
-		if !s.ReadUint16LengthPrefixed((*cryptobyte.String)(&ec.PublicKey)) {
-			return nil, errMalformedECHConfig
-		}
-		var cipherSuites cryptobyte.String
-		if !s.ReadUint16LengthPrefixed(&cipherSuites) {
-			return nil, errMalformedECHConfig
-		}
-		for !cipherSuites.Empty() {
-			var c echCipher
-			if !cipherSuites.ReadUint16(&c.KDFID) {
-				return nil, errMalformedECHConfig
-			}
-			if !cipherSuites.ReadUint16(&c.AEADID) {
-				return nil, errMalformedECHConfig
-			}
-			ec.SymmetricCipherSuite = append(ec.SymmetricCipherSuite, c)
-		}
-		if !s.ReadUint8(&ec.MaxNameLength) {
-			return nil, errMalformedECHConfig
-		}
-		var publicName cryptobyte.String
-		if !s.ReadUint8LengthPrefixed(&publicName) {
-			return nil, errMalformedECHConfig
-		}
-		ec.PublicName = publicName
-		var extensions cryptobyte.String
-		if !s.ReadUint16LengthPrefixed(&extensions) {
-			return nil, errMalformedECHConfig
-		}
-		for !extensions.Empty() {
-			var e echExtension
-			if !extensions.ReadUint16(&e.Type) {
-				return nil, errMalformedECHConfig
-			}
-			if !extensions.ReadUint16LengthPrefixed((*cryptobyte.String)(&e.Data)) {
-				return nil, errMalformedECHConfig
-			}
-			ec.Extensions = append(ec.Extensions, e)
-		}
-
-		configs = append(configs, ec)
 	}
 	return configs, nil
 }
 
 func pickECHConfig(list []echConfig) *echConfig {
 	for _, ec := range list {
-		if _, ok := hpke.SupportedKEMs[ec.KemID]; !ok {
+		if _, err := hpke.NewKEM(ec.KemID); err != nil {
 			continue
 		}
 		var validSCS bool
 		for _, cs := range ec.SymmetricCipherSuite {
-			if _, ok := hpke.SupportedAEADs[cs.AEADID]; !ok {
-				continue
-			}
-			if _, ok := hpke.SupportedKDFs[cs.KDFID]; !ok {
+			if _, _, err := echCipherSuite(cs); err != nil {
 				continue
 			}
 			validSCS = true
@@ -166,10 +186,7 @@ func pickECHCipherSuite(suites []echCipher) (echCipher, error) {
 		// NOTE: all of the supported AEADs and KDFs are fine, rather than
 		// imposing some sort of preference here, we just pick the first valid
 		// suite.
-		if _, ok := hpke.SupportedAEADs[s.AEADID]; !ok {
-			continue
-		}
-		if _, ok := hpke.SupportedKDFs[s.KDFID]; !ok {
+		if _, _, err := echCipherSuite(s); err != nil {
 			continue
 		}
 		return s, nil
@@ -177,6 +194,23 @@ func pickECHCipherSuite(suites []echCipher) (echCipher, error) {
 	return echCipher{}, errors.New("tls: no supported symmetric ciphersuites for ECH")
 }
 
+// echCipherSuite returns the HPKE KDF and AEAD of an ECH symmetric cipher
+// suite. The export-only AEAD can't be used to encrypt the ClientHello.
+func echCipherSuite(s echCipher) (hpke.KDF, hpke.AEAD, error) {
+	kdf, err := hpke.NewKDF(s.KDFID)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := hpke.NewAEAD(s.AEADID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if aead == hpke.ExportOnly() {
+		return nil, nil, errors.New("tls: export-only AEAD is not supported for ECH")
+	}
+	return kdf, aead, nil
+}
+
 func encodeInnerClientHello(inner *clientHelloMsg, maxNameLength int) ([]byte, error) {
 	h, err := inner.marshalMsg(true)
 	if err != nil {
@@ -239,6 +273,368 @@ func computeAndUpdateOuterECHExtension(outer, inner *clientHelloMsg, ech *echCon
 	return nil
 }
 
+const (
+	outerECHExt uint8 = 0
+	innerECHExt uint8 = 1
+)
+
+var (
+	errInvalidECHExt   = errors.New("tls: client sent invalid encrypted_client_hello extension")
+	errMalformedECHExt = errors.New("tls: malformed encrypted_client_hello extension")
+)
+
+// parseECHExt parses the encrypted_client_hello extension of a ClientHello.
+// For an inner extension only echType is populated.
+func parseECHExt(ext []byte) (echType uint8, cs echCipher, configID uint8, encap []byte, payload []byte, err error) {
+	s := cryptobyte.String(ext)
+	if !s.ReadUint8(&echType) {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if echType == innerECHExt {
+		if !s.Empty() {
+			return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+		}
+		return echType, echCipher{}, 0, nil, nil, nil
+	}
+	if echType != outerECHExt {
+		return 0, echCipher{}, 0, nil, nil, errInvalidECHExt
+	}
+	if !s.ReadUint16(&cs.KDFID) {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if !s.ReadUint16(&cs.AEADID) {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if !s.ReadUint8(&configID) {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if !readUint16LengthPrefixed(&s, &encap) {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if !readUint16LengthPrefixed(&s, &payload) || len(payload) == 0 {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	if !s.Empty() {
+		return 0, echCipher{}, 0, nil, nil, errMalformedECHExt
+	}
+	return echType, cs, configID, encap, payload, nil
+}
+
+// echServerContext holds the ECH state of a server when the client offered
+// ECH. If the server accepted it, c.echAccepted is set, and the context is
+// needed to decrypt the second ClientHello after a HelloRetryRequest.
+// Otherwise, retryConfigs is sent to the client, if not empty.
+type echServerContext struct {
+	hpkeContext *hpke.Recipient
+	configID    uint8
+	ciphersuite echCipher
+	// inner is true if the server is the backend of a split mode deployment,
+	// and received the inner ClientHello directly.
+	inner bool
+
+	retryConfigs []byte
+}
+
+// processECHClientHello processes the encrypted_client_hello extension of a
+// ClientHello. If ECH is accepted, it returns the inner ClientHello, which
+// replaces the outer one for the rest of the handshake, and sets
+// c.echAccepted. If ECH is rejected, it returns the outer ClientHello.
+func (c *Conn) processECHClientHello(outer *clientHelloMsg) (*clientHelloMsg, *echServerContext, error) {
+	if len(c.config.EncryptedClientHelloKeys) == 0 {
+		// Without keys, the only ECH extension we process is the inner one,
+		// which a client-facing server forwards to us with the decrypted
+		// ClientHello in split mode. Anything else is ignored, as it was
+		// before the server supported ECH.
+		if bytes.Equal(outer.encryptedClientHello, []byte{innerECHExt}) {
+			c.echAccepted = true
+			return outer, &echServerContext{inner: true}, nil
+		}
+		return outer, nil, nil
+	}
+
+	echType, echCiphersuite, configID, encap, payload, err := parseECHExt(outer.encryptedClientHello)
+	if err != nil {
+		if errors.Is(err, errInvalidECHExt) {
+			c.sendAlert(alertIllegalParameter)
+		} else {
+			c.sendAlert(alertDecodeError)
+		}
+		return nil, nil, errInvalidECHExt
+	}
+
+	if echType == innerECHExt {
+		c.echAccepted = true
+		return outer, &echServerContext{inner: true}, nil
+	}
+
+	for _, echKey := range c.config.EncryptedClientHelloKeys {
+		skip, config, err := parseECHConfig(echKey.Config)
+		if err != nil || skip || len(config.raw) != len(echKey.Config) {
+			c.sendAlert(alertInternalError)
+			return nil, nil, errors.New("tls: invalid EncryptedClientHelloKeys Config")
+		}
+		if config.ConfigID != configID || !slices.Contains(config.SymmetricCipherSuite, echCiphersuite) {
+			continue
+		}
+		kem, err := hpke.NewKEM(config.KemID)
+		if err != nil {
+			c.sendAlert(alertInternalError)
+			return nil, nil, fmt.Errorf("tls: invalid EncryptedClientHelloKeys Config: %s", err)
+		}
+		echPriv, err := kem.NewPrivateKey(echKey.PrivateKey)
+		if err != nil {
+			c.sendAlert(alertInternalError)
+			return nil, nil, fmt.Errorf("tls: invalid EncryptedClientHelloKeys PrivateKey: %s", err)
+		}
+		kdf, aead, err := echCipherSuite(echCiphersuite)
+		if err != nil {
+			continue
+		}
+		info := append([]byte("tls ech\x00"), echKey.Config...)
+		hpkeContext, err := hpke.NewRecipient(encap, echPriv, kdf, aead, info)
+		if err != nil {
+			// Attempt the next trial decryption.
+			continue
+		}
+		encodedInner, err := decryptECHPayload(hpkeContext, outer.original, payload)
+		if err != nil {
+			// Attempt the next trial decryption.
+			continue
+		}
+
+		// NOTE: we do not enforce that the sent server_name matches the ECH
+		// config's PublicName, since the client already had to know the config
+		// in order to properly encrypt the payload. This is only a MAY in the
+		// spec.
+
+		inner, err := decodeInnerClientHello(outer, encodedInner)
+		if err != nil {
+			c.sendAlert(alertIllegalParameter)
+			return nil, nil, errInvalidECHExt
+		}
+
+		c.echAccepted = true
+		return inner, &echServerContext{
+			hpkeContext: hpkeContext,
+			configID:    configID,
+			ciphersuite: echCiphersuite,
+		}, nil
+	}
+
+	retryConfigs, err := buildRetryConfigList(c.config.EncryptedClientHelloKeys)
+	if err != nil {
+		c.sendAlert(alertInternalError)
+		return nil, nil, err
+	}
+	return outer, &echServerContext{retryConfigs: retryConfigs}, nil
+}
+
+// processSecondECHClientHello decrypts the ClientHello sent in response to a
+// HelloRetryRequest, after ECH was accepted for the first one.
+func (c *Conn) processSecondECHClientHello(outer *clientHelloMsg, ech *echServerContext) (*clientHelloMsg, error) {
+	if len(outer.encryptedClientHello) == 0 {
+		c.sendAlert(alertMissingExtension)
+		return nil, errors.New("tls: second ClientHello is missing the encrypted_client_hello extension")
+	}
+	echType, echCiphersuite, configID, encap, payload, err := parseECHExt(outer.encryptedClientHello)
+	if err != nil {
+		if errors.Is(err, errInvalidECHExt) {
+			c.sendAlert(alertIllegalParameter)
+		} else {
+			c.sendAlert(alertDecodeError)
+		}
+		return nil, errInvalidECHExt
+	}
+	if ech.inner {
+		if echType != innerECHExt {
+			c.sendAlert(alertIllegalParameter)
+			return nil, errInvalidECHExt
+		}
+		return outer, nil
+	}
+	if echType != outerECHExt || configID != ech.configID ||
+		echCiphersuite != ech.ciphersuite || len(encap) != 0 {
+		c.sendAlert(alertIllegalParameter)
+		return nil, errInvalidECHExt
+	}
+	encodedInner, err := decryptECHPayload(ech.hpkeContext, outer.original, payload)
+	if err != nil {
+		c.sendAlert(alertDecryptError)
+		return nil, errors.New("tls: failed to decrypt second ClientHello")
+	}
+	inner, err := decodeInnerClientHello(outer, encodedInner)
+	if err != nil {
+		c.sendAlert(alertIllegalParameter)
+		return nil, errInvalidECHExt
+	}
+	return inner, nil
+}
+
+// decryptECHPayload opens the payload of the encrypted_client_hello extension
+// of outerRaw, using as additional data the outer ClientHello with the payload
+// replaced by zeroes, as computed by the client.
+func decryptECHPayload(context *hpke.Recipient, outerRaw, payload []byte) ([]byte, error) {
+	outerAAD := bytes.Replace(outerRaw[4:], payload, make([]byte, len(payload)), 1)
+	return context.Open(outerAAD, payload)
+}
+
+// decodeInnerClientHello reconstructs the inner ClientHello from its
+// EncodedClientHelloInner form, copying the legacy_session_id and any
+// extensions referenced by ech_outer_extensions from the outer ClientHello.
+// This is the reverse of encodeInnerClientHello.
+func decodeInnerClientHello(outer *clientHelloMsg, encoded []byte) (*clientHelloMsg, error) {
+	innerReader := cryptobyte.String(encoded)
+	var versionAndRandom, sessionID, cipherSuites, compressionMethods []byte
+	var extensions cryptobyte.String
+	if !innerReader.ReadBytes(&versionAndRandom, 2+32) ||
+		!readUint8LengthPrefixed(&innerReader, &sessionID) ||
+		len(sessionID) != 0 ||
+		!readUint16LengthPrefixed(&innerReader, &cipherSuites) ||
+		!readUint8LengthPrefixed(&innerReader, &compressionMethods) ||
+		!innerReader.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errInvalidECHExt
+	}
+
+	// The rest is padding, which must be all zeroes.
+	for _, p := range innerReader {
+		if p != 0 {
+			return nil, errInvalidECHExt
+		}
+	}
+
+	outerExts, err := clientHelloExtensions(outer.original)
+	if err != nil {
+		return nil, errInvalidECHExt
+	}
+
+	recon := cryptobyte.NewBuilder(nil)
+	recon.AddUint8(typeClientHello)
+	recon.AddUint24LengthPrefixed(func(recon *cryptobyte.Builder) {
+		recon.AddBytes(versionAndRandom)
+		recon.AddUint8LengthPrefixed(func(recon *cryptobyte.Builder) {
+			recon.AddBytes(outer.sessionId)
+		})
+		recon.AddUint16LengthPrefixed(func(recon *cryptobyte.Builder) {
+			recon.AddBytes(cipherSuites)
+		})
+		recon.AddUint8LengthPrefixed(func(recon *cryptobyte.Builder) {
+			recon.AddBytes(compressionMethods)
+		})
+		recon.AddUint16LengthPrefixed(func(recon *cryptobyte.Builder) {
+			for !extensions.Empty() {
+				var extType uint16
+				var extData cryptobyte.String
+				if !extensions.ReadUint16(&extType) ||
+					!extensions.ReadUint16LengthPrefixed(&extData) {
+					recon.SetError(errInvalidECHExt)
+					return
+				}
+				if extType != extensionECHOuterExtensions {
+					recon.AddUint16(extType)
+					recon.AddUint16LengthPrefixed(func(recon *cryptobyte.Builder) {
+						recon.AddBytes(extData)
+					})
+					continue
+				}
+				// The referenced extensions must appear in the outer
+				// ClientHello in the same relative order, so we look them
+				// up with a single forward scan. See Section 5.1 of the spec.
+				var outerExtTypes cryptobyte.String
+				if !extData.ReadUint8LengthPrefixed(&outerExtTypes) ||
+					outerExtTypes.Empty() || !extData.Empty() {
+					recon.SetError(errInvalidECHExt)
+					return
+				}
+				i := 0
+				for !outerExtTypes.Empty() {
+					var t uint16
+					if !outerExtTypes.ReadUint16(&t) || t == extensionEncryptedClientHello {
+						recon.SetError(errInvalidECHExt)
+						return
+					}
+					for i < len(outerExts) && outerExts[i].Type != t {
+						i++
+					}
+					if i == len(outerExts) {
+						recon.SetError(errInvalidECHExt)
+						return
+					}
+					recon.AddUint16(t)
+					recon.AddUint16LengthPrefixed(func(recon *cryptobyte.Builder) {
+						recon.AddBytes(outerExts[i].Data)
+					})
+					i++
+				}
+			}
+		})
+	})
+
+	innerRaw, err := recon.Bytes()
+	if err != nil {
+		return nil, errInvalidECHExt
+	}
+
+	inner := &clientHelloMsg{}
+	if !inner.unmarshal(innerRaw) {
+		return nil, errInvalidECHExt
+	}
+	if !bytes.Equal(inner.encryptedClientHello, []byte{innerECHExt}) {
+		return nil, errInvalidECHExt
+	}
+	if !slices.Contains(inner.supportedVersions, VersionTLS13) {
+		return nil, errInvalidECHExt
+	}
+	return inner, nil
+}
+
+// clientHelloExtensions returns the extensions of a marshaled ClientHello, in
+// order, using echExtension as a generic type and data pair.
+func clientHelloExtensions(raw []byte) ([]echExtension, error) {
+	s := cryptobyte.String(raw)
+	var ignored cryptobyte.String
+	if !s.Skip(4) || // message type and uint24 length field
+		!s.Skip(2+32) || // version and random
+		!s.ReadUint8LengthPrefixed(&ignored) || // session ID
+		!s.ReadUint16LengthPrefixed(&ignored) || // cipher suites
+		!s.ReadUint8LengthPrefixed(&ignored) { // compression methods
+		return nil, errors.New("tls: malformed ClientHello")
+	}
+	var extensions cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errors.New("tls: malformed ClientHello")
+	}
+	var exts []echExtension
+	for !extensions.Empty() {
+		var e echExtension
+		if !extensions.ReadUint16(&e.Type) ||
+			!extensions.ReadUint16LengthPrefixed((*cryptobyte.String)(&e.Data)) {
+			return nil, errors.New("tls: malformed ClientHello")
+		}
+		exts = append(exts, e)
+	}
+	return exts, nil
+}
+
+// buildRetryConfigList returns the ECHConfigList made of the configs of the
+// keys with SendAsRetry set, or nil if there are none.
+func buildRetryConfigList(keys []EncryptedClientHelloKey) ([]byte, error) {
+	var atLeastOneRetryConfig bool
+	var retryBuilder cryptobyte.Builder
+	retryBuilder.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range keys {
+			if !c.SendAsRetry {
+				continue
+			}
+			atLeastOneRetryConfig = true
+			b.AddBytes(c.Config)
+		}
+	})
+	if !atLeastOneRetryConfig {
+		return nil, nil
+	}
+	return retryBuilder.Bytes()
+}
+
 // validDNSName is a rather rudimentary check for the validity of a DNS name.
 // This is used to check if the public_name in a ECHConfig is valid when we are
 // picking a config. This can be somewhat lax because even if we pick a
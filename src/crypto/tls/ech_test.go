@@ -5,8 +5,13 @@
 package tls
 
 import (
+	"bytes"
+	"crypto/hpke"
 	"encoding/hex"
+	"errors"
 	"testing"
+
+	"golang.org/x/crypto/cryptobyte"
 )
 
 func TestDecodeECHConfigLists(t *testing.T) {
@@ -46,3 +51,191 @@ func TestSkipBadConfigs(t *testing.T) {
 		t.Fatal("pickECHConfig picked an invalid config")
 	}
 }
+
+// newTestECHKey generates a key for the given HPKE KEM, and returns it along
+// with the corresponding ECHConfig, which supports HKDF-SHA256 with either
+// AES-128-GCM or ChaCha20Poly1305.
+func newTestECHKey(t *testing.T, kemID uint16, configID uint8, publicName string) EncryptedClientHelloKey {
+	t.Helper()
+	kem, err := hpke.NewKEM(kemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := kem.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint16(extensionEncryptedClientHello)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint8(configID)
+		b.AddUint16(kemID)
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(k.PublicKey().Bytes())
+		})
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddUint16(0x0001) // HKDF-SHA256
+			b.AddUint16(0x0001) // AES-128-GCM
+			b.AddUint16(0x0001) // HKDF-SHA256
+			b.AddUint16(0x0003) // ChaCha20Poly1305
+		})
+		b.AddUint8(32) // maximum_name_length
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes([]byte(publicName))
+		})
+		b.AddUint16(0) // extensions
+	})
+	return EncryptedClientHelloKey{
+		Config:     b.BytesOrPanic(),
+		PrivateKey: k.Bytes(),
+	}
+}
+
+func echConfigList(configs ...[]byte) []byte {
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range configs {
+			b.AddBytes(c)
+		}
+	})
+	return b.BytesOrPanic()
+}
+
+func TestECHServer(t *testing.T) {
+	x25519Key := newTestECHKey(t, 0x0020, 1, "public.example")
+	p256Key := newTestECHKey(t, 0x0010, 2, "public.example")
+	unknownKey := newTestECHKey(t, 0x0020, 3, "public.example")
+	retryKey := x25519Key
+	retryKey.SendAsRetry = true
+
+	for _, tc := range []struct {
+		name          string
+		keys          []EncryptedClientHelloKey
+		clientConfig  []byte
+		hrr           bool
+		wantAccepted  bool
+		wantRetryList []byte
+	}{
+		{
+			name:         "accepted",
+			keys:         []EncryptedClientHelloKey{x25519Key},
+			clientConfig: x25519Key.Config,
+			wantAccepted: true,
+		},
+		{
+			name:         "accepted with HelloRetryRequest",
+			keys:         []EncryptedClientHelloKey{x25519Key},
+			clientConfig: x25519Key.Config,
+			hrr:          true,
+			wantAccepted: true,
+		},
+		{
+			name:         "accepted with second key",
+			keys:         []EncryptedClientHelloKey{x25519Key, p256Key},
+			clientConfig: p256Key.Config,
+			wantAccepted: true,
+		},
+		{
+			name:         "rejected without retry configs",
+			keys:         []EncryptedClientHelloKey{x25519Key},
+			clientConfig: unknownKey.Config,
+		},
+		{
+			name:          "rejected with retry configs",
+			keys:          []EncryptedClientHelloKey{retryKey, p256Key},
+			clientConfig:  unknownKey.Config,
+			wantRetryList: echConfigList(retryKey.Config),
+		},
+		{
+			name:          "rejected with retry configs and HelloRetryRequest",
+			keys:          []EncryptedClientHelloKey{retryKey},
+			clientConfig:  unknownKey.Config,
+			hrr:           true,
+			wantRetryList: echConfigList(retryKey.Config),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clientConfig, serverConfig := testConfig.Clone(), testConfig.Clone()
+			clientConfig.MinVersion = VersionTLS13
+			clientConfig.ServerName = "secret.example"
+			clientConfig.EncryptedClientHelloConfigList = echConfigList(tc.clientConfig)
+			clientConfig.EncryptedClientHelloRejectionVerify = func(cs ConnectionState) error {
+				if cs.ServerName != "public.example" {
+					t.Errorf("rejection verified for %q, want public.example", cs.ServerName)
+				}
+				return nil
+			}
+			serverConfig.EncryptedClientHelloKeys = tc.keys
+			if tc.hrr {
+				clientConfig.CurvePreferences = []CurveID{X25519, CurveP256}
+				serverConfig.CurvePreferences = []CurveID{CurveP256}
+			}
+			var sawServerName string
+			serverConfig.GetConfigForClient = func(chi *ClientHelloInfo) (*Config, error) {
+				sawServerName = chi.ServerName
+				return nil, nil
+			}
+
+			if !tc.wantAccepted {
+				// testHandshake flattens the errors, so drive the handshake
+				// here to inspect the ECHRejectionError.
+				c, s := localPipe(t)
+				go func() {
+					Server(s, serverConfig).Handshake()
+					s.Close()
+				}()
+				err := Client(c, clientConfig).Handshake()
+				c.Close()
+				var echErr *ECHRejectionError
+				if !errors.As(err, &echErr) {
+					t.Fatalf("handshake error = %v, want ECHRejectionError", err)
+				}
+				if !bytes.Equal(echErr.RetryConfigList, tc.wantRetryList) {
+					t.Errorf("RetryConfigList = %x, want %x", echErr.RetryConfigList, tc.wantRetryList)
+				}
+				if sawServerName != "public.example" {
+					t.Errorf("GetConfigForClient saw ServerName %q, want public.example", sawServerName)
+				}
+				return
+			}
+
+			ss, cs, err := testHandshake(t, clientConfig, serverConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ss.ECHAccepted || !cs.ECHAccepted {
+				t.Errorf("ECHAccepted: server %v, client %v; want true", ss.ECHAccepted, cs.ECHAccepted)
+			}
+			if ss.ServerName != "secret.example" || sawServerName != "secret.example" {
+				t.Errorf("server saw ServerName %q (GetConfigForClient %q), want secret.example", ss.ServerName, sawServerName)
+			}
+			if ss.DidResume || cs.DidResume {
+				t.Error("unexpected resumption")
+			}
+		})
+	}
+}
+
+func TestECHServerNotOffered(t *testing.T) {
+	clientConfig, serverConfig := testConfig.Clone(), testConfig.Clone()
+	serverConfig.EncryptedClientHelloKeys = []EncryptedClientHelloKey{newTestECHKey(t, 0x0020, 1, "public.example")}
+	ss, cs, err := testHandshake(t, clientConfig, serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss.ECHAccepted || cs.ECHAccepted {
+		t.Error("ECH accepted without being offered")
+	}
+}
+
+func TestECHServerTLS12(t *testing.T) {
+	clientConfig, serverConfig := testConfig.Clone(), testConfig.Clone()
+	key := newTestECHKey(t, 0x0020, 1, "public.example")
+	clientConfig.EncryptedClientHelloConfigList = echConfigList(key.Config)
+	clientConfig.MinVersion = 0
+	serverConfig.EncryptedClientHelloKeys = []EncryptedClientHelloKey{key}
+	serverConfig.MaxVersion = VersionTLS12
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err == nil {
+		t.Fatal("handshake succeeded with ECH and TLS 1.2")
+	}
+}
@@ -10,8 +10,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
-	"crypto/internal/hpke"
-	"crypto/internal/mlkem768"
+	"crypto/hpke"
 	"crypto/rsa"
 	"crypto/subtle"
 	"crypto/x509"
@@ -22,6 +21,7 @@ import (
 	"internal/godebug"
 	"io"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -148,39 +148,21 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, *keySharePrivateKeys, *echCon
 		}
 
 		curveID := config.curvePreferences(maxVersion)[0]
-		keyShareKeys = &keySharePrivateKeys{curveID: curveID}
-		if curveID == x25519Kyber768Draft00 {
-			keyShareKeys.ecdhe, err = generateECDHEKey(config.rand(), X25519)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			seed := make([]byte, mlkem768.SeedSize)
-			if _, err := io.ReadFull(config.rand(), seed); err != nil {
-				return nil, nil, nil, err
-			}
-			keyShareKeys.kyber, err = mlkem768.NewKeyFromSeed(seed)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			// For draft-tls-westerbaan-xyber768d00-03, we send both a hybrid
-			// and a standard X25519 key share, since most servers will only
-			// support the latter. We reuse the same X25519 ephemeral key for
-			// both, as allowed by draft-ietf-tls-hybrid-design-09, Section 3.2.
-			hello.keyShares = []keyShare{
-				{group: x25519Kyber768Draft00, data: append(keyShareKeys.ecdhe.PublicKey().Bytes(),
-					keyShareKeys.kyber.EncapsulationKey()...)},
-				{group: X25519, data: keyShareKeys.ecdhe.PublicKey().Bytes()},
-			}
-		} else {
-			if _, ok := curveForCurveID(curveID); !ok {
-				return nil, nil, nil, errors.New("tls: CurvePreferences includes unsupported curve")
-			}
-			keyShareKeys.ecdhe, err = generateECDHEKey(config.rand(), curveID)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-			hello.keyShares = []keyShare{{group: curveID, data: keyShareKeys.ecdhe.PublicKey().Bytes()}}
+		var data []byte
+		keyShareKeys, data, err = newKeyShare(config.rand(), curveID)
+		if err != nil {
+			return nil, nil, nil, err
 		}
+		hello.keyShares = []keyShare{{group: curveID, data: data}}
+		// For hybrid key exchanges, we also send a key share for the ECDH
+		// component if it's supported, since many servers will only support
+		// the latter. We reuse the same ephemeral key for both, as allowed by
+		// draft-ietf-tls-hybrid-design-09, Section 3.2.
+		if h, ok := hybridGroups[curveID]; ok && slices.Contains(hello.supportedCurves, h.ecdh) {
+			hello.keyShares = append(hello.keyShares, keyShare{group: h.ecdh, data: keyShareKeys.ecdhe.PublicKey().Bytes()})
+		}
+
+		hello.certCompressionAlgorithms = config.certCompressionAlgorithms()
 	}
 
 	if c.quic != nil {
@@ -220,7 +202,11 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, *keySharePrivateKeys, *echCon
 		hello.secureRenegotiationSupported = false
 		hello.extendedMasterSecret = false
 
-		echPK, err := hpke.ParseHPKEPublicKey(ech.config.KemID, ech.config.PublicKey)
+		kem, err := hpke.NewKEM(ech.config.KemID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		echPK, err := kem.NewPublicKey(ech.config.PublicKey)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -228,9 +214,13 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, *keySharePrivateKeys, *echCon
 		if err != nil {
 			return nil, nil, nil, err
 		}
+		kdf, aead, err := echCipherSuite(suite)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 		ech.kdfID, ech.aeadID = suite.KDFID, suite.AEADID
 		info := append([]byte("tls ech\x00"), ech.config.raw...)
-		ech.encapsulatedKey, ech.hpkeContext, err = hpke.SetupSender(ech.config.KemID, suite.KDFID, suite.AEADID, echPK, info)
+		ech.encapsulatedKey, ech.hpkeContext, err = hpke.NewSender(echPK, kdf, aead, info)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -248,6 +238,7 @@ type echContext struct {
 	kdfID           uint16
 	aeadID          uint16
 	echRejected     bool
+	retryConfigs    []byte
 }
 
 func (c *Conn) clientHandshake(ctx context.Context) (err error) {
@@ -696,6 +687,7 @@ func (hs *clientHandshakeState) doFullHandshake() error {
 			c.sendAlert(alertBadCertificate)
 			return errors.New("tls: server's identity changed during renegotiation")
 		}
+		c.verifiedOCSP = c.verifiedOCSPResponse()
 	}
 
 	keyAgreement := hs.suite.ka(c.vers)
@@ -922,6 +914,7 @@ func (hs *clientHandshakeState) processServerHello() (bool, error) {
 	c.activeCertHandles = hs.c.activeCertHandles
 	c.verifiedChains = hs.session.verifiedChains
 	c.ocspResponse = hs.session.ocspResponse
+	c.verifiedOCSP = c.verifiedOCSPResponse()
 	// Let the ServerHello SCTs override the session SCTs from the original
 	// connection, if any are provided
 	if len(c.scts) == 0 && len(hs.session.scts) != 0 {
@@ -1101,6 +1094,7 @@ func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
 				CurrentTime:   c.config.time(),
 				DNSName:       c.serverName,
 				Intermediates: x509.NewCertPool(),
+				Revocation:    c.revocationOptions(c.ocspResponse),
 			}
 
 			for _, cert := range certs[1:] {
@@ -1109,7 +1103,7 @@ func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
 			var err error
 			c.verifiedChains, err = certs[0].Verify(opts)
 			if err != nil {
-				c.sendAlert(alertBadCertificate)
+				c.sendAlert(verificationErrorAlert(err))
 				return &CertificateVerificationError{UnverifiedCertificates: certs, Err: err}
 			}
 		}
@@ -1119,6 +1113,7 @@ func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
 			CurrentTime:   c.config.time(),
 			DNSName:       c.config.ServerName,
 			Intermediates: x509.NewCertPool(),
+			Revocation:    c.revocationOptions(c.ocspResponse),
 		}
 
 		for _, cert := range certs[1:] {
@@ -1127,9 +1122,22 @@ func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
 		var err error
 		c.verifiedChains, err = certs[0].Verify(opts)
 		if err != nil {
-			c.sendAlert(alertBadCertificate)
+			c.sendAlert(verificationErrorAlert(err))
 			return &CertificateVerificationError{UnverifiedCertificates: certs, Err: err}
 		}
+		c.verifiedOCSP = c.verifiedOCSPResponse()
+
+		if c.config.CTPolicy != nil {
+			var issuer *x509.Certificate
+			if chain := c.verifiedChains[0]; len(chain) > 1 {
+				issuer = chain[1]
+			}
+			_, err := c.config.CTPolicy.Check(certs[0], issuer, c.scts, c.verifiedOCSP, c.config.time())
+			if err != nil {
+				c.sendAlert(alertBadCertificate)
+				return &CertificateVerificationError{UnverifiedCertificates: certs, Err: err}
+			}
+		}
 	}
 
 	switch certs[0].PublicKey.(type) {
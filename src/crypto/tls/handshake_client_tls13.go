@@ -9,7 +9,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/hmac"
-	"crypto/internal/mlkem768"
+	"crypto/internal/mlkem"
 	"crypto/rsa"
 	"crypto/subtle"
 	"errors"
@@ -87,7 +87,6 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 		}
 	}
 
-	var echRetryConfigList []byte
 	if hs.echContext != nil {
 		confTranscript := cloneHash(hs.echContext.innerTranscript, hs.suite.hash)
 		confTranscript.Write(hs.serverHello.original[:30])
@@ -116,9 +115,6 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 			}
 		} else {
 			hs.echContext.echRejected = true
-			// If the server sent us retry configs, we'll return these to
-			// the user so they can update their Config.
-			echRetryConfigList = hs.serverHello.encryptedClientHello
 		}
 	}
 
@@ -157,7 +153,7 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 
 	if hs.echContext != nil && hs.echContext.echRejected {
 		c.sendAlert(alertECHRequired)
-		return &ECHRejectionError{echRetryConfigList}
+		return &ECHRejectionError{hs.echContext.retryConfigs}
 	}
 
 	c.isHandshakeComplete.Store(true)
@@ -324,23 +320,13 @@ func (hs *clientHandshakeStateTLS13) processHelloRetryRequest() error {
 			c.sendAlert(alertIllegalParameter)
 			return errors.New("tls: server sent an unnecessary HelloRetryRequest key_share")
 		}
-		// Note: we don't support selecting X25519Kyber768Draft00 in a HRR,
-		// because we currently only support it at all when CurvePreferences is
-		// empty, which will cause us to also send a key share for it.
-		//
-		// This will have to change once we support selecting hybrid KEMs
-		// without sending key shares for them.
-		if _, ok := curveForCurveID(curveID); !ok {
-			c.sendAlert(alertInternalError)
-			return errors.New("tls: CurvePreferences includes unsupported curve")
-		}
-		key, err := generateECDHEKey(c.config.rand(), curveID)
+		keyShareKeys, data, err := newKeyShare(c.config.rand(), curveID)
 		if err != nil {
 			c.sendAlert(alertInternalError)
 			return err
 		}
-		hs.keyShareKeys = &keySharePrivateKeys{curveID: curveID, ecdhe: key}
-		hello.keyShares = []keyShare{{group: curveID, data: key.PublicKey().Bytes()}}
+		hs.keyShareKeys = keyShareKeys
+		hello.keyShares = []keyShare{{group: curveID, data: data}}
 	}
 
 	if len(hello.pskIdentities) > 0 {
@@ -474,6 +460,7 @@ func (hs *clientHandshakeStateTLS13) processServerHello() error {
 	c.activeCertHandles = hs.session.activeCertHandles
 	c.verifiedChains = hs.session.verifiedChains
 	c.ocspResponse = hs.session.ocspResponse
+	c.verifiedOCSP = c.verifiedOCSPResponse()
 	c.scts = hs.session.scts
 	return nil
 }
@@ -482,12 +469,15 @@ func (hs *clientHandshakeStateTLS13) establishHandshakeKeys() error {
 	c := hs.c
 
 	ecdhePeerData := hs.serverHello.serverShare.data
-	if hs.serverHello.serverShare.group == x25519Kyber768Draft00 {
-		if len(ecdhePeerData) != x25519PublicKeySize+mlkem768.CiphertextSize {
+	hybrid, isHybrid := hybridGroups[hs.serverHello.serverShare.group]
+	var ciphertext []byte
+	if isHybrid {
+		var ok bool
+		ecdhePeerData, ciphertext, ok = hybrid.split(ecdhePeerData, mlkem.CiphertextSize768)
+		if !ok {
 			c.sendAlert(alertIllegalParameter)
 			return errors.New("tls: invalid server key share")
 		}
-		ecdhePeerData = hs.serverHello.serverShare.data[:x25519PublicKeySize]
 	}
 	peerKey, err := hs.keyShareKeys.ecdhe.Curve().NewPublicKey(ecdhePeerData)
 	if err != nil {
@@ -499,17 +489,16 @@ func (hs *clientHandshakeStateTLS13) establishHandshakeKeys() error {
 		c.sendAlert(alertIllegalParameter)
 		return errors.New("tls: invalid server key share")
 	}
-	if hs.serverHello.serverShare.group == x25519Kyber768Draft00 {
-		if hs.keyShareKeys.kyber == nil {
+	if isHybrid {
+		if hs.keyShareKeys.mlkem == nil {
 			return c.sendAlert(alertInternalError)
 		}
-		ciphertext := hs.serverHello.serverShare.data[x25519PublicKeySize:]
-		kyberShared, err := kyberDecapsulate(hs.keyShareKeys.kyber, ciphertext)
+		mlkemShared, err := hs.keyShareKeys.mlkem.Decapsulate(ciphertext)
 		if err != nil {
 			c.sendAlert(alertIllegalParameter)
-			return errors.New("tls: invalid Kyber server key share")
+			return errors.New("tls: invalid ML-KEM server key share")
 		}
-		sharedKey = append(sharedKey, kyberShared...)
+		sharedKey = hybrid.join(sharedKey, mlkemShared)
 	}
 	c.curveID = hs.serverHello.serverShare.group
 
@@ -612,6 +601,11 @@ func (hs *clientHandshakeStateTLS13) readServerParameters() error {
 		c.sendAlert(alertUnsupportedExtension)
 		return errors.New("tls: server sent ECH retry configs after accepting ECH")
 	}
+	if hs.echContext != nil && hs.echContext.echRejected {
+		// If the server sent us retry configs, we'll return these to the
+		// user so they can update their Config.
+		hs.echContext.retryConfigs = encryptedExtensions.echRetryConfigs
+	}
 
 	return nil
 }
@@ -649,6 +643,13 @@ func (hs *clientHandshakeStateTLS13) readServerCertificate() error {
 		}
 	}
 
+	if compressedMsg, ok := msg.(*compressedCertificateMsg); ok {
+		msg, err = c.decompressCertificateMsg(compressedMsg, hs.hello.certCompressionAlgorithms)
+		if err != nil {
+			return err
+		}
+	}
+
 	certMsg, ok := msg.(*certificateMsgTLS13)
 	if !ok {
 		c.sendAlert(alertUnexpectedMessage)
@@ -788,7 +789,11 @@ func (hs *clientHandshakeStateTLS13) sendClientCertificate() error {
 	certMsg.scts = hs.certReq.scts && len(cert.SignedCertificateTimestamps) > 0
 	certMsg.ocspStapling = hs.certReq.ocspStapling && len(cert.OCSPStaple) > 0
 
-	if _, err := hs.c.writeHandshakeRecord(certMsg, hs.transcript); err != nil {
+	msg, err := c.compressCertificateMsg(certMsg, hs.certReq.certCompressionAlgorithms)
+	if err != nil {
+		return err
+	}
+	if _, err := hs.c.writeHandshakeRecord(msg, hs.transcript); err != nil {
 		return err
 	}
 
@@ -97,6 +97,7 @@ type clientHelloMsg struct {
 	pskBinders                       [][]byte
 	quicTransportParameters          []byte
 	encryptedClientHello             []byte
+	certCompressionAlgorithms        []CertificateCompressionAlgorithm
 }
 
 func (m *clientHelloMsg) marshalMsg(echInner bool) ([]byte, error) {
@@ -229,6 +230,21 @@ func (m *clientHelloMsg) marshalMsg(echInner bool) ([]byte, error) {
 			})
 		}
 	}
+	if len(m.certCompressionAlgorithms) > 0 {
+		// RFC 8879, Section 3
+		if echInner {
+			echOuterExts = append(echOuterExts, extensionCompressCertificate)
+		} else {
+			exts.AddUint16(extensionCompressCertificate)
+			exts.AddUint16LengthPrefixed(func(exts *cryptobyte.Builder) {
+				exts.AddUint8LengthPrefixed(func(exts *cryptobyte.Builder) {
+					for _, alg := range m.certCompressionAlgorithms {
+						exts.AddUint16(uint16(alg))
+					}
+				})
+			})
+		}
+	}
 	if len(m.alpnProtocols) > 0 {
 		// RFC 7301, Section 3.1
 		if echInner {
@@ -557,6 +573,20 @@ func (m *clientHelloMsg) unmarshal(data []byte) bool {
 				m.supportedSignatureAlgorithmsCert = append(
 					m.supportedSignatureAlgorithmsCert, SignatureScheme(sigAndAlg))
 			}
+		case extensionCompressCertificate:
+			// RFC 8879, Section 3
+			var algs cryptobyte.String
+			if !extData.ReadUint8LengthPrefixed(&algs) || algs.Empty() {
+				return false
+			}
+			for !algs.Empty() {
+				var alg uint16
+				if !algs.ReadUint16(&alg) {
+					return false
+				}
+				m.certCompressionAlgorithms = append(
+					m.certCompressionAlgorithms, CertificateCompressionAlgorithm(alg))
+			}
 		case extensionRenegotiationInfo:
 			// RFC 5746, Section 3.2
 			if !readUint8LengthPrefixed(&extData, &m.secureRenegotiation) {
@@ -629,6 +659,14 @@ func (m *clientHelloMsg) unmarshal(data []byte) bool {
 			if !extData.CopyBytes(m.quicTransportParameters) {
 				return false
 			}
+		case extensionEncryptedClientHello:
+			if len(extData) == 0 {
+				return false
+			}
+			m.encryptedClientHello = make([]byte, len(extData))
+			if !extData.CopyBytes(m.encryptedClientHello) {
+				return false
+			}
 		case extensionPreSharedKey:
 			// RFC 8446, Section 4.2.11
 			if !extensions.Empty() {
@@ -706,6 +744,7 @@ func (m *clientHelloMsg) clone() *clientHelloMsg {
 		pskBinders:                       slices.Clone(m.pskBinders),
 		quicTransportParameters:          slices.Clone(m.quicTransportParameters),
 		encryptedClientHello:             slices.Clone(m.encryptedClientHello),
+		certCompressionAlgorithms:        slices.Clone(m.certCompressionAlgorithms),
 	}
 }
 
@@ -1225,6 +1264,7 @@ type certificateRequestMsgTLS13 struct {
 	supportedSignatureAlgorithms     []SignatureScheme
 	supportedSignatureAlgorithmsCert []SignatureScheme
 	certificateAuthorities           [][]byte
+	certCompressionAlgorithms        []CertificateCompressionAlgorithm
 }
 
 func (m *certificateRequestMsgTLS13) marshal() ([]byte, error) {
@@ -1281,6 +1321,17 @@ func (m *certificateRequestMsgTLS13) marshal() ([]byte, error) {
 					})
 				})
 			}
+			if len(m.certCompressionAlgorithms) > 0 {
+				// RFC 8879, Section 3
+				b.AddUint16(extensionCompressCertificate)
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+					b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+						for _, alg := range m.certCompressionAlgorithms {
+							b.AddUint16(uint16(alg))
+						}
+					})
+				})
+			}
 		})
 	})
 
@@ -1350,6 +1401,19 @@ func (m *certificateRequestMsgTLS13) unmarshal(data []byte) bool {
 				}
 				m.certificateAuthorities = append(m.certificateAuthorities, ca)
 			}
+		case extensionCompressCertificate:
+			var algs cryptobyte.String
+			if !extData.ReadUint8LengthPrefixed(&algs) || algs.Empty() {
+				return false
+			}
+			for !algs.Empty() {
+				var alg uint16
+				if !algs.ReadUint16(&alg) {
+					return false
+				}
+				m.certCompressionAlgorithms = append(
+					m.certCompressionAlgorithms, CertificateCompressionAlgorithm(alg))
+			}
 		default:
 			// Ignore unknown extensions.
 			continue
@@ -1573,6 +1637,40 @@ func unmarshalCertificate(s *cryptobyte.String, certificate *Certificate) bool {
 	return true
 }
 
+// compressedCertificateMsg replaces a TLS 1.3 Certificate message when
+// certificate compression is negotiated. See RFC 8879, Section 4.
+type compressedCertificateMsg struct {
+	algorithm          CertificateCompressionAlgorithm
+	uncompressedLength uint32
+	compressed         []byte
+}
+
+func (m *compressedCertificateMsg) marshal() ([]byte, error) {
+	var b cryptobyte.Builder
+	b.AddUint8(typeCompressedCertificate)
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint16(uint16(m.algorithm))
+		b.AddUint24(m.uncompressedLength)
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(m.compressed)
+		})
+	})
+
+	return b.Bytes()
+}
+
+func (m *compressedCertificateMsg) unmarshal(data []byte) bool {
+	*m = compressedCertificateMsg{}
+	s := cryptobyte.String(data)
+
+	return s.Skip(4) && // message type and uint24 length field
+		s.ReadUint16((*uint16)(&m.algorithm)) &&
+		s.ReadUint24(&m.uncompressedLength) &&
+		readUint24LengthPrefixed(&s, &m.compressed) &&
+		len(m.compressed) > 0 &&
+		s.Empty()
+}
+
 type serverKeyExchangeMsg struct {
 	key []byte
 }
@@ -36,6 +36,7 @@ var tests = []handshakeMessage{
 	&newSessionTicketMsgTLS13{},
 	&certificateRequestMsgTLS13{},
 	&certificateMsgTLS13{},
+	&compressedCertificateMsg{},
 	&SessionState{},
 }
 
@@ -201,6 +202,9 @@ func (*clientHelloMsg) Generate(rand *rand.Rand, size int) reflect.Value {
 		ks.data = randomBytes(rand.Intn(200)+1, rand)
 		m.keyShares = append(m.keyShares, ks)
 	}
+	for i := 0; i < rand.Intn(4); i++ {
+		m.certCompressionAlgorithms = append(m.certCompressionAlgorithms, CertificateCompressionAlgorithm(rand.Intn(0xffff)+1))
+	}
 	switch rand.Intn(3) {
 	case 1:
 		m.pskModes = []uint8{pskModeDHE}
@@ -471,6 +475,9 @@ func (*certificateRequestMsgTLS13) Generate(rand *rand.Rand, size int) reflect.V
 			m.certificateAuthorities[i] = randomBytes(rand.Intn(10)+1, rand)
 		}
 	}
+	for i := 0; i < rand.Intn(4); i++ {
+		m.certCompressionAlgorithms = append(m.certCompressionAlgorithms, CertificateCompressionAlgorithm(rand.Intn(0xffff)+1))
+	}
 	return reflect.ValueOf(m)
 }
 
@@ -494,6 +501,14 @@ func (*certificateMsgTLS13) Generate(rand *rand.Rand, size int) reflect.Value {
 	return reflect.ValueOf(m)
 }
 
+func (*compressedCertificateMsg) Generate(rand *rand.Rand, size int) reflect.Value {
+	m := &compressedCertificateMsg{}
+	m.algorithm = CertificateCompressionAlgorithm(rand.Intn(0x10000))
+	m.uncompressedLength = uint32(rand.Intn(1 << 24))
+	m.compressed = randomBytes(rand.Intn(500)+1, rand)
+	return reflect.ValueOf(m)
+}
+
 func TestRejectEmptySCTList(t *testing.T) {
 	// RFC 6962, Section 3.3.1 specifies that empty SCT lists are invalid.
 
@@ -9,7 +9,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/hmac"
-	"crypto/internal/mlkem768"
+	"crypto/internal/mlkem"
 	"crypto/rsa"
 	"errors"
 	"hash"
@@ -42,6 +42,7 @@ type serverHandshakeStateTLS13 struct {
 	trafficSecret   []byte // client_application_traffic_secret_0
 	transcript      hash.Hash
 	clientFinished  []byte
+	echContext      *echServerContext
 }
 
 func (hs *serverHandshakeStateTLS13) handshake() error {
@@ -221,13 +222,16 @@ func (hs *serverHandshakeStateTLS13) processClientHello() error {
 
 	ecdhGroup := selectedGroup
 	ecdhData := clientKeyShare.data
-	if selectedGroup == x25519Kyber768Draft00 {
-		ecdhGroup = X25519
-		if len(ecdhData) != x25519PublicKeySize+mlkem768.EncapsulationKeySize {
+	hybrid, isHybrid := hybridGroups[selectedGroup]
+	var encapsulationKey []byte
+	if isHybrid {
+		ecdhGroup = hybrid.ecdh
+		var ok bool
+		ecdhData, encapsulationKey, ok = hybrid.split(ecdhData, mlkem.EncapsulationKeySize768)
+		if !ok {
 			c.sendAlert(alertIllegalParameter)
-			return errors.New("tls: invalid Kyber client key share")
+			return errors.New("tls: invalid hybrid client key share")
 		}
-		ecdhData = ecdhData[:x25519PublicKeySize]
 	}
 	if _, ok := curveForCurveID(ecdhGroup); !ok {
 		c.sendAlert(alertInternalError)
@@ -249,14 +253,15 @@ func (hs *serverHandshakeStateTLS13) processClientHello() error {
 		c.sendAlert(alertIllegalParameter)
 		return errors.New("tls: invalid client key share")
 	}
-	if selectedGroup == x25519Kyber768Draft00 {
-		ciphertext, kyberShared, err := kyberEncapsulate(clientKeyShare.data[x25519PublicKeySize:])
+	if isHybrid {
+		k, err := mlkem.NewEncapsulationKey768(encapsulationKey)
 		if err != nil {
 			c.sendAlert(alertIllegalParameter)
-			return errors.New("tls: invalid Kyber client key share")
+			return errors.New("tls: invalid ML-KEM client key share")
 		}
-		hs.sharedKey = append(hs.sharedKey, kyberShared...)
-		hs.hello.serverShare.data = append(hs.hello.serverShare.data, ciphertext...)
+		mlkemShared, ciphertext := k.Encapsulate()
+		hs.sharedKey = hybrid.join(hs.sharedKey, mlkemShared)
+		hs.hello.serverShare.data = hybrid.join(hs.hello.serverShare.data, ciphertext)
 	}
 
 	selectedProto, err := negotiateALPN(c.config.NextProtos, hs.clientHello.alpnProtocols, c.quic != nil)
@@ -530,6 +535,27 @@ func (hs *serverHandshakeStateTLS13) doHelloRetryRequest(selectedGroup CurveID)
 		selectedGroup:     selectedGroup,
 	}
 
+	if c.echAccepted {
+		// Signal ECH acceptance with a confirmation in the
+		// encrypted_client_hello extension, computed over the
+		// HelloRetryRequest with the confirmation set to zeroes.
+		helloRetryRequest.encryptedClientHello = make([]byte, 8)
+		confTranscript := cloneHash(hs.transcript, hs.suite.hash)
+		if confTranscript == nil {
+			c.sendAlert(alertInternalError)
+			return nil, errors.New("tls: internal error: failed to clone hash")
+		}
+		if err := transcriptMsg(helloRetryRequest, confTranscript); err != nil {
+			return nil, err
+		}
+		helloRetryRequest.encryptedClientHello = hs.suite.expandLabel(
+			hs.suite.extract(hs.clientHello.random, nil),
+			"hrr ech accept confirmation",
+			confTranscript.Sum(nil),
+			8,
+		)
+	}
+
 	if _, err := hs.c.writeHandshakeRecord(helloRetryRequest, hs.transcript); err != nil {
 		return nil, err
 	}
@@ -550,6 +576,13 @@ func (hs *serverHandshakeStateTLS13) doHelloRetryRequest(selectedGroup CurveID)
 		return nil, unexpectedMessageError(clientHello, msg)
 	}
 
+	if c.echAccepted {
+		clientHello, err = c.processSecondECHClientHello(clientHello, hs.echContext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if len(clientHello.keyShares) != 1 {
 		c.sendAlert(alertIllegalParameter)
 		return nil, errors.New("tls: client didn't send one key share in second ClientHello")
@@ -640,6 +673,27 @@ func (hs *serverHandshakeStateTLS13) sendServerParameters() error {
 	if err := transcriptMsg(hs.clientHello, hs.transcript); err != nil {
 		return err
 	}
+	if c.echAccepted {
+		// Signal ECH acceptance by replacing the last 8 bytes of the server
+		// random with a confirmation, computed over the ServerHello with those
+		// bytes set to zeroes.
+		copy(hs.hello.random[32-8:], make([]byte, 8))
+		echTranscript := cloneHash(hs.transcript, hs.suite.hash)
+		if echTranscript == nil {
+			c.sendAlert(alertInternalError)
+			return errors.New("tls: internal error: failed to clone hash")
+		}
+		if err := transcriptMsg(hs.hello, echTranscript); err != nil {
+			return err
+		}
+		acceptConfirmation := hs.suite.expandLabel(
+			hs.suite.extract(hs.clientHello.random, nil),
+			"ech accept confirmation",
+			echTranscript.Sum(nil),
+			8,
+		)
+		copy(hs.hello.random[32-8:], acceptConfirmation)
+	}
 	if _, err := hs.c.writeHandshakeRecord(hs.hello, hs.transcript); err != nil {
 		return err
 	}
@@ -683,6 +737,9 @@ func (hs *serverHandshakeStateTLS13) sendServerParameters() error {
 
 	encryptedExtensions := new(encryptedExtensionsMsg)
 	encryptedExtensions.alpnProtocol = c.clientProtocol
+	if hs.echContext != nil && !c.echAccepted {
+		encryptedExtensions.echRetryConfigs = hs.echContext.retryConfigs
+	}
 
 	if c.quic != nil {
 		p, err := c.quicGetTransportParameters()
@@ -721,6 +778,7 @@ func (hs *serverHandshakeStateTLS13) sendServerCertificate() error {
 		if c.config.ClientCAs != nil {
 			certReq.certificateAuthorities = c.config.ClientCAs.Subjects()
 		}
+		certReq.certCompressionAlgorithms = c.config.certCompressionAlgorithms()
 
 		if _, err := hs.c.writeHandshakeRecord(certReq, hs.transcript); err != nil {
 			return err
@@ -733,7 +791,11 @@ func (hs *serverHandshakeStateTLS13) sendServerCertificate() error {
 	certMsg.scts = hs.clientHello.scts && len(hs.cert.SignedCertificateTimestamps) > 0
 	certMsg.ocspStapling = hs.clientHello.ocspStapling && len(hs.cert.OCSPStaple) > 0
 
-	if _, err := hs.c.writeHandshakeRecord(certMsg, hs.transcript); err != nil {
+	msg, err := c.compressCertificateMsg(certMsg, hs.clientHello.certCompressionAlgorithms)
+	if err != nil {
+		return err
+	}
+	if _, err := hs.c.writeHandshakeRecord(msg, hs.transcript); err != nil {
 		return err
 	}
 
@@ -944,6 +1006,13 @@ func (hs *serverHandshakeStateTLS13) readClientCertificate() error {
 		return err
 	}
 
+	if compressedMsg, ok := msg.(*compressedCertificateMsg); ok {
+		msg, err = c.decompressCertificateMsg(compressedMsg, c.config.certCompressionAlgorithms())
+		if err != nil {
+			return err
+		}
+	}
+
 	certMsg, ok := msg.(*certificateMsgTLS13)
 	if !ok {
 		c.sendAlert(alertUnexpectedMessage)
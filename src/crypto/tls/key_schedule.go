@@ -7,15 +7,11 @@ package tls
 import (
 	"crypto/ecdh"
 	"crypto/hmac"
-	"crypto/internal/mlkem768"
+	"crypto/internal/fips140/tls13"
+	"crypto/internal/mlkem"
 	"errors"
-	"fmt"
 	"hash"
 	"io"
-
-	"golang.org/x/crypto/cryptobyte"
-	"golang.org/x/crypto/hkdf"
-	"golang.org/x/crypto/sha3"
 )
 
 // This file contains the functions necessary to compute the TLS 1.3 key
@@ -35,53 +31,17 @@ const (
 
 // expandLabel implements HKDF-Expand-Label from RFC 8446, Section 7.1.
 func (c *cipherSuiteTLS13) expandLabel(secret []byte, label string, context []byte, length int) []byte {
-	var hkdfLabel cryptobyte.Builder
-	hkdfLabel.AddUint16(uint16(length))
-	hkdfLabel.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
-		b.AddBytes([]byte("tls13 "))
-		b.AddBytes([]byte(label))
-	})
-	hkdfLabel.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
-		b.AddBytes(context)
-	})
-	hkdfLabelBytes, err := hkdfLabel.Bytes()
-	if err != nil {
-		// Rather than calling BytesOrPanic, we explicitly handle this error, in
-		// order to provide a reasonable error message. It should be basically
-		// impossible for this to panic, and routing errors back through the
-		// tree rooted in this function is quite painful. The labels are fixed
-		// size, and the context is either a fixed-length computed hash, or
-		// parsed from a field which has the same length limitation. As such, an
-		// error here is likely to only be caused during development.
-		//
-		// NOTE: another reasonable approach here might be to return a
-		// randomized slice if we encounter an error, which would break the
-		// connection, but avoid panicking. This would perhaps be safer but
-		// significantly more confusing to users.
-		panic(fmt.Errorf("failed to construct HKDF label: %s", err))
-	}
-	out := make([]byte, length)
-	n, err := hkdf.Expand(c.hash.New, secret, hkdfLabelBytes).Read(out)
-	if err != nil || n != length {
-		panic("tls: HKDF-Expand-Label invocation failed unexpectedly")
-	}
-	return out
+	return tls13.ExpandLabel(c.hash.New, secret, label, context, length)
 }
 
 // deriveSecret implements Derive-Secret from RFC 8446, Section 7.1.
 func (c *cipherSuiteTLS13) deriveSecret(secret []byte, label string, transcript hash.Hash) []byte {
-	if transcript == nil {
-		transcript = c.hash.New()
-	}
-	return c.expandLabel(secret, label, transcript.Sum(nil), c.hash.Size())
+	return tls13.DeriveSecret(c.hash.New, secret, label, transcript)
 }
 
 // extract implements HKDF-Extract with the cipher suite hash.
 func (c *cipherSuiteTLS13) extract(newSecret, currentSecret []byte) []byte {
-	if newSecret == nil {
-		newSecret = make([]byte, c.hash.Size())
-	}
-	return hkdf.Extract(c.hash.New, newSecret, currentSecret)
+	return tls13.Extract(c.hash.New, newSecret, currentSecret)
 }
 
 // nextTrafficSecret generates the next traffic secret, given the current one,
@@ -122,38 +82,81 @@ func (c *cipherSuiteTLS13) exportKeyingMaterial(masterSecret []byte, transcript
 type keySharePrivateKeys struct {
 	curveID CurveID
 	ecdhe   *ecdh.PrivateKey
-	kyber   *mlkem768.DecapsulationKey
+	mlkem   *mlkem.DecapsulationKey768
 }
 
-// kyberDecapsulate implements decapsulation according to Kyber Round 3.
-func kyberDecapsulate(dk *mlkem768.DecapsulationKey, c []byte) ([]byte, error) {
-	K, err := mlkem768.Decapsulate(dk, c)
+// newKeyShare generates the private keys for a TLS 1.3 key share of the given
+// group, and returns them along with the key share data.
+func newKeyShare(rand io.Reader, curveID CurveID) (*keySharePrivateKeys, []byte, error) {
+	ecdhGroup := curveID
+	hybrid, isHybrid := hybridGroups[curveID]
+	if isHybrid {
+		ecdhGroup = hybrid.ecdh
+	}
+	if _, ok := curveForCurveID(ecdhGroup); !ok {
+		return nil, nil, errors.New("tls: CurvePreferences includes unsupported curve")
+	}
+	keys := &keySharePrivateKeys{curveID: curveID}
+	var err error
+	keys.ecdhe, err = generateECDHEKey(rand, ecdhGroup)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return kyberSharedSecret(K, c), nil
-}
-
-// kyberEncapsulate implements encapsulation according to Kyber Round 3.
-func kyberEncapsulate(ek []byte) (c, ss []byte, err error) {
-	c, ss, err = mlkem768.Encapsulate(ek)
+	if !isHybrid {
+		return keys, keys.ecdhe.PublicKey().Bytes(), nil
+	}
+	seed := make([]byte, mlkem.SeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, err
+	}
+	keys.mlkem, err = mlkem.NewDecapsulationKey768(seed)
 	if err != nil {
 		return nil, nil, err
 	}
-	return c, kyberSharedSecret(ss, c), nil
-}
-
-func kyberSharedSecret(K, c []byte) []byte {
-	// Package mlkem768 implements ML-KEM, which compared to Kyber removed a
-	// final hashing step. Compute SHAKE-256(K || SHA3-256(c), 32) to match Kyber.
-	// See https://words.filippo.io/mlkem768/#bonus-track-using-a-ml-kem-implementation-as-kyber-v3.
-	h := sha3.NewShake256()
-	h.Write(K)
-	ch := sha3.Sum256(c)
-	h.Write(ch[:])
-	out := make([]byte, 32)
-	h.Read(out)
-	return out
+	return keys, hybrid.join(keys.ecdhe.PublicKey().Bytes(), keys.mlkem.EncapsulationKey().Bytes()), nil
+}
+
+// hybridGroup describes a hybrid post-quantum key exchange, which combines
+// ML-KEM-768 with an ECDH group by concatenating their key shares and their
+// shared secrets. See draft-ietf-tls-ecdhe-mlkem-00, Section 4.
+type hybridGroup struct {
+	ecdh       CurveID // the ECDH component
+	ecdhSize   int     // size of the ECDH key shares
+	mlkemFirst bool    // whether the ML-KEM component comes first
+}
+
+var hybridGroups = map[CurveID]hybridGroup{
+	X25519MLKEM768:    {ecdh: X25519, ecdhSize: x25519PublicKeySize, mlkemFirst: true},
+	SecP256r1MLKEM768: {ecdh: CurveP256, ecdhSize: 65},
+}
+
+// isTLS13OnlyKeyExchange returns whether curve is only supported in TLS 1.3.
+func isTLS13OnlyKeyExchange(curve CurveID) bool {
+	_, ok := hybridGroups[curve]
+	return ok
+}
+
+// join concatenates the ECDH and ML-KEM components of a key share or shared
+// secret, in the order specified for the hybrid group.
+func (h hybridGroup) join(ecdh, mlkem []byte) []byte {
+	out := make([]byte, 0, len(ecdh)+len(mlkem))
+	if h.mlkemFirst {
+		return append(append(out, mlkem...), ecdh...)
+	}
+	return append(append(out, ecdh...), mlkem...)
+}
+
+// split separates the ECDH and ML-KEM components of a key share, where the
+// ML-KEM component is mlkemSize bytes long. It returns false if the key share
+// has the wrong length.
+func (h hybridGroup) split(data []byte, mlkemSize int) (ecdh, mlkem []byte, ok bool) {
+	if len(data) != h.ecdhSize+mlkemSize {
+		return nil, nil, false
+	}
+	if h.mlkemFirst {
+		return data[mlkemSize:], data[:mlkemSize], true
+	}
+	return data[:h.ecdhSize], data[h.ecdhSize:], true
 }
 
 const x25519PublicKeySize = 32
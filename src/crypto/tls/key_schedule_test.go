@@ -6,7 +6,9 @@ package tls
 
 import (
 	"bytes"
-	"crypto/internal/mlkem768"
+	"crypto/ecdh"
+	"crypto/internal/mlkem"
+	"crypto/rand"
 	"encoding/hex"
 	"hash"
 	"strings"
@@ -175,38 +177,137 @@ func TestExtract(t *testing.T) {
 	}
 }
 
-func TestKyberDecapsulate(t *testing.T) {
-	// From https://pq-crystals.org/kyber/data/kyber-submission-nist-round3.zip
-	dkBytes, _ := hex.DecodeString("07638FB69868F3D320E5862BD96933FEB311B362093C9B5D50170BCED43F1B536D9A204BB1F22695950BA1F2A9E8EB828B284488760B3FC84FABA04275D5628E39C5B2471374283C503299C0AB49B66B8BBB56A4186624F919A2BA59BB08D8551880C2BEFC4F87F25F59AB587A79C327D792D54C974A69262FF8A78938289E9A87B688B083E0595FE218B6BB1505941CE2E81A5A64C5AAC60417256985349EE47A52420A5F97477B7236AC76BC70E8288729287EE3E34A3DBC3683C0B7B10029FC203418537E7466BA6385A8FF301EE12708F82AAA1E380FC7A88F8F205AB7E88D7E95952A55BA20D09B79A47141D62BF6EB7DD307B08ECA13A5BC5F6B68581C6865B27BBCDDAB142F4B2CBFF488C8A22705FAA98A2B9EEA3530C76662335CC7EA3A00777725EBCCCD2A4636B2D9122FF3AB77123CE0883C1911115E50C9E8A94194E48DD0D09CFFB3ADCD2C1E92430903D07ADBF00532031575AA7F9E7B5A1F3362DEC936D4043C05F2476C07578BC9CBAF2AB4E382727AD41686A96B2548820BB03B32F11B2811AD62F489E951632ABA0D1DF89680CC8A8B53B481D92A68D70B4EA1C3A6A561C0692882B5CA8CC942A8D495AFCB06DE89498FB935B775908FE7A03E324D54CC19D4E1AABD3593B38B19EE1388FE492B43127E5A504253786A0D69AD32601C28E2C88504A5BA599706023A61363E17C6B9BB59BDC697452CD059451983D738CA3FD034E3F5988854CA05031DB09611498988197C6B30D258DFE26265541C89A4B31D6864E9389B03CB74F7EC4323FB9421A4B9790A26D17B0398A26767350909F84D57B6694DF830664CA8B3C3C03ED2AE67B89006868A68527CCD666459AB7F056671000C6164D3A7F266A14D97CBD7004D6C92CACA770B844A4FA9B182E7B18CA885082AC5646FCB4A14E1685FEB0C9CE3372AB95365C04FD83084F80A23FF10A05BF15F7FA5ACC6C0CB462C33CA524FA6B8BB359043BA68609EAA2536E81D08463B19653B5435BA946C9ADDEB202B04B031CC960DCC12E4518D428B32B257A4FC7313D3A7980D80082E934F9D95C32B0A0191A23604384DD9E079BBBAA266D14C3F756B9F2133107433A4E83FA7187282A809203A4FAF841851833D121AC383843A5E55BC2381425E16C7DB4CC9AB5C1B0D91A47E2B8DE0E582C86B6B0D907BB360B97F40AB5D038F6B75C814B27D9B968D419832BC8C2BEE605EF6E5059D33100D90485D378450014221736C07407CAC260408AA64926619788B8601C2A752D1A6CBF820D7C7A04716203225B3895B9342D147A8185CFC1BB65BA06B4142339903C0AC4651385B45D98A8B19D28CD6BAB088787F7EE1B12461766B43CBCCB96434427D93C065550688F6948ED1B5475A425F1B85209D061C08B56C1CC069F6C0A7C6F29358CAB911087732A649D27C9B98F9A48879387D9B00C25959A71654D6F6A946164513E47A75D005986C2363C09F6B537ECA78B9303A5FA457608A586A653A347DB04DFCC19175B3A301172536062A658A95277570C8852CA8973F4AE123A334047DD711C8927A634A03388A527B034BF7A8170FA702C1F7C23EC32D18A2374890BE9C787A9409C82D192C4BB705A2F996CE405DA72C2D9C843EE9F8313ECC7F86D6294D59159D9A879A542E260922ADF999051CC45200C9FFDB60449C49465979272367C083A7D6267A3ED7A7FD47957C219327F7CA73A4007E1627F00B11CC80573C15AEE6640FB8562DFA6B240CA0AD351AC4AC155B96C14C8AB13DD262CDFD51C4BB5572FD616553D17BDD430ACBEA3E95F0B698D66990AB51E5D03783A8B3D278A5720454CF9695CFDCA08485BA099C51CD92A7EA7587C1D15C28E609A81852601B0604010679AA482D51261EC36E36B8719676217FD74C54786488F4B4969C05A8BA27CA3A77CCE73B965923CA554E422B9B61F4754641608AC16C9B8587A32C1C5DD788F88B36B717A46965635DEB67F45B129B99070909C93EB80B42C2B3F3F70343A7CF37E8520E7BCFC416ACA4F18C7981262BA2BFC756AE03278F0EC66DC2057696824BA6769865A601D7148EF6F54E5AF5686AA2906F994CE38A5E0B938F239007003022C03392DF3401B1E4A3A7EBC6161449F73374C8B0140369343D9295FDF511845C4A46EBAAB6CA5492F6800B98C0CC803653A4B1D6E6AAED1932BACC5FEFAA818BA502859BA5494C5F5402C8536A9C4C1888150617F80098F6B2A99C39BC5DC7CF3B5900A21329AB59053ABAA64ED163E859A8B3B3CA3359B750CCC3E710C7AC43C8191CB5D68870C06391C0CB8AEC72B897AC6BE7FBAACC676ED66314C83630E89448C88A1DF04ACEB23ABF2E409EF333C622289C18A2134E650C45257E47475FA33AA537A5A8F7680214716C50D470E3284963CA64F54677AEC54B5272162BF52BC8142E1D4183FC017454A6B5A496831759064024745978CBD51A6CEDC8955DE4CC6D363670A47466E82BE5C23603A17BF22ACDB7CC984AF08C87E14E27753CF587A8EC3447E62C649E887A67C36C9CE98721B697213275646B194F36758673A8ED11284455AFC7A8529F69C97A3C2D7B8C636C0BA55614B768E624E712930F776169B01715725351BC74B47395ED52B25A1313C95164814C34C979CBDFAB85954662CAB485E75087A98CC74BB82CA2D1B5BF2803238480638C40E90B43C7460E7AA917F010151FAB1169987B372ABB59271F7006C24E60236B84B9DDD600623704254617FB498D89E58B0368BCB2103E79353EB587860C1422E476162E425BC2381DB82C6592737E1DD602864B0167A71EC1F223305C02FE25052AF2B3B5A55A0D7A2022D9A798DC0C5874A98702AAF4054C5D80338A5248B5B7BD09C53B5E2A084B047D277A861B1A73BB51488DE04EF573C85230A0470B73175C9FA50594F66A5F50B4150054C93B68186F8B5CBC49316C8548A642B2B36A1D454C7489AC33B2D2CE6668096782A2C1E0866D21A65E16B585E7AF8618BDF3184C1986878508917277B93E10706B1614972B2A94C7310FE9C708C231A1A8AC8D9314A529A97F469BF64962D820648443099A076D55D4CEA824A58304844F99497C10A25148618A315D72CA857D1B04D575B94F85C01D19BEF211BF0AA3362E7041FD16596D808E867B44C4C00D1CDA3418967717F147D0EB21B42AAEE74AC35D0B92414B958531AADF463EC6305AE5ECAF79174002F26DDECC813BF32672E8529D95A4E730A7AB4A3E8F8A8AF979A665EAFD465FC64A0C5F8F3F9003489415899D59A543D8208C54A3166529B53922D4EC143B50F01423B177895EDEE22BB739F647ECF85F50BC25EF7B5A725DEE868626ED79D451140800E03B59B956F8210E556067407D13DC90FA9E8B872BFB8F")
-	dk, err := mlkem768.NewKeyFromExtendedEncoding(dkBytes)
-	if err != nil {
-		t.Fatal(err)
-	}
-	ct, _ := hex.DecodeString("B52C56B92A4B7CE9E4CB7C5B1B163167A8A1675B2FDEF84A5B67CA15DB694C9F11BD027C30AE22EC921A1D911599AF0585E48D20DA70DF9F39E32EF95D4C8F44BFEFDAA5DA64F1054631D04D6D3CFD0A540DD7BA3886E4B5F13E878788604C95C096EAB3919F427521419A946C26CC041475D7124CDC01D0373E5B09C7A70603CFDB4FB3405023F2264DC3F983C4FC02A2D1B268F2208A1F6E2A6209BFF12F6F465F0B069C3A7F84F606D8A94064003D6EC114C8E808D3053884C1D5A142FBF20112EB360FDA3F0F28B172AE50F5E7D83801FB3F0064B687187074BD7FE30EDDAA334CF8FC04FA8CED899CEADE4B4F28B68372BAF98FF482A415B731155B75CEB976BE0EA0285BA01A27F1857A8FB377A3AE0C23B2AA9A079BFABFF0D5B2F1CD9B718BEA03C42F343A39B4F142D01AD8ACBB50E38853CF9A50C8B44C3CF671A4A9043B26DDBB24959AD6715C08521855C79A23B9C3D6471749C40725BDD5C2776D43AED20204BAA141EFB3304917474B7F9F7A4B08B1A93DAED98C67495359D37D67F7438BEE5E43585634B26C6B3810D7CDCBC0F6EB877A6087E68ACB8480D3A8CF6900447E49B417F15A53B607A0E216B855970D37406870B4568722DA77A4084703816784E2F16BED18996532C5D8B7F5D214464E5F3F6E905867B0CE119E252A66713253544685D208E1723908A0CE97834652E08AE7BDC881A131B73C71E84D20D68FDEFF4F5D70CD1AF57B78E3491A9865942321800A203C05ED1FEEB5A28E584E19F6535E7F84E4A24F84A72DCAF5648B4A4235DD664464482F03176E888C28BFC6C1CB238CFFA35A321E71791D9EA8ED0878C61121BF8D2A4AB2C1A5E120BC40ABB1892D1715090A0EE48252CA297A99AA0E510CF26B1ADD06CA543E1C5D6BDCD3B9C585C8538045DB5C252EC3C8C3C954D9BE5907094A894E60EAB43538CFEE82E8FFC0791B0D0F43AC1627830A61D56DAD96C62958B0DE780B78BD47A604550DAB83FFF227C324049471F35248CFB849B25724FF704D5277AA352D550958BE3B237DFF473EC2ADBAEA48CA2658AEFCC77BBD4264AB374D70EAE5B964416CE8226A7E3255A0F8D7E2ADCA062BCD6D78D60D1B32E11405BE54B66EF0FDDD567702A3BCCFEDE3C584701269ED14809F06F8968356BB9267FE86E514252E88BB5C30A7ECB3D0E621021EE0FBF7871B09342BF84F55C97EAF86C48189C7FF4DF389F077E2806E5FA73B3E9458A16C7E275F4F602275580EB7B7135FB537FA0CD95D6EA58C108CD8943D70C1643111F4F01CA8A8276A902666ED81B78D168B006F16AAA3D8E4CE4F4D0FB0997E41AEFFB5B3DAA838732F357349447F387776C793C0479DE9E99498CC356FDB0075A703F23C55D47B550EC89B02ADE89329086A50843456FEDC3788AC8D97233C54560467EE1D0F024B18428F0D73B30E19F5C63B9ABF11415BEA4D0170130BAABD33C05E6524E5FB5581B22B0433342248266D0F1053B245CC2462DC44D34965102482A8ED9E4E964D5683E5D45D0C8269")
-	ss, err := kyberDecapsulate(dk, ct)
-	if err != nil {
-		t.Fatal(err)
-	}
-	exp, _ := hex.DecodeString("914CB67FE5C38E73BF74181C0AC50428DEDF7750A98058F7D536708774535B29")
-	if !bytes.Equal(ss, exp) {
-		t.Fatalf("got %x, want %x", ss, exp)
+func TestHybridKeyShareLayout(t *testing.T) {
+	for _, tt := range []struct {
+		group    CurveID
+		ecdhSize int
+		ecdhAt   int
+	}{
+		{X25519MLKEM768, x25519PublicKeySize, mlkem.EncapsulationKeySize768},
+		{SecP256r1MLKEM768, 65, 0},
+	} {
+		t.Run(tt.group.String(), func(t *testing.T) {
+			keys, data, err := newKeyShare(rand.Reader, tt.group)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != tt.ecdhSize+mlkem.EncapsulationKeySize768 {
+				t.Fatalf("key share is %d bytes, want %d", len(data), tt.ecdhSize+mlkem.EncapsulationKeySize768)
+			}
+			if got := data[tt.ecdhAt : tt.ecdhAt+tt.ecdhSize]; !bytes.Equal(got, keys.ecdhe.PublicKey().Bytes()) {
+				t.Errorf("ECDH component is not at offset %d", tt.ecdhAt)
+			}
+			h := hybridGroups[tt.group]
+			ecdh, ek, ok := h.split(data, mlkem.EncapsulationKeySize768)
+			if !ok {
+				t.Fatal("split failed")
+			}
+			if !bytes.Equal(ecdh, keys.ecdhe.PublicKey().Bytes()) || !bytes.Equal(ek, keys.mlkem.EncapsulationKey().Bytes()) {
+				t.Error("split returned the wrong components")
+			}
+			if !bytes.Equal(h.join(ecdh, ek), data) {
+				t.Error("join does not invert split")
+			}
+			if _, _, ok := h.split(data[1:], mlkem.EncapsulationKeySize768); ok {
+				t.Error("split accepted a short key share")
+			}
+		})
 	}
 }
 
-func TestKyberEncapsulate(t *testing.T) {
-	dk, err := mlkem768.GenerateKey()
-	if err != nil {
-		t.Fatal(err)
+func TestHybridSharedSecret(t *testing.T) {
+	// The ciphertext and shared secrets were computed with an independent
+	// implementation of ML-KEM-768, X25519 and P-256. The client key share
+	// uses the ML-KEM seed 00 01 02 ... 3f and the ECDH private key 42 42 ...
+	// 42, and the server ECDH private key is 24 24 ... 24.
+	seed := make([]byte, mlkem.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
 	}
-	ct, ss, err := kyberEncapsulate(dk.EncapsulationKey())
-	if err != nil {
-		t.Fatal(err)
-	}
-	dkSS, err := kyberDecapsulate(dk, ct)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !bytes.Equal(ss, dkSS) {
-		t.Fatalf("got %x, want %x", ss, dkSS)
+	ciphertext := parseVector(`
+		55ebb2f4f6de15b5663ca465bbf42fc4c7760c18da86b7d1e83974cc96914eca
+		91a61ea706df4f4ef360ef423fabdca5d6cba18bcc8d45a47f470d844dd06fd7
+		51d96f84df0d5a1207a3684bc025f67eac59404698ec86bcf6d24cdf9941334c
+		bc768e41942b6f18de2ec59402b69dc5bed14f43e4c5359f555df0c69cd11dc2
+		625933f4db7d8ed7d0e4eb3919f8e09f785cb13e8eb87b24cfded277fbb9a82a
+		47103c2f154f39eda3d562eafb0bfc2db1b8d336c98694ad12070dcbf1b23fd0
+		2c3365afceee15f1a476e44da8bc8dc413cf1fdf9bd002af3a42ee7ef5f99a49
+		19ae152bf9c5d968e11357eb93f9a243a2090e827f5c51a25d5be4b2a504da49
+		6aee6023f0f52bf998e4b7848ccc0adb5b97b28f3e01ec43333476f46e6889be
+		c7def8988252f08081023abaa3a1e6bf2ce39b5754d46af828ebdad9255afef8
+		ae1630e1c8145958921c259991bfc1687b1d6cc05369272f38cede927097a2aa
+		fe91bc7597992b916066b4eff464f713e95613aca09b608ea7f4c39548b295a8
+		d289c2b01c3e12b3fa19c3b75d10348ca79aa43e7d67ff21f54abc253ac6ccbe
+		e8c7f2a1caad0b23919ee5c45218cf6f07b1019882f0f64b9b2beec8de83f7ad
+		ea0fec7fa17ea79adb1b39ef79b6805670e8d65c5171d67936eeaaf768ae6634
+		e15a3d53e7858aa729eb54c2da8869571a33979905be4fc51570a45aaae73634
+		8f03fead383423a350999c3ef11be7e7ba7786bf0322426d6ef0b4e6503e9794
+		068a4cb7f3affcb3d9de7e55c931f497f63d7ddbf10d886795dab89ca3d8a268
+		18cc9a9d47275ac846aa76227dfa7a71f9746f396d70f8bdad1007a669718729
+		ef774fec29434d63b94d12684c83c9296efda1ea8751710c3e068abb018b9ed4
+		e2ef0209477fefe8f095309da968b2ae1692ba308c9969a8ca58865e53d2aa00
+		9f4b540980f58b141b2aae4e32b03538cce98192ee75634db9dc4e846ab35337
+		3ea2bc27d92c4abf37ae6f498840db9714fb3aa9e75b546d3c2ffe472c6134a5
+		123842bc8b9276f11f9e2fded2d91a40f9d5f5b7fcde3d6b74149e3333041811
+		5453825a69412bbd86f8d0555dfb2f2bc09924b6694b2f2afe28cb0151bd2091
+		6dd8517425301f1d1c9337b1d4f27714ffd8ccde11fbcce3df6c34897a30d95d
+		e9d3c934860387289763992b5b8c01a2fce03667738321ca58ebf621f8f36ffd
+		e4264d767f53fc23caded2ec96763da912296270bfabba8a012a2c2cd7b73c01
+		50a7c68c54428499df34dbcbf82320df4ba619bd058828cb6c18a4f78fb0c066
+		c7210f5e3d07758b9ff00259357343179de660b8f991270f91c96621fd6c8845
+		4416a63b6fc8b0c94d00996d146975282ab5e8ad6c9c8b36755f1dad11136d36
+		f5537f1429f86ce6dbb6c31ec57d848a979ddc1acc352af9e827ff174dabf708
+		b320be488082cca53008c34c3f07ed0e8a1d738e13cadb4f1cff7a5133738b81
+		3c162924b6e3e1e40f64fecf07639f025c9da3eb0893df03077ef478189c45c6`)
+	x25519Server := parseVector(`
+		04bcd2e0d00f2cce5fe8f1c6c2fbec5c07fa56e3aa5c88a5689975d88b3fce05`)
+	p256Server := parseVector(`
+		045e0b88955478752f20b52c86f7cf76a6a2f65e350293b09b5bc48afe12ef6d
+		8888fce0bddd9dac2d7a522ca845cb400a11b415028a1f83e36d97104bd3505e
+		29`)
+
+	for _, tt := range []struct {
+		group CurveID
+		curve ecdh.Curve
+		share []byte // the server key share, as laid out on the wire
+		want  []byte
+	}{
+		{X25519MLKEM768, ecdh.X25519(), append(bytes.Clone(ciphertext), x25519Server...), parseVector(`
+			b95a28da98fb128b2866d8e996247477feecef870ffee61debda02896942fd61
+			07911fea3785e6cd6763e1fd5dab461fc08a6841af21db4a8ac7b7f99b64103a`)},
+		{SecP256r1MLKEM768, ecdh.P256(), append(bytes.Clone(p256Server), ciphertext...), parseVector(`
+			e349cf6859d17371eaad97b5605b346539b69cef845378beaab8c3e54b0d12c7
+			b95a28da98fb128b2866d8e996247477feecef870ffee61debda02896942fd61`)},
+	} {
+		t.Run(tt.group.String(), func(t *testing.T) {
+			ecdhKey, err := tt.curve.NewPrivateKey(bytes.Repeat([]byte{0x42}, 32))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dk, err := mlkem.NewDecapsulationKey768(seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := hybridGroups[tt.group]
+			ecdhData, ct, ok := h.split(tt.share, mlkem.CiphertextSize768)
+			if !ok {
+				t.Fatal("split failed")
+			}
+			peerKey, err := tt.curve.NewPublicKey(ecdhData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ecdhShared, err := ecdhKey.ECDH(peerKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mlkemShared, err := dk.Decapsulate(ct)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := h.join(ecdhShared, mlkemShared); !bytes.Equal(got, tt.want) {
+				t.Errorf("shared secret = %x, want %x", got, tt.want)
+			}
+		})
 	}
 }
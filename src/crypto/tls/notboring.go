@@ -6,4 +6,9 @@
 
 package tls
 
-func needFIPS() bool { return false }
+import "crypto/internal/fips140only"
+
+// needFIPS reports whether the FIPS 140-3 only mode of the Go Cryptographic
+// Module is enabled, in which case only FIPS-approved versions, cipher suites,
+// curves and signature algorithms are negotiated.
+func needFIPS() bool { return fips140only.Enabled }
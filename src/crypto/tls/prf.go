@@ -7,6 +7,7 @@ package tls
 import (
 	"crypto"
 	"crypto/hmac"
+	"crypto/internal/fips140/tls12"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -23,7 +24,9 @@ func splitPreMasterSecret(secret []byte) (s1, s2 []byte) {
 	return
 }
 
-// pHash implements the P_hash function, as defined in RFC 4346, Section 5.
+// pHash implements the P_hash function, as defined in RFC 4346, Section 5. It's
+// only used by the TLS 1.0 and 1.1 PRF, the TLS 1.2 one is implemented by the
+// FIPS 140-3 module.
 func pHash(result, secret, seed []byte, hash func() hash.Hash) {
 	h := hmac.New(hash, secret)
 	h.Write(seed)
@@ -66,11 +69,7 @@ func prf10(result, secret, label, seed []byte) {
 // prf12 implements the TLS 1.2 pseudo-random function, as defined in RFC 5246, Section 5.
 func prf12(hashFunc func() hash.Hash) func(result, secret, label, seed []byte) {
 	return func(result, secret, label, seed []byte) {
-		labelAndSeed := make([]byte, len(label)+len(seed))
-		copy(labelAndSeed, label)
-		copy(labelAndSeed[len(label):], seed)
-
-		pHash(result, secret, labelAndSeed, hashFunc)
+		copy(result, tls12.PRF(hashFunc, secret, string(label), seed, len(result)))
 	}
 }
 
@@ -99,6 +98,14 @@ func prfAndHashForVersion(version uint16, suite *cipherSuite) (func(result, secr
 	}
 }
 
+// hashForTLS12 returns the hash function of the TLS 1.2 PRF of suite.
+func hashForTLS12(suite *cipherSuite) func() hash.Hash {
+	if suite.flags&suiteSHA384 != 0 {
+		return sha512.New384
+	}
+	return sha256.New
+}
+
 func prfForVersion(version uint16, suite *cipherSuite) func(result, secret, label, seed []byte) {
 	prf, _ := prfAndHashForVersion(version, suite)
 	return prf
@@ -107,6 +114,10 @@ func prfForVersion(version uint16, suite *cipherSuite) func(result, secret, labe
 // masterFromPreMasterSecret generates the master secret from the pre-master
 // secret. See RFC 5246, Section 8.1.
 func masterFromPreMasterSecret(version uint16, suite *cipherSuite, preMasterSecret, clientRandom, serverRandom []byte) []byte {
+	if version == VersionTLS12 {
+		return tls12.MasterSecret(hashForTLS12(suite), preMasterSecret, clientRandom, serverRandom)
+	}
+
 	seed := make([]byte, 0, len(clientRandom)+len(serverRandom))
 	seed = append(seed, clientRandom...)
 	seed = append(seed, serverRandom...)
@@ -119,6 +130,10 @@ func masterFromPreMasterSecret(version uint16, suite *cipherSuite, preMasterSecr
 // extMasterFromPreMasterSecret generates the extended master secret from the
 // pre-master secret. See RFC 7627.
 func extMasterFromPreMasterSecret(version uint16, suite *cipherSuite, preMasterSecret, transcript []byte) []byte {
+	if version == VersionTLS12 {
+		return tls12.ExtendedMasterSecret(hashForTLS12(suite), preMasterSecret, transcript)
+	}
+
 	masterSecret := make([]byte, masterSecretLength)
 	prfForVersion(version, suite)(masterSecret, preMasterSecret, extendedMasterSecretLabel, transcript)
 	return masterSecret
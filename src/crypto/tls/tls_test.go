@@ -11,6 +11,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -29,6 +30,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"golang.org/x/crypto/cryptobyte"
 )
 
 var rsaCertPEM = `-----BEGIN CERTIFICATE-----
@@ -855,6 +858,10 @@ func TestCloneNonFuncFields(t *testing.T) {
 			f.Set(reflect.ValueOf(map[string]*Certificate{"a": nil}))
 		case "RootCAs", "ClientCAs":
 			f.Set(reflect.ValueOf(x509.NewCertPool()))
+		case "RevocationOptions":
+			f.Set(reflect.ValueOf(&x509.RevocationOptions{SoftFail: true}))
+		case "CTPolicy":
+			f.Set(reflect.ValueOf(&x509.CTPolicy{MinSCTs: 2}))
 		case "ClientSessionCache":
 			f.Set(reflect.ValueOf(NewLRUClientSessionCache(10)))
 		case "KeyLogWriter":
@@ -875,10 +882,16 @@ func TestCloneNonFuncFields(t *testing.T) {
 			f.Set(reflect.ValueOf([]uint16{1, 2}))
 		case "CurvePreferences":
 			f.Set(reflect.ValueOf([]CurveID{CurveP256}))
+		case "CertificateCompression":
+			f.Set(reflect.ValueOf([]CertificateCompressionAlgorithm{CertificateCompressionZstd}))
 		case "Renegotiation":
 			f.Set(reflect.ValueOf(RenegotiateOnceAsClient))
 		case "EncryptedClientHelloConfigList":
 			f.Set(reflect.ValueOf([]byte{'x'}))
+		case "EncryptedClientHelloKeys":
+			f.Set(reflect.ValueOf([]EncryptedClientHelloKey{
+				{Config: []byte{1}, PrivateKey: []byte{1}},
+			}))
 		case "mutex", "autoSessionTicketKeys", "sessionTicketKeys":
 			continue // these are unexported fields that are handled separately
 		default:
@@ -1837,32 +1850,35 @@ func testVerifyCertificates(t *testing.T, version uint16) {
 	}
 }
 
-func TestHandshakeKyber(t *testing.T) {
-	if x25519Kyber768Draft00.String() != "X25519Kyber768Draft00" {
-		t.Fatalf("unexpected CurveID string: %v", x25519Kyber768Draft00.String())
-	}
-
+func TestHandshakeMLKEM(t *testing.T) {
 	var tests = []struct {
 		name                string
 		clientConfig        func(*Config)
 		serverConfig        func(*Config)
 		preparation         func(*testing.T)
 		expectClientSupport bool
-		expectKyber         bool
+		expectCurve         CurveID
 		expectHRR           bool
 	}{
 		{
 			name:                "Default",
 			expectClientSupport: true,
-			expectKyber:         true,
-			expectHRR:           false,
+			expectCurve:         X25519MLKEM768,
 		},
 		{
 			name: "ClientCurvePreferences",
 			clientConfig: func(config *Config) {
 				config.CurvePreferences = []CurveID{X25519}
 			},
-			expectClientSupport: false,
+			expectCurve: X25519,
+		},
+		{
+			name: "ClientMLKEMOnly",
+			clientConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{X25519MLKEM768}
+			},
+			expectClientSupport: true,
+			expectCurve:         X25519MLKEM768,
 		},
 		{
 			name: "ServerCurvePreferencesX25519",
@@ -1870,8 +1886,7 @@ func TestHandshakeKyber(t *testing.T) {
 				config.CurvePreferences = []CurveID{X25519}
 			},
 			expectClientSupport: true,
-			expectKyber:         false,
-			expectHRR:           false,
+			expectCurve:         X25519,
 		},
 		{
 			name: "ServerCurvePreferencesHRR",
@@ -1879,7 +1894,47 @@ func TestHandshakeKyber(t *testing.T) {
 				config.CurvePreferences = []CurveID{CurveP256}
 			},
 			expectClientSupport: true,
-			expectKyber:         false,
+			expectCurve:         CurveP256,
+			expectHRR:           true,
+		},
+		{
+			name: "SecP256r1MLKEM768",
+			clientConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{SecP256r1MLKEM768, CurveP256}
+			},
+			serverConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{SecP256r1MLKEM768, X25519MLKEM768, CurveP256}
+			},
+			expectCurve: SecP256r1MLKEM768,
+		},
+		{
+			name: "SecP256r1MLKEM768Fallback",
+			clientConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{SecP256r1MLKEM768, CurveP256}
+			},
+			expectCurve: CurveP256,
+		},
+		{
+			name: "HRRToSecP256r1MLKEM768",
+			clientConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{X25519, SecP256r1MLKEM768}
+			},
+			serverConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{SecP256r1MLKEM768}
+			},
+			expectCurve: SecP256r1MLKEM768,
+			expectHRR:   true,
+		},
+		{
+			name: "HRRToX25519MLKEM768",
+			clientConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{CurveP256, X25519MLKEM768}
+			},
+			serverConfig: func(config *Config) {
+				config.CurvePreferences = []CurveID{X25519MLKEM768, X25519}
+			},
+			expectClientSupport: true,
+			expectCurve:         X25519MLKEM768,
 			expectHRR:           true,
 		},
 		{
@@ -1887,7 +1942,7 @@ func TestHandshakeKyber(t *testing.T) {
 			clientConfig: func(config *Config) {
 				config.MaxVersion = VersionTLS12
 			},
-			expectClientSupport: false,
+			expectCurve: X25519,
 		},
 		{
 			name: "ServerTLSv12",
@@ -1895,14 +1950,21 @@ func TestHandshakeKyber(t *testing.T) {
 				config.MaxVersion = VersionTLS12
 			},
 			expectClientSupport: true,
-			expectKyber:         false,
+			expectCurve:         X25519,
 		},
 		{
 			name: "GODEBUG",
+			preparation: func(t *testing.T) {
+				t.Setenv("GODEBUG", "tlsmlkem=0")
+			},
+			expectCurve: X25519,
+		},
+		{
+			name: "GODEBUGKyber",
 			preparation: func(t *testing.T) {
 				t.Setenv("GODEBUG", "tlskyber=0")
 			},
-			expectClientSupport: false,
+			expectCurve: X25519,
 		},
 	}
 
@@ -1920,10 +1982,10 @@ func TestHandshakeKyber(t *testing.T) {
 				test.serverConfig(serverConfig)
 			}
 			serverConfig.GetConfigForClient = func(hello *ClientHelloInfo) (*Config, error) {
-				if !test.expectClientSupport && slices.Contains(hello.SupportedCurves, x25519Kyber768Draft00) {
-					return nil, errors.New("client supports Kyber768Draft00")
-				} else if test.expectClientSupport && !slices.Contains(hello.SupportedCurves, x25519Kyber768Draft00) {
-					return nil, errors.New("client does not support Kyber768Draft00")
+				if !test.expectClientSupport && slices.Contains(hello.SupportedCurves, X25519MLKEM768) {
+					return nil, errors.New("client supports X25519MLKEM768")
+				} else if test.expectClientSupport && !slices.Contains(hello.SupportedCurves, X25519MLKEM768) {
+					return nil, errors.New("client does not support X25519MLKEM768")
 				}
 				return nil, nil
 			}
@@ -1935,20 +1997,11 @@ func TestHandshakeKyber(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			if test.expectKyber {
-				if ss.testingOnlyCurveID != x25519Kyber768Draft00 {
-					t.Errorf("got CurveID %v (server), expected %v", ss.testingOnlyCurveID, x25519Kyber768Draft00)
-				}
-				if cs.testingOnlyCurveID != x25519Kyber768Draft00 {
-					t.Errorf("got CurveID %v (client), expected %v", cs.testingOnlyCurveID, x25519Kyber768Draft00)
-				}
-			} else {
-				if ss.testingOnlyCurveID == x25519Kyber768Draft00 {
-					t.Errorf("got CurveID %v (server), expected not Kyber", ss.testingOnlyCurveID)
-				}
-				if cs.testingOnlyCurveID == x25519Kyber768Draft00 {
-					t.Errorf("got CurveID %v (client), expected not Kyber", cs.testingOnlyCurveID)
-				}
+			if ss.CurveID != test.expectCurve {
+				t.Errorf("got CurveID %v (server), expected %v", ss.CurveID, test.expectCurve)
+			}
+			if cs.CurveID != test.expectCurve {
+				t.Errorf("got CurveID %v (client), expected %v", cs.CurveID, test.expectCurve)
 			}
 			if test.expectHRR {
 				if !ss.testingOnlyDidHRR {
@@ -1969,6 +2022,36 @@ func TestHandshakeKyber(t *testing.T) {
 	}
 }
 
+func TestCurveIDOnResumption(t *testing.T) {
+	for _, tt := range []struct {
+		version      uint16
+		resumedCurve CurveID
+	}{
+		// TLS 1.2 resumptions don't perform a key exchange.
+		{VersionTLS12, 0},
+		{VersionTLS13, CurveP384},
+	} {
+		t.Run(VersionName(tt.version), func(t *testing.T) {
+			clientConfig, serverConfig := testConfig.Clone(), testConfig.Clone()
+			clientConfig.MaxVersion = tt.version
+			clientConfig.CurvePreferences = []CurveID{CurveP384}
+			clientConfig.ClientSessionCache = NewLRUClientSessionCache(1)
+			for i, want := range []CurveID{CurveP384, tt.resumedCurve} {
+				ss, cs, err := testHandshake(t, clientConfig, serverConfig)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resumed := i == 1; ss.DidResume != resumed || cs.DidResume != resumed {
+					t.Fatalf("handshake %d: DidResume is %v (server), %v (client)", i, ss.DidResume, cs.DidResume)
+				}
+				if ss.CurveID != want || cs.CurveID != want {
+					t.Errorf("handshake %d: got CurveID %v (server), %v (client), expected %v", i, ss.CurveID, cs.CurveID, want)
+				}
+			}
+		})
+	}
+}
+
 func TestX509KeyPairPopulateCertificate(t *testing.T) {
 	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
@@ -2074,3 +2157,199 @@ func TestLargeCertMsg(t *testing.T) {
 		t.Fatalf("unexpected failure :%s", err)
 	}
 }
+
+// newTestCAAndLeaf returns a new CA, and a leaf certificate for
+// example.golang issued by it.
+func newTestCAAndLeaf(t *testing.T) (ca *x509.Certificate, caKey *ecdsa.PrivateKey, leaf *x509.Certificate, leafKey *ecdsa.PrivateKey) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err = x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		DNSNames:     []string{"example.golang"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, ca, leafKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err = x509.ParseCertificate(leafDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca, caKey, leaf, leafKey
+}
+
+func TestRevocationOptions(t *testing.T) {
+	ca, caKey, leaf, leafKey := newTestCAAndLeaf(t)
+	staple := func(status x509.OCSPCertStatus) []byte {
+		der, err := x509.CreateOCSPResponse(rand.Reader, &x509.OCSPResponse{
+			Status:       status,
+			SerialNumber: leaf.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, ca, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	for _, version := range []uint16{VersionTLS12, VersionTLS13} {
+		for _, status := range []x509.OCSPCertStatus{x509.OCSPGood, x509.OCSPRevoked} {
+			for _, check := range []bool{false, true} {
+				name := fmt.Sprintf("%s/%v/check=%v", VersionName(version), status, check)
+				t.Run(name, func(t *testing.T) {
+					serverConfig := testConfig.Clone()
+					serverConfig.MaxVersion = version
+					serverConfig.Certificates = []Certificate{{
+						Certificate: [][]byte{leaf.Raw},
+						PrivateKey:  leafKey,
+						OCSPStaple:  staple(status),
+					}}
+					clientConfig := testConfig.Clone()
+					clientConfig.InsecureSkipVerify = false
+					clientConfig.ServerName = "example.golang"
+					clientConfig.RootCAs = roots
+					clientConfig.Time = nil
+					if check {
+						clientConfig.RevocationOptions = &x509.RevocationOptions{}
+					}
+
+					_, cs, err := testHandshake(t, clientConfig, serverConfig)
+					if check && status == x509.OCSPRevoked {
+						if err == nil || !strings.Contains(err.Error(), "revoked") {
+							t.Fatalf("got %v, want revocation error", err)
+						}
+						return
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+					if cs.VerifiedOCSPResponse == nil {
+						t.Fatal("missing VerifiedOCSPResponse")
+					}
+					if cs.VerifiedOCSPResponse.Status != status {
+						t.Errorf("VerifiedOCSPResponse.Status = %v, want %v", cs.VerifiedOCSPResponse.Status, status)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestCTPolicy(t *testing.T) {
+	ca, _, leaf, leafKey := newTestCAAndLeaf(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	newLog := func() (*x509.CTLog, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &x509.CTLog{PublicKey: key.Public()}, key
+	}
+	// sign returns an SCT for leaf, as specified in RFC 6962, Section 3.2.
+	sign := func(log *x509.CTLog, key *ecdsa.PrivateKey) []byte {
+		logID, err := log.LogID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		timestamp := uint64(time.Now().Add(-time.Minute).UnixMilli())
+		signed := cryptobyte.NewBuilder(nil)
+		signed.AddUint8(0) // v1
+		signed.AddUint8(0) // certificate_timestamp
+		signed.AddUint64(timestamp)
+		signed.AddUint16(0) // x509_entry
+		signed.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(leaf.Raw) })
+		signed.AddUint16(0) // no extensions
+		h := sha256.Sum256(signed.BytesOrPanic())
+		sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		sct := cryptobyte.NewBuilder(nil)
+		sct.AddUint8(0)
+		sct.AddBytes(logID[:])
+		sct.AddUint64(timestamp)
+		sct.AddUint16(0)
+		sct.AddUint8(4) // sha256
+		sct.AddUint8(3) // ecdsa
+		sct.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(sig) })
+		return sct.BytesOrPanic()
+	}
+	logA, keyA := newLog()
+	logB, keyB := newLog()
+	untrusted, untrustedKey := newLog()
+
+	for _, version := range []uint16{VersionTLS12, VersionTLS13} {
+		for _, tt := range []struct {
+			name    string
+			scts    [][]byte
+			minSCTs int
+			wantErr bool
+		}{
+			{"two logs", [][]byte{sign(logA, keyA), sign(logB, keyB)}, 2, false},
+			{"one log", [][]byte{sign(logA, keyA)}, 2, true},
+			{"same log twice", [][]byte{sign(logA, keyA), sign(logA, keyA)}, 2, true},
+			{"untrusted log", [][]byte{sign(logA, keyA), sign(untrusted, untrustedKey)}, 2, true},
+			{"no SCTs", nil, 0, true},
+		} {
+			t.Run(VersionName(version)+"/"+tt.name, func(t *testing.T) {
+				serverConfig := testConfig.Clone()
+				serverConfig.MaxVersion = version
+				serverConfig.Certificates = []Certificate{{
+					Certificate:                 [][]byte{leaf.Raw},
+					PrivateKey:                  leafKey,
+					SignedCertificateTimestamps: tt.scts,
+				}}
+				clientConfig := testConfig.Clone()
+				clientConfig.InsecureSkipVerify = false
+				clientConfig.ServerName = "example.golang"
+				clientConfig.RootCAs = roots
+				clientConfig.Time = nil
+				clientConfig.CTPolicy = &x509.CTPolicy{
+					Logs:    []*x509.CTLog{logA, logB},
+					MinSCTs: tt.minSCTs,
+				}
+
+				_, _, err := testHandshake(t, clientConfig, serverConfig)
+				if tt.wantErr {
+					if err == nil || !strings.Contains(err.Error(), "SCTs") {
+						t.Fatalf("got %v, want CT policy error", err)
+					}
+				} else if err != nil {
+					t.Fatal(err)
+				}
+			})
+		}
+	}
+}
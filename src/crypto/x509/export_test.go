@@ -0,0 +1,11 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+// Test helpers shared with the x509_test package.
+var (
+	NewTestCA   = newTestCA
+	NewTestCert = newTestCert
+)
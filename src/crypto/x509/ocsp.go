@@ -0,0 +1,667 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// OCSPResponseStatus is the status of an OCSP response as a whole, as
+// specified in RFC 6960, Section 4.2.1. It is distinct from the status of the
+// certificate the response is about, which is only present on success.
+type OCSPResponseStatus int
+
+const (
+	OCSPSuccess          OCSPResponseStatus = 0
+	OCSPMalformedRequest OCSPResponseStatus = 1
+	OCSPInternalError    OCSPResponseStatus = 2
+	OCSPTryLater         OCSPResponseStatus = 3
+	// Status code four is unused in OCSP.
+	OCSPSignatureRequired OCSPResponseStatus = 5
+	OCSPUnauthorized      OCSPResponseStatus = 6
+)
+
+func (s OCSPResponseStatus) String() string {
+	switch s {
+	case OCSPSuccess:
+		return "success"
+	case OCSPMalformedRequest:
+		return "malformed request"
+	case OCSPInternalError:
+		return "internal error"
+	case OCSPTryLater:
+		return "try later"
+	case OCSPSignatureRequired:
+		return "signature required"
+	case OCSPUnauthorized:
+		return "unauthorized"
+	default:
+		return "unknown OCSP response status " + strconv.Itoa(int(s))
+	}
+}
+
+// OCSPResponseError is returned by [ParseOCSPResponse] when the responder
+// returned an error status instead of a signed response.
+type OCSPResponseError struct {
+	Status OCSPResponseStatus
+}
+
+func (e OCSPResponseError) Error() string {
+	return "x509: OCSP responder returned an error: " + e.Status.String()
+}
+
+// OCSPCertStatus is the revocation status of a certificate, as reported by an
+// OCSP responder.
+type OCSPCertStatus int
+
+const (
+	OCSPGood OCSPCertStatus = iota
+	OCSPRevoked
+	OCSPUnknown
+)
+
+func (s OCSPCertStatus) String() string {
+	switch s {
+	case OCSPGood:
+		return "good"
+	case OCSPRevoked:
+		return "revoked"
+	case OCSPUnknown:
+		return "unknown"
+	default:
+		return "invalid OCSP certificate status " + strconv.Itoa(int(s))
+	}
+}
+
+// These structures reflect the ASN.1 structure of OCSP requests and responses.
+// See RFC 6960, Sections 4.1.1 and 4.2.1.
+
+type ocspCertID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+type ocspRequestASN1 struct {
+	TBSRequest ocspTBSRequest
+}
+
+type ocspTBSRequest struct {
+	Version       int              `asn1:"explicit,tag:0,default:0,optional"`
+	RequestorName pkix.RDNSequence `asn1:"explicit,tag:1,optional"`
+	RequestList   []ocspSingleRequest
+}
+
+type ocspSingleRequest struct {
+	Cert ocspCertID
+}
+
+type ocspResponseASN1 struct {
+	Status   asn1.Enumerated
+	Response ocspResponseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    ocspResponseData
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseData struct {
+	Raw                asn1.RawContent
+	Version            int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID     asn1.RawValue
+	ProducedAt         time.Time `asn1:"generalized"`
+	Responses          []ocspSingleResponse
+	ResponseExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+type ocspSingleResponse struct {
+	CertID           ocspCertID
+	Good             asn1.Flag        `asn1:"tag:0,optional"`
+	Revoked          ocspRevokedInfo  `asn1:"tag:1,optional"`
+	Unknown          asn1.Flag        `asn1:"tag:2,optional"`
+	ThisUpdate       time.Time        `asn1:"generalized"`
+	NextUpdate       time.Time        `asn1:"generalized,explicit,tag:0,optional"`
+	SingleExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+type ocspRevokedInfo struct {
+	RevocationTime time.Time       `asn1:"generalized"`
+	Reason         asn1.Enumerated `asn1:"explicit,tag:0,optional"`
+}
+
+var oidOCSPBasicResponse = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+
+var ocspHashOIDs = []struct {
+	hash crypto.Hash
+	oid  asn1.ObjectIdentifier
+}{
+	{crypto.SHA1, asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}},
+	{crypto.SHA256, oidSHA256},
+	{crypto.SHA384, oidSHA384},
+	{crypto.SHA512, oidSHA512},
+}
+
+func ocspHashFromOID(oid asn1.ObjectIdentifier) crypto.Hash {
+	for _, h := range ocspHashOIDs {
+		if h.oid.Equal(oid) {
+			return h.hash
+		}
+	}
+	return 0
+}
+
+func ocspOIDFromHash(hash crypto.Hash) asn1.ObjectIdentifier {
+	for _, h := range ocspHashOIDs {
+		if h.hash == hash {
+			return h.oid
+		}
+	}
+	return nil
+}
+
+// issuerHashes returns the hashes of the issuer's subject and of its public
+// key, as used in the CertID of OCSP requests and responses.
+func issuerHashes(issuer *Certificate, hash crypto.Hash) (nameHash, keyHash []byte, err error) {
+	spki := cryptobyte.String(issuer.RawSubjectPublicKeyInfo)
+	var publicKey cryptobyte.String
+	if !spki.ReadASN1(&spki, cryptobyte_asn1.SEQUENCE) ||
+		!spki.SkipASN1(cryptobyte_asn1.SEQUENCE) ||
+		!spki.ReadASN1BitStringAsBytes((*[]byte)(&publicKey)) {
+		return nil, nil, errors.New("x509: malformed issuer public key")
+	}
+	subject, err := subjectBytes(issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+	h := hash.New()
+	h.Write(subject)
+	nameHash = h.Sum(nil)
+	h.Reset()
+	h.Write(publicKey)
+	keyHash = h.Sum(nil)
+	return nameHash, keyHash, nil
+}
+
+// matchesIssuer reports whether id identifies a certificate issued by issuer.
+func (id *ocspCertID) matchesIssuer(issuer *Certificate) bool {
+	hash := ocspHashFromOID(id.HashAlgorithm.Algorithm)
+	if hash == 0 || !hash.Available() {
+		return false
+	}
+	nameHash, keyHash, err := issuerHashes(issuer, hash)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(id.NameHash, nameHash) && bytes.Equal(id.IssuerKeyHash, keyHash)
+}
+
+// OCSPRequest is a request for the revocation status of a single certificate,
+// as specified in RFC 6960, Section 4.1.
+type OCSPRequest struct {
+	// HashAlgorithm is the hash used to compute IssuerNameHash and
+	// IssuerKeyHash.
+	HashAlgorithm crypto.Hash
+	// IssuerNameHash and IssuerKeyHash are the hashes of the issuer's DER
+	// encoded subject and public key, respectively.
+	IssuerNameHash []byte
+	IssuerKeyHash  []byte
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber *big.Int
+}
+
+// NewOCSPRequest returns a request for the revocation status of cert, which
+// must have been issued by issuer. If hash is zero, SHA-1 is used, as it's
+// the only hash that responders are required to support.
+func NewOCSPRequest(cert, issuer *Certificate, hash crypto.Hash) (*OCSPRequest, error) {
+	if hash == 0 {
+		hash = crypto.SHA1
+	}
+	if ocspOIDFromHash(hash) == nil || !hash.Available() {
+		return nil, errors.New("x509: unsupported OCSP hash function")
+	}
+	nameHash, keyHash, err := issuerHashes(issuer, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &OCSPRequest{
+		HashAlgorithm:  hash,
+		IssuerNameHash: nameHash,
+		IssuerKeyHash:  keyHash,
+		SerialNumber:   cert.SerialNumber,
+	}, nil
+}
+
+// Marshal returns the DER encoding of the request.
+func (req *OCSPRequest) Marshal() ([]byte, error) {
+	oid := ocspOIDFromHash(req.HashAlgorithm)
+	if oid == nil {
+		return nil, errors.New("x509: unsupported OCSP hash function")
+	}
+	if req.SerialNumber == nil {
+		return nil, errors.New("x509: OCSP request is missing a serial number")
+	}
+	return asn1.Marshal(ocspRequestASN1{
+		TBSRequest: ocspTBSRequest{
+			RequestList: []ocspSingleRequest{{
+				Cert: ocspCertID{
+					HashAlgorithm: pkix.AlgorithmIdentifier{
+						Algorithm:  oid,
+						Parameters: asn1.NullRawValue,
+					},
+					NameHash:      req.IssuerNameHash,
+					IssuerKeyHash: req.IssuerKeyHash,
+					SerialNumber:  req.SerialNumber,
+				},
+			}},
+		},
+	})
+}
+
+// ParseOCSPRequest parses a DER encoded OCSP request. Only the first
+// certificate in the request is returned. Signed requests are not supported.
+func ParseOCSPRequest(der []byte) (*OCSPRequest, error) {
+	var req ocspRequestASN1
+	rest, err := asn1.Unmarshal(der, &req)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("x509: trailing data after OCSP request")
+	}
+	if len(req.TBSRequest.RequestList) == 0 {
+		return nil, errors.New("x509: OCSP request contains no certificates")
+	}
+	id := req.TBSRequest.RequestList[0].Cert
+	hash := ocspHashFromOID(id.HashAlgorithm.Algorithm)
+	if hash == 0 {
+		return nil, errors.New("x509: unsupported OCSP hash function")
+	}
+	if id.SerialNumber == nil {
+		return nil, errors.New("x509: OCSP request is missing a serial number")
+	}
+	return &OCSPRequest{
+		HashAlgorithm:  hash,
+		IssuerNameHash: id.NameHash,
+		IssuerKeyHash:  id.IssuerKeyHash,
+		SerialNumber:   id.SerialNumber,
+	}, nil
+}
+
+// MatchesIssuer reports whether req is about a certificate issued by issuer.
+func (req *OCSPRequest) MatchesIssuer(issuer *Certificate) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+	nameHash, keyHash, err := issuerHashes(issuer, req.HashAlgorithm)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(req.IssuerNameHash, nameHash) && bytes.Equal(req.IssuerKeyHash, keyHash)
+}
+
+// OCSPResponse is a signed OCSP response about a single certificate, as
+// specified in RFC 6960, Section 4.2.
+type OCSPResponse struct {
+	// Raw contains the complete DER encoded response.
+	Raw []byte
+
+	// Status is the revocation status of the certificate.
+	Status OCSPCertStatus
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber *big.Int
+
+	// ProducedAt is the time the response was signed.
+	ProducedAt time.Time
+	// ThisUpdate is the time the status was known to be correct, and
+	// NextUpdate is the time newer information will be available. NextUpdate
+	// may be zero if the responder always has newer information available.
+	ThisUpdate time.Time
+	NextUpdate time.Time
+
+	// RevokedAt and RevocationReason are set if Status is OCSPRevoked.
+	// RevocationReason uses the same values as
+	// [RevocationListEntry.ReasonCode].
+	RevokedAt        time.Time
+	RevocationReason int
+
+	// Certificate is the delegated responder certificate that signed the
+	// response, or nil if the response was signed directly by the issuer.
+	Certificate *Certificate
+
+	RawTBSResponseData []byte
+	Signature          []byte
+	SignatureAlgorithm SignatureAlgorithm
+
+	// IssuerHash is the hash used to identify the issuer in the response.
+	IssuerHash crypto.Hash
+
+	// RawResponderName is the DER encoded subject of the responder, and
+	// ResponderKeyHash is the SHA-1 hash of its public key. Exactly one of
+	// them is set.
+	RawResponderName []byte
+	ResponderKeyHash []byte
+
+	// Extensions contains the extensions of the single response about the
+	// certificate. When parsing, unknown critical extensions cause an error.
+	Extensions []pkix.Extension
+
+	// ExtraExtensions contains extensions to be copied, raw, into the single
+	// response by CreateOCSPResponse. It's ignored when parsing.
+	ExtraExtensions []pkix.Extension
+}
+
+// ParseOCSPResponse parses a DER encoded OCSP response about cert, and
+// verifies that it was signed by issuer or by a delegated responder with the
+// OCSP signing extended key usage, in turn signed by issuer.
+//
+// If the responder returned an error status, the returned error is an
+// [OCSPResponseError]. If the response contains multiple certificates, the
+// one matching cert is returned.
+//
+// ParseOCSPResponse doesn't check that the response is current, nor the
+// validity period of the delegated responder certificate.
+func ParseOCSPResponse(der []byte, cert, issuer *Certificate) (*OCSPResponse, error) {
+	var resp ocspResponseASN1
+	rest, err := asn1.Unmarshal(der, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("x509: trailing data after OCSP response")
+	}
+	if status := OCSPResponseStatus(resp.Status); status != OCSPSuccess {
+		return nil, OCSPResponseError{status}
+	}
+	if !resp.Response.ResponseType.Equal(oidOCSPBasicResponse) {
+		return nil, errors.New("x509: unsupported OCSP response type")
+	}
+
+	var basic ocspBasicResponse
+	rest, err = asn1.Unmarshal(resp.Response.Response, &basic)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("x509: trailing data after OCSP basic response")
+	}
+	for _, ext := range basic.TBSResponseData.ResponseExtensions {
+		if ext.Critical {
+			return nil, errors.New("x509: unsupported critical extension in OCSP response")
+		}
+	}
+
+	var single *ocspSingleResponse
+	for i := range basic.TBSResponseData.Responses {
+		r := &basic.TBSResponseData.Responses[i]
+		if r.CertID.SerialNumber != nil && r.CertID.SerialNumber.Cmp(cert.SerialNumber) == 0 &&
+			r.CertID.matchesIssuer(issuer) {
+			single = r
+			break
+		}
+	}
+	if single == nil {
+		return nil, errors.New("x509: OCSP response is not about the certificate")
+	}
+	for _, ext := range single.SingleExtensions {
+		if ext.Critical {
+			return nil, errors.New("x509: unsupported critical extension in OCSP response")
+		}
+	}
+
+	ret := &OCSPResponse{
+		Raw:                der,
+		SerialNumber:       single.CertID.SerialNumber,
+		ProducedAt:         basic.TBSResponseData.ProducedAt,
+		ThisUpdate:         single.ThisUpdate,
+		NextUpdate:         single.NextUpdate,
+		RawTBSResponseData: basic.TBSResponseData.Raw,
+		Signature:          basic.Signature.RightAlign(),
+		SignatureAlgorithm: getSignatureAlgorithmFromAI(basic.SignatureAlgorithm),
+		IssuerHash:         ocspHashFromOID(single.CertID.HashAlgorithm.Algorithm),
+		Extensions:         single.SingleExtensions,
+	}
+
+	switch {
+	case bool(single.Good):
+		ret.Status = OCSPGood
+	case bool(single.Unknown):
+		ret.Status = OCSPUnknown
+	default:
+		ret.Status = OCSPRevoked
+		ret.RevokedAt = single.Revoked.RevocationTime
+		ret.RevocationReason = int(single.Revoked.Reason)
+	}
+
+	rawResponderID := basic.TBSResponseData.RawResponderID
+	switch {
+	case rawResponderID.Class == asn1.ClassContextSpecific && rawResponderID.Tag == 1:
+		ret.RawResponderName = rawResponderID.Bytes
+	case rawResponderID.Class == asn1.ClassContextSpecific && rawResponderID.Tag == 2:
+		if _, err := asn1.Unmarshal(rawResponderID.Bytes, &ret.ResponderKeyHash); err != nil {
+			return nil, errors.New("x509: malformed OCSP responder ID")
+		}
+	default:
+		return nil, errors.New("x509: malformed OCSP responder ID")
+	}
+
+	// The response may carry the responder certificate and its chain, or the
+	// issuer itself. Only a certificate directly signed by the issuer can be a
+	// delegated responder, so look for that one.
+	responder := issuer
+	for _, raw := range basic.Certificates {
+		c, err := ParseCertificate(raw.FullBytes)
+		if err != nil {
+			return nil, err
+		}
+		if c.Equal(issuer) {
+			continue
+		}
+		if !bytes.Equal(c.RawIssuer, issuer.RawSubject) || c.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+		if err := ret.CheckSignatureFrom(c); err == nil {
+			responder = c
+			break
+		}
+	}
+	if responder != issuer {
+		hasOCSPSigning := false
+		for _, eku := range responder.ExtKeyUsage {
+			if eku == ExtKeyUsageOCSPSigning {
+				hasOCSPSigning = true
+				break
+			}
+		}
+		if !hasOCSPSigning {
+			return nil, errors.New("x509: OCSP responder certificate is not authorized for OCSP signing")
+		}
+		ret.Certificate = responder
+		return ret, nil
+	}
+	if err := ret.CheckSignatureFrom(issuer); err != nil {
+		return nil, errors.New("x509: bad OCSP response signature: " + err.Error())
+	}
+	return ret, nil
+}
+
+// CheckSignatureFrom verifies that the signature on resp is a valid signature
+// from responder.
+func (resp *OCSPResponse) CheckSignatureFrom(responder *Certificate) error {
+	if responder.PublicKeyAlgorithm == UnknownPublicKeyAlgorithm {
+		return ErrUnsupportedAlgorithm
+	}
+	return checkSignature(resp.SignatureAlgorithm, resp.RawTBSResponseData, resp.Signature, responder.PublicKey, false)
+}
+
+// CreateOCSPResponse creates a new DER encoded OCSP response about the
+// certificate with serial number template.SerialNumber, issued by issuer.
+//
+// The response is signed with priv. If template.Certificate is nil, priv must
+// be the issuer's private key. Otherwise, template.Certificate is the
+// delegated responder certificate, which is included in the response, and
+// priv must be its private key.
+//
+// The following members of template are used: Status, SerialNumber,
+// ProducedAt, ThisUpdate, NextUpdate, RevokedAt, RevocationReason,
+// Certificate, SignatureAlgorithm, IssuerHash, and ExtraExtensions. If
+// ProducedAt is zero, the current time is used. If IssuerHash is zero, SHA-1
+// is used.
+func CreateOCSPResponse(rand io.Reader, template *OCSPResponse, issuer *Certificate, priv crypto.Signer) ([]byte, error) {
+	if template == nil {
+		return nil, errors.New("x509: template can not be nil")
+	}
+	if issuer == nil {
+		return nil, errors.New("x509: issuer can not be nil")
+	}
+	if priv == nil {
+		return nil, errors.New("x509: priv can not be nil")
+	}
+	if template.SerialNumber == nil {
+		return nil, errors.New("x509: template contains nil SerialNumber field")
+	}
+
+	responder := issuer
+	if template.Certificate != nil {
+		responder = template.Certificate
+	}
+	if pub, ok := priv.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(responder.PublicKey) {
+		return nil, errors.New("x509: provided PrivateKey doesn't match the responder's PublicKey")
+	}
+
+	hash := template.IssuerHash
+	if hash == 0 {
+		hash = crypto.SHA1
+	}
+	hashOID := ocspOIDFromHash(hash)
+	if hashOID == nil || !hash.Available() {
+		return nil, errors.New("x509: unsupported OCSP hash function")
+	}
+	nameHash, keyHash, err := issuerHashes(issuer, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureAlgorithm, algorithmIdentifier, err := signingParamsForKey(priv, template.SignatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	single := ocspSingleResponse{
+		CertID: ocspCertID{
+			HashAlgorithm: pkix.AlgorithmIdentifier{
+				Algorithm:  hashOID,
+				Parameters: asn1.NullRawValue,
+			},
+			NameHash:      nameHash,
+			IssuerKeyHash: keyHash,
+			SerialNumber:  template.SerialNumber,
+		},
+		ThisUpdate:       template.ThisUpdate.UTC(),
+		NextUpdate:       template.NextUpdate.UTC(),
+		SingleExtensions: template.ExtraExtensions,
+	}
+	switch template.Status {
+	case OCSPGood:
+		single.Good = true
+	case OCSPUnknown:
+		single.Unknown = true
+	case OCSPRevoked:
+		single.Revoked = ocspRevokedInfo{
+			RevocationTime: template.RevokedAt.UTC(),
+			Reason:         asn1.Enumerated(template.RevocationReason),
+		}
+	default:
+		return nil, errors.New("x509: invalid OCSP certificate status")
+	}
+
+	// Identify the responder by the SHA-1 hash of its public key, as RFC 6960,
+	// Section 4.2.2.3 defines it: the hash of the subjectPublicKey BIT STRING
+	// contents, the same value used for IssuerKeyHash.
+	_, responderKeyHash, err := issuerHashes(responder, crypto.SHA1)
+	if err != nil {
+		return nil, err
+	}
+	responderKeyHashDER, err := asn1.Marshal(responderKeyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	producedAt := template.ProducedAt
+	if producedAt.IsZero() {
+		producedAt = time.Now()
+	}
+	tbs := ocspResponseData{
+		RawResponderID: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        2,
+			IsCompound: true,
+			Bytes:      responderKeyHashDER,
+		},
+		ProducedAt: producedAt.UTC().Truncate(time.Second),
+		Responses:  []ocspSingleResponse{single},
+	}
+	tbsDER, err := asn1.Marshal(tbs)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signTBS(tbsDER, priv, signatureAlgorithm, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := ocspBasicResponse{
+		TBSResponseData:    ocspResponseData{Raw: tbsDER},
+		SignatureAlgorithm: algorithmIdentifier,
+		Signature: asn1.BitString{
+			Bytes:     signature,
+			BitLength: len(signature) * 8,
+		},
+	}
+	if template.Certificate != nil {
+		basic.Certificates = []asn1.RawValue{{FullBytes: template.Certificate.Raw}}
+	}
+	basicDER, err := asn1.Marshal(basic)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(ocspResponseASN1{
+		Status: asn1.Enumerated(OCSPSuccess),
+		Response: ocspResponseBytes{
+			ResponseType: oidOCSPBasicResponse,
+			Response:     basicDER,
+		},
+	})
+}
+
+// CreateOCSPErrorResponse returns a DER encoded unsigned OCSP response with
+// the given error status.
+func CreateOCSPErrorResponse(status OCSPResponseStatus) ([]byte, error) {
+	if status == OCSPSuccess {
+		return nil, errors.New("x509: OCSPSuccess is not an error status")
+	}
+	return asn1.Marshal(ocspResponseASN1{Status: asn1.Enumerated(status)})
+}
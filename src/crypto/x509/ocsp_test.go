@@ -0,0 +1,268 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// newTestCA returns a new self-signed CA certificate valid for an hour
+// around the current time, and its private key.
+func newTestCA(t *testing.T, name string) (*Certificate, crypto.Signer) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              KeyUsageCertSign | KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// newTestCert returns a new certificate issued from template by issuer, valid
+// for an hour around the current time, and its private key.
+func newTestCert(t *testing.T, template, issuer *Certificate, issuerKey crypto.Signer) (*Certificate, crypto.Signer) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	der, err := CreateCertificate(rand.Reader, template, issuer, key.Public(), issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestOCSPRequest(t *testing.T) {
+	issuer, issuerKey := newTestCA(t, "Issuer")
+	other, _ := newTestCA(t, "Other")
+	cert, _ := newTestCert(t, &Certificate{SerialNumber: big.NewInt(42)}, issuer, issuerKey)
+
+	for _, hash := range []crypto.Hash{0, crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512} {
+		req, err := NewOCSPRequest(cert, issuer, hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, err := req.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := ParseOCSPRequest(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantHash := hash
+		if wantHash == 0 {
+			wantHash = crypto.SHA1
+		}
+		if parsed.HashAlgorithm != wantHash {
+			t.Errorf("HashAlgorithm = %v, want %v", parsed.HashAlgorithm, wantHash)
+		}
+		if parsed.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+			t.Errorf("SerialNumber = %v, want %v", parsed.SerialNumber, cert.SerialNumber)
+		}
+		if !parsed.MatchesIssuer(issuer) {
+			t.Errorf("%v: request doesn't match its issuer", wantHash)
+		}
+		if parsed.MatchesIssuer(other) {
+			t.Errorf("%v: request matches the wrong issuer", wantHash)
+		}
+	}
+
+	if _, err := NewOCSPRequest(cert, issuer, crypto.MD5); err == nil {
+		t.Error("NewOCSPRequest accepted MD5")
+	}
+	if _, err := ParseOCSPRequest([]byte{0x30, 0x00}); err == nil {
+		t.Error("ParseOCSPRequest accepted an empty request")
+	}
+}
+
+func TestOCSPResponse(t *testing.T) {
+	issuer, issuerKey := newTestCA(t, "Issuer")
+	other, otherKey := newTestCA(t, "Other")
+	cert, _ := newTestCert(t, &Certificate{SerialNumber: big.NewInt(42)}, issuer, issuerKey)
+
+	now := time.Now().Truncate(time.Second).UTC()
+	for _, status := range []OCSPCertStatus{OCSPGood, OCSPRevoked, OCSPUnknown} {
+		template := &OCSPResponse{
+			Status:           status,
+			SerialNumber:     cert.SerialNumber,
+			ThisUpdate:       now.Add(-time.Minute),
+			NextUpdate:       now.Add(time.Hour),
+			RevokedAt:        now.Add(-time.Hour),
+			RevocationReason: 1,
+			IssuerHash:       crypto.SHA256,
+		}
+		if status != OCSPRevoked {
+			template.RevokedAt, template.RevocationReason = time.Time{}, 0
+		}
+		der, err := CreateOCSPResponse(rand.Reader, template, issuer, issuerKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := ParseOCSPResponse(der, cert, issuer)
+		if err != nil {
+			t.Fatalf("%v: %v", status, err)
+		}
+		if resp.Status != status {
+			t.Errorf("Status = %v, want %v", resp.Status, status)
+		}
+		if !resp.ThisUpdate.Equal(template.ThisUpdate) || !resp.NextUpdate.Equal(template.NextUpdate) {
+			t.Errorf("%v: ThisUpdate, NextUpdate = %v, %v, want %v, %v", status,
+				resp.ThisUpdate, resp.NextUpdate, template.ThisUpdate, template.NextUpdate)
+		}
+		if !resp.RevokedAt.Equal(template.RevokedAt) || resp.RevocationReason != template.RevocationReason {
+			t.Errorf("%v: RevokedAt, RevocationReason = %v, %d, want %v, %d", status,
+				resp.RevokedAt, resp.RevocationReason, template.RevokedAt, template.RevocationReason)
+		}
+		if resp.IssuerHash != crypto.SHA256 {
+			t.Errorf("IssuerHash = %v, want SHA-256", resp.IssuerHash)
+		}
+		if resp.Certificate != nil {
+			t.Errorf("unexpected responder certificate")
+		}
+		if len(resp.ResponderKeyHash) == 0 {
+			t.Errorf("missing ResponderKeyHash")
+		}
+
+		if _, err := ParseOCSPResponse(der, cert, other); err == nil {
+			t.Errorf("%v: response accepted for the wrong issuer", status)
+		}
+	}
+
+	// A response signed by the wrong key.
+	template := &OCSPResponse{SerialNumber: cert.SerialNumber, ThisUpdate: now}
+	if _, err := CreateOCSPResponse(rand.Reader, template, issuer, otherKey); err == nil {
+		t.Error("CreateOCSPResponse accepted a key that doesn't match the issuer")
+	}
+	der, err := CreateOCSPResponse(rand.Reader, template, issuer, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der[len(der)-1] ^= 0xff
+	if _, err := ParseOCSPResponse(der, cert, issuer); err == nil {
+		t.Error("ParseOCSPResponse accepted a bad signature")
+	}
+
+	// A response about a different certificate.
+	wrongSerial, _ := newTestCert(t, &Certificate{SerialNumber: big.NewInt(43)}, issuer, issuerKey)
+	der, err = CreateOCSPResponse(rand.Reader, template, issuer, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseOCSPResponse(der, wrongSerial, issuer); err == nil {
+		t.Error("ParseOCSPResponse accepted a response about the wrong serial")
+	}
+}
+
+func TestOCSPDelegatedResponder(t *testing.T) {
+	issuer, issuerKey := newTestCA(t, "Issuer")
+	other, otherKey := newTestCA(t, "Other")
+	cert, _ := newTestCert(t, &Certificate{SerialNumber: big.NewInt(42)}, issuer, issuerKey)
+
+	responder, responderKey := newTestCert(t, &Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Responder"},
+		ExtKeyUsage:  []ExtKeyUsage{ExtKeyUsageOCSPSigning},
+	}, issuer, issuerKey)
+	notAuthorized, notAuthorizedKey := newTestCert(t, &Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "Not a responder"},
+		ExtKeyUsage:  []ExtKeyUsage{ExtKeyUsageServerAuth},
+	}, issuer, issuerKey)
+	otherResponder, otherResponderKey := newTestCert(t, &Certificate{
+		SerialNumber: big.NewInt(4),
+		Subject:      pkix.Name{CommonName: "Other responder"},
+		ExtKeyUsage:  []ExtKeyUsage{ExtKeyUsageOCSPSigning},
+	}, other, otherKey)
+
+	template := &OCSPResponse{
+		Status:       OCSPRevoked,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   time.Now(),
+		RevokedAt:    time.Now(),
+		Certificate:  responder,
+	}
+	der, err := CreateOCSPResponse(rand.Reader, template, issuer, responderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ParseOCSPResponse(der, cert, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Certificate.Equal(responder) {
+		t.Error("wrong responder certificate")
+	}
+	if resp.Status != OCSPRevoked {
+		t.Errorf("Status = %v, want revoked", resp.Status)
+	}
+
+	template.Certificate = notAuthorized
+	der, err = CreateOCSPResponse(rand.Reader, template, issuer, notAuthorizedKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseOCSPResponse(der, cert, issuer); err == nil {
+		t.Error("accepted a responder without the OCSP signing EKU")
+	}
+
+	template.Certificate = otherResponder
+	der, err = CreateOCSPResponse(rand.Reader, template, issuer, otherResponderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseOCSPResponse(der, cert, issuer); err == nil {
+		t.Error("accepted a responder issued by a different CA")
+	}
+}
+
+func TestOCSPErrorResponse(t *testing.T) {
+	issuer, issuerKey := newTestCA(t, "Issuer")
+	cert, _ := newTestCert(t, &Certificate{SerialNumber: big.NewInt(42)}, issuer, issuerKey)
+
+	der, err := CreateOCSPErrorResponse(OCSPTryLater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseOCSPResponse(der, cert, issuer)
+	var respErr OCSPResponseError
+	if !errors.As(err, &respErr) || respErr.Status != OCSPTryLater {
+		t.Errorf("ParseOCSPResponse error = %v, want OCSPResponseError{OCSPTryLater}", err)
+	}
+	if _, err := CreateOCSPErrorResponse(OCSPSuccess); err == nil {
+		t.Error("CreateOCSPErrorResponse accepted OCSPSuccess")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509/pkix"
@@ -287,6 +288,23 @@ func parsePublicKey(keyData *publicKeyInfo) (any, error) {
 			return nil, errors.New("x509: X25519 key encoded with illegal parameters")
 		}
 		return ecdh.X25519().NewPublicKey(der)
+	case oid.Equal(oidPublicKeyEd448):
+		// RFC 8410, Section 3
+		// > For all of the OIDs, the parameters MUST be absent.
+		if len(params.FullBytes) != 0 {
+			return nil, errors.New("x509: Ed448 key encoded with illegal parameters")
+		}
+		if len(der) != ed448.PublicKeySize {
+			return nil, errors.New("x509: wrong Ed448 public key size")
+		}
+		return ed448.PublicKey(der), nil
+	case oid.Equal(oidPublicKeyX448):
+		// RFC 8410, Section 3
+		// > For all of the OIDs, the parameters MUST be absent.
+		if len(params.FullBytes) != 0 {
+			return nil, errors.New("x509: X448 key encoded with illegal parameters")
+		}
+		return ecdh.X448().NewPublicKey(der)
 	case oid.Equal(oidPublicKeyDSA):
 		y := new(big.Int)
 		if !der.ReadASN1Integer(y) {
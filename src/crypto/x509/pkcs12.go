@@ -0,0 +1,723 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha1"
+	"crypto/subtle"
+	"crypto/x509/internal/rc2"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"hash"
+	"io"
+	"unicode/utf16"
+)
+
+// This file implements the subset of PKCS #12 (RFC 7292) that is used to
+// exchange a private key together with its certificate chain: password
+// integrity (MacData) and password privacy (PBES1 and PBES2 encryption).
+// Public-key integrity and privacy modes are not supported.
+
+var (
+	oidPKCS7Data          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidPKCS7EncryptedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+
+	oidKeyBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 1}
+	oidPKCS8ShroudedKeyBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidCertTypeX509        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+
+	oidAttributeLocalKeyID = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+
+	oidPBEWithSHAAnd3KeyTripleDESCBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidPBEWithSHAAnd128BitRC2CBC     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 5}
+	oidPBEWithSHAAnd40BitRC2CBC      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 6}
+
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+// pfxPDU reflects the PFX structure of RFC 7292, Section 4.
+type pfxPDU struct {
+	Version  int
+	AuthSafe pkcs12ContentInfo
+	MacData  pkcs12MacData `asn1:"optional"`
+}
+
+type pkcs12ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type pkcs12EncryptedData struct {
+	Version              int
+	EncryptedContentInfo pkcs12EncryptedContentInfo
+}
+
+type pkcs12EncryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+type pkcs12MacData struct {
+	Mac        pkcs12DigestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type pkcs12DigestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type pkcs12SafeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type pkcs12CertBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbeParams reflects the parameters of the PKCS #12 PBE algorithms, RFC 7292,
+// Appendix C.
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+// pbes2Params and pbkdf2Params reflect the PBES2 and PBKDF2 parameters of
+// RFC 8018, Appendix A.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt       []byte
+	Iterations int
+	KeyLength  int                      `asn1:"optional"`
+	PRF        pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// explicitTag0 wraps der in an explicit [0] tag. encoding/asn1 doesn't add or
+// strip the explicit tag of RawValue fields, so Bytes holds the tagged element.
+func explicitTag0(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+// ParsePKCS12 decodes a PKCS #12 file (also known as PFX or .p12) protected
+// with password. The file must contain exactly one private key, which is
+// returned together with the certificate for that key and any other
+// certificates in the file, in the order they appear.
+//
+// The key is returned in the same form as [ParsePKCS8PrivateKey]. Both PBES2
+// (with PBKDF2 and AES or Triple DES) and the legacy PKCS #12 password based
+// encryption algorithms (pbeWithSHAAnd3-KeyTripleDES-CBC and RC2-CBC) are
+// supported. If the file is integrity protected, an incorrect password
+// results in [IncorrectPasswordError].
+func ParsePKCS12(pfxData []byte, password string) (key any, leaf *Certificate, caCerts []*Certificate, err error) {
+	var pfx pfxPDU
+	if rest, err := asn1.Unmarshal(pfxData, &pfx); err != nil {
+		return nil, nil, nil, err
+	} else if len(rest) != 0 {
+		return nil, nil, nil, errors.New("x509: trailing data after PKCS#12 PFX")
+	}
+	if pfx.Version != 3 {
+		return nil, nil, nil, errors.New("x509: unsupported PKCS#12 version")
+	}
+	if !pfx.AuthSafe.ContentType.Equal(oidPKCS7Data) {
+		return nil, nil, nil, errors.New("x509: PKCS#12 public-key integrity mode is not supported")
+	}
+	var authSafe []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafe); err != nil {
+		return nil, nil, nil, errors.New("x509: malformed PKCS#12 authenticated safe")
+	}
+
+	bmpPassword, err := bmpStringZeroTerminated(password)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(pfx.MacData.Mac.Algorithm.Algorithm) != 0 {
+		err := pfx.MacData.verify(authSafe, bmpPassword)
+		if err == IncorrectPasswordError && password == "" {
+			// Some implementations encode the empty password as an empty
+			// string rather than as a zero-terminated empty BMPString.
+			if pfx.MacData.verify(authSafe, nil) == nil {
+				bmpPassword, err = nil, nil
+			}
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	var contents []pkcs12ContentInfo
+	if rest, err := asn1.Unmarshal(authSafe, &contents); err != nil || len(rest) != 0 {
+		return nil, nil, nil, errors.New("x509: malformed PKCS#12 authenticated safe")
+	}
+
+	var keys []pkcs12Key
+	var certs []pkcs12Cert
+	for _, ci := range contents {
+		var safeContents []byte
+		switch {
+		case ci.ContentType.Equal(oidPKCS7Data):
+			if _, err := asn1.Unmarshal(ci.Content.Bytes, &safeContents); err != nil {
+				return nil, nil, nil, errors.New("x509: malformed PKCS#12 safe contents")
+			}
+		case ci.ContentType.Equal(oidPKCS7EncryptedData):
+			var ed pkcs12EncryptedData
+			if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+				return nil, nil, nil, errors.New("x509: malformed PKCS#12 encrypted data")
+			}
+			if ed.Version != 0 {
+				return nil, nil, nil, errors.New("x509: unsupported PKCS#12 encrypted data version")
+			}
+			eci := ed.EncryptedContentInfo
+			safeContents, err = pbeDecrypt(eci.ContentEncryptionAlgorithm, eci.EncryptedContent, password, bmpPassword)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		default:
+			return nil, nil, nil, errors.New("x509: PKCS#12 public-key privacy mode is not supported")
+		}
+
+		var bags []pkcs12SafeBag
+		if rest, err := asn1.Unmarshal(safeContents, &bags); err != nil || len(rest) != 0 {
+			return nil, nil, nil, errors.New("x509: malformed PKCS#12 safe contents")
+		}
+		for _, bag := range bags {
+			localKeyID := bag.localKeyID()
+			switch {
+			case bag.ID.Equal(oidKeyBag):
+				k, err := ParsePKCS8PrivateKey(bag.Value.Bytes)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				keys = append(keys, pkcs12Key{k, localKeyID})
+			case bag.ID.Equal(oidPKCS8ShroudedKeyBag):
+				var epki encryptedPrivateKeyInfo
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &epki); err != nil {
+					return nil, nil, nil, errors.New("x509: malformed PKCS#12 shrouded key bag")
+				}
+				der, err := pbeDecrypt(epki.Algorithm, epki.EncryptedData, password, bmpPassword)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				k, err := ParsePKCS8PrivateKey(der)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				keys = append(keys, pkcs12Key{k, localKeyID})
+			case bag.ID.Equal(oidCertBag):
+				var cb pkcs12CertBag
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &cb); err != nil {
+					return nil, nil, nil, errors.New("x509: malformed PKCS#12 certificate bag")
+				}
+				if !cb.ID.Equal(oidCertTypeX509) {
+					continue
+				}
+				cert, err := ParseCertificate(cb.Data)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				certs = append(certs, pkcs12Cert{cert, localKeyID})
+			}
+		}
+	}
+
+	if len(keys) != 1 {
+		return nil, nil, nil, errors.New("x509: PKCS#12 file must contain exactly one private key")
+	}
+	key = keys[0].key
+	leafIndex := -1
+	if keys[0].localKeyID != nil {
+		for i, c := range certs {
+			if bytes.Equal(c.localKeyID, keys[0].localKeyID) {
+				leafIndex = i
+				break
+			}
+		}
+	}
+	if leafIndex < 0 {
+		for i, c := range certs {
+			if privateKeyMatches(key, c.cert.PublicKey) {
+				leafIndex = i
+				break
+			}
+		}
+	}
+	if leafIndex < 0 {
+		return nil, nil, nil, errors.New("x509: PKCS#12 file contains no certificate for the private key")
+	}
+	leaf = certs[leafIndex].cert
+	for i, c := range certs {
+		if i != leafIndex {
+			caCerts = append(caCerts, c.cert)
+		}
+	}
+	return key, leaf, caCerts, nil
+}
+
+type pkcs12Key struct {
+	key        any
+	localKeyID []byte
+}
+
+type pkcs12Cert struct {
+	cert       *Certificate
+	localKeyID []byte
+}
+
+func (bag *pkcs12SafeBag) localKeyID() []byte {
+	for _, attr := range bag.Attributes {
+		if !attr.ID.Equal(oidAttributeLocalKeyID) {
+			continue
+		}
+		var values [][]byte
+		if _, err := asn1.UnmarshalWithParams(attr.Value.FullBytes, &values, "set"); err != nil || len(values) != 1 {
+			return nil
+		}
+		return values[0]
+	}
+	return nil
+}
+
+func privateKeyMatches(priv, pub any) bool {
+	k, ok := priv.(interface{ Public() crypto.PublicKey })
+	if !ok {
+		return false
+	}
+	p, ok := k.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && p.Equal(pub)
+}
+
+func (md *pkcs12MacData) verify(message, bmpPassword []byte) error {
+	h := ocspHashFromOID(md.Mac.Algorithm.Algorithm)
+	if h == 0 || !h.Available() {
+		return errors.New("x509: unsupported PKCS#12 MAC algorithm " + md.Mac.Algorithm.Algorithm.String())
+	}
+	if md.Iterations < 1 {
+		return errors.New("x509: invalid PKCS#12 MAC iteration count")
+	}
+	key := pkcs12KDF(h, 3, bmpPassword, md.MacSalt, md.Iterations, h.Size())
+	mac := hmac.New(h.New, key)
+	mac.Write(message)
+	if !hmac.Equal(mac.Sum(nil), md.Mac.Digest) {
+		return IncorrectPasswordError
+	}
+	return nil
+}
+
+// bmpStringZeroTerminated encodes s as a zero-terminated BMPString, as
+// required for passwords by the PKCS #12 key derivation function.
+func bmpStringZeroTerminated(s string) ([]byte, error) {
+	u := utf16.Encode([]rune(s))
+	out := make([]byte, 0, 2*len(u)+2)
+	for _, c := range u {
+		if c == 0 {
+			return nil, errors.New("x509: PKCS#12 password contains a NUL character")
+		}
+		out = append(out, byte(c>>8), byte(c))
+	}
+	return append(out, 0, 0), nil
+}
+
+// fillWithRepeats returns pattern repeated up to the next multiple of v bytes.
+func fillWithRepeats(pattern []byte, v int) []byte {
+	if len(pattern) == 0 {
+		return nil
+	}
+	n := v * ((len(pattern) + v - 1) / v)
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = pattern[i%len(pattern)]
+	}
+	return out
+}
+
+// pkcs12KDF implements the key derivation function of RFC 7292, Appendix B.2,
+// with the given hash, diversifier id and iteration count.
+func pkcs12KDF(h crypto.Hash, id byte, password, salt []byte, iterations, size int) []byte {
+	hh := h.New()
+	u, v := hh.Size(), hh.BlockSize()
+
+	D := bytes.Repeat([]byte{id}, v)
+	I := append(fillWithRepeats(salt, v), fillWithRepeats(password, v)...)
+	B := make([]byte, v)
+
+	out := make([]byte, 0, size+u)
+	for len(out) < size {
+		hh.Reset()
+		hh.Write(D)
+		hh.Write(I)
+		A := hh.Sum(nil)
+		for j := 1; j < iterations; j++ {
+			hh.Reset()
+			hh.Write(A)
+			A = hh.Sum(A[:0])
+		}
+		out = append(out, A...)
+		if len(out) >= size {
+			break
+		}
+
+		// Set each v-byte block I_j of I to (I_j + B + 1) mod 2^v, where B
+		// is A repeated to v bytes.
+		for k := range B {
+			B[k] = A[k%u]
+		}
+		for j := 0; j < len(I); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				carry += int(I[j+k]) + int(B[k])
+				I[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+	return out[:size]
+}
+
+// pbeDecrypt decrypts ciphertext with the password based encryption scheme
+// identified by algo. password is used for PBES2, and bmpPassword for the
+// PKCS #12 specific schemes.
+func pbeDecrypt(algo pkix.AlgorithmIdentifier, ciphertext []byte, password string, bmpPassword []byte) ([]byte, error) {
+	var block cipher.Block
+	var iv []byte
+	switch {
+	case algo.Algorithm.Equal(oidPBES2):
+		var err error
+		block, iv, err = pbes2Cipher(algo.Parameters.FullBytes, password)
+		if err != nil {
+			return nil, err
+		}
+	case algo.Algorithm.Equal(oidPBEWithSHAAnd3KeyTripleDESCBC),
+		algo.Algorithm.Equal(oidPBEWithSHAAnd128BitRC2CBC),
+		algo.Algorithm.Equal(oidPBEWithSHAAnd40BitRC2CBC):
+		var params pbeParams
+		if rest, err := asn1.Unmarshal(algo.Parameters.FullBytes, &params); err != nil || len(rest) != 0 {
+			return nil, errors.New("x509: malformed PKCS#12 PBE parameters")
+		}
+		if params.Iterations < 1 {
+			return nil, errors.New("x509: invalid PKCS#12 PBE iteration count")
+		}
+		kdf := func(id byte, size int) []byte {
+			return pkcs12KDF(crypto.SHA1, id, bmpPassword, params.Salt, params.Iterations, size)
+		}
+		var err error
+		switch {
+		case algo.Algorithm.Equal(oidPBEWithSHAAnd3KeyTripleDESCBC):
+			block, err = des.NewTripleDESCipher(kdf(1, 24))
+		case algo.Algorithm.Equal(oidPBEWithSHAAnd128BitRC2CBC):
+			block, err = rc2.New(kdf(1, 16), 128)
+		default:
+			block, err = rc2.New(kdf(1, 5), 40)
+		}
+		if err != nil {
+			return nil, err
+		}
+		iv = kdf(2, block.BlockSize())
+	default:
+		return nil, errors.New("x509: unsupported PKCS#12 encryption algorithm " + algo.Algorithm.String())
+	}
+
+	bs := block.BlockSize()
+	if len(ciphertext) == 0 || len(ciphertext)%bs != 0 {
+		return nil, errors.New("x509: PKCS#12 encrypted data is not a multiple of the block size")
+	}
+	out := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, ciphertext)
+
+	// A wrong password is most likely to result in invalid padding.
+	n := int(out[len(out)-1])
+	if n == 0 || n > bs {
+		return nil, IncorrectPasswordError
+	}
+	if subtle.ConstantTimeCompare(out[len(out)-n:], bytes.Repeat([]byte{byte(n)}, n)) != 1 {
+		return nil, IncorrectPasswordError
+	}
+	return out[:len(out)-n], nil
+}
+
+var pbkdf2PRFs = []struct {
+	oid  asn1.ObjectIdentifier
+	hash crypto.Hash
+}{
+	{oidHMACWithSHA1, crypto.SHA1},
+	{oidHMACWithSHA256, crypto.SHA256},
+	{oidHMACWithSHA384, crypto.SHA384},
+	{oidHMACWithSHA512, crypto.SHA512},
+}
+
+var pbes2Ciphers = []struct {
+	oid     asn1.ObjectIdentifier
+	keySize int
+	new     func(key []byte) (cipher.Block, error)
+}{
+	{oidAES128CBC, 16, aes.NewCipher},
+	{oidAES192CBC, 24, aes.NewCipher},
+	{oidAES256CBC, 32, aes.NewCipher},
+	{oidDESEDE3CBC, 24, des.NewTripleDESCipher},
+}
+
+// pbes2Cipher derives the block cipher and IV described by the PBES2
+// parameters in der from password.
+func pbes2Cipher(der []byte, password string) (cipher.Block, []byte, error) {
+	var params pbes2Params
+	if rest, err := asn1.Unmarshal(der, &params); err != nil || len(rest) != 0 {
+		return nil, nil, errors.New("x509: malformed PBES2 parameters")
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, nil, errors.New("x509: unsupported PBES2 key derivation function " + params.KeyDerivationFunc.Algorithm.String())
+	}
+	var kdfParams pbkdf2Params
+	if rest, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil || len(rest) != 0 {
+		return nil, nil, errors.New("x509: malformed PBKDF2 parameters")
+	}
+
+	prf := crypto.SHA1
+	if len(kdfParams.PRF.Algorithm) != 0 {
+		prf = 0
+		for _, p := range pbkdf2PRFs {
+			if p.oid.Equal(kdfParams.PRF.Algorithm) {
+				prf = p.hash
+			}
+		}
+		if prf == 0 || !prf.Available() {
+			return nil, nil, errors.New("x509: unsupported PBKDF2 PRF " + kdfParams.PRF.Algorithm.String())
+		}
+	}
+
+	for _, c := range pbes2Ciphers {
+		if !c.oid.Equal(params.EncryptionScheme.Algorithm) {
+			continue
+		}
+		if kdfParams.KeyLength != 0 && kdfParams.KeyLength != c.keySize {
+			return nil, nil, errors.New("x509: invalid PBKDF2 key length")
+		}
+		var iv []byte
+		if rest, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(rest) != 0 {
+			return nil, nil, errors.New("x509: malformed PBES2 encryption scheme parameters")
+		}
+		key, err := pbkdf2.Key(func() hash.Hash { return prf.New() }, password, kdfParams.Salt, kdfParams.Iterations, c.keySize)
+		if err != nil {
+			return nil, nil, err
+		}
+		block, err := c.new(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(iv) != block.BlockSize() {
+			return nil, nil, errors.New("x509: invalid PBES2 IV length")
+		}
+		return block, iv, nil
+	}
+	return nil, nil, errors.New("x509: unsupported PBES2 encryption scheme " + params.EncryptionScheme.Algorithm.String())
+}
+
+const (
+	pkcs12Iterations = 2048
+	pkcs12SaltSize   = 16
+)
+
+// pbes2Encrypt encrypts plaintext with PBES2, using PBKDF2 with HMAC-SHA256
+// and AES-256-CBC.
+func pbes2Encrypt(rand io.Reader, plaintext []byte, password string) (pkix.AlgorithmIdentifier, []byte, error) {
+	salt := make([]byte, pkcs12SaltSize)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand, salt); err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	if _, err := io.ReadFull(rand, iv); err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+
+	key, err := pbkdf2.Key(func() hash.Hash { return crypto.SHA256.New() }, password, salt, pkcs12Iterations, 32)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	n := aes.BlockSize - len(plaintext)%aes.BlockSize
+	padded := append(bytes.Clone(plaintext), bytes.Repeat([]byte{byte(n)}, n)...)
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, padded)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:       salt,
+		Iterations: pkcs12Iterations,
+		PRF:        pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	return pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}}, ciphertext, nil
+}
+
+// CreatePKCS12 encodes key, its certificate leaf and the optional caCerts as
+// a PKCS #12 file (also known as PFX or .p12) protected with password.
+//
+// key must be a private key supported by [MarshalPKCS8PrivateKey] that
+// matches the public key of leaf. The key and the certificates are encrypted
+// with PBES2, using PBKDF2 with HMAC-SHA256 and AES-256-CBC, and the file is
+// integrity protected with HMAC-SHA256. Salts and IVs are read from rand.
+//
+// The resulting files can be read by OpenSSL 1.1.1 and later, and by most
+// current platforms, but not by some legacy systems that only support the
+// PKCS #12 specific encryption algorithms.
+func CreatePKCS12(rand io.Reader, key any, leaf *Certificate, caCerts []*Certificate, password string) ([]byte, error) {
+	if leaf == nil {
+		return nil, errors.New("x509: missing leaf certificate")
+	}
+	if !privateKeyMatches(key, leaf.PublicKey) {
+		return nil, errors.New("x509: private key does not match the leaf certificate")
+	}
+	bmpPassword, err := bmpStringZeroTerminated(password)
+	if err != nil {
+		return nil, err
+	}
+
+	localKeyIDSum := sha1.Sum(leaf.Raw)
+	localKeyID, err := asn1.MarshalWithParams([][]byte{localKeyIDSum[:]}, "set")
+	if err != nil {
+		return nil, err
+	}
+	localKeyIDAttr := []pkcs12Attribute{{ID: oidAttributeLocalKeyID, Value: asn1.RawValue{FullBytes: localKeyID}}}
+
+	var certBags []pkcs12SafeBag
+	for i, cert := range append([]*Certificate{leaf}, caCerts...) {
+		cb, err := asn1.Marshal(pkcs12CertBag{ID: oidCertTypeX509, Data: cert.Raw})
+		if err != nil {
+			return nil, err
+		}
+		bag := pkcs12SafeBag{ID: oidCertBag, Value: explicitTag0(cb)}
+		if i == 0 {
+			bag.Attributes = localKeyIDAttr
+		}
+		certBags = append(certBags, bag)
+	}
+	certContents, err := asn1.Marshal(certBags)
+	if err != nil {
+		return nil, err
+	}
+	certAlgo, certCiphertext, err := pbes2Encrypt(rand, certContents, password)
+	if err != nil {
+		return nil, err
+	}
+	encryptedCerts, err := asn1.Marshal(pkcs12EncryptedData{
+		EncryptedContentInfo: pkcs12EncryptedContentInfo{
+			ContentType:                oidPKCS7Data,
+			ContentEncryptionAlgorithm: certAlgo,
+			EncryptedContent:           certCiphertext,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keyDER, err := MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	keyAlgo, keyCiphertext, err := pbes2Encrypt(rand, keyDER, password)
+	if err != nil {
+		return nil, err
+	}
+	epki, err := asn1.Marshal(encryptedPrivateKeyInfo{Algorithm: keyAlgo, EncryptedData: keyCiphertext})
+	if err != nil {
+		return nil, err
+	}
+	keyContents, err := asn1.Marshal([]pkcs12SafeBag{{
+		ID:         oidPKCS8ShroudedKeyBag,
+		Value:      explicitTag0(epki),
+		Attributes: localKeyIDAttr,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	keyData, err := asn1.Marshal(keyContents)
+	if err != nil {
+		return nil, err
+	}
+
+	authSafe, err := asn1.Marshal([]pkcs12ContentInfo{
+		{ContentType: oidPKCS7EncryptedData, Content: explicitTag0(encryptedCerts)},
+		{ContentType: oidPKCS7Data, Content: explicitTag0(keyData)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	authSafeData, err := asn1.Marshal(authSafe)
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt := make([]byte, pkcs12SaltSize)
+	if _, err := io.ReadFull(rand, macSalt); err != nil {
+		return nil, err
+	}
+	macKey := pkcs12KDF(crypto.SHA256, 3, bmpPassword, macSalt, pkcs12Iterations, crypto.SHA256.Size())
+	mac := hmac.New(crypto.SHA256.New, macKey)
+	mac.Write(authSafe)
+
+	return asn1.Marshal(pfxPDU{
+		Version:  3,
+		AuthSafe: pkcs12ContentInfo{ContentType: oidPKCS7Data, Content: explicitTag0(authSafeData)},
+		MacData: pkcs12MacData{
+			Mac: pkcs12DigestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
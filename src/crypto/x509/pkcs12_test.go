@@ -0,0 +1,261 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// The following files were generated with OpenSSL 3.0 from the same key,
+// certificate and CA certificate, with the commands
+//
+//	openssl pkcs12 -export -passout pass:password -certfile ca.pem
+//	openssl pkcs12 -export -passout pass:password -certfile ca.pem -legacy
+//	openssl pkcs12 -export -passout pass:password -legacy \
+//		-certpbe PBE-SHA1-RC2-128 -keypbe PBE-SHA1-3DES -macalg sha1
+//	openssl pkcs12 -export -passout pass: \
+//		-certpbe AES-128-CBC -keypbe DES-EDE3-CBC -macalg sha512
+
+// pkcs12Modern uses PBES2 with AES-256-CBC and a HMAC-SHA256 MAC.
+var pkcs12Modern = `
+MIIFTAIBAzCCBQIGCSqGSIb3DQEHAaCCBPMEggTvMIIE6zCCA6IGCSqGSIb3DQEHBqCCA5MwggOP
+AgEAMIIDiAYJKoZIhvcNAQcBMFcGCSqGSIb3DQEFDTBKMCkGCSqGSIb3DQEFDDAcBAhe3QGUEU5Y
+1gICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQMEASoEEL6FY1E3gHMAvQj8HuxqJyCAggMgJ0hQ
+H2+k96Q1bowpp/gGJllbzHyWoYaZBpcIlZSfUgl2BtrQh5iLDGmCKoGakhbhwqWYrpEIaKOVbpUp
+10EB3uHDowkCcBaMEI1SVcYB1x9xcnOeWZfNa4+yTC0x0qW5AXwKnzE0LMNGqotzyFowTrNUzN7F
+8F0vXEHS4KhVnFk3UV5xaIXDgw7U4h00SnJkWDcWjllB7fYyMFCNQWhY8YVcAErS28Jx5UfIRk6Q
+cXD3MnluvxgoTR6qMcuiAorHfy3cBXMO4VRXp1H6+REB+ohlefhZWEw5YKc+xXEOFgAXqGlxv8vs
+aqzxgXPZt8zzA7VayYE7jVI62+GK0yGGAFhCKNyysxpu1IbzmbH5vwfwMppR1CthzmiLtkKWa7sY
+0d6+14AcK8H9W2kNn02nVK7d3sRIhm9KHYWnY1qaHgKLCTAm+dTYGoRMG1dSZIsb+tZuBOQiLUH0
+1qbb0EDt9DZegXGe+igCtKSKEmwMNsLuoM+p1OkIVVvhmD0sV6j0jh6ScPDircL2FkpNAlz5dYCK
+PNf7PUIepmgLKCay+xp2t+sHjrY0O1B5Mq6jxnNsYjv+BrfUZWmwC1uoIp0k53VpEr17twU/GTAb
+smcVa+WnNrFntAg4zYB1tkU3LLw/nH8ZSJAzUQv1yXouIUqfHodLaApBMkk7pp/qd+9W+95osHAH
+lazipO69LI3OABxeanziMRh0rO41nwmbLHGXfN8i3g1As9NY9k5btnaV8LHNfZvEBWlt7y80PZsa
+nUXwNRzEEvqTCl4o9zDud6v//pJlLl+sWtkAdKjkaInXdMDP1/ovoOP9hk8SwuPeFopehpmbrXgg
+am9y2uOfyNkDnsr7s1mw6mFvnd2rREj5zhprULimrfICFzqUROidhrEWDf35NwyJ2GtAFG13tIzr
+J/4/vUNHLnV7Tn0WooFckNn6GolUmXv3jC5kmko8dNqzMInc/cfjsqyiZa77S0dtF0HLRZe55QDX
+L1WcxpE8zdH2lcDAdAkdILKYKz12ikKFhoc5PLPBa3gsVSU5l51LjmlpkT099JH/ukwjBvc+888w
+ggFBBgkqhkiG9w0BBwGgggEyBIIBLjCCASowggEmBgsqhkiG9w0BDAoBAqCB7zCB7DBXBgkqhkiG
+9w0BBQ0wSjApBgkqhkiG9w0BBQwwHAQI181GNu7l90YCAggAMAwGCCqGSIb3DQIJBQAwHQYJYIZI
+AWUDBAEqBBClHr/9UIt+KS5HsJYlDSlTBIGQwgJOL58CLckhefSm1O8tN8BTmAB0/arXxt/04gdN
+KEMVKJRtapvA3deDFBzHHjZKdcbNdvkeMlWBOiiOROxiImugjdaauyVlfqHqPj53ewrDnvCgtCB7
+cpVOPcRWCovk+/Wa0vG3keM7gbl4AC7e7XM4GFPpyPUmQkGY0Z5sx3rMA19OtgwQ3sbM5ru9FXbc
+MSUwIwYJKoZIhvcNAQkVMRYEFGYD3WAZE2sNg4/ST3XCVR4mn1DzMEEwMTANBglghkgBZQMEAgEF
+AAQgs2768W0J70JG4FoS8KuxSxi/N2aAnyPsFgOtYzjr5rIECOBEIcWAAwwQAgIIAA==`
+
+// pkcs12Legacy uses RC2-40 for the certificates, Triple DES for the key and a
+// HMAC-SHA1 MAC.
+var pkcs12Legacy = `
+MIIEwgIBAzCCBIgGCSqGSIb3DQEHAaCCBHkEggR1MIIEcTCCA2cGCSqGSIb3DQEHBqCCA1gwggNU
+AgEAMIIDTQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQYwDgQIuE2Rs8AO7psCAggAgIIDICD9vpDf
+VtF+qYYRJUPrVxJbSxeQck+5wzn2TlFAfT3leRUdKL36OgI+czT5TENEzIUUqcqnAkYP/L/q3+RI
+y53YoaDkDrcuE00ktuxw+Vk4rebE36frj1gjSD9/Z6pJPt6dGerLtzoWOoZzvV1ObhUvEQ2ogiOK
++BBK8LbFlTDW5HQIXLMSS00YmwJkV9U/ctWp6XEh1/zDrQNoTRJ+00ncREBJv0Ck7u/fXPSv3oDh
+w2eSy9T8F5l619XLfyD4ZvMLakON9mor4ToF0RKGOwi4ycrCnHxuKHVe5rKohuMYuzNGh8cFLBmi
+zzNOlfeSvrJ/S3vQEO3CHeTsHn9JuBuyP/5r7eH+EX4w6OxD1iEiTnvCJ68KdG9Vc4HlVEjtqrNU
+4Yy9OfsumstllQflNnNY/uugEOVFuaCs7zOvH94uSEpDZO/dliSVWWFl0XV13nboNkH7YvRpAMvP
+Rbs63TrGTiRtrqUYaFPJJPVd9yubEnIjMUaPecSV+xrnUKT+KVmyfMUYUONt38ajMyNAp+2n3V6a
+mQ9yniV1ZPPmptrOWKKT+PC3SYf/T0eclL5M6azs/HN/RBOZ61inWwzHvRE5TFp12RR7HkEn/ALl
+h8UqrkIn08ULXQDN5rkMIYS+gIGLwwhcGzEmfrxXWNplS8XEYVXu5XAs5wW+3y9FzNgS/QqqhS9d
+6nZ+7/Ky0Kl6Y6VKqGRRy8Wnkb+v3O8cAx4smJraZUccnuHG1UpDlnsx6KY+VpL8itoyip5zGD+T
+5S+SLeFc0yrxgMXVed9eoYV2Xhi2T+mzGPC5oBSXNA528eYwCTkBs72/eviqgh31XvcGUpDUCa7P
+ZvFksd0ydpQIqEfsVUore3Iwk5Qp9CBGwOrg5NxktM2mXY/QMQ+ttolrMGB+eBbwWkD5z0Pyqyp0
+bKbxva5Vf+IAS1dLBTJ/PZPnEanzyAILAvoSGkp7h9GmuzoDVDRJRSSwsYsLv4U9KCe23AgXkG1n
+4tpc85xBBQZsOd9gxvvJH+5wtZdEXb51SjCi+5qWUs9sY7dqk1+oBo1hqj1KESN+ybly26xxMIIB
+AgYJKoZIhvcNAQcBoIH0BIHxMIHuMIHrBgsqhkiG9w0BDAoBAqCBtDCBsTAcBgoqhkiG9w0BDAED
+MA4ECIY5YuEWTYZXAgIIAASBkHjpJld23eaPwqAy+7ounaCxOZAXk8GQYOV2ZHqFujvT/Y8xJn+Z
+gcd9Y9JQweTv3gS34UhNQn4KI9U2orhgjNT63RnvPWTgpjEhk2rNu44hH683bdPYdJjY+kXX2Ja4
+bTkRNxv3Ep+DG7fpLQ9K7IdM15pfCNYthWd7x9Bg39JxS2wR1MgTAVGjKdRb4SpfJzElMCMGCSqG
+SIb3DQEJFTEWBBRmA91gGRNrDYOP0k91wlUeJp9Q8zAxMCEwCQYFKw4DAhoFAAQUez7mu14r64M+
+WuBTsLlfNcU+bFwECBbE0S96xc86AgIIAA==`
+
+// pkcs12RC2128 uses RC2-128 for the certificate and Triple DES for the key.
+var pkcs12RC2128 = `
+MIIDEgIBAzCCAtgGCSqGSIb3DQEHAaCCAskEggLFMIICwTCCAbcGCSqGSIb3DQEHBqCCAagwggGk
+AgEAMIIBnQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQUwDgQIsr/L0edekxcCAggAgIIBcP34sA4e
+p8mycozOJFysffv2MwDiYw5yE9UTGwmmodDbfD+PbQ7C+YvgaNNrz40CONO8swawT4gGhGPvMrML
+DJphjmCQ28SIo4hPqHctyrQkxvWrZszD+NEeAMi3ghDv0/zLmSG/trC/rfIS8s+btyyjsNRAH3Ez
+hOc+3VadSqRavz8T7qppSRwHf1W3yeIeIRh/GaN4kH2NnfnRXybdehdtZJz8fjvfaCO0e+K2Xmfz
+komld5kG/BZCk1kzQZK4ohUHx5lVQ7haJEVQZ3I9A1wgEK8gZ35Px8t5DOVFWrflbzTV4igLyLsu
+qfDoJgWEyUH/7iHTEr3G9o8Fq94jkqCFxVgsso6qQOewhkOSybX7VJS9EoratVgMFZ+dDWi/dZ5Z
+rXKbrzbZDSdB73tl/4eKreEEMI62tIcEtB0r3Zut3YFwGjFcGJv99bLN5gJWvj8fQ9SZHbV3WSBo
+4Rawh4emeq3hZtNyH/gRU0uOZiyhMIIBAgYJKoZIhvcNAQcBoIH0BIHxMIHuMIHrBgsqhkiG9w0B
+DAoBAqCBtDCBsTAcBgoqhkiG9w0BDAEDMA4ECNsQWaz2FOKjAgIIAASBkBE5yqeL8RPUihDQV0R1
+i6pxQ88jk1xOu1/wyoDyiLeVZHknERFWHyDR6Rr/20s1sUHsgU5SqSUtehUWi0wyxewQajyLsnLx
+RzbFIKLXjAm+ry+xm60wHcC5ZqhiUhe0y+osFjRMMvCbxOiNw33Yp0SmPEk6ho7MucHPJP8kbZur
+RfUgl1H01bmLd6d7ikPPQDElMCMGCSqGSIb3DQEJFTEWBBRmA91gGRNrDYOP0k91wlUeJp9Q8zAx
+MCEwCQYFKw4DAhoFAAQUDJHyZ0dYTgMhO0BWeTt/15UFAHUECMkWw199LfA9AgIIAA==`
+
+// pkcs12EmptyPassword uses PBES2 with AES-128-CBC and DES-EDE3-CBC, and a
+// HMAC-SHA512 MAC, with an empty password.
+var pkcs12EmptyPassword = `
+MIIDswIBAzCCA0kGCSqGSIb3DQEHAaCCAzoEggM2MIIDMjCCAfIGCSqGSIb3DQEHBqCCAeMwggHf
+AgEAMIIB2AYJKoZIhvcNAQcBMFcGCSqGSIb3DQEFDTBKMCkGCSqGSIb3DQEFDDAcBAgF475MPg5e
+UgICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQMEAQIEEGpOJXgMEdkuBhJ0tcuy1FCAggFwYR9G
+Ze2eZM1b4L/YpqpADr496fbSH9ic3YcT36Eb7oABHpJFja7rH+s0dqoZ6ln9ZxjUBUiDeMXar4HX
+oWbaBR1d6GDG0aA9x1hMKuNOPYSnVfTyBm1wlH+798nCCBqyt/cSrzedc460ouPnTZ6vlLt5GC+s
+HNg8Qq9TEE+ISV7gRzeny3vWe0Aaidg0adselwAMdnrPGMBoAJOmhNDsRRPuNhoByHzKVxxRAFSc
+8LKIV5riSQisDDMR03X9fR/E5LhYxatvxNqEE/aWN3GZLf3aPOJixThmKNjZQTvbfIlYdX8Heae1
+PL8hR2LoVjkg/oXJQi3NdkDdZObgU2KJvRnp+WGvCdQUV3E7Li0H14HIF79pgTuw0nJNhJQd1uns
+y8VsnXlcyf9Zvb5zAm8wq5t8OXfGGzkDCK0x/bsub5gSVQ8ZWG991XrtArMq41/jfJz4W32tpNnB
+wdTCCW4ouWfgfDNwZomtkzEzIkeQYHcwggE4BgkqhkiG9w0BBwGgggEpBIIBJTCCASEwggEdBgsq
+hkiG9w0BDAoBAqCB5jCB4zBOBgkqhkiG9w0BBQ0wQTApBgkqhkiG9w0BBQwwHAQI2JhKJZ3JZgcC
+AggAMAwGCCqGSIb3DQIJBQAwFAYIKoZIhvcNAwcECP/ztcPsrRM/BIGQEMx/MSGFzFuG7SGgMZO8
+EVj4NMHfhNIfHDblOB2AGadiFZDjI9q5R5X9E/th43gGjWca27LVz/g2bZ58oPc1lSHZoTTd9mFe
+RRVqavP06fww1RrirJvFHXOT4kY4rf64D72RbhZsPqK5vVffpM7gBjNEirWma9iV1dTc03If32Wi
+f9nHPFLCY0z9fxGUkYj5MSUwIwYJKoZIhvcNAQkVMRYEFGYD3WAZE2sNg4/ST3XCVR4mn1DzMGEw
+UTANBglghkgBZQMEAgMFAARAHUiVBoICi/aP7gE3BeKI5NLHUM1oNzAHPIfFLSxH76yjydssFxYm
+GlgHYNAOOs9/RkUmAh0SE5aa4jnDPEzG9wQI7p863TNKdp8CAggA`
+
+func TestParsePKCS12(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		password string
+		caCerts  int
+	}{
+		{"PBES2", pkcs12Modern, "password", 1},
+		{"legacy", pkcs12Legacy, "password", 1},
+		{"RC2-128", pkcs12RC2128, "password", 0},
+		{"empty password", pkcs12EmptyPassword, "", 0},
+	}
+	var leafKey *ecdsa.PublicKey
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			der, err := base64.StdEncoding.DecodeString(test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			key, leaf, caCerts, err := ParsePKCS12(der, test.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			priv, ok := key.(*ecdsa.PrivateKey)
+			if !ok {
+				t.Fatalf("got key of type %T, want *ecdsa.PrivateKey", key)
+			}
+			if !priv.PublicKey.Equal(leaf.PublicKey) {
+				t.Error("key doesn't match the leaf certificate")
+			}
+			if leaf.Subject.CommonName != "leaf" {
+				t.Errorf("leaf CommonName = %q, want %q", leaf.Subject.CommonName, "leaf")
+			}
+			if leafKey == nil {
+				leafKey = &priv.PublicKey
+			} else if !leafKey.Equal(&priv.PublicKey) {
+				t.Error("files decoded to different keys")
+			}
+			if len(caCerts) != test.caCerts {
+				t.Fatalf("got %d CA certificates, want %d", len(caCerts), test.caCerts)
+			}
+			if test.caCerts > 0 {
+				if caCerts[0].Subject.CommonName != "PKCS12-CA" {
+					t.Errorf("CA CommonName = %q, want %q", caCerts[0].Subject.CommonName, "PKCS12-CA")
+				}
+				if err := leaf.CheckSignatureFrom(caCerts[0]); err != nil {
+					t.Errorf("leaf is not signed by the CA: %v", err)
+				}
+			}
+
+			if _, _, _, err := ParsePKCS12(der, "wrong"); err != IncorrectPasswordError {
+				t.Errorf("ParsePKCS12 with the wrong password: got %v, want IncorrectPasswordError", err)
+			}
+		})
+	}
+}
+
+func TestCreatePKCS12(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, caKey := newTestCA(t, "PKCS12-CA")
+
+	for _, key := range []crypto.Signer{rsaKey, ecKey, edKey} {
+		template := &Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      pkix.Name{CommonName: "leaf"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := CreateCertificate(rand.Reader, template, ca, key.Public(), caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, password := range []string{"", "password", "pässwörd 🔑"} {
+			pfx, err := CreatePKCS12(rand.Reader, key, leaf, []*Certificate{ca}, password)
+			if err != nil {
+				t.Fatalf("%T: %v", key, err)
+			}
+			gotKey, gotLeaf, gotCACerts, err := ParsePKCS12(pfx, password)
+			if err != nil {
+				t.Fatalf("%T, %q: %v", key, password, err)
+			}
+			if !gotKey.(interface{ Equal(crypto.PrivateKey) bool }).Equal(key) {
+				t.Errorf("%T: decoded a different key", key)
+			}
+			if !gotLeaf.Equal(leaf) {
+				t.Errorf("%T: decoded a different leaf", key)
+			}
+			if len(gotCACerts) != 1 || !gotCACerts[0].Equal(ca) {
+				t.Errorf("%T: decoded different CA certificates", key)
+			}
+			if _, _, _, err := ParsePKCS12(pfx, password+"x"); err != IncorrectPasswordError {
+				t.Errorf("%T: ParsePKCS12 with the wrong password: got %v, want IncorrectPasswordError", key, err)
+			}
+		}
+	}
+
+	if _, err := CreatePKCS12(rand.Reader, ecKey, ca, nil, "password"); err == nil {
+		t.Error("CreatePKCS12 accepted a key that doesn't match the certificate")
+	}
+	if _, err := CreatePKCS12(rand.Reader, ecKey, nil, nil, "password"); err == nil {
+		t.Error("CreatePKCS12 accepted a nil leaf")
+	}
+}
+
+func TestPKCS12KDF(t *testing.T) {
+	// Test vectors from golang.org/x/crypto/pkcs12, the second of which
+	// exercises a carry into the most significant byte of I_j.
+	password, err := bmpStringZeroTerminated("sesame")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := pkcs12KDF(crypto.SHA1, 1, password, bytes.Repeat([]byte{0xff}, 8), 2048, 24)
+	if want := "7cd9fd3e2b3be7691a44e3bef0f9ea0fb9b897d4e325d9d1"; hex.EncodeToString(key) != want {
+		t.Errorf("got %x, want %s", key, want)
+	}
+
+	salt, _ := hex.DecodeString("f37e05b518324b4b")
+	key = pkcs12KDF(crypto.SHA1, 1, []byte{0, 0}, salt, 2048, 24)
+	if want := "00f759ff47d14dd03665d5943cb3c4a39a2555c02aed66e1"; hex.EncodeToString(key) != want {
+		t.Errorf("got %x, want %s", key, want)
+	}
+}
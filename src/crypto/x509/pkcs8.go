@@ -8,6 +8,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/rsa"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -27,9 +28,9 @@ type pkcs8 struct {
 
 // ParsePKCS8PrivateKey parses an unencrypted private key in PKCS #8, ASN.1 DER form.
 //
-// It returns a *[rsa.PrivateKey], an *[ecdsa.PrivateKey], an [ed25519.PrivateKey] (not
-// a pointer), or an *[ecdh.PrivateKey] (for X25519). More types might be supported
-// in the future.
+// It returns a *[rsa.PrivateKey], an *[ecdsa.PrivateKey], an [ed25519.PrivateKey]
+// or [ed448.PrivateKey] (not pointers), or an *[ecdh.PrivateKey] (for X25519 and
+// X448). More types might be supported in the future.
 //
 // This kind of key is commonly encoded in PEM blocks of type "PRIVATE KEY".
 func ParsePKCS8PrivateKey(der []byte) (key any, err error) {
@@ -86,6 +87,29 @@ func ParsePKCS8PrivateKey(der []byte) (key any, err error) {
 		}
 		return ecdh.X25519().NewPrivateKey(curvePrivateKey)
 
+	case privKey.Algo.Algorithm.Equal(oidPublicKeyEd448):
+		if l := len(privKey.Algo.Parameters.FullBytes); l != 0 {
+			return nil, errors.New("x509: invalid Ed448 private key parameters")
+		}
+		var curvePrivateKey []byte
+		if _, err := asn1.Unmarshal(privKey.PrivateKey, &curvePrivateKey); err != nil {
+			return nil, fmt.Errorf("x509: invalid Ed448 private key: %v", err)
+		}
+		if l := len(curvePrivateKey); l != ed448.SeedSize {
+			return nil, fmt.Errorf("x509: invalid Ed448 private key length: %d", l)
+		}
+		return ed448.NewKeyFromSeed(curvePrivateKey), nil
+
+	case privKey.Algo.Algorithm.Equal(oidPublicKeyX448):
+		if l := len(privKey.Algo.Parameters.FullBytes); l != 0 {
+			return nil, errors.New("x509: invalid X448 private key parameters")
+		}
+		var curvePrivateKey []byte
+		if _, err := asn1.Unmarshal(privKey.PrivateKey, &curvePrivateKey); err != nil {
+			return nil, fmt.Errorf("x509: invalid X448 private key: %v", err)
+		}
+		return ecdh.X448().NewPrivateKey(curvePrivateKey)
+
 	default:
 		return nil, fmt.Errorf("x509: PKCS#8 wrapping contained private key with unknown algorithm: %v", privKey.Algo.Algorithm)
 	}
@@ -94,8 +118,8 @@ func ParsePKCS8PrivateKey(der []byte) (key any, err error) {
 // MarshalPKCS8PrivateKey converts a private key to PKCS #8, ASN.1 DER form.
 //
 // The following key types are currently supported: *[rsa.PrivateKey],
-// *[ecdsa.PrivateKey], [ed25519.PrivateKey] (not a pointer), and *[ecdh.PrivateKey].
-// Unsupported key types result in an error.
+// *[ecdsa.PrivateKey], [ed25519.PrivateKey] and [ed448.PrivateKey] (not pointers),
+// and *[ecdh.PrivateKey]. Unsupported key types result in an error.
 //
 // This kind of key is commonly encoded in PEM blocks of type "PRIVATE KEY".
 func MarshalPKCS8PrivateKey(key any) ([]byte, error) {
@@ -138,10 +162,21 @@ func MarshalPKCS8PrivateKey(key any) ([]byte, error) {
 		}
 		privKey.PrivateKey = curvePrivateKey
 
+	case ed448.PrivateKey:
+		privKey.Algo = pkix.AlgorithmIdentifier{
+			Algorithm: oidPublicKeyEd448,
+		}
+		curvePrivateKey, err := asn1.Marshal(k.Seed())
+		if err != nil {
+			return nil, fmt.Errorf("x509: failed to marshal private key: %v", err)
+		}
+		privKey.PrivateKey = curvePrivateKey
+
 	case *ecdh.PrivateKey:
-		if k.Curve() == ecdh.X25519() {
+		if c := k.Curve(); c == ecdh.X25519() || c == ecdh.X448() {
+			oid, _ := oidFromECDHCurve(c)
 			privKey.Algo = pkix.AlgorithmIdentifier{
-				Algorithm: oidPublicKeyX25519,
+				Algorithm: oid,
 			}
 			var err error
 			if privKey.PrivateKey, err = asn1.Marshal(k.Bytes()); err != nil {
@@ -9,6 +9,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/hex"
@@ -55,6 +56,16 @@ var pkcs8Ed25519PrivateKeyHex = `302e020100300506032b657004220420d4ee72dbf913584
 //	openssl genpkey -algorithm x25519
 var pkcs8X25519PrivateKeyHex = `302e020100300506032b656e0422042068ff93a73c5adefd6d498b24e588fd4daa10924d992afed01b43ca5725025a6b`
 
+// Generated using:
+//
+//	openssl genpkey -algorithm ed448
+var pkcs8Ed448PrivateKeyHex = `3047020100300506032b6571043b04399e0c5291def21eecddb4e163d8d70272cbecdc96e23579469a06dcf4c2a3c716bd38d0cfe024c9050f01c8bdb40669f23e07d7670d76ddd0c2`
+
+// Generated using:
+//
+//	openssl genpkey -algorithm x448
+var pkcs8X448PrivateKeyHex = `3046020100300506032b656f043a04384430d9452a3b1aa2421b03412619046e77b3347a8268b6bf93812f8951ce16d5cc80cbd9a2807b95c378f17facd0504a01d764b29daed7b8`
+
 func TestPKCS8(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -101,6 +112,16 @@ func TestPKCS8(t *testing.T) {
 			keyHex:  pkcs8X25519PrivateKeyHex,
 			keyType: reflect.TypeOf(&ecdh.PrivateKey{}),
 		},
+		{
+			name:    "Ed448 private key",
+			keyHex:  pkcs8Ed448PrivateKeyHex,
+			keyType: reflect.TypeOf(ed448.PrivateKey{}),
+		},
+		{
+			name:    "X448 private key",
+			keyHex:  pkcs8X448PrivateKeyHex,
+			keyType: reflect.TypeOf(&ecdh.PrivateKey{}),
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,306 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+)
+
+// RevocationOptions configures the revocation checks performed by
+// [Certificate.Verify] when set in [VerifyOptions.Revocation].
+//
+// Each certificate in a chain, except the root, is checked against the
+// stapled OCSP response (for the leaf), the cache, the provided CRLs, and
+// finally the OCSP responders and CRL distribution points listed in the
+// certificate, if Fetch is set. The first source that provides a current and
+// correctly signed status is used. Chains that contain a revoked certificate
+// are rejected.
+//
+// Certificates that list neither an OCSP responder nor a CRL distribution
+// point, and are not covered by OCSPStaple or CRLs, are not checked.
+type RevocationOptions struct {
+	// OCSPStaple is an optional DER encoded OCSP response about the leaf
+	// certificate, such as one stapled to a TLS handshake.
+	OCSPStaple []byte
+
+	// CRLs are optional revocation lists to check certificates against.
+	// Lists that are not signed by the certificate's issuer, or are not
+	// current, are ignored.
+	CRLs []*RevocationList
+
+	// Fetch, if not nil, is used to retrieve revocation information from
+	// the OCSP responders and CRL distribution points listed in the
+	// certificates. For OCSP, ocspRequest is the DER encoded request to POST
+	// to url with Content-Type application/ocsp-request, and Fetch must
+	// return the body of the response. For CRLs, ocspRequest is nil and Fetch
+	// must return the body of a GET request for url.
+	Fetch func(url string, ocspRequest []byte) ([]byte, error)
+
+	// Cache, if not nil, stores the OCSP responses and CRLs retrieved with
+	// Fetch until their NextUpdate time.
+	Cache *RevocationCache
+
+	// LeafOnly limits revocation checking to the leaf certificate.
+	LeafOnly bool
+
+	// SoftFail, if true, accepts certificates whose revocation status could
+	// not be determined, for example because the OCSP responder could not
+	// be reached. Otherwise, Verify returns a [CertificateInvalidError] with
+	// reason [RevocationStatusUnknown] for them. Revoked certificates are
+	// always rejected.
+	SoftFail bool
+}
+
+// RevocationCache is a cache of OCSP responses and CRLs, to be shared across
+// calls to [Certificate.Verify] through [RevocationOptions.Cache]. Entries
+// expire at the NextUpdate time of the response or list.
+//
+// The zero value is an empty cache ready to use. A RevocationCache is safe for
+// concurrent use by multiple goroutines.
+type RevocationCache struct {
+	mu   sync.Mutex
+	ocsp map[[32]byte]*OCSPResponse
+	crls map[string]*RevocationList
+}
+
+func ocspCacheKey(cert, issuer *Certificate) [32]byte {
+	h := sha256.New()
+	h.Write(cert.Raw)
+	h.Write(issuer.Raw)
+	var key [32]byte
+	h.Sum(key[:0])
+	return key
+}
+
+func (c *RevocationCache) getOCSP(cert, issuer *Certificate, now time.Time) *OCSPResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := ocspCacheKey(cert, issuer)
+	resp, ok := c.ocsp[key]
+	if ok && !now.Before(resp.NextUpdate) {
+		delete(c.ocsp, key)
+		return nil
+	}
+	return resp
+}
+
+func (c *RevocationCache) putOCSP(cert, issuer *Certificate, resp *OCSPResponse) {
+	if resp.NextUpdate.IsZero() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ocsp == nil {
+		c.ocsp = make(map[[32]byte]*OCSPResponse)
+	}
+	c.ocsp[ocspCacheKey(cert, issuer)] = resp
+}
+
+func (c *RevocationCache) getCRL(url string, now time.Time) *RevocationList {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	crl, ok := c.crls[url]
+	if ok && !now.Before(crl.NextUpdate) {
+		delete(c.crls, url)
+		return nil
+	}
+	return crl
+}
+
+func (c *RevocationCache) putCRL(url string, crl *RevocationList) {
+	if crl.NextUpdate.IsZero() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.crls == nil {
+		c.crls = make(map[string]*RevocationList)
+	}
+	c.crls[url] = crl
+}
+
+// checkChains returns the chains that don't contain revoked certificates or,
+// unless SoftFail is set, certificates with unknown revocation status. If no
+// chains are left, it returns the error for the first rejected chain.
+func (opts *RevocationOptions) checkChains(chains [][]*Certificate, now time.Time) ([][]*Certificate, error) {
+	type pair struct{ cert, issuer *Certificate }
+	results := make(map[pair]error)
+
+	var firstErr error
+	valid := chains[:0:0]
+	for _, chain := range chains {
+		var err error
+		for i := 0; i < len(chain)-1 && err == nil; i++ {
+			if opts.LeafOnly && i > 0 {
+				break
+			}
+			p := pair{chain[i], chain[i+1]}
+			result, ok := results[p]
+			if !ok {
+				result = opts.checkCertificate(p.cert, p.issuer, i == 0, now)
+				results[p] = result
+			}
+			err = result
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		valid = append(valid, chain)
+	}
+	if len(valid) == 0 {
+		return nil, firstErr
+	}
+	return valid, nil
+}
+
+// errRevocationUnknown is returned by the individual checks when they could
+// not determine the status of a certificate.
+var errRevocationUnknown = errors.New("x509: revocation status unknown")
+
+func (opts *RevocationOptions) checkCertificate(cert, issuer *Certificate, isLeaf bool, now time.Time) error {
+	if isLeaf && opts.OCSPStaple != nil {
+		resp, err := ParseOCSPResponse(opts.OCSPStaple, cert, issuer)
+		if err == nil && ocspResponseIsCurrent(resp, now) && resp.Status != OCSPUnknown {
+			return ocspStatusError(cert, resp)
+		}
+	}
+
+	if opts.Cache != nil {
+		if resp := opts.Cache.getOCSP(cert, issuer, now); resp != nil {
+			return ocspStatusError(cert, resp)
+		}
+	}
+
+	for _, crl := range opts.CRLs {
+		if crlIsUsable(crl, issuer, now) {
+			return crlStatusError(cert, crl)
+		}
+	}
+
+	if opts.Fetch != nil {
+		for _, url := range cert.OCSPServer {
+			if err := opts.fetchOCSP(url, cert, issuer, now); err != errRevocationUnknown {
+				return err
+			}
+		}
+		for _, url := range cert.CRLDistributionPoints {
+			if err := opts.fetchCRL(url, cert, issuer, now); err != errRevocationUnknown {
+				return err
+			}
+		}
+	}
+
+	if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+		return nil
+	}
+	if opts.SoftFail {
+		return nil
+	}
+	return CertificateInvalidError{cert, RevocationStatusUnknown, ""}
+}
+
+func (opts *RevocationOptions) fetchOCSP(url string, cert, issuer *Certificate, now time.Time) error {
+	req, err := NewOCSPRequest(cert, issuer, 0)
+	if err != nil {
+		return errRevocationUnknown
+	}
+	reqDER, err := req.Marshal()
+	if err != nil {
+		return errRevocationUnknown
+	}
+	der, err := opts.Fetch(url, reqDER)
+	if err != nil {
+		return errRevocationUnknown
+	}
+	resp, err := ParseOCSPResponse(der, cert, issuer)
+	if err != nil || !ocspResponseIsCurrent(resp, now) {
+		return errRevocationUnknown
+	}
+	if resp.Status == OCSPUnknown {
+		return errRevocationUnknown
+	}
+	if opts.Cache != nil {
+		opts.Cache.putOCSP(cert, issuer, resp)
+	}
+	return ocspStatusError(cert, resp)
+}
+
+func (opts *RevocationOptions) fetchCRL(url string, cert, issuer *Certificate, now time.Time) error {
+	if opts.Cache != nil {
+		if crl := opts.Cache.getCRL(url, now); crl != nil && crlIsUsable(crl, issuer, now) {
+			return crlStatusError(cert, crl)
+		}
+	}
+	der, err := opts.Fetch(url, nil)
+	if err != nil {
+		return errRevocationUnknown
+	}
+	crl, err := ParseRevocationList(der)
+	if err != nil || !crlIsUsable(crl, issuer, now) {
+		return errRevocationUnknown
+	}
+	if opts.Cache != nil {
+		opts.Cache.putCRL(url, crl)
+	}
+	return crlStatusError(cert, crl)
+}
+
+func ocspResponseIsCurrent(resp *OCSPResponse, now time.Time) bool {
+	if now.Before(resp.ThisUpdate) {
+		return false
+	}
+	if !resp.NextUpdate.IsZero() && !now.Before(resp.NextUpdate) {
+		return false
+	}
+	if resp.Certificate != nil && (now.Before(resp.Certificate.NotBefore) || now.After(resp.Certificate.NotAfter)) {
+		return false
+	}
+	return true
+}
+
+func ocspStatusError(cert *Certificate, resp *OCSPResponse) error {
+	switch resp.Status {
+	case OCSPGood:
+		return nil
+	case OCSPRevoked:
+		return CertificateInvalidError{cert, Revoked, "revoked at " + resp.RevokedAt.Format(time.RFC3339)}
+	default:
+		return errRevocationUnknown
+	}
+}
+
+// crlIsUsable reports whether crl is a current, complete CRL issued by issuer.
+func crlIsUsable(crl *RevocationList, issuer *Certificate, now time.Time) bool {
+	if !bytes.Equal(crl.RawIssuer, issuer.RawSubject) {
+		return false
+	}
+	if now.Before(crl.ThisUpdate) || !crl.NextUpdate.IsZero() && !now.Before(crl.NextUpdate) {
+		return false
+	}
+	// Critical extensions such as Issuing Distribution Point and Delta CRL
+	// Indicator restrict the scope of the list, which we don't support.
+	for _, ext := range crl.Extensions {
+		if ext.Critical {
+			return false
+		}
+	}
+	return crl.CheckSignatureFrom(issuer) == nil
+}
+
+func crlStatusError(cert *Certificate, crl *RevocationList) error {
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber != nil && entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return CertificateInvalidError{cert, Revoked, "revoked at " + entry.RevocationTime.Format(time.RFC3339)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	. "crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// revocationTestPKI is a root, an intermediate, and a local OCSP responder
+// and CRL server for the intermediate.
+type revocationTestPKI struct {
+	t            *testing.T
+	root         *Certificate
+	rootKey      crypto.Signer
+	intermediate *Certificate
+	key          crypto.Signer
+	server       *httptest.Server
+
+	revoked      map[int64]bool
+	ocspRequests atomic.Int32
+	crlRequests  atomic.Int32
+}
+
+func newRevocationTestPKI(t *testing.T) *revocationTestPKI {
+	pki := &revocationTestPKI{t: t, revoked: make(map[int64]bool)}
+	pki.server = httptest.NewServer(http.HandlerFunc(pki.serveHTTP))
+	t.Cleanup(pki.server.Close)
+
+	pki.root, pki.rootKey = NewTestCA(t, "Revocation Test Root")
+	pki.intermediate, pki.key = NewTestCert(t, &Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Revocation Test Intermediate"},
+		KeyUsage:              KeyUsageCertSign | KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, pki.root, pki.rootKey)
+	return pki
+}
+
+// leaf returns a new leaf certificate issued by the intermediate, pointing to
+// the test server for OCSP and/or CRLs.
+func (pki *revocationTestPKI) leaf(serial int64, ocsp, crl bool) *Certificate {
+	template := &Certificate{
+		SerialNumber: big.NewInt(serial),
+		DNSNames:     []string{"example.com"},
+		ExtKeyUsage:  []ExtKeyUsage{ExtKeyUsageServerAuth},
+	}
+	if ocsp {
+		template.OCSPServer = []string{pki.server.URL + "/ocsp"}
+	}
+	if crl {
+		template.CRLDistributionPoints = []string{pki.server.URL + "/crl"}
+	}
+	leaf, _ := NewTestCert(pki.t, template, pki.intermediate, pki.key)
+	return leaf
+}
+
+func (pki *revocationTestPKI) ocspResponse(serial *big.Int) []byte {
+	template := &OCSPResponse{
+		Status:       OCSPGood,
+		SerialNumber: serial,
+		ThisUpdate:   time.Now().Add(-time.Minute),
+		NextUpdate:   time.Now().Add(time.Hour),
+	}
+	if pki.revoked[serial.Int64()] {
+		template.Status = OCSPRevoked
+		template.RevokedAt = time.Now().Add(-time.Minute)
+	}
+	der, err := CreateOCSPResponse(rand.Reader, template, pki.intermediate, pki.key)
+	if err != nil {
+		pki.t.Error(err)
+	}
+	return der
+}
+
+func (pki *revocationTestPKI) crl() []byte {
+	template := &RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+	for serial := range pki.revoked {
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, RevocationListEntry{
+			SerialNumber:   big.NewInt(serial),
+			RevocationTime: time.Now().Add(-time.Minute),
+		})
+	}
+	der, err := CreateRevocationList(rand.Reader, template, pki.intermediate, pki.key)
+	if err != nil {
+		pki.t.Error(err)
+	}
+	return der
+}
+
+func (pki *revocationTestPKI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/ocsp" && r.Method == "POST":
+		pki.ocspRequests.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		req, err := ParseOCSPRequest(body)
+		if err != nil || !req.MatchesIssuer(pki.intermediate) {
+			der, _ := CreateOCSPErrorResponse(OCSPMalformedRequest)
+			w.Write(der)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(pki.ocspResponse(req.SerialNumber))
+	case r.URL.Path == "/crl" && r.Method == "GET":
+		pki.crlRequests.Add(1)
+		w.Write(pki.crl())
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func (pki *revocationTestPKI) fetch(url string, ocspRequest []byte) ([]byte, error) {
+	var resp *http.Response
+	var err error
+	if ocspRequest != nil {
+		resp, err = pki.server.Client().Post(url, "application/ocsp-request", bytes.NewReader(ocspRequest))
+	} else {
+		resp, err = pki.server.Client().Get(url)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (pki *revocationTestPKI) verify(leaf *Certificate, revocation *RevocationOptions) error {
+	roots := NewCertPool()
+	roots.AddCert(pki.root)
+	intermediates := NewCertPool()
+	intermediates.AddCert(pki.intermediate)
+	_, err := leaf.Verify(VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		Revocation:    revocation,
+	})
+	return err
+}
+
+func isInvalidReason(err error, reason InvalidReason) bool {
+	var invalidErr CertificateInvalidError
+	return errors.As(err, &invalidErr) && invalidErr.Reason == reason
+}
+
+func TestRevocationOCSP(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	good := pki.leaf(10, true, false)
+	revoked := pki.leaf(11, true, false)
+	pki.revoked[11] = true
+
+	opts := &RevocationOptions{Fetch: pki.fetch, LeafOnly: true}
+	if err := pki.verify(good, opts); err != nil {
+		t.Errorf("good certificate: %v", err)
+	}
+	if err := pki.verify(revoked, opts); !isInvalidReason(err, Revoked) {
+		t.Errorf("revoked certificate: got %v, want Revoked", err)
+	}
+	if err := pki.verify(revoked, nil); err != nil {
+		t.Errorf("revoked certificate without revocation checking: %v", err)
+	}
+	if n := pki.ocspRequests.Load(); n != 2 {
+		t.Errorf("got %d OCSP requests, want 2", n)
+	}
+}
+
+func TestRevocationCRL(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	good := pki.leaf(10, false, true)
+	revoked := pki.leaf(11, false, true)
+	pki.revoked[11] = true
+
+	opts := &RevocationOptions{Fetch: pki.fetch, LeafOnly: true}
+	if err := pki.verify(good, opts); err != nil {
+		t.Errorf("good certificate: %v", err)
+	}
+	if err := pki.verify(revoked, opts); !isInvalidReason(err, Revoked) {
+		t.Errorf("revoked certificate: got %v, want Revoked", err)
+	}
+
+	// Provided CRLs are used without fetching.
+	crl, err := ParseRevocationList(pki.crl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pki.crlRequests.Store(0)
+	opts = &RevocationOptions{CRLs: []*RevocationList{crl}, LeafOnly: true}
+	if err := pki.verify(good, opts); err != nil {
+		t.Errorf("good certificate with provided CRL: %v", err)
+	}
+	if err := pki.verify(revoked, opts); !isInvalidReason(err, Revoked) {
+		t.Errorf("revoked certificate with provided CRL: got %v, want Revoked", err)
+	}
+	if n := pki.crlRequests.Load(); n != 0 {
+		t.Errorf("got %d CRL requests, want 0", n)
+	}
+
+	// A CRL from a different issuer is ignored.
+	other := newRevocationTestPKI(t)
+	otherCRL, err := ParseRevocationList(other.crl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts = &RevocationOptions{CRLs: []*RevocationList{otherCRL}, LeafOnly: true}
+	if err := pki.verify(revoked, opts); !isInvalidReason(err, RevocationStatusUnknown) {
+		t.Errorf("revoked certificate with unrelated CRL: got %v, want RevocationStatusUnknown", err)
+	}
+}
+
+func TestRevocationStaple(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	leaf := pki.leaf(10, true, false)
+
+	// A stapled response is used instead of querying the responder.
+	pki.revoked[10] = true
+	staple := pki.ocspResponse(leaf.SerialNumber)
+	pki.revoked[10] = false
+	err := pki.verify(leaf, &RevocationOptions{OCSPStaple: staple, Fetch: pki.fetch, LeafOnly: true})
+	if !isInvalidReason(err, Revoked) {
+		t.Errorf("got %v, want Revoked", err)
+	}
+	if n := pki.ocspRequests.Load(); n != 0 {
+		t.Errorf("got %d OCSP requests, want 0", n)
+	}
+
+	// A staple for a different certificate is ignored.
+	other := pki.leaf(11, true, false)
+	err = pki.verify(other, &RevocationOptions{OCSPStaple: staple, Fetch: pki.fetch, LeafOnly: true})
+	if err != nil {
+		t.Errorf("unrelated staple: %v", err)
+	}
+	if n := pki.ocspRequests.Load(); n != 1 {
+		t.Errorf("got %d OCSP requests, want 1", n)
+	}
+}
+
+func TestRevocationCache(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	ocspLeaf := pki.leaf(10, true, false)
+	crlLeaf := pki.leaf(11, false, true)
+
+	opts := &RevocationOptions{Fetch: pki.fetch, Cache: new(RevocationCache), LeafOnly: true}
+	for range 3 {
+		if err := pki.verify(ocspLeaf, opts); err != nil {
+			t.Fatal(err)
+		}
+		if err := pki.verify(crlLeaf, opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := pki.ocspRequests.Load(); n != 1 {
+		t.Errorf("got %d OCSP requests, want 1", n)
+	}
+	if n := pki.crlRequests.Load(); n != 1 {
+		t.Errorf("got %d CRL requests, want 1", n)
+	}
+}
+
+func TestRevocationUnknown(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	leaf := pki.leaf(10, true, true)
+	pki.server.Close()
+
+	err := pki.verify(leaf, &RevocationOptions{Fetch: pki.fetch, LeafOnly: true})
+	if !isInvalidReason(err, RevocationStatusUnknown) {
+		t.Errorf("got %v, want RevocationStatusUnknown", err)
+	}
+	if err := pki.verify(leaf, &RevocationOptions{Fetch: pki.fetch, LeafOnly: true, SoftFail: true}); err != nil {
+		t.Errorf("soft fail: %v", err)
+	}
+
+	// Without any revocation information in the certificate, there is
+	// nothing to check.
+	if err := pki.verify(pki.leaf(11, false, false), &RevocationOptions{Fetch: pki.fetch}); err != nil {
+		t.Errorf("certificate without revocation information: %v", err)
+	}
+}
+
+func TestRevocationIntermediate(t *testing.T) {
+	pki := newRevocationTestPKI(t)
+	leaf := pki.leaf(10, true, false)
+
+	template := &RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []RevocationListEntry{{
+			SerialNumber:   pki.intermediate.SerialNumber,
+			RevocationTime: time.Now().Add(-time.Minute),
+		}},
+	}
+	der, err := CreateRevocationList(rand.Reader, template, pki.root, pki.rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCRL, err := ParseRevocationList(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &RevocationOptions{CRLs: []*RevocationList{rootCRL}, Fetch: pki.fetch}
+	if err := pki.verify(leaf, opts); !isInvalidReason(err, Revoked) {
+		t.Errorf("revoked intermediate: got %v, want Revoked", err)
+	}
+	opts.LeafOnly = true
+	if err := pki.verify(leaf, opts); err != nil {
+		t.Errorf("revoked intermediate with LeafOnly: %v", err)
+	}
+
+	// The same list signed by the intermediate doesn't apply to it.
+	der, err = CreateRevocationList(rand.Reader, template, pki.intermediate, pki.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selfCRL, err := ParseRevocationList(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pki.verify(leaf, &RevocationOptions{CRLs: []*RevocationList{selfCRL}, Fetch: pki.fetch}); err != nil {
+		t.Errorf("intermediate revoked by the wrong issuer: %v", err)
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	oidExtensionSCTList     = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+	oidExtensionOCSPSCTList = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 5}
+)
+
+// SignedCertificateTimestamp is a version 1 Certificate Transparency SCT, a
+// promise by a log to incorporate a certificate, as specified in RFC 6962,
+// Section 3.2.
+type SignedCertificateTimestamp struct {
+	// Raw contains the TLS encoding of the SCT.
+	Raw []byte
+
+	// LogID is the SHA-256 hash of the log's DER encoded public key.
+	LogID [32]byte
+	// Timestamp is the time the log issued the SCT, with millisecond
+	// precision.
+	Timestamp time.Time
+	// Extensions are the opaque CT extensions of the SCT.
+	Extensions []byte
+
+	// SignatureAlgorithm is the algorithm of Signature, either
+	// [ECDSAWithSHA256] or [SHA256WithRSA].
+	SignatureAlgorithm SignatureAlgorithm
+	Signature          []byte
+
+	// Precert reports whether the SCT was issued for the precertificate of
+	// the certificate, as is the case for SCTs embedded in it.
+	Precert bool
+}
+
+// ParseSignedCertificateTimestamp parses a single TLS encoded SCT.
+func ParseSignedCertificateTimestamp(b []byte) (*SignedCertificateTimestamp, error) {
+	s := cryptobyte.String(b)
+	sct := &SignedCertificateTimestamp{Raw: b}
+	var version, hash, sig uint8
+	var timestamp uint64
+	var logID []byte
+	if !s.ReadUint8(&version) {
+		return nil, errors.New("x509: malformed SCT")
+	}
+	if version != 0 {
+		return nil, errors.New("x509: unsupported SCT version " + strconv.Itoa(int(version)))
+	}
+	if !s.ReadBytes(&logID, 32) || !s.ReadUint64(&timestamp) ||
+		!s.ReadUint16LengthPrefixed((*cryptobyte.String)(&sct.Extensions)) ||
+		!s.ReadUint8(&hash) || !s.ReadUint8(&sig) ||
+		!s.ReadUint16LengthPrefixed((*cryptobyte.String)(&sct.Signature)) || !s.Empty() {
+		return nil, errors.New("x509: malformed SCT")
+	}
+	copy(sct.LogID[:], logID)
+	if timestamp > 1<<63-1 {
+		return nil, errors.New("x509: malformed SCT timestamp")
+	}
+	sct.Timestamp = time.UnixMilli(int64(timestamp))
+
+	// Only SHA-256 is allowed by RFC 6962, Section 2.1.4, with either ECDSA
+	// or RSA. These are the TLS 1.2 HashAlgorithm and SignatureAlgorithm code
+	// points.
+	switch {
+	case hash == 4 && sig == 3:
+		sct.SignatureAlgorithm = ECDSAWithSHA256
+	case hash == 4 && sig == 1:
+		sct.SignatureAlgorithm = SHA256WithRSA
+	default:
+		return nil, errors.New("x509: unsupported SCT signature algorithm")
+	}
+	return sct, nil
+}
+
+// ParseSignedCertificateTimestampList parses a TLS encoded
+// SignedCertificateTimestampList, as found in certificate and OCSP response
+// extensions.
+func ParseSignedCertificateTimestampList(b []byte) ([]*SignedCertificateTimestamp, error) {
+	s := cryptobyte.String(b)
+	var list cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&list) || !s.Empty() || list.Empty() {
+		return nil, errors.New("x509: malformed SCT list")
+	}
+	var scts []*SignedCertificateTimestamp
+	for !list.Empty() {
+		var raw cryptobyte.String
+		if !list.ReadUint16LengthPrefixed(&raw) || raw.Empty() {
+			return nil, errors.New("x509: malformed SCT list")
+		}
+		sct, err := ParseSignedCertificateTimestamp(raw)
+		if err != nil {
+			return nil, err
+		}
+		scts = append(scts, sct)
+	}
+	return scts, nil
+}
+
+// parseSCTListExtension parses an extension value containing an OCTET STRING
+// wrapping a SignedCertificateTimestampList.
+func parseSCTListExtension(value []byte) ([]*SignedCertificateTimestamp, error) {
+	var list []byte
+	if rest, err := asn1.Unmarshal(value, &list); err != nil || len(rest) > 0 {
+		return nil, errors.New("x509: malformed SCT list extension")
+	}
+	return ParseSignedCertificateTimestampList(list)
+}
+
+// SignedCertificateTimestamps parses the SCTs embedded in c, as specified in
+// RFC 6962, Section 3.3. It returns nil if c has no embedded SCTs.
+func (c *Certificate) SignedCertificateTimestamps() ([]*SignedCertificateTimestamp, error) {
+	for _, ext := range c.Extensions {
+		if !ext.Id.Equal(oidExtensionSCTList) {
+			continue
+		}
+		scts, err := parseSCTListExtension(ext.Value)
+		if err != nil {
+			return nil, err
+		}
+		for _, sct := range scts {
+			sct.Precert = true
+		}
+		return scts, nil
+	}
+	return nil, nil
+}
+
+// SignedCertificateTimestamps parses the SCTs in the extension of resp, as
+// specified in RFC 6962, Section 3.3. It returns nil if resp has no SCTs.
+func (resp *OCSPResponse) SignedCertificateTimestamps() ([]*SignedCertificateTimestamp, error) {
+	for _, ext := range resp.Extensions {
+		if ext.Id.Equal(oidExtensionOCSPSCTList) {
+			return parseSCTListExtension(ext.Value)
+		}
+	}
+	return nil, nil
+}
+
+// CTLog is a Certificate Transparency log trusted to issue SCTs.
+type CTLog struct {
+	// Description is a human readable name for the log.
+	Description string
+
+	// PublicKey is the log's public key, either an *ecdsa.PublicKey on P-256
+	// or an *rsa.PublicKey.
+	PublicKey crypto.PublicKey
+}
+
+// LogID returns the ID of the log, the SHA-256 hash of its DER encoded public
+// key, which appears in the SCTs it issues.
+func (log *CTLog) LogID() ([32]byte, error) {
+	der, err := MarshalPKIXPublicKey(log.PublicKey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(der), nil
+}
+
+// Verify checks that sct is a valid SCT from log for cert, issued by issuer.
+// If sct.Precert is false, the SCT must be about the certificate itself,
+// and issuer is not used.
+func (sct *SignedCertificateTimestamp) Verify(log *CTLog, cert, issuer *Certificate) error {
+	logID, err := log.LogID()
+	if err != nil {
+		return err
+	}
+	if logID != sct.LogID {
+		return errors.New("x509: SCT was not issued by the log")
+	}
+	signed, err := sct.signedData(cert, issuer)
+	if err != nil {
+		return err
+	}
+	if err := checkSignature(sct.SignatureAlgorithm, signed, sct.Signature, log.PublicKey, false); err != nil {
+		return errors.New("x509: invalid SCT signature: " + err.Error())
+	}
+	return nil
+}
+
+// signedData returns the digitally-signed struct of RFC 6962, Section 3.2.
+func (sct *SignedCertificateTimestamp) signedData(cert, issuer *Certificate) ([]byte, error) {
+	var b cryptobyte.Builder
+	b.AddUint8(0) // version v1
+	b.AddUint8(0) // signature_type certificate_timestamp
+	b.AddUint64(uint64(sct.Timestamp.UnixMilli()))
+	if sct.Precert {
+		if issuer == nil {
+			return nil, errors.New("x509: issuer is required to verify embedded SCTs")
+		}
+		tbs, err := precertTBSCertificate(cert.RawTBSCertificate)
+		if err != nil {
+			return nil, err
+		}
+		issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+		b.AddUint16(1) // entry_type precert_entry
+		b.AddBytes(issuerKeyHash[:])
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(tbs)
+		})
+	} else {
+		b.AddUint16(0) // entry_type x509_entry
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(cert.Raw)
+		})
+	}
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes(sct.Extensions)
+	})
+	return b.Bytes()
+}
+
+// precertTBSCertificate returns the TBSCertificate that the log signed for a
+// certificate with embedded SCTs: the final TBSCertificate with the SCT list
+// extension removed, as specified in RFC 6962, Section 3.2.
+func precertTBSCertificate(rawTBS []byte) ([]byte, error) {
+	errMalformed := errors.New("x509: malformed tbs certificate")
+	input := cryptobyte.String(rawTBS)
+	var tbs cryptobyte.String
+	if !input.ReadASN1(&tbs, cryptobyte_asn1.SEQUENCE) {
+		return nil, errMalformed
+	}
+	extensionsTag := cryptobyte_asn1.Tag(3).Constructed().ContextSpecific()
+
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for !tbs.Empty() {
+			var element cryptobyte.String
+			var tag cryptobyte_asn1.Tag
+			if !tbs.ReadAnyASN1Element(&element, &tag) {
+				b.SetError(errMalformed)
+				return
+			}
+			if tag != extensionsTag {
+				b.AddBytes(element)
+				continue
+			}
+
+			var extensions cryptobyte.String
+			if !element.ReadASN1(&element, extensionsTag) ||
+				!element.ReadASN1(&extensions, cryptobyte_asn1.SEQUENCE) {
+				b.SetError(errMalformed)
+				return
+			}
+			var kept [][]byte
+			for !extensions.Empty() {
+				var ext, extInner cryptobyte.String
+				var oid asn1.ObjectIdentifier
+				if !extensions.ReadASN1Element(&ext, cryptobyte_asn1.SEQUENCE) {
+					b.SetError(errMalformed)
+					return
+				}
+				extInner = ext
+				if !extInner.ReadASN1(&extInner, cryptobyte_asn1.SEQUENCE) ||
+					!extInner.ReadASN1ObjectIdentifier(&oid) {
+					b.SetError(errMalformed)
+					return
+				}
+				if !oid.Equal(oidExtensionSCTList) {
+					kept = append(kept, ext)
+				}
+			}
+			if len(kept) == 0 {
+				continue
+			}
+			b.AddASN1(extensionsTag, func(b *cryptobyte.Builder) {
+				b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					for _, ext := range kept {
+						b.AddBytes(ext)
+					}
+				})
+			})
+		}
+	})
+	return b.Bytes()
+}
+
+// CTPolicy is a Certificate Transparency policy, requiring certificates to be
+// accompanied by valid SCTs from a number of trusted logs.
+type CTPolicy struct {
+	// Logs are the trusted CT logs. SCTs from other logs are ignored.
+	Logs []*CTLog
+
+	// MinSCTs is the number of distinct logs that must have issued valid
+	// SCTs for the certificate. If zero, one is required.
+	MinSCTs int
+}
+
+// Check verifies the SCTs for cert, issued by issuer, and returns an error if
+// fewer than p.MinSCTs logs in p.Logs issued valid ones.
+//
+// SCTs are collected from the certificate itself, from tlsSCTs, the raw SCTs
+// delivered in the TLS extension (as in the SignedCertificateTimestamps field
+// of crypto/tls.ConnectionState), and from ocspResponse, if not nil. SCTs
+// with a timestamp after now are invalid.
+//
+// Check returns the valid SCTs, at most one per log.
+func (p *CTPolicy) Check(cert, issuer *Certificate, tlsSCTs [][]byte, ocspResponse *OCSPResponse, now time.Time) ([]*SignedCertificateTimestamp, error) {
+	var scts []*SignedCertificateTimestamp
+	if embedded, err := cert.SignedCertificateTimestamps(); err == nil {
+		scts = append(scts, embedded...)
+	}
+	for _, raw := range tlsSCTs {
+		if sct, err := ParseSignedCertificateTimestamp(raw); err == nil {
+			scts = append(scts, sct)
+		}
+	}
+	if ocspResponse != nil {
+		if fromOCSP, err := ocspResponse.SignedCertificateTimestamps(); err == nil {
+			scts = append(scts, fromOCSP...)
+		}
+	}
+
+	logs := make(map[[32]byte]*CTLog, len(p.Logs))
+	for _, log := range p.Logs {
+		id, err := log.LogID()
+		if err != nil {
+			return nil, err
+		}
+		logs[id] = log
+	}
+
+	var valid []*SignedCertificateTimestamp
+	seen := make(map[[32]byte]bool)
+	for _, sct := range scts {
+		log, ok := logs[sct.LogID]
+		if !ok || seen[sct.LogID] || sct.Timestamp.After(now) {
+			continue
+		}
+		if sct.Verify(log, cert, issuer) != nil {
+			continue
+		}
+		seen[sct.LogID] = true
+		valid = append(valid, sct)
+	}
+
+	required := p.MinSCTs
+	if required == 0 {
+		required = 1
+	}
+	if len(valid) < required {
+		return valid, errors.New("x509: certificate has valid SCTs from " + strconv.Itoa(len(valid)) +
+			" trusted logs, but policy requires " + strconv.Itoa(required))
+	}
+	return valid, nil
+}
@@ -0,0 +1,363 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+func TestParseEmbeddedSCTs(t *testing.T) {
+	leaf, err := certificateFromPEM(googleLeaf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scts, err := leaf.SignedCertificateTimestamps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Values from openssl x509 -ext ct_precert_scts.
+	want := []struct {
+		logID     string
+		timestamp time.Time
+	}{
+		{"7a328c54d8b72db620ea38e0521ee98416703213854d3bd22bc13a57a352eb52",
+			time.Date(2023, time.January, 2, 9, 19, 20, 101e6, time.UTC)},
+		{"e83ed0da3ef5063532e75728bc896bc903d3cbd1116beceb69e1777d6d06bd6e",
+			time.Date(2023, time.January, 2, 9, 19, 20, 52e6, time.UTC)},
+	}
+	if len(scts) != len(want) {
+		t.Fatalf("got %d SCTs, want %d", len(scts), len(want))
+	}
+	for i, sct := range scts {
+		if got := hex.EncodeToString(sct.LogID[:]); got != want[i].logID {
+			t.Errorf("SCT %d: LogID = %s, want %s", i, got, want[i].logID)
+		}
+		if !sct.Timestamp.Equal(want[i].timestamp) {
+			t.Errorf("SCT %d: Timestamp = %v, want %v", i, sct.Timestamp, want[i].timestamp)
+		}
+		if sct.SignatureAlgorithm != ECDSAWithSHA256 {
+			t.Errorf("SCT %d: SignatureAlgorithm = %v, want ECDSAWithSHA256", i, sct.SignatureAlgorithm)
+		}
+		if !sct.Precert {
+			t.Errorf("SCT %d: Precert is false", i)
+		}
+	}
+
+	tbs, err := precertTBSCertificate(leaf.RawTBSCertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	precert, err := parseTBSForTest(tbs)
+	if err != nil {
+		t.Fatalf("precert TBSCertificate doesn't parse: %v", err)
+	}
+	if len(precert.Extensions) != len(leaf.Extensions)-1 {
+		t.Errorf("precert has %d extensions, want %d", len(precert.Extensions), len(leaf.Extensions)-1)
+	}
+	for _, ext := range precert.Extensions {
+		if ext.Id.Equal(oidExtensionSCTList) {
+			t.Errorf("SCT extension was not removed")
+		}
+	}
+}
+
+// parseTBSForTest parses a TBSCertificate by wrapping it in a certificate with
+// a dummy signature.
+func parseTBSForTest(tbs []byte) (*Certificate, error) {
+	// The outer signature algorithm must match the one in the TBSCertificate.
+	input := cryptobyte.String(tbs)
+	var sigAlg cryptobyte.String
+	if !input.ReadASN1(&input, cryptobyte_asn1.SEQUENCE) ||
+		!input.SkipOptionalASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+		!input.SkipASN1(cryptobyte_asn1.INTEGER) ||
+		!input.ReadASN1Element(&sigAlg, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("malformed TBSCertificate")
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddBytes(tbs)
+		b.AddBytes(sigAlg)
+		b.AddASN1BitString([]byte{0})
+	})
+	der, err := b.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificate(der)
+}
+
+type testCTLog struct {
+	log *CTLog
+	key crypto.Signer
+}
+
+func newTestCTLog(t *testing.T, rsaKey bool) *testCTLog {
+	var key crypto.Signer
+	var err error
+	if rsaKey {
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	} else {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCTLog{&CTLog{Description: "test log", PublicKey: key.Public()}, key}
+}
+
+// sign returns a TLS encoded SCT from l for cert.
+func (l *testCTLog) sign(t *testing.T, cert, issuer *Certificate, precert bool, timestamp time.Time) []byte {
+	logID, err := l.log.LogID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sct := &SignedCertificateTimestamp{
+		LogID:     logID,
+		Timestamp: timestamp.Truncate(time.Millisecond),
+		Precert:   precert,
+	}
+	signed, err := sct.signedData(cert, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(signed)
+	sig, err := l.key.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigAlg := uint8(3)
+	if _, ok := l.key.(*rsa.PrivateKey); ok {
+		sigAlg = 1
+	}
+	var b cryptobyte.Builder
+	b.AddUint8(0)
+	b.AddBytes(logID[:])
+	b.AddUint64(uint64(sct.Timestamp.UnixMilli()))
+	b.AddUint16(0)
+	b.AddUint8(4)
+	b.AddUint8(sigAlg)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(sig) })
+	return b.BytesOrPanic()
+}
+
+func sctListExtension(t *testing.T, oid asn1.ObjectIdentifier, scts ...[]byte) pkix.Extension {
+	var b cryptobyte.Builder
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, sct := range scts {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(sct) })
+		}
+	})
+	value, err := asn1.Marshal(b.BytesOrPanic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkix.Extension{Id: oid, Value: value}
+}
+
+type sctTestPKI struct {
+	issuer    *Certificate
+	issuerKey crypto.Signer
+	template  *Certificate
+	leafKey   crypto.Signer
+	precert   *Certificate
+}
+
+func newSCTTestPKI(t *testing.T) *sctTestPKI {
+	issuer, issuerKey := newTestCA(t, "CT Test Issuer")
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour).Truncate(time.Second),
+		NotAfter:     time.Now().Add(time.Hour).Truncate(time.Second),
+		ExtKeyUsage:  []ExtKeyUsage{ExtKeyUsageServerAuth},
+	}
+	pki := &sctTestPKI{issuer: issuer, issuerKey: issuerKey, template: template, leafKey: leafKey}
+	pki.precert = pki.leaf(t)
+	return pki
+}
+
+// leaf returns a certificate issued from the template, with extra extensions.
+func (pki *sctTestPKI) leaf(t *testing.T, extensions ...pkix.Extension) *Certificate {
+	template := *pki.template
+	template.ExtraExtensions = extensions
+	der, err := CreateCertificate(rand.Reader, &template, pki.issuer, pki.leafKey.Public(), pki.issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestPrecertTBSCertificate(t *testing.T) {
+	pki := newSCTTestPKI(t)
+	ext := sctListExtension(t, oidExtensionSCTList, []byte("not really an SCT"))
+	cert := pki.leaf(t, ext)
+	tbs, err := precertTBSCertificate(cert.RawTBSCertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tbs, pki.precert.RawTBSCertificate) {
+		t.Errorf("precert TBSCertificate doesn't match the certificate issued without SCTs")
+	}
+}
+
+func TestSCTVerify(t *testing.T) {
+	pki := newSCTTestPKI(t)
+	for _, rsaLog := range []bool{false, true} {
+		log := newTestCTLog(t, rsaLog)
+		other := newTestCTLog(t, false)
+
+		embedded := log.sign(t, pki.precert, pki.issuer, true, time.Now())
+		cert := pki.leaf(t, sctListExtension(t, oidExtensionSCTList, embedded))
+		scts, err := cert.SignedCertificateTimestamps()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scts) != 1 {
+			t.Fatalf("got %d embedded SCTs, want 1", len(scts))
+		}
+		if err := scts[0].Verify(log.log, cert, pki.issuer); err != nil {
+			t.Errorf("embedded SCT: %v", err)
+		}
+		if err := scts[0].Verify(other.log, cert, pki.issuer); err == nil {
+			t.Errorf("embedded SCT verified with the wrong log")
+		}
+		if err := scts[0].Verify(log.log, pki.precert, pki.issuer); err != nil {
+			t.Errorf("embedded SCT doesn't verify against the precertificate: %v", err)
+		}
+		if err := scts[0].Verify(log.log, cert, cert); err == nil {
+			t.Errorf("embedded SCT verified with the wrong issuer")
+		}
+
+		sct, err := ParseSignedCertificateTimestamp(log.sign(t, cert, nil, false, time.Now()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sct.Verify(log.log, cert, nil); err != nil {
+			t.Errorf("TLS SCT: %v", err)
+		}
+		if err := sct.Verify(log.log, pki.precert, nil); err == nil {
+			t.Errorf("TLS SCT verified for a different certificate")
+		}
+		sct.Timestamp = sct.Timestamp.Add(time.Millisecond)
+		if err := sct.Verify(log.log, cert, nil); err == nil {
+			t.Errorf("TLS SCT verified with a modified timestamp")
+		}
+	}
+}
+
+func TestParseSCTErrors(t *testing.T) {
+	log := newTestCTLog(t, false)
+	pki := newSCTTestPKI(t)
+	good := log.sign(t, pki.precert, nil, false, time.Now())
+	if _, err := ParseSignedCertificateTimestamp(good); err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range map[string][]byte{
+		"empty":        {},
+		"version 2":    append([]byte{1}, good[1:]...),
+		"truncated":    good[:len(good)-1],
+		"trailing":     append(bytes.Clone(good), 0),
+		"SHA-1":        append(append(bytes.Clone(good[:43]), 2), good[44:]...),
+		"no signature": good[:43],
+	} {
+		if _, err := ParseSignedCertificateTimestamp(b); err == nil {
+			t.Errorf("%s: parsing succeeded", name)
+		}
+	}
+	for name, b := range map[string][]byte{
+		"empty list":  {0, 0},
+		"empty SCT":   {0, 2, 0, 0},
+		"bad length":  {0, 5, 0, 1},
+		"no prefix":   good,
+		"nil":         nil,
+		"trailing":    {0, 0, 0},
+		"invalid SCT": {0, 3, 0, 1, 1},
+	} {
+		if _, err := ParseSignedCertificateTimestampList(b); err == nil {
+			t.Errorf("%s: list parsing succeeded", name)
+		}
+	}
+}
+
+func TestCTPolicy(t *testing.T) {
+	pki := newSCTTestPKI(t)
+	logA, logB, logC := newTestCTLog(t, false), newTestCTLog(t, true), newTestCTLog(t, false)
+	untrusted := newTestCTLog(t, false)
+
+	now := time.Now()
+	cert := pki.leaf(t, sctListExtension(t, oidExtensionSCTList,
+		logA.sign(t, pki.precert, pki.issuer, true, now),
+		untrusted.sign(t, pki.precert, pki.issuer, true, now)))
+	tlsSCTs := [][]byte{
+		logA.sign(t, cert, nil, false, now),
+		logB.sign(t, cert, nil, false, now),
+		[]byte("garbage"),
+	}
+	futureSCT := [][]byte{logB.sign(t, cert, nil, false, now.Add(time.Hour))}
+
+	ocspDER, err := CreateOCSPResponse(rand.Reader, &OCSPResponse{
+		SerialNumber:    cert.SerialNumber,
+		ThisUpdate:      now,
+		ExtraExtensions: []pkix.Extension{sctListExtension(t, oidExtensionOCSPSCTList, logC.sign(t, cert, nil, false, now))},
+	}, pki.issuer, pki.issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocspResp, err := ParseOCSPResponse(ocspDER, cert, pki.issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &CTPolicy{Logs: []*CTLog{logA.log, logB.log, logC.log}}
+	for _, tt := range []struct {
+		name      string
+		min       int
+		tlsSCTs   [][]byte
+		ocsp      *OCSPResponse
+		wantValid int
+		wantErr   bool
+	}{
+		{"embedded", 0, nil, nil, 1, false},
+		{"embedded, two required", 2, nil, nil, 1, true},
+		{"embedded and TLS", 2, tlsSCTs, nil, 2, false},
+		{"embedded and TLS, three required", 3, tlsSCTs, nil, 2, true},
+		{"all sources", 3, tlsSCTs, ocspResp, 3, false},
+		{"future timestamp", 2, futureSCT, nil, 1, true},
+	} {
+		policy.MinSCTs = tt.min
+		valid, err := policy.Check(cert, pki.issuer, tt.tlsSCTs, tt.ocsp, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want error: %v", tt.name, err, tt.wantErr)
+		}
+		if len(valid) != tt.wantValid {
+			t.Errorf("%s: got %d valid SCTs, want %d", tt.name, len(valid), tt.wantValid)
+		}
+	}
+}
@@ -56,6 +56,13 @@ const (
 	// CANotAuthorizedForExtKeyUsage results when an intermediate or root
 	// certificate does not permit a requested extended key usage.
 	CANotAuthorizedForExtKeyUsage
+	// Revoked results when a certificate was revoked by its issuer, according
+	// to an OCSP response or CRL checked through VerifyOptions.Revocation.
+	Revoked
+	// RevocationStatusUnknown results when the revocation status of a
+	// certificate could not be determined with VerifyOptions.Revocation, and
+	// RevocationOptions.SoftFail is not set.
+	RevocationStatusUnknown
 )
 
 // CertificateInvalidError results when an odd error occurs. Users of this
@@ -86,6 +93,10 @@ func (e CertificateInvalidError) Error() string {
 		return "x509: issuer has name constraints but leaf doesn't have a SAN extension"
 	case UnconstrainedName:
 		return "x509: issuer has name constraints but leaf contains unknown or unconstrained name: " + e.Detail
+	case Revoked:
+		return "x509: certificate has been revoked: " + e.Detail
+	case RevocationStatusUnknown:
+		return "x509: certificate revocation status could not be determined"
 	}
 	return "x509: unknown error"
 }
@@ -201,6 +212,11 @@ type VerifyOptions struct {
 	// certificates from consuming excessive amounts of CPU time when
 	// validating. It does not apply to the platform verifier.
 	MaxConstraintComparisions int
+
+	// Revocation, if not nil, enables checking the revocation status of the
+	// certificates in the verified chains with OCSP and CRLs. It's applied to
+	// the chains returned by the platform verifier as well.
+	Revocation *RevocationOptions
 }
 
 const (
@@ -756,6 +772,17 @@ func (c *Certificate) Verify(opts VerifyOptions) (chains [][]*Certificate, err e
 	if len(c.Raw) == 0 {
 		return nil, errNotParsed
 	}
+	if opts.Revocation != nil {
+		defer func() {
+			if err == nil {
+				now := opts.CurrentTime
+				if now.IsZero() {
+					now = time.Now()
+				}
+				chains, err = opts.Revocation.checkChains(chains, now)
+			}
+		}()
+	}
 	for i := 0; i < opts.Intermediates.len(); i++ {
 		c, _, err := opts.Intermediates.cert(i)
 		if err != nil {
@@ -26,9 +26,12 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
+	"crypto/internal/fips140only"
 	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/pem"
@@ -64,8 +67,9 @@ type pkixPublicKey struct {
 // public key is a SubjectPublicKeyInfo structure (see RFC 5280, Section 4.1).
 //
 // It returns a *[rsa.PublicKey], *[dsa.PublicKey], *[ecdsa.PublicKey],
-// [ed25519.PublicKey] (not a pointer), or *[ecdh.PublicKey] (for X25519).
-// More types might be supported in the future.
+// [ed25519.PublicKey] or [ed448.PublicKey] (not pointers), or
+// *[ecdh.PublicKey] (for X25519 and X448). More types might be supported in
+// the future.
 //
 // This kind of key is commonly encoded in PEM blocks of type "PUBLIC KEY".
 func ParsePKIXPublicKey(derBytes []byte) (pub any, err error) {
@@ -114,11 +118,17 @@ func marshalPublicKey(pub any) (publicKeyBytes []byte, publicKeyAlgorithm pkix.A
 	case ed25519.PublicKey:
 		publicKeyBytes = pub
 		publicKeyAlgorithm.Algorithm = oidPublicKeyEd25519
+	case ed448.PublicKey:
+		publicKeyBytes = pub
+		publicKeyAlgorithm.Algorithm = oidPublicKeyEd448
 	case *ecdh.PublicKey:
 		publicKeyBytes = pub.Bytes()
-		if pub.Curve() == ecdh.X25519() {
+		switch pub.Curve() {
+		case ecdh.X25519():
 			publicKeyAlgorithm.Algorithm = oidPublicKeyX25519
-		} else {
+		case ecdh.X448():
+			publicKeyAlgorithm.Algorithm = oidPublicKeyX448
+		default:
 			oid, ok := oidFromECDHCurve(pub.Curve())
 			if !ok {
 				return nil, pkix.AlgorithmIdentifier{}, errors.New("x509: unsupported elliptic curve")
@@ -143,8 +153,8 @@ func marshalPublicKey(pub any) (publicKeyBytes []byte, publicKeyAlgorithm pkix.A
 // (see RFC 5280, Section 4.1).
 //
 // The following key types are currently supported: *[rsa.PublicKey],
-// *[ecdsa.PublicKey], [ed25519.PublicKey] (not a pointer), and *[ecdh.PublicKey].
-// Unsupported key types result in an error.
+// *[ecdsa.PublicKey], [ed25519.PublicKey] and [ed448.PublicKey] (not
+// pointers), and *[ecdh.PublicKey]. Unsupported key types result in an error.
 //
 // This kind of key is commonly encoded in PEM blocks of type "PUBLIC KEY".
 func MarshalPKIXPublicKey(pub any) ([]byte, error) {
@@ -230,6 +240,7 @@ const (
 	SHA384WithRSAPSS
 	SHA512WithRSAPSS
 	PureEd25519
+	PureEd448
 )
 
 func (algo SignatureAlgorithm) isRSAPSS() bool {
@@ -267,6 +278,7 @@ const (
 	DSA // Only supported for parsing.
 	ECDSA
 	Ed25519
+	Ed448
 )
 
 var publicKeyAlgoName = [...]string{
@@ -274,6 +286,7 @@ var publicKeyAlgoName = [...]string{
 	DSA:     "DSA",
 	ECDSA:   "ECDSA",
 	Ed25519: "Ed25519",
+	Ed448:   "Ed448",
 }
 
 func (algo PublicKeyAlgorithm) String() string {
@@ -331,6 +344,7 @@ func (algo PublicKeyAlgorithm) String() string {
 // RFC 8410 3 Curve25519 and Curve448 Algorithm Identifiers
 //
 //	id-Ed25519   OBJECT IDENTIFIER ::= { 1 3 101 112 }
+//	id-Ed448     OBJECT IDENTIFIER ::= { 1 3 101 113 }
 var (
 	oidSignatureMD5WithRSA      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 4}
 	oidSignatureSHA1WithRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 5}
@@ -345,6 +359,7 @@ var (
 	oidSignatureECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
 	oidSignatureECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
 	oidSignatureEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidSignatureEd448           = asn1.ObjectIdentifier{1, 3, 101, 113}
 
 	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
 	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
@@ -383,6 +398,7 @@ var signatureAlgorithmDetails = []struct {
 	{ECDSAWithSHA384, "ECDSA-SHA384", oidSignatureECDSAWithSHA384, emptyRawValue, ECDSA, crypto.SHA384, false},
 	{ECDSAWithSHA512, "ECDSA-SHA512", oidSignatureECDSAWithSHA512, emptyRawValue, ECDSA, crypto.SHA512, false},
 	{PureEd25519, "Ed25519", oidSignatureEd25519, emptyRawValue, Ed25519, crypto.Hash(0) /* no pre-hashing */, false},
+	{PureEd448, "Ed448", oidSignatureEd448, emptyRawValue, Ed448, crypto.Hash(0) /* no pre-hashing */, false},
 }
 
 var emptyRawValue = asn1.RawValue{}
@@ -413,7 +429,7 @@ type pssParameters struct {
 }
 
 func getSignatureAlgorithmFromAI(ai pkix.AlgorithmIdentifier) SignatureAlgorithm {
-	if ai.Algorithm.Equal(oidSignatureEd25519) {
+	if ai.Algorithm.Equal(oidSignatureEd25519) || ai.Algorithm.Equal(oidSignatureEd448) {
 		// RFC 8410, Section 3
 		// > For all of the OIDs, the parameters MUST be absent.
 		if len(ai.Parameters.FullBytes) != 0 {
@@ -488,9 +504,13 @@ var (
 	// RFC 8410, Section 3
 	//
 	//	id-X25519    OBJECT IDENTIFIER ::= { 1 3 101 110 }
+	//	id-X448      OBJECT IDENTIFIER ::= { 1 3 101 111 }
 	//	id-Ed25519   OBJECT IDENTIFIER ::= { 1 3 101 112 }
+	//	id-Ed448     OBJECT IDENTIFIER ::= { 1 3 101 113 }
 	oidPublicKeyX25519  = asn1.ObjectIdentifier{1, 3, 101, 110}
+	oidPublicKeyX448    = asn1.ObjectIdentifier{1, 3, 101, 111}
 	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidPublicKeyEd448   = asn1.ObjectIdentifier{1, 3, 101, 113}
 )
 
 // getPublicKeyAlgorithmFromOID returns the exposed PublicKeyAlgorithm
@@ -506,6 +526,8 @@ func getPublicKeyAlgorithmFromOID(oid asn1.ObjectIdentifier) PublicKeyAlgorithm
 		return ECDSA
 	case oid.Equal(oidPublicKeyEd25519):
 		return Ed25519
+	case oid.Equal(oidPublicKeyEd448):
+		return Ed448
 	}
 	return UnknownPublicKeyAlgorithm
 }
@@ -566,6 +588,8 @@ func oidFromECDHCurve(curve ecdh.Curve) (asn1.ObjectIdentifier, bool) {
 	switch curve {
 	case ecdh.X25519():
 		return oidPublicKeyX25519, true
+	case ecdh.X448():
+		return oidPublicKeyX448, true
 	case ecdh.P256():
 		return oidNamedCurveP256, true
 	case ecdh.P384():
@@ -905,7 +929,7 @@ func checkSignature(algo SignatureAlgorithm, signed, signature []byte, publicKey
 
 	switch hashType {
 	case crypto.Hash(0):
-		if pubKeyAlgo != Ed25519 {
+		if pubKeyAlgo != Ed25519 && pubKeyAlgo != Ed448 {
 			return ErrUnsupportedAlgorithm
 		}
 	case crypto.MD5:
@@ -954,6 +978,14 @@ func checkSignature(algo SignatureAlgorithm, signed, signature []byte, publicKey
 			return errors.New("x509: Ed25519 verification failure")
 		}
 		return
+	case ed448.PublicKey:
+		if pubKeyAlgo != Ed448 {
+			return signaturePublicKeyAlgoMismatchError(pubKeyAlgo, pub)
+		}
+		if !ed448.Verify(pub, signed, signature) {
+			return errors.New("x509: Ed448 verification failure")
+		}
+		return
 	}
 	return ErrUnsupportedAlgorithm
 }
@@ -1475,8 +1507,12 @@ func signingParamsForKey(key crypto.Signer, sigAlgo SignatureAlgorithm) (Signatu
 		pubType = Ed25519
 		defaultAlgo = PureEd25519
 
+	case ed448.PublicKey:
+		pubType = Ed448
+		defaultAlgo = PureEd448
+
 	default:
-		return 0, ai, errors.New("x509: only RSA, ECDSA and Ed25519 keys supported")
+		return 0, ai, errors.New("x509: only RSA, ECDSA, Ed25519 and Ed448 keys supported")
 	}
 
 	if sigAlgo == 0 {
@@ -1579,9 +1615,9 @@ var emptyASN1Subject = []byte{0x30, 0}
 //
 // The returned slice is the certificate in DER encoding.
 //
-// The currently supported key types are *rsa.PublicKey, *ecdsa.PublicKey and
-// ed25519.PublicKey. pub must be a supported key type, and priv must be a
-// crypto.Signer with a supported public key.
+// The currently supported key types are *rsa.PublicKey, *ecdsa.PublicKey,
+// ed25519.PublicKey and ed448.PublicKey. pub must be a supported key type, and
+// priv must be a crypto.Signer with a supported public key.
 //
 // The AuthorityKeyId will be taken from the SubjectKeyId of parent, if any,
 // unless the resulting certificate is self-signed. Otherwise the value from
@@ -1653,8 +1689,16 @@ func CreateCertificate(rand io.Reader, template, parent *Certificate, pub, priv
 		//   (1) The keyIdentifier is composed of the 160-bit SHA-1 hash of the
 		//   value of the BIT STRING subjectPublicKey (excluding the tag,
 		//   length, and number of unused bits).
-		h := sha1.Sum(publicKeyBytes)
-		subjectKeyId = h[:]
+		//
+		// SHA-1 is not allowed in FIPS 140-only mode, so the leftmost 160
+		// bits of the SHA-256 hash are used instead, per RFC 7093, Section 2.
+		if fips140only.Enabled {
+			h := sha256.Sum256(publicKeyBytes)
+			subjectKeyId = h[:20]
+		} else {
+			h := sha1.Sum(publicKeyBytes)
+			subjectKeyId = h[:]
+		}
 	}
 
 	// Check that the signer's public key matches the private key, if available.
@@ -1951,9 +1995,9 @@ func parseCSRExtensions(rawAttributes []asn1.RawValue) ([]pkix.Extension, error)
 //
 // priv is the private key to sign the CSR with, and the corresponding public
 // key will be included in the CSR. It must implement crypto.Signer and its
-// Public() method must return a *rsa.PublicKey or a *ecdsa.PublicKey or a
-// ed25519.PublicKey. (A *rsa.PrivateKey, *ecdsa.PrivateKey or
-// ed25519.PrivateKey satisfies this.)
+// Public() method must return a *rsa.PublicKey, a *ecdsa.PublicKey, a
+// ed25519.PublicKey or a ed448.PublicKey. (A *rsa.PrivateKey,
+// *ecdsa.PrivateKey, ed25519.PrivateKey or ed448.PrivateKey satisfies this.)
 //
 // The returned slice is the certificate request in DER encoding.
 func CreateCertificateRequest(rand io.Reader, template *CertificateRequest, priv any) (csr []byte, err error) {
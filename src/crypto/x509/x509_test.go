@@ -11,6 +11,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -126,6 +127,20 @@ func TestParsePKIXPublicKey(t *testing.T) {
 			t.Errorf("Value returned from ParsePKIXPublicKey was not an X25519 public key")
 		}
 	})
+	t.Run("Ed448", func(t *testing.T) {
+		pub := testParsePKIXPublicKey(t, pemEd448Key)
+		_, ok := pub.(ed448.PublicKey)
+		if !ok {
+			t.Errorf("Value returned from ParsePKIXPublicKey was not an Ed448 public key")
+		}
+	})
+	t.Run("X448", func(t *testing.T) {
+		pub := testParsePKIXPublicKey(t, pemX448Key)
+		k, ok := pub.(*ecdh.PublicKey)
+		if !ok || k.Curve() != ecdh.X448() {
+			t.Errorf("Value returned from ParsePKIXPublicKey was not an X448 public key")
+		}
+	})
 }
 
 var pemPublicKey = `-----BEGIN PUBLIC KEY-----
@@ -171,6 +186,22 @@ MCowBQYDK2VuAyEA5yGXrH/6OzxuWEhEWS01/f4OP+Of3Yrddy6/J1kDTVM=
 -----END PUBLIC KEY-----
 `
 
+// pemEd448Key was generated from pkcs8Ed448PrivateKeyHex with "openssl pkey -pubout".
+var pemEd448Key = `
+-----BEGIN PUBLIC KEY-----
+MEMwBQYDK2VxAzoAGEvTi7dOIsMSVLeXGuOvfVgDAJgutaIOjUgc85ukXCmlf89/
+stQARs9UMZ5eeCBO3cb2/G+782GA
+-----END PUBLIC KEY-----
+`
+
+// pemX448Key was generated from pkcs8X448PrivateKeyHex with "openssl pkey -pubout".
+var pemX448Key = `
+-----BEGIN PUBLIC KEY-----
+MEIwBQYDK2VvAzkA1Y1852mhQe7Nycz7onC41BIhkb2YCeZS51TtNtLIZwicjazj
+Vf9khDcNYVcDWdUyFBjYyuf0vrA=
+-----END PUBLIC KEY-----
+`
+
 func TestPKIXMismatchPublicKeyFormat(t *testing.T) {
 
 	const pkcs1PublicKey = "308201080282010100817cfed98bcaa2e2a57087451c7674e0c675686dc33ff1268b0c2a6ee0202dec710858ee1c31bdf5e7783582e8ca800be45f3275c6576adc35d98e26e95bb88ca5beb186f853b8745d88bc9102c5f38753bcda519fb05948d5c77ac429255ff8aaf27d9f45d1586e95e2e9ba8a7cb771b8a09dd8c8fed3f933fd9b439bc9f30c475953418ef25f71a2b6496f53d94d39ce850aa0cc75d445b5f5b4f4ee4db78ab197a9a8d8a852f44529a007ac0ac23d895928d60ba538b16b0b087a7f903ed29770e215019b77eaecc360f35f7ab11b6d735978795b2c4a74e5bdea4dc6594cd67ed752a108e666729a753ab36d6c4f606f8760f507e1765be8cd744007e629020103"
@@ -611,6 +642,11 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		t.Fatalf("Failed to generate Ed25519 key: %s", err)
 	}
 
+	ed448Pub, ed448Priv, err := ed448.GenerateKey(random)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed448 key: %s", err)
+	}
+
 	tests := []struct {
 		name      string
 		pub, priv any
@@ -625,6 +661,8 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		{"ECDSA/RSAPSS", &ecdsaPriv.PublicKey, testPrivateKey, false, SHA256WithRSAPSS},
 		{"RSAPSS/ECDSA", &testPrivateKey.PublicKey, ecdsaPriv, false, ECDSAWithSHA384},
 		{"Ed25519", ed25519Pub, ed25519Priv, true, PureEd25519},
+		{"Ed448", ed448Pub, ed448Priv, true, PureEd448},
+		{"Ed448/ECDSA", ed448Pub, ecdsaPriv, false, ECDSAWithSHA256},
 	}
 
 	testExtKeyUsage := []ExtKeyUsage{ExtKeyUsageClientAuth, ExtKeyUsageServerAuth}
@@ -1414,6 +1452,11 @@ func TestCreateCertificateRequest(t *testing.T) {
 		t.Fatalf("Failed to generate Ed25519 key: %s", err)
 	}
 
+	_, ed448Priv, err := ed448.GenerateKey(random)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed448 key: %s", err)
+	}
+
 	tests := []struct {
 		name    string
 		priv    any
@@ -1425,6 +1468,7 @@ func TestCreateCertificateRequest(t *testing.T) {
 		{"ECDSA-384", ecdsa384Priv, ECDSAWithSHA256},
 		{"ECDSA-521", ecdsa521Priv, ECDSAWithSHA256},
 		{"Ed25519", ed25519Priv, PureEd25519},
+		{"Ed448", ed448Priv, PureEd448},
 	}
 
 	for _, test := range tests {
@@ -208,6 +208,9 @@ var depsRules = `
 	OS
 	< golang.org/x/sys/cpu;
 
+	OS
+	< internal/extract;
+
 	# FMT is OS (which includes string routines) plus reflect and fmt.
 	# It does not include package log, which should be avoided in core packages.
 	arena, strconv, unicode
@@ -266,8 +269,8 @@ var depsRules = `
 
 	# compression
 	FMT, encoding/binary, hash/adler32, hash/crc32, sort
-	< compress/bzip2, compress/flate, compress/lzw, internal/zstd
-	< archive/zip, compress/gzip, compress/zlib;
+	< compress/brotli, compress/bzip2, compress/flate, compress/lzw, internal/zstd
+	< compress/gzip, compress/zlib, compress/zstd;
 
 	# templates
 	FMT
@@ -377,7 +380,9 @@ var depsRules = `
 	< plugin;
 
 	CGO, FMT
-	< os/user
+	< os/user;
+
+	os/user, internal/extract
 	< archive/tar;
 
 	sync
@@ -445,16 +450,48 @@ var depsRules = `
 	sync/atomic < crypto/internal/boring/bcache, crypto/internal/boring/fipstls;
 	crypto/internal/boring/sig, crypto/internal/boring/fipstls < crypto/tls/fipsonly;
 
+	# crypto/internal/sysrand is the operating system randomness source
+	# shared by crypto/rand and the packages it depends on.
+	OS < crypto/internal/sysrand;
+
+	# The Go Cryptographic Module, see crypto/internal/fips140.
+	hash, internal/godebug, internal/goexperiment
+	< crypto/internal/fips140
+	< crypto/internal/fips140/sha256, crypto/internal/fips140/sha512
+	< crypto/internal/fips140/hmac
+	< crypto/internal/fips140/hkdf;
+
 	# CRYPTO is core crypto algorithms - no cgo, fmt, net.
 	crypto/internal/boring/sig,
 	crypto/internal/boring/syso,
+	crypto/internal/sysrand,
 	golang.org/x/sys/cpu,
 	hash, embed
 	< crypto
+	< crypto/sha3;
+
+	crypto/internal/fips140/sha256,
+	crypto/internal/fips140/sha512,
+	crypto/sha3
+	< crypto/internal/fips140only
 	< crypto/subtle
 	< crypto/internal/alias
 	< crypto/cipher;
 
+	crypto/cipher, crypto/internal/fips140
+	< crypto/internal/fips140/aes;
+
+	crypto/subtle, embed
+	< crypto/internal/fips140/nistec/fiat
+	< crypto/internal/fips140/nistec;
+
+	crypto/internal/fips140/aes,
+	crypto/internal/fips140/hkdf,
+	crypto/internal/fips140/nistec
+	< crypto/internal/fips140/bigmod
+	< crypto/internal/fips140/ecdsa, crypto/internal/fips140/rsa,
+	  crypto/internal/fips140/tls12, crypto/internal/fips140/tls13;
+
 	crypto/cipher,
 	crypto/internal/boring/bcache
 	< crypto/internal/boring
@@ -462,32 +499,41 @@ var depsRules = `
 
 	crypto/internal/alias
 	< crypto/internal/randutil
-	< crypto/internal/nistec/fiat
-	< crypto/internal/nistec
 	< crypto/internal/edwards25519/field
-	< crypto/internal/edwards25519;
+	< crypto/internal/edwards25519
+	< crypto/internal/edwards448/field;
 
-	crypto/boring
+	crypto/boring,
+	crypto/internal/fips140/aes,
+	crypto/internal/fips140/hmac
 	< crypto/aes, crypto/des, crypto/hmac, crypto/md5, crypto/rc4,
 	  crypto/sha1, crypto/sha256, crypto/sha512;
 
-	crypto/boring, crypto/internal/edwards25519/field
+	crypto/boring, crypto/internal/edwards25519/field,
+	crypto/internal/edwards448/field, crypto/internal/fips140/nistec
 	< crypto/ecdh;
 
-	# Unfortunately, stuck with reflect via encoding/binary.
-	encoding/binary, crypto/boring < golang.org/x/crypto/sha3;
+	crypto/hmac, crypto/internal/fips140/hkdf
+	< crypto/hkdf, crypto/pbkdf2;
 
 	crypto/aes,
 	crypto/des,
 	crypto/ecdh,
+	crypto/hkdf,
 	crypto/hmac,
 	crypto/internal/edwards25519,
+	crypto/internal/edwards448/field,
+	crypto/internal/fips140/ecdsa,
+	crypto/internal/fips140/rsa,
+	crypto/internal/fips140/tls12,
+	crypto/internal/fips140/tls13,
 	crypto/md5,
+	crypto/pbkdf2,
 	crypto/rc4,
 	crypto/sha1,
 	crypto/sha256,
-	crypto/sha512,
-	golang.org/x/crypto/sha3
+	crypto/sha3,
+	crypto/sha512
 	< CRYPTO;
 
 	CGO, fmt, net !< CRYPTO;
@@ -496,18 +542,27 @@ var depsRules = `
 	CRYPTO, FMT, math/big
 	< crypto/internal/boring/bbig
 	< crypto/rand
-	< crypto/internal/mlkem768
+	< crypto/internal/mlkem
+	< crypto/mlkem
 	< crypto/ed25519
 	< encoding/asn1
 	< golang.org/x/crypto/cryptobyte/asn1
 	< golang.org/x/crypto/cryptobyte
-	< crypto/internal/bigmod
-	< crypto/dsa, crypto/elliptic, crypto/rsa
+	< crypto/internal/edwards448
+	< crypto/dsa, crypto/ed448, crypto/elliptic, crypto/rsa
 	< crypto/ecdsa
 	< CRYPTO-MATH;
 
 	CGO, net !< CRYPTO-MATH;
 
+	CRYPTO-MATH, encoding/base64
+	< crypto/internal/blake2b, crypto/internal/phc
+	< crypto/argon2, crypto/scrypt;
+
+	# archives
+	CRYPTO-MATH, compress/flate, compress/zstd, internal/extract
+	< archive/zip;
+
 	# TLS, Prince of Dependencies.
 	CRYPTO-MATH, NET, container/list, encoding/hex, encoding/pem
 	< golang.org/x/crypto/internal/alias
@@ -515,13 +570,15 @@ var depsRules = `
 	< golang.org/x/crypto/chacha20
 	< golang.org/x/crypto/internal/poly1305
 	< golang.org/x/crypto/chacha20poly1305
-	< golang.org/x/crypto/hkdf
-	< crypto/internal/hpke
-	< crypto/x509/internal/macos
+	< crypto/chacha20poly1305, crypto/hpke
+	< crypto/x509/internal/macos, crypto/x509/internal/rc2
 	< crypto/x509/pkix;
 
 	crypto/internal/boring/fipstls, crypto/x509/pkix
-	< crypto/x509
+	< crypto/x509;
+
+	# certificate compression (RFC 8879) uses zlib, brotli and zstd.
+	crypto/x509, compress/brotli, compress/zlib, compress/zstd
 	< crypto/tls;
 
 	# crypto-aware packages
@@ -557,7 +614,9 @@ var depsRules = `
 	NET, crypto/tls
 	< net/http/httptrace;
 
+	compress/brotli,
 	compress/gzip,
+	compress/zstd,
 	golang.org/x/net/http/httpguts,
 	golang.org/x/net/http/httpproxy,
 	golang.org/x/net/http2/hpack,
@@ -580,6 +639,10 @@ var depsRules = `
 	net/http, flag
 	< net/http/httptest;
 
+	encoding/json, net/http
+	< crypto/acme
+	< crypto/acme/autocert;
+
 	net/http, regexp
 	< net/http/cgi
 	< net/http/fcgi;
@@ -617,6 +680,9 @@ var depsRules = `
 	log/slog, testing
 	< testing/slogtest;
 
+	encoding/json, net/http/httptest, testing
+	< crypto/acme/internal/acmetest;
+
 	FMT, crypto/sha256, encoding/json, go/ast, go/parser, go/token,
 	internal/godebug, math/rand, encoding/hex, crypto/sha256
 	< internal/fuzz;
@@ -0,0 +1,359 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package extract writes the files of an archive into a directory,
+// for archive/tar and archive/zip.
+//
+// The files are kept within the directory. Names that are not local
+// are rejected, files are never created or written through symbolic
+// links, and symbolic links are only created if they cannot lead out
+// of the directory. This holds as long as no other process modifies
+// the directory during the extraction.
+package extract
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Symlink policies.
+const (
+	SymlinkError = iota // fail
+	SymlinkSkip         // skip symbolic links
+	SymlinkLocal        // create links that stay within the directory
+)
+
+// Options mirror the ExtractOptions of archive/tar and archive/zip.
+type Options struct {
+	Symlinks            int
+	SkipSpecial         bool
+	PreserveOwner       bool
+	PreservePermissions bool
+	Overwrite           bool
+	MaxFiles            int64
+	MaxFileSize         int64
+	MaxTotalSize        int64
+}
+
+// Kinds of file.
+type Kind int
+
+const (
+	Regular Kind = iota
+	Dir
+	Symlink
+	Hardlink
+	Special // devices and named pipes
+)
+
+// An Entry describes a file in an archive.
+type Entry struct {
+	Name     string // slash-separated path in the archive
+	Kind     Kind
+	Linkname string // the target of a symbolic or hard link
+	Mode     fs.FileMode
+	ModTime  time.Time // if zero, the time is not set
+	Uid, Gid int
+	Size     int64 // size of the content of a regular file
+}
+
+var (
+	errSymlink  = errors.New("symbolic links are not allowed")
+	errLinkDest = errors.New("link leads outside the destination")
+	errSpecial  = errors.New("special files are not allowed")
+	errThrough  = errors.New("path goes through a symbolic link or a file")
+	errNotFile  = errors.New("hard link target is not a regular file")
+	errFiles    = errors.New("too many files")
+	errFileSize = errors.New("file too large")
+	errTotal    = errors.New("total size of files too large")
+)
+
+// An Extractor writes files into a destination directory.
+type Extractor struct {
+	dst         string
+	opts        Options
+	errInsecure error // the ErrInsecurePath of the archive package
+	files       int64
+	total       int64
+	dirs        []*Entry // directories whose metadata is set by Finish
+}
+
+// New returns an Extractor that writes files into dst,
+// creating it if it does not exist.
+func New(dst string, opts *Options, errInsecure error) (*Extractor, error) {
+	if err := os.MkdirAll(dst, 0777); err != nil {
+		return nil, err
+	}
+	x := &Extractor{dst: dst, errInsecure: errInsecure}
+	if opts != nil {
+		x.opts = *opts
+	}
+	return x, nil
+}
+
+func pathError(name string, err error) error {
+	return &fs.PathError{Op: "extract", Path: name, Err: err}
+}
+
+// Extract extracts the file e. For a regular file, it calls write
+// to write the content to w, which is an [*os.File].
+func (x *Extractor) Extract(e *Entry, write func(w io.Writer) error) error {
+	if e.Kind == Special {
+		if x.opts.SkipSpecial {
+			return nil
+		}
+		return pathError(e.Name, errSpecial)
+	}
+	if e.Kind == Symlink && x.opts.Symlinks != SymlinkLocal {
+		if x.opts.Symlinks == SymlinkSkip {
+			return nil
+		}
+		return pathError(e.Name, errSymlink)
+	}
+	name, err := x.localName(e.Name)
+	if err != nil {
+		return err
+	}
+	if name == "." {
+		// The destination itself.
+		if e.Kind == Dir {
+			return nil
+		}
+		return pathError(e.Name, x.errInsecure)
+	}
+	if x.files++; x.opts.MaxFiles > 0 && x.files > x.opts.MaxFiles {
+		return pathError(e.Name, errFiles)
+	}
+	if e.Kind == Regular {
+		if x.opts.MaxFileSize > 0 && e.Size > x.opts.MaxFileSize {
+			return pathError(e.Name, errFileSize)
+		}
+		if x.total += e.Size; x.opts.MaxTotalSize > 0 && x.total > x.opts.MaxTotalSize {
+			return pathError(e.Name, errTotal)
+		}
+	}
+	if err := x.mkdirParents(name); err != nil {
+		return pathError(e.Name, err)
+	}
+	path := filepath.Join(x.dst, name)
+
+	switch e.Kind {
+	case Dir:
+		if err := x.mkdir(path, e.Mode); err != nil {
+			return pathError(e.Name, err)
+		}
+		x.dirs = append(x.dirs, e)
+		return nil
+	case Symlink:
+		if !localLink(name, e.Linkname) {
+			return pathError(e.Name, errLinkDest)
+		}
+		if err := x.remove(path); err != nil {
+			return pathError(e.Name, err)
+		}
+		if err := os.Symlink(filepath.FromSlash(e.Linkname), path); err != nil {
+			return err
+		}
+		if x.opts.PreserveOwner {
+			return os.Lchown(path, e.Uid, e.Gid)
+		}
+		return nil
+	case Hardlink:
+		old, err := x.localName(e.Linkname)
+		if err != nil {
+			return err
+		}
+		if err := x.checkParents(old); err != nil {
+			return pathError(e.Name, err)
+		}
+		oldPath := filepath.Join(x.dst, old)
+		if fi, err := os.Lstat(oldPath); err != nil {
+			return err
+		} else if !fi.Mode().IsRegular() {
+			return pathError(e.Name, errNotFile)
+		}
+		if err := x.remove(path); err != nil {
+			return pathError(e.Name, err)
+		}
+		return os.Link(oldPath, path)
+	}
+
+	if err := x.remove(path); err != nil {
+		return pathError(e.Name, err)
+	}
+	// O_EXCL makes sure that the file is created, not a link followed.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, e.Mode.Perm())
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if err == nil && x.opts.PreserveOwner {
+		err = f.Chown(e.Uid, e.Gid)
+	}
+	if err == nil && x.opts.PreservePermissions {
+		// After Chown, which may clear the setuid and setgid bits.
+		err = f.Chmod(e.Mode & (fs.ModePerm | fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky))
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err == nil && !e.ModTime.IsZero() {
+		err = os.Chtimes(path, time.Time{}, e.ModTime)
+	}
+	return err
+}
+
+// Finish sets the ownership, permissions, and modification times of
+// the directories, which is done last so that creating files in them
+// does not change their times, and permissions do not prevent it.
+func (x *Extractor) Finish() error {
+	// Deeper directories first.
+	slices.SortStableFunc(x.dirs, func(a, b *Entry) int {
+		return strings.Count(b.Name, "/") - strings.Count(a.Name, "/")
+	})
+	for _, e := range x.dirs {
+		name, _ := x.localName(e.Name)
+		path := filepath.Join(x.dst, name)
+		if x.opts.PreserveOwner {
+			if err := os.Lchown(path, e.Uid, e.Gid); err != nil {
+				return err
+			}
+		}
+		if x.opts.PreservePermissions {
+			if err := os.Chmod(path, e.Mode&(fs.ModePerm|fs.ModeSetuid|fs.ModeSetgid|fs.ModeSticky)); err != nil {
+				return err
+			}
+		}
+		if !e.ModTime.IsZero() {
+			if err := os.Chtimes(path, time.Time{}, e.ModTime); err != nil {
+				return err
+			}
+		}
+	}
+	x.dirs = nil
+	return nil
+}
+
+// localName returns name as a clean local path in the native format.
+func (x *Extractor) localName(name string) (string, error) {
+	name = filepath.FromSlash(strings.TrimSuffix(name, "/"))
+	if name == "" {
+		return ".", nil
+	}
+	if !filepath.IsLocal(name) {
+		return "", pathError(name, x.errInsecure)
+	}
+	return filepath.Clean(name), nil
+}
+
+// mkdirParents creates the directories that lead to name, if needed.
+// It fails if one of them exists and is not a directory.
+func (x *Extractor) mkdirParents(name string) error {
+	dir := filepath.Dir(name)
+	if dir == "." {
+		return nil
+	}
+	path := x.dst
+	for _, elem := range strings.Split(dir, string(filepath.Separator)) {
+		path = filepath.Join(path, elem)
+		fi, err := os.Lstat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			if err := os.Mkdir(path, 0777); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return errThrough
+		}
+	}
+	return nil
+}
+
+// checkParents checks that the directories that lead
+// to name exist and are not symbolic links.
+func (x *Extractor) checkParents(name string) error {
+	path := x.dst
+	for _, elem := range strings.Split(filepath.Dir(name), string(filepath.Separator)) {
+		path = filepath.Join(path, elem)
+		fi, err := os.Lstat(path)
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return errThrough
+		}
+	}
+	return nil
+}
+
+// mkdir creates the directory path, unless it already exists.
+func (x *Extractor) mkdir(path string, mode fs.FileMode) error {
+	fi, err := os.Lstat(path)
+	if err == nil && fi.IsDir() {
+		return nil
+	}
+	if err == nil {
+		if err := x.remove(path); err != nil {
+			return err
+		}
+	}
+	// Keep the directory writable until Finish.
+	return os.Mkdir(path, mode.Perm()|0700)
+}
+
+// remove makes way for a new file at path. If there is a file or a
+// link there, it is removed if files may be overwritten, and is an
+// error otherwise. A directory there is always an error.
+func (x *Extractor) remove(path string) error {
+	fi, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() || !x.opts.Overwrite {
+		return fs.ErrExist
+	}
+	return os.Remove(path)
+}
+
+// localLink reports whether a symbolic link at name, a clean local
+// path, to target leads to a file within the destination.
+//
+// Resolving a path that goes up with ".." from a symbolic link leads
+// up from the link's target, not from the link itself, so a target
+// may only go up at its start, from the link's directory, which is
+// a real directory. Then it goes down, through directories and links
+// that stay within the destination.
+func localLink(name, target string) bool {
+	if target == "" || strings.HasPrefix(target, "/") || strings.Contains(target, `\`) || filepath.VolumeName(target) != "" {
+		return false
+	}
+	depth := strings.Count(name, string(filepath.Separator))
+	down := false
+	for _, elem := range strings.Split(target, "/") {
+		switch elem {
+		case "", ".":
+		case "..":
+			if down || depth == 0 {
+				return false
+			}
+			depth--
+		default:
+			down = true
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package extract
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalLink(t *testing.T) {
+	tests := []struct {
+		name, target string
+		want         bool
+	}{
+		{"link", "file", true},
+		{"link", "dir/file", true},
+		{"link", "./dir/./file", true},
+		{"link", "", false},
+		{"link", "..", false},
+		{"link", "/etc/passwd", false},
+		{"link", `dir\file`, false},
+		{"a/link", "..", true},
+		{"a/link", "../b/file", true},
+		{"a/link", "../..", false},
+		{"a/b/link", "../../file", true},
+		{"a/b/link", "../../../file", false},
+		{"a/link", "b/../file", false},
+		{"a/link", "b/../../..", false},
+		{"a/link", "../a/../..", false},
+	}
+	for _, tt := range tests {
+		if got := localLink(filepath.FromSlash(tt.name), tt.target); got != tt.want {
+			t.Errorf("localLink(%q, %q) = %v, want %v", tt.name, tt.target, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// dictMagic is the magic number that starts a zstd dictionary. RFC 5.
+const dictMagic = 0xec30a437
+
+// A Dict is a parsed zstd dictionary. RFC 5.
+//
+// A dictionary that does not start with the dictionary magic number
+// is a raw content dictionary: all of its bytes are content,
+// it has no entropy tables, and its ID is 0.
+type Dict struct {
+	// ID is the dictionary ID recorded in frames that use it.
+	ID uint32
+
+	// Content is the data that logically precedes each frame
+	// using this dictionary.
+	Content []byte
+
+	// RepeatedOffsets are the initial repeated offsets for
+	// frames using this dictionary.
+	RepeatedOffsets [3]uint32
+
+	// Whether the dictionary provided entropy tables.
+	hasEntropy bool
+
+	// The Huffman table used for literals.
+	huffmanTable     []uint16
+	huffmanTableBits int
+
+	// The sequence decode FSE tables.
+	seqTables    [3][]fseBaselineEntry
+	seqTableBits [3]uint8
+}
+
+// ParseDict parses a zstd dictionary.
+// The Content field of the result refers to data.
+func ParseDict(data []byte) (*Dict, error) {
+	if len(data) < 8 || binary.LittleEndian.Uint32(data) != dictMagic {
+		return &Dict{
+			Content:         data,
+			RepeatedOffsets: [3]uint32{1, 4, 8},
+		}, nil
+	}
+
+	d := &Dict{
+		ID: binary.LittleEndian.Uint32(data[4:]),
+	}
+	if d.ID == 0 {
+		return nil, errors.New("zstd: dictionary ID must not be zero")
+	}
+
+	// The tables use the same decoders as a compressed block,
+	// which report errors through a Reader.
+	var r Reader
+	b := block(data)
+	off := 8
+
+	d.huffmanTable = make([]uint16, 1<<maxHuffmanBits)
+	tableBits, off, err := r.readHuff(b, off, d.huffmanTable)
+	if err != nil {
+		return nil, dictError(err)
+	}
+	d.huffmanTableBits = tableBits
+
+	// The FSE tables are in the order offset, match, literal.
+	for _, kind := range [...]seqCode{seqOffset, seqMatch, seqLiteral} {
+		info := &seqCodeInfo[kind]
+		fse := make([]fseEntry, 1<<info.maxBits)
+		tableBits, roff, err := r.readFSE(b, off, info.maxSym, info.maxBits, fse)
+		if err != nil {
+			return nil, dictError(err)
+		}
+		fse = fse[:1<<tableBits]
+		baseline := make([]fseBaselineEntry, len(fse))
+		if err := info.toBaseline(&r, roff, fse, baseline); err != nil {
+			return nil, dictError(err)
+		}
+		d.seqTables[kind] = baseline
+		d.seqTableBits[kind] = uint8(tableBits)
+		off = roff
+	}
+
+	if off+12 > len(data) {
+		return nil, errors.New("zstd: dictionary truncated")
+	}
+	for i := range d.RepeatedOffsets {
+		d.RepeatedOffsets[i] = binary.LittleEndian.Uint32(data[off:])
+		off += 4
+	}
+	d.Content = data[off:]
+
+	for _, o := range d.RepeatedOffsets {
+		if o == 0 || uint64(o) > uint64(len(d.Content)) {
+			return nil, errors.New("zstd: invalid repeated offset in dictionary")
+		}
+	}
+
+	d.hasEntropy = true
+	return d, nil
+}
+
+// dictError converts an error found while decoding
+// the dictionary entropy tables.
+func dictError(err error) error {
+	var ze *zstdError
+	if errors.As(err, &ze) {
+		return errors.New("zstd: invalid dictionary: " + ze.err.Error())
+	}
+	return errors.New("zstd: invalid dictionary: " + err.Error())
+}
+
+// SetDict sets the dictionary used to decompress frames.
+// The dictionary is used for frames that name its ID,
+// and for frames that name no dictionary at all.
+// It is retained by Reset. A nil dictionary removes it.
+func (r *Reader) SetDict(d *Dict) {
+	r.dict = d
+}
+
+// startDict prepares to read a frame that uses the dictionary d.
+// It is called after the window has been reset.
+func (r *Reader) startDict(d *Dict, windowSize int) {
+	// Dictionary content logically precedes the frame, so it must
+	// remain available for backreferences along with the window.
+	r.window.reset(windowSize + len(d.Content))
+	r.window.save(d.Content)
+
+	r.repeatedOffset1 = d.RepeatedOffsets[0]
+	r.repeatedOffset2 = d.RepeatedOffsets[1]
+	r.repeatedOffset3 = d.RepeatedOffsets[2]
+
+	if !d.hasEntropy {
+		return
+	}
+
+	if len(r.huffmanTable) < 1<<maxHuffmanBits {
+		r.huffmanTable = make([]uint16, 1<<maxHuffmanBits)
+	}
+	copy(r.huffmanTable, d.huffmanTable[:1<<d.huffmanTableBits])
+	r.huffmanTableBits = d.huffmanTableBits
+
+	for kind := range d.seqTables {
+		info := &seqCodeInfo[kind]
+		if cap(r.seqTableBuffers[kind]) == 0 {
+			r.seqTableBuffers[kind] = make([]fseBaselineEntry, 1<<info.maxBits)
+		}
+		n := copy(r.seqTableBuffers[kind][:cap(r.seqTableBuffers[kind])], d.seqTables[kind])
+		r.seqTables[kind] = r.seqTableBuffers[kind][:n]
+		r.seqTableBits[kind] = d.seqTableBits[kind]
+	}
+}
@@ -146,3 +146,26 @@ func (xh *xxhash64) mergeRound(v, n uint64) uint64 {
 	v = v*xxhPrime64c1 + xxhPrime64c4
 	return v
 }
+
+// XXHash64 computes the xxHash-64 checksum used for zstd
+// content checksums. The zero value is not ready for use;
+// call Reset first.
+type XXHash64 struct {
+	xh xxhash64
+}
+
+// Reset discards the current state and prepares to compute a new hash.
+func (x *XXHash64) Reset() {
+	x.xh.reset()
+}
+
+// Write adds b to the hash. It never returns an error.
+func (x *XXHash64) Write(b []byte) (int, error) {
+	x.xh.update(b)
+	return len(b), nil
+}
+
+// Sum64 returns the hash of the data written so far.
+func (x *XXHash64) Sum64() uint64 {
+	return x.xh.digest()
+}
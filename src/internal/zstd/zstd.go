@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // Package zstd provides a decompressor for zstd streams,
-// described in RFC 8878. It does not support dictionaries.
+// described in RFC 8878.
 package zstd
 
 import (
@@ -82,6 +82,12 @@ type Reader struct {
 
 	// For checksum computation.
 	checksum xxhash64
+
+	// The dictionary set by SetDict, or nil.
+	dict *Dict
+
+	// The function set by SetFrameHook, or nil.
+	frameHook func(offset int64)
 }
 
 // NewReader creates a new Reader that decompresses data from the given reader.
@@ -119,6 +125,16 @@ func (r *Reader) Reset(input io.Reader) {
 	// seqTableBuffers
 	// scratch
 	// fseScratch
+	// dict
+	// frameHook
+}
+
+// SetFrameHook arranges for f to be called with the offset in the
+// input of each frame, other than skippable frames, once its magic
+// number has been read. When f is called, all of the data of the
+// earlier frames has been returned by Read. It is retained by Reset.
+func (r *Reader) SetFrameHook(f func(offset int64)) {
+	r.frameHook = f
 }
 
 // Read implements [io.Reader].
@@ -190,6 +206,10 @@ retry:
 		return r.makeError(relativeOffset, "invalid magic number")
 	}
 
+	if r.frameHook != nil {
+		r.frameHook(r.blockOffset)
+	}
+
 	relativeOffset += 4
 
 	// Read Frame_Header_Descriptor. RFC 3.1.1.1.1.
@@ -255,12 +275,20 @@ retry:
 	}
 
 	// Dictionary_ID. RFC 3.1.1.1.3.
+	// A zero Dictionary ID, like a missing one, means that
+	// the frame names no dictionary; we use the one we were
+	// given, if any, as the decoder is permitted to do.
 	if dictionaryIdSize != 0 {
-		dictionaryId := r.scratch[windowDescriptorSize : windowDescriptorSize+dictionaryIdSize]
-		// Allow only zero Dictionary ID.
-		for _, b := range dictionaryId {
-			if b != 0 {
-				return r.makeError(relativeOffset, "dictionaries are not supported")
+		var dictionaryId uint32
+		for i, b := range r.scratch[windowDescriptorSize : windowDescriptorSize+dictionaryIdSize] {
+			dictionaryId |= uint32(b) << (8 * i)
+		}
+		if dictionaryId != 0 {
+			if r.dict == nil {
+				return r.makeError(relativeOffset, "frame requires a dictionary")
+			}
+			if r.dict.ID != dictionaryId {
+				return r.wrapError(relativeOffset, fmt.Errorf("frame requires dictionary ID %d, have %d", dictionaryId, r.dict.ID))
 			}
 		}
 	}
@@ -312,6 +340,9 @@ retry:
 	r.seqTables[0] = nil
 	r.seqTables[1] = nil
 	r.seqTables[2] = nil
+	if r.dict != nil {
+		r.startDict(r.dict, int(windowSize))
+	}
 
 	return nil
 }
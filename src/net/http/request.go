@@ -18,10 +18,10 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http/httptrace"
+	"net/http/internal/ascii"
 	"net/textproto"
 	"net/url"
 	urlpkg "net/url"
-	"std/net/http/internal/ascii"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,9 +12,8 @@ import (
 	"internal/godebug"
 	"io"
 	"net/http/httptrace"
-	"std/net/http/internal"
-	"std/net/http/internal/ascii"
-
+	"net/http/internal"
+	"net/http/internal/ascii"
 	"net/textproto"
 	"reflect"
 	"slices"
@@ -11,7 +11,9 @@ package http
 
 import (
 	"bufio"
+	"compress/brotli"
 	"compress/gzip"
+	"compress/zstd"
 	"container/list"
 	"context"
 	"crypto/tls"
@@ -19,6 +21,7 @@ import (
 	"fmt"
 	"internal/godebug"
 	"io"
+	"io/fs"
 	"log"
 	"net"
 	"net/http/httptrace"
@@ -201,6 +204,13 @@ type Transport struct {
 	// uncompressed.
 	DisableCompression bool
 
+	// AcceptBrotliZstd, if true, makes the Transport request brotli
+	// and zstd compression as well as gzip, when it requests
+	// compression, with an "Accept-Encoding: gzip, br, zstd" request
+	// header. Responses in any of these encodings are transparently
+	// decoded in the same way as gzipped responses.
+	AcceptBrotliZstd bool
+
 	// MaxIdleConns controls the maximum number of idle (keep-alive)
 	// connections across all hosts. Zero means no limit.
 	MaxIdleConns int
@@ -302,6 +312,37 @@ func (t *Transport) writeBufferSize() int {
 	return 4 << 10
 }
 
+// requestsCompression reports whether t asks for a compressed
+// response to req, because the caller didn't set their own value
+// for Accept-Encoding.
+func (t *Transport) requestsCompression(req *Request) bool {
+	// Request gzip only, not deflate. Deflate is ambiguous and
+	// not as universally supported anyway.
+	// See: https://zlib.net/zlib_faq.html#faq39
+	//
+	// Note that we don't request this for HEAD requests,
+	// due to a bug in nginx:
+	//   https://trac.nginx.org/nginx/ticket/358
+	//   https://golang.org/issue/5522
+	//
+	// We don't request gzip if the request is for a range, since
+	// auto-decoding a portion of a gzipped document will just fail
+	// anyway. See https://golang.org/issue/8923
+	return !t.DisableCompression &&
+		req.Header.Get("Accept-Encoding") == "" &&
+		req.Header.Get("Range") == "" &&
+		req.Method != "HEAD"
+}
+
+// acceptEncoding returns the Accept-Encoding header value
+// that t uses when it requests compression.
+func (t *Transport) acceptEncoding() string {
+	if t.AcceptBrotliZstd {
+		return "gzip, br, zstd"
+	}
+	return "gzip"
+}
+
 func (t *Transport) readBufferSize() int {
 	if t.ReadBufferSize > 0 {
 		return t.ReadBufferSize
@@ -322,6 +363,7 @@ func (t *Transport) Clone() *Transport {
 		TLSHandshakeTimeout:    t.TLSHandshakeTimeout,
 		DisableKeepAlives:      t.DisableKeepAlives,
 		DisableCompression:     t.DisableCompression,
+		AcceptBrotliZstd:       t.AcceptBrotliZstd,
 		MaxIdleConns:           t.MaxIdleConns,
 		MaxIdleConnsPerHost:    t.MaxIdleConnsPerHost,
 		MaxConnsPerHost:        t.MaxConnsPerHost,
@@ -560,7 +602,7 @@ func (t *Transport) roundTrip(req *Request) (_ *Response, err error) {
 	req = setupRewindBody(req)
 
 	if altRT := t.alternateRoundTripper(req); altRT != nil {
-		if resp, err := altRT.RoundTrip(req); err != ErrSkipAltProtocol {
+		if resp, err := t.roundTripAlt(altRT, req); err != ErrSkipAltProtocol {
 			return resp, err
 		}
 		var err error
@@ -639,7 +681,7 @@ func (t *Transport) roundTrip(req *Request) (_ *Response, err error) {
 		var resp *Response
 		if pconn.alt != nil {
 			// HTTP/2 path.
-			resp, err = pconn.alt.RoundTrip(req)
+			resp, err = t.roundTripAlt(pconn.alt, req)
 		} else {
 			resp, err = pconn.roundTrip(treq)
 		}
@@ -2305,8 +2347,8 @@ func (pc *persistConn) readLoop() {
 		}
 
 		resp.Body = body
-		if rc.addedGzip && ascii.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
-			resp.Body = &gzipReader{body: body}
+		if newReader := pc.t.decompressor(rc.addedGzip, resp.Header.Get("Content-Encoding")); newReader != nil {
+			resp.Body = &gzipReader{body: body, newReader: newReader}
 			resp.Header.Del("Content-Encoding")
 			resp.Header.Del("Content-Length")
 			resp.ContentLength = -1
@@ -2603,8 +2645,8 @@ type requestAndChan struct {
 	ch   chan responseAndError // unbuffered; always send in select on callerGone
 
 	// whether the Transport (as opposed to the user client code)
-	// added the Accept-Encoding gzip header. If the Transport
-	// set it, only then do we transparently decode the gzip.
+	// added the Accept-Encoding header. If the Transport
+	// set it, only then do we transparently decode the response.
 	addedGzip bool
 
 	// Optional blocking chan for Expect: 100-continue (for send).
@@ -2679,27 +2721,12 @@ func (pc *persistConn) roundTrip(req *transportRequest) (resp *Response, err err
 
 	// Ask for a compressed version if the caller didn't set their
 	// own value for Accept-Encoding. We only attempt to
-	// uncompress the gzip stream if we were the layer that
+	// uncompress the response if we were the layer that
 	// requested it.
 	requestedGzip := false
-	if !pc.t.DisableCompression &&
-		req.Header.Get("Accept-Encoding") == "" &&
-		req.Header.Get("Range") == "" &&
-		req.Method != "HEAD" {
-		// Request gzip only, not deflate. Deflate is ambiguous and
-		// not as universally supported anyway.
-		// See: https://zlib.net/zlib_faq.html#faq39
-		//
-		// Note that we don't request this for HEAD requests,
-		// due to a bug in nginx:
-		//   https://trac.nginx.org/nginx/ticket/358
-		//   https://golang.org/issue/5522
-		//
-		// We don't request gzip if the request is for a range, since
-		// auto-decoding a portion of a gzipped document will just fail
-		// anyway. See https://golang.org/issue/8923
+	if pc.t.requestsCompression(req.Request) {
 		requestedGzip = true
-		req.extraHeaders().Set("Accept-Encoding", "gzip")
+		req.extraHeaders().Set("Accept-Encoding", pc.t.acceptEncoding())
 	}
 
 	var continueCh chan struct{}
@@ -2946,19 +2973,120 @@ func (es *bodyEOFSignal) condfn(err error) error {
 	return err
 }
 
-// gzipReader wraps a response body so it can lazily
-// call gzip.NewReader on the first call to Read
+// decompressor returns the function that creates a decompressing
+// reader for a response with the given Content-Encoding, or nil if t
+// does not decode it. The Transport only decodes responses to requests
+// for which it added the Accept-Encoding header itself, as reported
+// by added, in the encodings that it requested.
+func (t *Transport) decompressor(added bool, encoding string) func(io.Reader) (io.Reader, error) {
+	if !added {
+		return nil
+	}
+	switch {
+	case ascii.EqualFold(encoding, "gzip"):
+		return newGzipReader
+	case ascii.EqualFold(encoding, "br") && t.AcceptBrotliZstd:
+		return newBrotliReader
+	case ascii.EqualFold(encoding, "zstd") && t.AcceptBrotliZstd:
+		return newZstdReader
+	}
+	return nil
+}
+
+func newGzipReader(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+func newBrotliReader(r io.Reader) (io.Reader, error) {
+	return brotli.NewReader(r), nil
+}
+
+func newZstdReader(r io.Reader) (io.Reader, error) {
+	return zstd.NewReader(bufio.NewReader(r)), nil
+}
+
+// roundTripAlt sends req with the alternate RoundTripper rt.
+//
+// The bundled HTTP/2 Transport only requests and decodes gzip. If t
+// accepts brotli and zstd too, roundTripAlt requests compression for
+// HTTP/2 requests itself, so that the HTTP/2 Transport leaves the
+// response alone, and decodes the response as HTTP/1 requests do.
+func (t *Transport) roundTripAlt(rt RoundTripper, req *Request) (*Response, error) {
+	switch rt.(type) {
+	case *http2Transport, http2noDialH2RoundTripper:
+	default:
+		return rt.RoundTrip(req)
+	}
+	if !t.AcceptBrotliZstd || !t.requestsCompression(req) {
+		return rt.RoundTrip(req)
+	}
+	r := *req
+	r.Header = req.Header.Clone()
+	r.Header.Set("Accept-Encoding", t.acceptEncoding())
+	resp, err := rt.RoundTrip(&r)
+	if err != nil {
+		return resp, err
+	}
+	if resp.Request == &r {
+		resp.Request = req
+	}
+	if newReader := t.decompressor(true, resp.Header.Get("Content-Encoding")); newReader != nil {
+		resp.Body = &decompressReader{body: resp.Body, newReader: newReader}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+	return resp, nil
+}
+
+// decompressReader wraps an HTTP/2 response body so it can lazily
+// create a decompressing reader on the first call to Read.
+type decompressReader struct {
+	_         incomparable
+	body      io.ReadCloser                      // underlying Response.Body
+	newReader func(io.Reader) (io.Reader, error) // creates zr
+	zr        io.Reader                          // lazily-initialized decompressing reader
+	zerr      error                              // sticky error
+}
+
+func (dr *decompressReader) Read(p []byte) (n int, err error) {
+	if dr.zerr != nil {
+		return 0, dr.zerr
+	}
+	if dr.zr == nil {
+		dr.zr, err = dr.newReader(dr.body)
+		if err != nil {
+			dr.zerr = err
+			return 0, err
+		}
+	}
+	return dr.zr.Read(p)
+}
+
+func (dr *decompressReader) Close() error {
+	if err := dr.body.Close(); err != nil {
+		return err
+	}
+	dr.zerr = fs.ErrClosed
+	return nil
+}
+
+// gzipReader wraps a response body so it can lazily create a
+// decompressing reader, usually with gzip.NewReader, on the first
+// call to Read
 type gzipReader struct {
-	_    incomparable
-	body *bodyEOFSignal // underlying HTTP/1 response body framing
-	zr   *gzip.Reader   // lazily-initialized gzip reader
-	zerr error          // any error from gzip.NewReader; sticky
+	_         incomparable
+	body      *bodyEOFSignal                     // underlying HTTP/1 response body framing
+	newReader func(io.Reader) (io.Reader, error) // creates zr
+	zr        io.Reader                          // lazily-initialized decompressing reader
+	zerr      error                              // any error from newReader; sticky
 }
 
 func (gz *gzipReader) Read(p []byte) (n int, err error) {
 	if gz.zr == nil {
 		if gz.zerr == nil {
-			gz.zr, gz.zerr = gzip.NewReader(gz.body)
+			gz.zr, gz.zerr = gz.newReader(gz.body)
 		}
 		if gz.zerr != nil {
 			return 0, gz.zerr
@@ -13,6 +13,7 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"compress/zstd"
 	"context"
 	"crypto/rand"
 	"crypto/tls"
@@ -4327,6 +4328,74 @@ func testTransportContentEncodingCaseInsensitive(t *testing.T, mode testMode) {
 	}
 }
 
+func TestTransportAcceptBrotliZstd(t *testing.T) { run(t, testTransportAcceptBrotliZstd) }
+func testTransportAcceptBrotliZstd(t *testing.T, mode testMode) {
+	const want = "abababababababababab"
+	// want, compressed with brotli.
+	br := []byte{0x1b, 0x13, 0x00, 0xf8, 0xa5, 0xc2, 0xc4, 0x42, 0x8c, 0x00, 0x40, 0x05}
+	var zs bytes.Buffer
+	zw := zstd.NewWriter(&zs)
+	zw.Write([]byte(want))
+	zw.Close()
+
+	for _, accept := range []bool{false, true} {
+		for _, ce := range []string{"br", "zstd", "ZSTD"} {
+			t.Run(fmt.Sprintf("%s/accept=%v", ce, accept), func(t *testing.T) {
+				cst := newClientServerTest(t, mode, HandlerFunc(func(w ResponseWriter, r *Request) {
+					wantAE := "gzip"
+					if accept {
+						wantAE = "gzip, br, zstd"
+					}
+					if got := r.Header.Get("Accept-Encoding"); got != wantAE {
+						t.Errorf("Accept-Encoding = %q, want %q", got, wantAE)
+					}
+					w.Header().Set("Content-Encoding", ce)
+					if ce == "br" {
+						w.Write(br)
+					} else {
+						w.Write(zs.Bytes())
+					}
+				}), func(tr *Transport) {
+					tr.AcceptBrotliZstd = accept
+				})
+
+				res, err := cst.c.Get(cst.ts.URL)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, err := io.ReadAll(res.Body)
+				res.Body.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !accept {
+					// Encodings that were not requested are left alone.
+					if got := res.Header.Get("Content-Encoding"); got != ce {
+						t.Errorf("Content-Encoding = %q, want %q", got, ce)
+					}
+					if string(body) == want {
+						t.Errorf("body was decoded, but %s was not requested", ce)
+					}
+					return
+				}
+				if string(body) != want {
+					t.Errorf("body = %q, want %q", body, want)
+				}
+				if !res.Uncompressed {
+					t.Error("Uncompressed = false, want true")
+				}
+				if got := res.Header.Get("Content-Encoding"); got != "" {
+					t.Errorf("Content-Encoding = %q, want none", got)
+				}
+				if res.ContentLength != -1 {
+					t.Errorf("ContentLength = %d, want -1", res.ContentLength)
+				}
+			})
+		}
+	}
+}
+
 // https://go.dev/issue/49621
 func TestConnClosedBeforeRequestIsWritten(t *testing.T) {
 	run(t, testConnClosedBeforeRequestIsWritten, testNotParallel, []testMode{http1Mode})
@@ -6317,6 +6386,7 @@ func TestTransportClone(t *testing.T) {
 		TLSHandshakeTimeout:    time.Second,
 		DisableKeepAlives:      true,
 		DisableCompression:     true,
+		AcceptBrotliZstd:       true,
 		MaxIdleConns:           1,
 		MaxIdleConnsPerHost:    1,
 		MaxConnsPerHost:        1,